@@ -175,6 +175,23 @@ func runCLI(flags cliFlags) {
 		fmt.Println("  ORCA_PORT              Server port (default: 4040)")
 		fmt.Println("  ORCA_LOG_LEVEL         Log level (default: INFO)")
 		fmt.Println("  ORCA_ENV               Environment (production/prod for production mode - if in production mode TLS will be used throughout for all gRPC connections)")
+		fmt.Println("  ORCA_RETRY_MAX_ATTEMPTS        Attempts made at each processor call, including the first (default: 3)")
+		fmt.Println("  ORCA_RETRY_INITIAL_BACKOFF     Backoff before the first retry (default: 500ms)")
+		fmt.Println("  ORCA_RETRY_MAX_BACKOFF         Upper bound on the backoff between retries (default: 10s)")
+		fmt.Println("  ORCA_RETRY_BACKOFF_MULTIPLIER  Factor the backoff grows by after each retry (default: 2)")
+		fmt.Println("  ORCA_RETRY_JITTER              Fraction of the backoff that is randomised (default: 0.2)")
+		fmt.Println("  ORCA_RETRY_CODES               Comma separated gRPC codes that are retried (default: UNAVAILABLE,RESOURCE_EXHAUSTED,ABORTED)")
+		fmt.Println("  ORCA_PROCESSOR_CONN_IDLE_TIMEOUT  How long a processor connection may be unused before it is closed (default: 5m)")
+		fmt.Println("  ORCA_ALGORITHM_TIMEOUT         Longest a processor may take to execute an algorithm without its own timeout (default: 10m)")
+		fmt.Println("  ORCA_EXECUTION_WORKERS         Executions processed at once, bounding the calls made to processors and the database (default: 20)")
+		fmt.Println("  ORCA_EXECUTION_QUEUE_SIZE      Executions that can wait for a worker before windows are rejected (default: 1000)")
+		fmt.Println("  ORCA_REPROCESS_RATE            Stored windows a reprocess triggers per second (default: 10)")
+		fmt.Println("  ORCA_RESULT_WRITE_POLICY       overwrite or revision, whether rewritten results keep the values they replace (default: overwrite)")
+		fmt.Println("  ORCA_DISPATCH_POLICY           least_loaded or round_robin, how tasks are spread between processor instances (default: least_loaded)")
+		fmt.Println("  ORCA_HEARTBEAT_INTERVAL        How often every processor instance is health checked (default: 30s)")
+		fmt.Println("  ORCA_PROCESSOR_UNAVAILABLE_AFTER  How long a processor instance can go unseen before it is marked unavailable (default: 2m)")
+		fmt.Println("  ORCA_SCHEDULE_INTERVAL         How often window schedules are checked for windows that are due (default: 10s)")
+		fmt.Println("  ORCA_EXECUTION_LEASE           How long an unfinished execution stays leased to the instance running it (default: 1m)")
 		return
 	}
 
@@ -34,6 +34,25 @@ func (n Node) AlgoDepIds() []int64 {
 	return n.algoDepIds
 }
 
+func (n Node) ProcId() int64 {
+	return n.procId
+}
+
+func (n Node) WindowId() int64 {
+	return n.windowId
+}
+
+// NewNode constructs a Node outside of plan building, e.g. when
+// rehydrating a Plan that was persisted to the datalayer
+func NewNode(algoId int64, procId int64, windowId int64, algoDepIds []int64) Node {
+	return Node{
+		algoId:     algoId,
+		procId:     procId,
+		windowId:   windowId,
+		algoDepIds: algoDepIds,
+	}
+}
+
 // ProcessorTask represents a set of tasks (nodes) assigned to a single processor
 type ProcessorTask struct {
 	ProcId int64
@@ -106,11 +125,19 @@ func LayeredTopoSort(g graph.Directed) ([][]graph.Node, error) {
 
 // BuildPlan builds a parallel execution Plan from the DAG represented by algoExecPaths,
 // windowExecPaths, and procExecPaths.
+//
+// Only algorithms of the target window type are planned. Algorithms of other
+// window types that they depend on are kept in their dependencies, with their
+// results expected to already be in storage.
+//
+// When target algorithms are given, the graph is pruned to them and the
+// algorithms they transitively depend on
 func BuildPlan(
 	algoExecPaths []string,
 	windowExecPaths []string,
 	procExecPaths []string,
 	targetWindowId int64,
+	targetAlgoIds []int64,
 ) (Plan, error) {
 	if len(algoExecPaths) != len(windowExecPaths) || len(windowExecPaths) != len(procExecPaths) {
 		return Plan{}, fmt.Errorf(
@@ -184,6 +211,28 @@ func BuildPlan(
 		}
 	}
 
+	// nodes to keep when pruning to the target algorithms
+	var keep map[int64]bool
+	if len(targetAlgoIds) > 0 {
+		keep = make(map[int64]bool)
+		var visit func(node graph.Node)
+		visit = func(node graph.Node) {
+			if keep[node.ID()] {
+				return
+			}
+			keep[node.ID()] = true
+			upstream := g.To(node.ID())
+			for upstream.Next() {
+				visit(upstream.Node())
+			}
+		}
+		for _, algoId := range targetAlgoIds {
+			if node, ok := nodeMap[algoId]; ok {
+				visit(node)
+			}
+		}
+	}
+
 	layers, err := LayeredTopoSort(g)
 	if err != nil {
 		return Plan{}, fmt.Errorf("error during layered topological sort: %v", err)
@@ -215,8 +264,17 @@ func BuildPlan(
 			// sort the algo deps within the node
 			slices.Sort(node.algoDepIds)
 
+			if node.windowId != targetWindowId {
+				continue
+			}
+			if keep != nil && !keep[node.id] {
+				continue
+			}
 			taskMap[node.procId] = append(taskMap[node.procId], node)
 		}
+		if len(taskMap) == 0 {
+			continue
+		}
 		var stage Stage
 		for procId, nodes := range taskMap {
 			if !slices.Contains(plan.AffectedProcessors, procId) {
@@ -244,6 +302,46 @@ func BuildPlan(
 	return plan, nil
 }
 
+// FilterPlan returns the plan with only the given algorithms, dropping any
+// tasks and stages left empty. The dependencies of the remaining algorithms
+// are kept, with the results of algorithms filtered out expected to already
+// be in storage. The full plan is returned when no algorithms are given
+func FilterPlan(plan Plan, algoIds []int64) Plan {
+	if len(algoIds) == 0 {
+		return plan
+	}
+
+	var filtered Plan
+	for _, stage := range plan.Stages {
+		var filteredStage Stage
+		for _, task := range stage.Tasks {
+			var nodes []Node
+			for _, node := range task.Nodes {
+				if slices.Contains(algoIds, node.algoId) {
+					nodes = append(nodes, node)
+				}
+			}
+			if len(nodes) == 0 {
+				continue
+			}
+			if !slices.Contains(filtered.AffectedProcessors, task.ProcId) {
+				filtered.AffectedProcessors = append(filtered.AffectedProcessors, task.ProcId)
+			}
+			filteredStage.Tasks = append(filteredStage.Tasks, ProcessorTask{
+				ProcId: task.ProcId,
+				Nodes:  nodes,
+			})
+		}
+		if len(filteredStage.Tasks) == 0 {
+			continue
+		}
+		filtered.Stages = append(filtered.Stages, filteredStage)
+	}
+	slices.Sort(filtered.AffectedProcessors)
+
+	return filtered
+}
+
 // splitPath splits a path string into segments.
 func splitPath(path string) []string {
 	return strings.Split(path, ".")
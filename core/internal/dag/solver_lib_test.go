@@ -12,6 +12,7 @@ func TestBuildPlan(t *testing.T) {
 		windowExecPath []string
 		procExecPath   []string
 		targetWindowId int64
+		targetAlgoIds  []int64
 		want           Plan
 		wantErr        bool
 	}{
@@ -81,6 +82,41 @@ func TestBuildPlan(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:           "cross window type dependency",
+			algoExecPath:   []string{"1.2.3"},
+			windowExecPath: []string{"1.2.2"},
+			procExecPath:   []string{"1.2.2"},
+			targetWindowId: 2,
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 2, Nodes: []Node{{algoId: 2, procId: 2, algoDepIds: []int64{1}}}},
+					}},
+					{Tasks: []ProcessorTask{
+						{ProcId: 2, Nodes: []Node{{algoId: 3, procId: 2, algoDepIds: []int64{2}}}},
+					}},
+				},
+				AffectedProcessors: []int64{2},
+			},
+			wantErr: false,
+		},
+		{
+			name:           "downstream window type not planned",
+			algoExecPath:   []string{"1.2.3"},
+			windowExecPath: []string{"1.2.2"},
+			procExecPath:   []string{"1.2.2"},
+			targetWindowId: 1,
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 1, Nodes: []Node{{algoId: 1, procId: 1, algoDepIds: nil}}},
+					}},
+				},
+				AffectedProcessors: []int64{1},
+			},
+			wantErr: false,
+		},
 		{
 			name:           "cycle detection",
 			algoExecPath:   []string{"1.2", "2.1"},
@@ -155,6 +191,76 @@ func TestBuildPlan(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:           "target algorithm with its upstream",
+			algoExecPath:   []string{"1.2.5", "3.4.5", "6.7"},
+			windowExecPath: []string{"1.1.1", "1.1.1", "1.1"},
+			procExecPath:   []string{"1.2.3", "1.2.3", "4.5"},
+			targetWindowId: 1,
+			targetAlgoIds:  []int64{4},
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 1, Nodes: []Node{{algoId: 3, procId: 1, algoDepIds: nil}}},
+					}},
+					{Tasks: []ProcessorTask{
+						{ProcId: 2, Nodes: []Node{{algoId: 4, procId: 2, algoDepIds: []int64{3}}}},
+					}},
+				},
+				AffectedProcessors: []int64{1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name:           "targets sharing upstream",
+			algoExecPath:   []string{"1.2.4", "1.3.4", "5"},
+			windowExecPath: []string{"1.1.1", "1.1.1", "1"},
+			procExecPath:   []string{"1.2.3", "1.2.3", "4"},
+			targetWindowId: 1,
+			targetAlgoIds:  []int64{2, 3},
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 1, Nodes: []Node{{algoId: 1, procId: 1, algoDepIds: nil}}},
+					}},
+					{Tasks: []ProcessorTask{
+						{ProcId: 2, Nodes: []Node{
+							{algoId: 2, procId: 2, algoDepIds: []int64{1}},
+							{algoId: 3, procId: 2, algoDepIds: []int64{1}},
+						}},
+					}},
+				},
+				AffectedProcessors: []int64{1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name:           "target upstream of another window type not planned",
+			algoExecPath:   []string{"1.2.3"},
+			windowExecPath: []string{"1.2.2"},
+			procExecPath:   []string{"1.2.2"},
+			targetWindowId: 2,
+			targetAlgoIds:  []int64{2},
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 2, Nodes: []Node{{algoId: 2, procId: 2, algoDepIds: []int64{1}}}},
+					}},
+				},
+				AffectedProcessors: []int64{2},
+			},
+			wantErr: false,
+		},
+		{
+			name:           "unknown target algorithm",
+			algoExecPath:   []string{"1.2"},
+			windowExecPath: []string{"1.1"},
+			procExecPath:   []string{"1.1"},
+			targetWindowId: 1,
+			targetAlgoIds:  []int64{3},
+			want:           Plan{},
+			wantErr:        false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -164,6 +270,7 @@ func TestBuildPlan(t *testing.T) {
 				tt.windowExecPath,
 				tt.procExecPath,
 				tt.targetWindowId,
+				tt.targetAlgoIds,
 			)
 
 			// Handling errors
@@ -188,6 +295,96 @@ func TestBuildPlan(t *testing.T) {
 	}
 }
 
+func TestFilterPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		algoIds []int64
+		want    Plan
+	}{
+		{
+			name:    "no algorithms keeps the full plan",
+			algoIds: nil,
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 1, Nodes: []Node{{algoId: 1, procId: 1, algoDepIds: nil}}},
+					}},
+					{Tasks: []ProcessorTask{
+						{ProcId: 2, Nodes: []Node{
+							{algoId: 2, procId: 2, algoDepIds: []int64{1}},
+							{algoId: 3, procId: 2, algoDepIds: []int64{1}},
+						}},
+					}},
+					{Tasks: []ProcessorTask{
+						{ProcId: 3, Nodes: []Node{
+							{algoId: 4, procId: 3, algoDepIds: []int64{2, 3}},
+						}},
+					}},
+				},
+				AffectedProcessors: []int64{1, 2, 3},
+			},
+		},
+		{
+			name:    "downstream algorithm keeps its dependencies",
+			algoIds: []int64{4},
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 3, Nodes: []Node{
+							{algoId: 4, procId: 3, algoDepIds: []int64{2, 3}},
+						}},
+					}},
+				},
+				AffectedProcessors: []int64{3},
+			},
+		},
+		{
+			name:    "algorithms in separate stages",
+			algoIds: []int64{1, 3},
+			want: Plan{
+				Stages: []Stage{
+					{Tasks: []ProcessorTask{
+						{ProcId: 1, Nodes: []Node{{algoId: 1, procId: 1, algoDepIds: nil}}},
+					}},
+					{Tasks: []ProcessorTask{
+						{ProcId: 2, Nodes: []Node{
+							{algoId: 3, procId: 2, algoDepIds: []int64{1}},
+						}},
+					}},
+				},
+				AffectedProcessors: []int64{1, 2},
+			},
+		},
+		{
+			name:    "unknown algorithm leaves an empty plan",
+			algoIds: []int64{5},
+			want:    Plan{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := BuildPlan(
+				[]string{"1.2.4", "1.3.4"},
+				[]string{"1.1.1", "1.1.1"},
+				[]string{"1.2.3", "1.2.3"},
+				1,
+				nil,
+			)
+			if err != nil {
+				t.Fatalf("BuildPlan() error = %v", err)
+			}
+
+			got := normalisePlan(FilterPlan(plan, tt.algoIds))
+			want := normalisePlan(tt.want)
+
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("FilterPlan() = %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
 // normalisePlan removes ID fields before comparison because they are generated at runtime.
 func normalisePlan(plan Plan) Plan {
 	for stageIdx := range plan.Stages {
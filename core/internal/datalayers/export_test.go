@@ -9,6 +9,8 @@ import (
 	"log"
 	"log/slog"
 	"net"
+	"slices"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc"
@@ -20,16 +22,52 @@ import (
 
 type mockOrcaProcessorServer struct {
 	pb.UnimplementedOrcaProcessorServer
+
+	// number of ExecuteDagPart calls left to fail with UNAVAILABLE
+	failures atomic.Int32
+
+	// algorithms that report a handled failure rather than a result
+	failingAlgorithms []string
+
+	// how long each algorithm takes to execute
+	delay time.Duration
+
+	// the load reported through health checks, and whether to report the
+	// processor as not serving
+	activeTasks int32
+	notServing  bool
+
+	// number of ExecuteDagPart calls left that fail with UNAVAILABLE once
+	// their results are sent. The failing algorithms only fail in these calls
+	// when the processor recovers from them
+	crashes  atomic.Int32
+	recovers bool
+
+	// number of ExecuteDagPart calls received
+	executions atomic.Int32
 }
 
 // ExecuteDagPart implements the streaming RPC for DAG execution
 func (s *mockOrcaProcessorServer) ExecuteDagPart(req *pb.ExecutionRequest, stream pb.OrcaProcessor_ExecuteDagPartServer) error {
 	slog.Debug("Received ExecuteDagPart request", "exec_id", req.GetExecId())
+	s.executions.Add(1)
+
+	if s.failures.Add(-1) >= 0 {
+		return status.Error(codes.Unavailable, "mock processor is unavailable")
+	}
+	crashing := s.crashes.Add(-1) >= 0
 
 	// simulate processing each algorithm in the request
 	for i, algorithm := range req.GetAlgorithms() {
+		select {
+		case <-stream.Context().Done():
+			slog.Debug("ExecuteDagPart cancelled", "exec_id", req.GetExecId())
+			return stream.Context().Err()
+		case <-time.After(s.delay):
+		}
 
-		// create a mock result for this algorithm
+		// create a mock result for this algorithm, being the number of
+		// dependency results sent with the request
 		result := &pb.ExecutionResult{
 			ExecId: req.GetExecId(),
 			AlgorithmResult: &pb.AlgorithmResult{
@@ -37,11 +75,18 @@ func (s *mockOrcaProcessorServer) ExecuteDagPart(req *pb.ExecutionRequest, strea
 				Result: &pb.Result{
 					Status: pb.ResultStatus_RESULT_STATUS_SUCEEDED,
 					ResultData: &pb.Result_SingleValue{
-						SingleValue: 0},
+						SingleValue: float32(len(req.GetAlgorithmResults()))},
 					Timestamp: req.GetWindow().GetTimeFrom().GetSeconds(),
 				},
 			},
 		}
+		if slices.Contains(s.failingAlgorithms, algorithm.GetName()) && (crashing || !s.recovers) {
+			result.AlgorithmResult.Result = &pb.Result{
+				Status:       pb.ResultStatus_RESULT_STATUS_HANDLED_FAILED,
+				ErrorMessage: "mock algorithm failed",
+				Timestamp:    req.GetWindow().GetTimeFrom().GetSeconds(),
+			}
+		}
 
 		// stream the result back
 		if err := stream.Send(result); err != nil {
@@ -52,6 +97,10 @@ func (s *mockOrcaProcessorServer) ExecuteDagPart(req *pb.ExecutionRequest, strea
 		slog.Debug("sent result for algorithm", "result_num", i+1, "algorithm_num", len(req.GetAlgorithms()), "algorithm_name", algorithm.GetName())
 	}
 
+	if crashing {
+		return status.Error(codes.Unavailable, "mock processor crashed")
+	}
+
 	slog.Debug("completed ExecuteDagPart", "exec_id", req.GetExecId())
 	return nil
 }
@@ -64,25 +113,63 @@ func (s *mockOrcaProcessorServer) HealthCheck(ctx context.Context, req *pb.Healt
 		Status:  pb.HealthCheckResponse_STATUS_SERVING,
 		Message: "Mock processor is healthy and ready",
 		Metrics: &pb.ProcessorMetrics{
-			ActiveTasks:   0,
+			ActiveTasks:   s.activeTasks,
 			MemoryBytes:   0,
 			CpuPercent:    0.0,
 			UptimeSeconds: 0,
 		},
 	}
+	if s.notServing {
+		response.Status = pb.HealthCheckResponse_STATUS_NOT_SERVING
+		response.Message = "Mock processor is not serving"
+	}
 
 	return response, nil
 }
 
 // StartMockOrcaProcessor starts a mock gRPC server implementing OrcaProcessor
 func StartMockOrcaProcessor(port int) (*grpc.Server, net.Listener, error) {
+	return startMockOrcaProcessor(port, &mockOrcaProcessorServer{})
+}
+
+// StartFlakyMockOrcaProcessor starts a mock gRPC server implementing OrcaProcessor
+// that fails the first `failures` calls to ExecuteDagPart with UNAVAILABLE
+func StartFlakyMockOrcaProcessor(port int, failures int32) (*grpc.Server, net.Listener, error) {
+	mock := &mockOrcaProcessorServer{}
+	mock.failures.Store(failures)
+	return startMockOrcaProcessor(port, mock)
+}
+
+// StartFailingMockOrcaProcessor starts a mock gRPC server implementing OrcaProcessor
+// where the named algorithms report a handled failure instead of a result
+func StartFailingMockOrcaProcessor(port int, failingAlgorithms ...string) (*grpc.Server, net.Listener, error) {
+	return startMockOrcaProcessor(port, &mockOrcaProcessorServer{failingAlgorithms: failingAlgorithms})
+}
+
+// StartRecoveringMockOrcaProcessor starts a mock gRPC server implementing
+// OrcaProcessor where the named algorithms report a handled failure in the
+// first `crashes` calls to ExecuteDagPart, which then fail with UNAVAILABLE.
+// Later calls succeed
+func StartRecoveringMockOrcaProcessor(port int, crashes int32, failingAlgorithms ...string) (*grpc.Server, net.Listener, error) {
+	mock := &mockOrcaProcessorServer{failingAlgorithms: failingAlgorithms, recovers: true}
+	mock.crashes.Store(crashes)
+	return startMockOrcaProcessor(port, mock)
+}
+
+// StartSlowMockOrcaProcessor starts a mock gRPC server implementing OrcaProcessor
+// that takes `delay` to execute each algorithm
+func StartSlowMockOrcaProcessor(port int, delay time.Duration) (*grpc.Server, net.Listener, error) {
+	return startMockOrcaProcessor(port, &mockOrcaProcessorServer{delay: delay})
+}
+
+func startMockOrcaProcessor(port int, mock *mockOrcaProcessorServer) (*grpc.Server, net.Listener, error) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to listen: %v", err)
 	}
 
 	s := grpc.NewServer()
-	pb.RegisterOrcaProcessorServer(s, &mockOrcaProcessorServer{})
+	pb.RegisterOrcaProcessorServer(s, mock)
 
 	go func() {
 		slog.Debug("mock OrcaProcessor server listening", "port", port)
@@ -7,8 +7,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/orc-analytics/orca/core/internal/envs"
 	types "github.com/orc-analytics/orca/core/internal/types"
 	pb "github.com/orc-analytics/orca/core/protobufs/go"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -591,3 +594,3431 @@ func TestAlgosSameNamesDifferentProcessors(t *testing.T) {
 	err = dlyr.RegisterProcessor(testCtx, &proc2)
 	assert.NoError(t, err)
 }
+
+// TestResumeExecutions tests that completed executions are not picked back up on resume
+func TestResumeExecutions(t *testing.T) {
+
+	// start the mock OrcaProcessor gRPC server
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0) // set port to 0 to get random available port
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	processorConnStr := mockListener.Addr().String()
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForResume",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestAlgorithmForResume",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestProcessorForResume",
+		Runtime:             "Test",
+		ConnectionStr:       processorConnStr,
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	}
+
+	// 1. register a processor
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	statsBefore, err := dlyr.ReadResultsStats(testCtx)
+	assert.NoError(t, err)
+
+	// 2. emit a window and wait for its result to be stored
+	window := pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 2},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, &window)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	assert.Eventually(t, func() bool {
+		stats, err := dlyr.ReadResultsStats(testCtx)
+		return err == nil && stats.GetCount() == statsBefore.GetCount()+1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// 3. resuming should not reprocess the completed execution
+	err = dlyr.ResumeExecutions(testCtx)
+	assert.NoError(t, err)
+
+	time.Sleep(500 * time.Millisecond)
+	statsAfter, err := dlyr.ReadResultsStats(testCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, statsBefore.GetCount()+1, statsAfter.GetCount())
+}
+
+// TestResumeInterruptedExecutions tests that an execution plan left unfinished
+// by an instance that stopped is taken over by another instance once its lease
+// expires, and is left alone while the instance running it is alive
+func TestResumeInterruptedExecutions(t *testing.T) {
+	os.Setenv("ORCA_EXECUTION_LEASE", "300ms")
+	os.Setenv("ORCA_EXECUTION_WORKERS", "1")
+	envs.ReloadConfig()
+	t.Cleanup(func() {
+		os.Unsetenv("ORCA_EXECUTION_LEASE")
+		os.Unsetenv("ORCA_EXECUTION_WORKERS")
+		envs.ReloadConfig()
+	})
+
+	slowProcessor, slowListener, err := StartSlowMockOrcaProcessor(0, 3*time.Second)
+	assert.NoError(t, err)
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		slowProcessor.Stop()
+		slowListener.Close()
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	// the first instance has a single worker, which the slow window occupies
+	dlyrStopped, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	slowWindowType := pb.WindowType{Name: "TestSlowWindowForTakeover", Version: "1.0.0"}
+	windowType := pb.WindowType{Name: "TestWindowForTakeover", Version: "1.0.0"}
+
+	err = dlyrStopped.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:          "TestSlowProcessorForTakeover",
+		Runtime:       "Test",
+		ConnectionStr: slowListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{{
+			Name:       "TestSlowAlgorithmForTakeover",
+			Version:    "1.0.0",
+			WindowType: &slowWindowType,
+			ResultType: pb.ResultType_VALUE,
+		}},
+	})
+	assert.NoError(t, err)
+	err = dlyrStopped.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:          "TestProcessorForTakeover",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{{
+			Name:       "TestAlgorithmForTakeover",
+			Version:    "1.0.0",
+			WindowType: &windowType,
+			ResultType: pb.ResultType_VALUE,
+		}},
+	})
+	assert.NoError(t, err)
+
+	slowStatus, err := dlyrStopped.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 2},
+		WindowTypeName:    slowWindowType.GetName(),
+		WindowTypeVersion: slowWindowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		execution, err := dlyrStopped.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: slowStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_RUNNING
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// so the second window waits in its queue
+	emitStatus, err := dlyrStopped.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 2},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	// another instance leaves the plans alone while their lease is renewed
+	os.Unsetenv("ORCA_EXECUTION_WORKERS")
+	envs.ReloadConfig()
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		dlyr.(interface{ Close() }).Close()
+	})
+
+	err = dlyr.ResumeExecutions(testCtx)
+	assert.NoError(t, err)
+	time.Sleep(500 * time.Millisecond)
+	execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_PENDING, execution.GetStatus())
+
+	// then takes over the queued plan once the first instance stops, well
+	// before the first instance could have got to it
+	dlyrStopped.(interface{ Close() }).Close()
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 2*time.Second, 50*time.Millisecond)
+	assert.Len(t, execution.GetAlgorithms(), 1)
+	assert.Len(t, execution.GetAlgorithms()[0].GetAttempts(), 1)
+}
+
+// TestParallelStageTasks tests that a stage spanning several processors completes
+// before its dependants are executed
+func TestParallelStageTasks(t *testing.T) {
+	mockProcessor_1, mockListener_1, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+	mockProcessor_2, mockListener_2, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor_1.GracefulStop()
+		mockListener_1.Close()
+		mockProcessor_2.GracefulStop()
+		mockListener_2.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForParallelStages",
+		Version: "1.0.0",
+	}
+
+	algo_1 := pb.Algorithm{
+		Name:       "TestParallelAlgorithm1",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	algo_2 := pb.Algorithm{
+		Name:       "TestParallelAlgorithm2",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc_1 := pb.ProcessorRegistration{
+		Name:                "TestParallelProcessor1",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener_1.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo_1},
+	}
+
+	// algo_3 depends on algorithms from both processors in the first stage
+	algo_3 := pb.Algorithm{
+		Name:       "TestParallelAlgorithm3",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             algo_1.GetName(),
+				Version:          algo_1.GetVersion(),
+				ProcessorName:    proc_1.GetName(),
+				ProcessorRuntime: proc_1.GetRuntime(),
+			},
+			{
+				Name:             algo_2.GetName(),
+				Version:          algo_2.GetVersion(),
+				ProcessorName:    "TestParallelProcessor2",
+				ProcessorRuntime: "Test",
+			},
+		},
+	}
+
+	proc_2 := pb.ProcessorRegistration{
+		Name:                "TestParallelProcessor2",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener_2.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo_2, &algo_3},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc_1)
+	assert.NoError(t, err)
+	err = dlyr.RegisterProcessor(testCtx, &proc_2)
+	assert.NoError(t, err)
+
+	statsBefore, err := dlyr.ReadResultsStats(testCtx)
+	assert.NoError(t, err)
+
+	window := pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 2},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, &window)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	// all three algorithms produce a result
+	assert.Eventually(t, func() bool {
+		stats, err := dlyr.ReadResultsStats(testCtx)
+		return err == nil && stats.GetCount() == statsBefore.GetCount()+3
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// TestRetryPolicy tests that processor calls failing with a retryable code are
+// retried as per the processor's retry policy
+func TestRetryPolicy(t *testing.T) {
+	mockProcessor, mockListener, err := StartFlakyMockOrcaProcessor(0, 2)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForRetries",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestRetriedAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestRetriedProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+		RetryPolicy: &pb.RetryPolicy{
+			MaxAttempts:      3,
+			InitialBackoffMs: 10,
+			RetryableCodes:   []string{"UNAVAILABLE"},
+		},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	statsBefore, err := dlyr.ReadResultsStats(testCtx)
+	assert.NoError(t, err)
+
+	window := pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 2},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, &window)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	// the third attempt succeeds
+	assert.Eventually(t, func() bool {
+		stats, err := dlyr.ReadResultsStats(testCtx)
+		return err == nil && stats.GetCount() == statsBefore.GetCount()+1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// with the retry history recorded against the execution
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Len(t, execution.GetAlgorithms(), 1)
+	attempts := execution.GetAlgorithms()[0].GetAttempts()
+	assert.Len(t, attempts, 3)
+	for _, attempt := range attempts[:2] {
+		assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_FAILED, attempt.GetStatus())
+		assert.Equal(t, "Unavailable", attempt.GetErrorCode())
+	}
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED, attempts[2].GetStatus())
+}
+
+// TestReadExecutions tests that the state of executions can be read back
+// using the exec ID returned when emitting a window
+func TestReadExecutions(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForExecutions",
+		Version: "1.0.0",
+	}
+
+	algo_1 := pb.Algorithm{
+		Name:       "TestExecutionAlgorithm1",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:          "TestExecutionProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+	}
+
+	algo_2 := pb.Algorithm{
+		Name:       "TestExecutionAlgorithm2",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             algo_1.GetName(),
+				Version:          algo_1.GetVersion(),
+				ProcessorName:    proc.GetName(),
+				ProcessorRuntime: proc.GetRuntime(),
+			},
+		},
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&algo_1, &algo_2}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	window := pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 100},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 200},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, &window)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+	assert.NotEmpty(t, emitStatus.GetExecId())
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	assert.Equal(t, window.GetOrigin(), execution.GetWindow().GetOrigin())
+	assert.NotNil(t, execution.GetStarted())
+	assert.NotNil(t, execution.GetFinished())
+
+	// algorithms are reported in execution order
+	assert.Len(t, execution.GetAlgorithms(), 2)
+	for ii, algo := range []*pb.Algorithm{&algo_1, &algo_2} {
+		algoExecution := execution.GetAlgorithms()[ii]
+		assert.Equal(t, algo.GetName(), algoExecution.GetAlgorithm().GetName())
+		assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED, algoExecution.GetStatus())
+		assert.NotNil(t, algoExecution.GetFinished())
+		assert.Len(t, algoExecution.GetAttempts(), 1)
+	}
+
+	executions, err := dlyr.ReadExecutions(testCtx, &pb.ExecutionsRead{
+		TimeFrom: &timestamppb.Timestamp{Seconds: 50},
+		TimeTo:   &timestamppb.Timestamp{Seconds: 250},
+		Window:   &windowType,
+		Status:   pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, executions.GetExecutions(), 1)
+	assert.Equal(t, emitStatus.GetExecId(), executions.GetExecutions()[0].GetExecId())
+
+	// unknown executions are reported as such
+	_, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: "unknown"})
+	assert.ErrorIs(t, err, types.ExecutionNotFound)
+}
+
+// TestFailedDependencySkipped tests that algorithms depending on a failed
+// algorithm are skipped, while independent algorithms still run
+func TestFailedDependencySkipped(t *testing.T) {
+	mockProcessor, mockListener, err := StartFailingMockOrcaProcessor(0, "TestFailingAlgorithm")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForFailures",
+		Version: "1.0.0",
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:          "TestFailingProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+	}
+
+	failingAlgo := pb.Algorithm{
+		Name:       "TestFailingAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	dependentAlgo := pb.Algorithm{
+		Name:       "TestDependentAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             failingAlgo.GetName(),
+				Version:          failingAlgo.GetVersion(),
+				ProcessorName:    proc.GetName(),
+				ProcessorRuntime: proc.GetRuntime(),
+			},
+		},
+	}
+
+	independentAlgo := pb.Algorithm{
+		Name:       "TestIndependentAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&failingAlgo, &dependentAlgo, &independentAlgo}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	window := pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 300},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 400},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, &window)
+	assert.NoError(t, err)
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	algoStatuses := map[string]pb.ExecutionStatus{}
+	for _, algoExecution := range execution.GetAlgorithms() {
+		algoStatuses[algoExecution.GetAlgorithm().GetName()] = algoExecution.GetStatus()
+	}
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_FAILED, algoStatuses[failingAlgo.GetName()])
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_SKIPPED, algoStatuses[dependentAlgo.GetName()])
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED, algoStatuses[independentAlgo.GetName()])
+
+	// the failure is persisted and returned with its reason
+	results, err := dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+		TimeFrom:  &timestamppb.Timestamp{Seconds: 300},
+		TimeTo:    &timestamppb.Timestamp{Seconds: 400},
+		Algorithm: &failingAlgo,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 1)
+	assert.Equal(t, pb.ResultStatus_RESULT_STATUS_HANDLED_FAILED, results.GetResults()[0].GetStatus())
+	assert.Equal(t, "mock algorithm failed", results.GetResults()[0].GetErrorMessage())
+}
+
+// TestCrossWindowTypeDependency tests that results of dependencies on another
+// window type are read from the stored windows within the emitted window
+func TestCrossWindowTypeDependency(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	siteId := pb.MetadataField{Name: "site_id", Description: "Unique ID of the site"}
+
+	hourlyWindowType := pb.WindowType{
+		Name:           "TestHourlyWindow",
+		Version:        "1.0.0",
+		MetadataFields: []*pb.MetadataField{&siteId},
+	}
+	dailyWindowType := pb.WindowType{
+		Name:           "TestDailyWindow",
+		Version:        "1.0.0",
+		MetadataFields: []*pb.MetadataField{&siteId},
+	}
+
+	hourlyAlgo := pb.Algorithm{
+		Name:       "TestHourlyAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &hourlyWindowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	hourlyProc := pb.ProcessorRegistration{
+		Name:                "TestHourlyProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&hourlyAlgo},
+	}
+
+	dailyAlgo := pb.Algorithm{
+		Name:       "TestDailyAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &dailyWindowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             hourlyAlgo.GetName(),
+				Version:          hourlyAlgo.GetVersion(),
+				ProcessorName:    hourlyProc.GetName(),
+				ProcessorRuntime: hourlyProc.GetRuntime(),
+			},
+		},
+	}
+	dailyProc := pb.ProcessorRegistration{
+		Name:                "TestDailyProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&dailyAlgo},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &hourlyProc)
+	assert.NoError(t, err)
+	err = dlyr.RegisterProcessor(testCtx, &dailyProc)
+	assert.NoError(t, err)
+
+	day := int64(86400 * 10)
+	siteMetadata := func(site string) *structpb.Struct {
+		return &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"site_id": {Kind: &structpb.Value_StringValue{StringValue: site}},
+			},
+		}
+	}
+
+	// only the first two hourly windows are within the day and of the same site
+	hourlyWindows := []*pb.Window{
+		{TimeFrom: &timestamppb.Timestamp{Seconds: day}, TimeTo: &timestamppb.Timestamp{Seconds: day + 3600}, Metadata: siteMetadata("A")},
+		{TimeFrom: &timestamppb.Timestamp{Seconds: day + 3600}, TimeTo: &timestamppb.Timestamp{Seconds: day + 7200}, Metadata: siteMetadata("A")},
+		{TimeFrom: &timestamppb.Timestamp{Seconds: day}, TimeTo: &timestamppb.Timestamp{Seconds: day + 3600}, Metadata: siteMetadata("B")},
+		{TimeFrom: &timestamppb.Timestamp{Seconds: day - 3600}, TimeTo: &timestamppb.Timestamp{Seconds: day}, Metadata: siteMetadata("A")},
+	}
+	for _, window := range hourlyWindows {
+		window.WindowTypeName = hourlyWindowType.GetName()
+		window.WindowTypeVersion = hourlyWindowType.GetVersion()
+		window.Origin = "Test"
+
+		emitStatus, err := dlyr.EmitWindow(testCtx, window)
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	dailyWindow := pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: day},
+		TimeTo:            &timestamppb.Timestamp{Seconds: day + 86400},
+		WindowTypeName:    dailyWindowType.GetName(),
+		WindowTypeVersion: dailyWindowType.GetVersion(),
+		Origin:            "Test",
+		Metadata:          siteMetadata("A"),
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, &dailyWindow)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	// only the daily algorithm is executed against the daily window
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Len(t, execution.GetAlgorithms(), 1)
+	assert.Equal(t, dailyAlgo.GetName(), execution.GetAlgorithms()[0].GetAlgorithm().GetName())
+
+	// the mock processor returns the number of dependency results it was sent
+	results, err := dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+		TimeFrom:  dailyWindow.GetTimeFrom(),
+		TimeTo:    dailyWindow.GetTimeTo(),
+		Algorithm: &dailyAlgo,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 1)
+	assert.Equal(t, float32(2), results.GetResults()[0].GetSingleValue())
+}
+
+// TestProcessorConnectionRebuilt tests that the pooled connection to a
+// processor is rebuilt when it re-registers with a new connection string
+func TestProcessorConnectionRebuilt(t *testing.T) {
+	oldMock := &mockOrcaProcessorServer{}
+	oldProcessor, oldListener, err := startMockOrcaProcessor(0, oldMock)
+	assert.NoError(t, err)
+	newMock := &mockOrcaProcessorServer{}
+	newProcessor, newListener, err := startMockOrcaProcessor(0, newMock)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		oldProcessor.GracefulStop()
+		oldListener.Close()
+		newProcessor.GracefulStop()
+		newListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForConnections",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestConnectionAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestConnectionProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       oldListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	}
+
+	emitAndWait := func(seconds int64) {
+		emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: seconds},
+			TimeTo:            &timestamppb.Timestamp{Seconds: seconds + 1},
+			WindowTypeName:    windowType.GetName(),
+			WindowTypeVersion: windowType.GetVersion(),
+			Origin:            "Test",
+		})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	connectionState := func() pb.ConnectionState {
+		processors, err := dlyr.ReadProcessors(testCtx)
+		assert.NoError(t, err)
+		for _, processor := range processors.GetProcessor() {
+			if processor.GetName() == proc.GetName() {
+				return processor.GetConnectionState()
+			}
+		}
+		return pb.ConnectionState_CONNECTION_STATE_UNSPECIFIED
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ConnectionState_CONNECTION_STATE_DISCONNECTED, connectionState())
+
+	// the connection is kept open once used
+	emitAndWait(500)
+	assert.Equal(t, pb.ConnectionState_CONNECTION_STATE_READY, connectionState())
+
+	// re-registering with a new connection string replaces the instance and
+	// drops the old connection
+	proc.ConnectionStr = newListener.Addr().String()
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ConnectionState_CONNECTION_STATE_DISCONNECTED, connectionState())
+
+	processors, err := dlyr.ReadProcessors(testCtx)
+	assert.NoError(t, err)
+	for _, processor := range processors.GetProcessor() {
+		if processor.GetName() == proc.GetName() {
+			assert.Len(t, processor.GetInstances(), 1)
+			assert.Equal(t, newListener.Addr().String(), processor.GetInstances()[0].GetConnectionStr())
+		}
+	}
+
+	// and later tasks reach the processor at its new address, even though
+	// the old address still serves
+	emitAndWait(600)
+	assert.Equal(t, pb.ConnectionState_CONNECTION_STATE_READY, connectionState())
+	assert.Equal(t, int32(1), oldMock.executions.Load())
+	assert.Equal(t, int32(1), newMock.executions.Load())
+}
+
+// TestAlgorithmTimeout tests that algorithms taking longer than their timeout
+// are failed rather than blocking the execution
+func TestAlgorithmTimeout(t *testing.T) {
+	mockProcessor, mockListener, err := StartSlowMockOrcaProcessor(0, 2*time.Second)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		mockProcessor.Stop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForTimeouts",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestTimedOutAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		TimeoutMs:  100,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestTimedOutProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 700},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 800},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+
+	// the execution fails well before the processor would have finished
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	}, time.Second, 50*time.Millisecond)
+	assert.Len(t, execution.GetAlgorithms(), 1)
+	attempts := execution.GetAlgorithms()[0].GetAttempts()
+	assert.Len(t, attempts, 1)
+	assert.Equal(t, "DeadlineExceeded", attempts[0].GetErrorCode())
+}
+
+// TestCancelExecution tests that in-flight executions can be cancelled
+func TestCancelExecution(t *testing.T) {
+	mockProcessor, mockListener, err := StartSlowMockOrcaProcessor(0, 10*time.Second)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		mockProcessor.Stop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForCancellation",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestCancelledAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestCancelledProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 900},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1000},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+
+	// wait for the processor to be sent the work
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil &&
+			len(execution.GetAlgorithms()) == 1 &&
+			execution.GetAlgorithms()[0].GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_RUNNING
+	}, 5*time.Second, 50*time.Millisecond)
+
+	err = dlyr.CancelExecution(testCtx, &pb.ExecutionCancel{ExecId: emitStatus.GetExecId()})
+	assert.NoError(t, err)
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_CANCELLED
+	}, 2*time.Second, 50*time.Millisecond)
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_CANCELLED, execution.GetAlgorithms()[0].GetStatus())
+	assert.NotNil(t, execution.GetFinished())
+
+	// finished executions cannot be cancelled
+	err = dlyr.CancelExecution(testCtx, &pb.ExecutionCancel{ExecId: emitStatus.GetExecId()})
+	assert.ErrorIs(t, err, types.ExecutionFinished)
+
+	err = dlyr.CancelExecution(testCtx, &pb.ExecutionCancel{ExecId: "unknown"})
+	assert.ErrorIs(t, err, types.ExecutionNotFound)
+}
+
+// TestCancelExecutionFromPeer tests that an execution cancelled through
+// another instance of orca-core stops before its next stage, and that its
+// cancellation is not overwritten by the instance processing it
+func TestCancelExecutionFromPeer(t *testing.T) {
+	slowMock := &mockOrcaProcessorServer{delay: time.Second}
+	slowProcessor, slowListener, err := startMockOrcaProcessor(0, slowMock)
+	assert.NoError(t, err)
+	dependantMock := &mockOrcaProcessorServer{}
+	dependantProcessor, dependantListener, err := startMockOrcaProcessor(0, dependantMock)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		slowProcessor.Stop()
+		slowListener.Close()
+		dependantProcessor.Stop()
+		dependantListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+	peer, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForPeerCancellation",
+		Version: "1.0.0",
+	}
+
+	slowAlgo := pb.Algorithm{
+		Name:       "TestPeerCancelledSlowAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	slowProc := pb.ProcessorRegistration{
+		Name:                "TestPeerCancelledSlowProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       slowListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&slowAlgo},
+	}
+
+	dependantAlgo := pb.Algorithm{
+		Name:       "TestPeerCancelledDependantAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             slowAlgo.GetName(),
+				Version:          slowAlgo.GetVersion(),
+				ProcessorName:    slowProc.GetName(),
+				ProcessorRuntime: slowProc.GetRuntime(),
+			},
+		},
+	}
+	dependantProc := pb.ProcessorRegistration{
+		Name:                "TestPeerCancelledDependantProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       dependantListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&dependantAlgo},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &slowProc)
+	assert.NoError(t, err)
+	err = dlyr.RegisterProcessor(testCtx, &dependantProc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 900},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1000},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+
+	// wait for the slow processor to be sent the first stage
+	assert.Eventually(t, func() bool {
+		return slowMock.executions.Load() == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// the peer is not processing the execution, so only records the
+	// cancellation
+	err = peer.CancelExecution(testCtx, &pb.ExecutionCancel{ExecId: emitStatus.GetExecId()})
+	assert.NoError(t, err)
+
+	// once the first stage completes, the dependant stage is never sent
+	assert.Never(t, func() bool {
+		return dependantMock.executions.Load() > 0
+	}, 2*time.Second, 50*time.Millisecond)
+
+	execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_CANCELLED, execution.GetStatus())
+	for _, algorithm := range execution.GetAlgorithms() {
+		assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_CANCELLED, algorithm.GetStatus())
+	}
+	assert.NotNil(t, execution.GetFinished())
+}
+
+func TestExecutionQueue(t *testing.T) {
+	mockProcessor, mockListener, err := StartSlowMockOrcaProcessor(0, 2*time.Second)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		mockProcessor.Stop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	queue, err := dlyr.ReadExecutionQueue(testCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(20), queue.GetWorkers())
+	assert.Equal(t, int32(1000), queue.GetQueueSize())
+	assert.Equal(t, int32(0), queue.GetActive())
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForQueue",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestQueuedAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestQueuedProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1000},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1100},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	// the execution holds a worker while the processor works on it
+	assert.Eventually(t, func() bool {
+		queue, err := dlyr.ReadExecutionQueue(testCtx)
+		return err == nil && queue.GetActive() == 1 && queue.GetSaturation() > 0
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// and gives it back once finished
+	assert.Eventually(t, func() bool {
+		queue, err := dlyr.ReadExecutionQueue(testCtx)
+		return err == nil && queue.GetActive() == 0 && queue.GetSaturation() == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// TestReprocessWindows tests that a newly registered algorithm can be run over
+// stored windows, without re-triggering the algorithms it depends on
+func TestReprocessWindows(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForReprocessing",
+		Version: "1.0.0",
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:          "TestReprocessProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+	}
+
+	upstreamAlgo := pb.Algorithm{
+		Name:       "TestReprocessUpstreamAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&upstreamAlgo}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	// two windows for one asset, and one for another
+	for ii, asset := range []string{"a", "a", "b"} {
+		metadata, err := structpb.NewStruct(map[string]any{"asset": asset})
+		assert.NoError(t, err)
+
+		emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: int64(1200 + ii*100)},
+			TimeTo:            &timestamppb.Timestamp{Seconds: int64(1300 + ii*100)},
+			WindowTypeName:    windowType.GetName(),
+			WindowTypeVersion: windowType.GetVersion(),
+			Origin:            "Test",
+			Metadata:          metadata,
+		})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	// a new algorithm is registered after the windows were emitted
+	newAlgo := pb.Algorithm{
+		Name:       "TestReprocessNewAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             upstreamAlgo.GetName(),
+				Version:          upstreamAlgo.GetVersion(),
+				ProcessorName:    proc.GetName(),
+				ProcessorRuntime: proc.GetRuntime(),
+			},
+		},
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&upstreamAlgo, &newAlgo}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	metadataFilter, err := structpb.NewStruct(map[string]any{"asset": "a"})
+	assert.NoError(t, err)
+
+	reprocess, err := dlyr.ReprocessWindows(testCtx, &pb.WindowsReprocess{
+		TimeFrom:         &timestamppb.Timestamp{Seconds: 1200},
+		TimeTo:           &timestamppb.Timestamp{Seconds: 1500},
+		Window:           &windowType,
+		Metadata:         metadataFilter,
+		Algorithms:       []*pb.Algorithm{&newAlgo},
+		WindowsPerSecond: 100,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), reprocess.GetTotalWindows())
+
+	assert.Eventually(t, func() bool {
+		reprocess, err = dlyr.ReadReprocess(testCtx, &pb.ReprocessRead{ReprocessId: reprocess.GetReprocessId()})
+		return err == nil && reprocess.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, int32(2), reprocess.GetTriggeredWindows())
+	assert.Equal(t, int32(2), reprocess.GetSucceededWindows())
+	assert.NotNil(t, reprocess.GetFinished())
+
+	// the new algorithm is given the stored result of its dependency
+	results, err := dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+		TimeFrom:  &timestamppb.Timestamp{Seconds: 1200},
+		TimeTo:    &timestamppb.Timestamp{Seconds: 1500},
+		Algorithm: &newAlgo,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 2)
+	for _, result := range results.GetResults() {
+		assert.Equal(t, float32(1), result.GetSingleValue())
+	}
+
+	// which is not re-triggered
+	results, err = dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+		TimeFrom:  &timestamppb.Timestamp{Seconds: 1200},
+		TimeTo:    &timestamppb.Timestamp{Seconds: 1500},
+		Algorithm: &upstreamAlgo,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 3)
+
+	_, err = dlyr.ReadReprocess(testCtx, &pb.ReprocessRead{ReprocessId: "unknown"})
+	assert.ErrorIs(t, err, types.ReprocessNotFound)
+}
+
+// TestReprocessFinishesWithExecutions tests that a reprocess is only finished
+// once the executions it triggered are, failing if any of them did
+func TestReprocessFinishesWithExecutions(t *testing.T) {
+	windowType := pb.WindowType{
+		Name:    "TestWindowForReprocessCompletion",
+		Version: "1.0.0",
+	}
+	slowAlgo := pb.Algorithm{
+		Name:       "TestReprocessCompletionSlowAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	failingAlgo := pb.Algorithm{
+		Name:       "TestReprocessCompletionFailingAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	mockProcessor, mockListener, err := startMockOrcaProcessor(0, &mockOrcaProcessorServer{
+		delay:             500 * time.Millisecond,
+		failingAlgorithms: []string{failingAlgo.GetName()},
+	})
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestReprocessCompletionProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&slowAlgo, &failingAlgo},
+	})
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1200},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1300},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	reprocessAlgorithm := func(algo *pb.Algorithm) *pb.Reprocess {
+		reprocess, err := dlyr.ReprocessWindows(testCtx, &pb.WindowsReprocess{
+			TimeFrom:         &timestamppb.Timestamp{Seconds: 1200},
+			TimeTo:           &timestamppb.Timestamp{Seconds: 1300},
+			Window:           &windowType,
+			Algorithms:       []*pb.Algorithm{algo},
+			WindowsPerSecond: 100,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), reprocess.GetTotalWindows())
+		return reprocess
+	}
+
+	// the reprocess is running while the window it triggered is executing
+	reprocess := reprocessAlgorithm(&slowAlgo)
+	assert.Eventually(t, func() bool {
+		reprocess, err = dlyr.ReadReprocess(testCtx, &pb.ReprocessRead{ReprocessId: reprocess.GetReprocessId()})
+		return err == nil && reprocess.GetTriggeredWindows() == 1
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_RUNNING, reprocess.GetStatus())
+	assert.Nil(t, reprocess.GetFinished())
+
+	assert.Eventually(t, func() bool {
+		reprocess, err = dlyr.ReadReprocess(testCtx, &pb.ReprocessRead{ReprocessId: reprocess.GetReprocessId()})
+		return err == nil && reprocess.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, int32(1), reprocess.GetSucceededWindows())
+	assert.NotNil(t, reprocess.GetFinished())
+
+	// and fails with the executions it triggered
+	reprocess = reprocessAlgorithm(&failingAlgo)
+	assert.Eventually(t, func() bool {
+		reprocess, err = dlyr.ReadReprocess(testCtx, &pb.ReprocessRead{ReprocessId: reprocess.GetReprocessId()})
+		return err == nil && reprocess.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, int32(1), reprocess.GetTriggeredWindows())
+	assert.Equal(t, int32(1), reprocess.GetFailedWindows())
+	assert.NotNil(t, reprocess.GetFinished())
+}
+
+// TestEmitWindowTargetAlgorithms tests that emitting a window with target
+// algorithms only triggers them and the algorithms they depend on
+func TestEmitWindowTargetAlgorithms(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForTargets",
+		Version: "1.0.0",
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:          "TestTargetProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+	}
+
+	upstreamAlgo := pb.Algorithm{
+		Name:       "TestTargetUpstreamAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	targetAlgo := pb.Algorithm{
+		Name:       "TestTargetAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             upstreamAlgo.GetName(),
+				Version:          upstreamAlgo.GetVersion(),
+				ProcessorName:    proc.GetName(),
+				ProcessorRuntime: proc.GetRuntime(),
+			},
+		},
+	}
+
+	untargetedAlgo := pb.Algorithm{
+		Name:       "TestUntargetedAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&upstreamAlgo, &targetAlgo, &untargetedAlgo}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1600},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1700},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+		TargetAlgorithms:  []*pb.Algorithm{&targetAlgo},
+	})
+	assert.NoError(t, err)
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	var triggered []string
+	for _, algoExecution := range execution.GetAlgorithms() {
+		triggered = append(triggered, algoExecution.GetAlgorithm().GetName())
+	}
+	assert.ElementsMatch(t, []string{upstreamAlgo.GetName(), targetAlgo.GetName()}, triggered)
+
+	// emitting the stored window again for other algorithms reruns just those
+	// algorithms on it
+	window := &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1600},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1700},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+		TargetAlgorithms:  []*pb.Algorithm{&untargetedAlgo},
+	}
+	rerunStatus, err := dlyr.EmitWindow(testCtx, window)
+	assert.NoError(t, err)
+	assert.False(t, rerunStatus.GetDuplicate())
+	assert.NotEqual(t, emitStatus.GetExecId(), rerunStatus.GetExecId())
+
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: rerunStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Len(t, execution.GetAlgorithms(), 1)
+	assert.Equal(t, untargetedAlgo.GetName(), execution.GetAlgorithms()[0].GetAlgorithm().GetName())
+
+	// as it does when emitted in a batch
+	batchStatuses, err := dlyr.EmitWindows(testCtx, []*pb.Window{window})
+	assert.NoError(t, err)
+	assert.Len(t, batchStatuses, 1)
+	assert.False(t, batchStatuses[0].GetDuplicate())
+	assert.NotEmpty(t, batchStatuses[0].GetExecId())
+	assert.NotEqual(t, rerunStatus.GetExecId(), batchStatuses[0].GetExecId())
+
+	// the rerun is against the stored window rather than a copy of it
+	windows, err := dlyr.ReadWindows(testCtx, &pb.WindowsRead{
+		TimeFrom: &timestamppb.Timestamp{Seconds: 1600},
+		TimeTo:   &timestamppb.Timestamp{Seconds: 1700},
+		Window:   &windowType,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, windows.GetWindow(), 1)
+
+	// whereas emitting it again without target algorithms is a duplicate
+	window.TargetAlgorithms = nil
+	duplicateStatus, err := dlyr.EmitWindow(testCtx, window)
+	assert.NoError(t, err)
+	assert.True(t, duplicateStatus.GetDuplicate())
+	assert.Equal(t, emitStatus.GetExecId(), duplicateStatus.GetExecId())
+
+	// algorithms must be triggered by the window type to be targeted
+	_, err = dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1600},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1700},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+		TargetAlgorithms:  []*pb.Algorithm{{Name: "TestUnknownAlgorithm", Version: "1.0.0"}},
+	})
+	assert.ErrorIs(t, err, types.AlgorithmNotFound)
+}
+
+// TestFailedExecutionsRequeued tests that tasks failing after exhausting their
+// retries are dead-lettered, and can be requeued
+func TestFailedExecutionsRequeued(t *testing.T) {
+	mockProcessor, mockListener, err := StartFlakyMockOrcaProcessor(0, 2)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForDeadLetters",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestDeadLetteredAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestDeadLetteredProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+		RetryPolicy: &pb.RetryPolicy{
+			MaxAttempts:      2,
+			InitialBackoffMs: 10,
+			RetryableCodes:   []string{"UNAVAILABLE"},
+		},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1800},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1900},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+
+	// both attempts fail while the processor is unavailable
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	failedRead := &pb.FailedExecutionsRead{
+		TimeFrom:         &timestamppb.Timestamp{Seconds: 1800},
+		TimeTo:           &timestamppb.Timestamp{Seconds: 1900},
+		ProcessorName:    proc.GetName(),
+		ProcessorRuntime: proc.GetRuntime(),
+		Algorithm:        &pb.Algorithm{Name: algo.GetName()},
+	}
+	failed, err := dlyr.ReadFailedExecutions(testCtx, failedRead)
+	assert.NoError(t, err)
+	assert.Len(t, failed.GetFailedExecutions(), 1)
+	failedExecution := failed.GetFailedExecutions()[0]
+	assert.Equal(t, emitStatus.GetExecId(), failedExecution.GetExecId())
+	assert.Equal(t, "Unavailable", failedExecution.GetErrorCode())
+	assert.Equal(t, int32(2), failedExecution.GetAttempts())
+	assert.Len(t, failedExecution.GetRequest().GetAlgorithms(), 1)
+	assert.Equal(t, algo.GetName(), failedExecution.GetRequest().GetAlgorithms()[0].GetName())
+	assert.Nil(t, failedExecution.GetRequeued())
+
+	// failures of other processors are filtered out
+	otherProcessorRead := &pb.FailedExecutionsRead{
+		TimeFrom:      failedRead.GetTimeFrom(),
+		TimeTo:        failedRead.GetTimeTo(),
+		ProcessorName: "TestOtherProcessor",
+	}
+	failed, err = dlyr.ReadFailedExecutions(testCtx, otherProcessorRead)
+	assert.NoError(t, err)
+	assert.Empty(t, failed.GetFailedExecutions())
+
+	// the processor is now available, so the requeued execution succeeds
+	requeued, err := dlyr.RequeueFailedExecutions(testCtx, &pb.FailedExecutionsRequeue{
+		TimeFrom:      failedRead.GetTimeFrom(),
+		TimeTo:        failedRead.GetTimeTo(),
+		ProcessorName: proc.GetName(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{emitStatus.GetExecId()}, requeued.GetExecIds())
+
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// requeued failures are only read when asked for
+	failed, err = dlyr.ReadFailedExecutions(testCtx, failedRead)
+	assert.NoError(t, err)
+	assert.Empty(t, failed.GetFailedExecutions())
+
+	failedRead.IncludeRequeued = true
+	failed, err = dlyr.ReadFailedExecutions(testCtx, failedRead)
+	assert.NoError(t, err)
+	assert.Len(t, failed.GetFailedExecutions(), 1)
+	assert.NotNil(t, failed.GetFailedExecutions()[0].GetRequeued())
+}
+
+// TestRequeuedDependencyRecovers tests that an algorithm whose dependency
+// failed is executed once the requeued dependency succeeds
+func TestRequeuedDependencyRecovers(t *testing.T) {
+	mockProcessor, mockListener, err := StartRecoveringMockOrcaProcessor(0, 1, "TestRecoveringAlgorithm")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForRecoveries",
+		Version: "1.0.0",
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:          "TestRecoveringProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+		RetryPolicy: &pb.RetryPolicy{
+			MaxAttempts: 1,
+		},
+	}
+
+	recoveringAlgo := pb.Algorithm{
+		Name:       "TestRecoveringAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	dependentAlgo := pb.Algorithm{
+		Name:       "TestRecoveringDependentAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             recoveringAlgo.GetName(),
+				Version:          recoveringAlgo.GetVersion(),
+				ProcessorName:    proc.GetName(),
+				ProcessorRuntime: proc.GetRuntime(),
+			},
+		},
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&recoveringAlgo, &dependentAlgo}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 1800},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 1900},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+
+	// the dependency reports a failure before its processor crashes, so its
+	// dependant is skipped
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	algoStatuses := map[string]pb.ExecutionStatus{}
+	for _, algoExecution := range execution.GetAlgorithms() {
+		algoStatuses[algoExecution.GetAlgorithm().GetName()] = algoExecution.GetStatus()
+	}
+	assert.Equal(t, pb.ExecutionStatus_EXECUTION_STATUS_SKIPPED, algoStatuses[dependentAlgo.GetName()])
+
+	// the processor has recovered, so the requeued dependency succeeds and
+	// its dependant is executed
+	requeued, err := dlyr.RequeueFailedExecutions(testCtx, &pb.FailedExecutionsRequeue{
+		TimeFrom:      &timestamppb.Timestamp{Seconds: 1800},
+		TimeTo:        &timestamppb.Timestamp{Seconds: 1900},
+		ProcessorName: proc.GetName(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{emitStatus.GetExecId()}, requeued.GetExecIds())
+
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	for _, algoExecution := range execution.GetAlgorithms() {
+		assert.Equal(
+			t,
+			pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED,
+			algoExecution.GetStatus(),
+			algoExecution.GetAlgorithm().GetName(),
+		)
+	}
+}
+
+// TestResultsWrittenOncePerWindow tests that reprocessing a window replaces
+// the results it already has rather than adding to them
+func TestResultsWrittenOncePerWindow(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForIdempotentResults",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestIdempotentResultsAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestIdempotentResultsProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	})
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 2200},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 2300},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	for range 2 {
+		reprocess, err := dlyr.ReprocessWindows(testCtx, &pb.WindowsReprocess{
+			TimeFrom:         &timestamppb.Timestamp{Seconds: 2200},
+			TimeTo:           &timestamppb.Timestamp{Seconds: 2300},
+			Window:           &windowType,
+			Algorithms:       []*pb.Algorithm{&algo},
+			WindowsPerSecond: 100,
+		})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			reprocess, err = dlyr.ReadReprocess(testCtx, &pb.ReprocessRead{ReprocessId: reprocess.GetReprocessId()})
+			return err == nil && reprocess.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	results, err := dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+		TimeFrom:  &timestamppb.Timestamp{Seconds: 2200},
+		TimeTo:    &timestamppb.Timestamp{Seconds: 2300},
+		Algorithm: &algo,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 1)
+}
+
+// TestEmitWindowIdempotency tests that windows emitted again, either with the
+// same idempotency key or the same contents, are not re-triggered
+func TestEmitWindowIdempotency(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForIdempotency",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestIdempotencyAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestIdempotencyProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	})
+	assert.NoError(t, err)
+
+	metadata, err := structpb.NewStruct(map[string]any{"asset": "a"})
+	assert.NoError(t, err)
+
+	emit := func(seconds int64, idempotencyKey string) *pb.WindowEmitStatus {
+		emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: seconds},
+			TimeTo:            &timestamppb.Timestamp{Seconds: seconds + 100},
+			WindowTypeName:    windowType.GetName(),
+			WindowTypeVersion: windowType.GetVersion(),
+			Origin:            "Test",
+			Metadata:          metadata,
+			IdempotencyKey:    idempotencyKey,
+		})
+		assert.NoError(t, err)
+		return &emitStatus
+	}
+
+	// a retry carrying the same key is a duplicate, even if it differs
+	keyed := emit(2400, "emitter-1")
+	assert.False(t, keyed.GetDuplicate())
+	retried := emit(2500, "emitter-1")
+	assert.True(t, retried.GetDuplicate())
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, retried.GetStatus())
+	assert.Equal(t, keyed.GetExecId(), retried.GetExecId())
+
+	// without a key, windows are matched on their contents
+	unkeyed := emit(2600, "")
+	assert.False(t, unkeyed.GetDuplicate())
+	assert.NotEqual(t, keyed.GetExecId(), unkeyed.GetExecId())
+	retried = emit(2600, "")
+	assert.True(t, retried.GetDuplicate())
+	assert.Equal(t, unkeyed.GetExecId(), retried.GetExecId())
+
+	for _, execId := range []string{keyed.GetExecId(), unkeyed.GetExecId()} {
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: execId})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	// so the algorithm only ran once for each window
+	results, err := dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+		TimeFrom:  &timestamppb.Timestamp{Seconds: 2400},
+		TimeTo:    &timestamppb.Timestamp{Seconds: 2700},
+		Algorithm: &algo,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 2)
+}
+
+// TestProcessorInstances tests that a processor can be registered from
+// several instances, with tasks dispatched to the least loaded instance that
+// is serving
+func TestProcessorInstances(t *testing.T) {
+	idle := &mockOrcaProcessorServer{}
+	busy := &mockOrcaProcessorServer{activeTasks: 5}
+	down := &mockOrcaProcessorServer{notServing: true}
+
+	var listeners []string
+	for _, mock := range []*mockOrcaProcessorServer{idle, busy, down} {
+		mockProcessor, mockListener, err := startMockOrcaProcessor(0, mock)
+		assert.NoError(t, err)
+		t.Cleanup(func() {
+			mockProcessor.Stop()
+			mockListener.Close()
+		})
+		listeners = append(listeners, mockListener.Addr().String())
+	}
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForInstances",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestInstancesAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestInstancesProcessor",
+		Runtime:             "Test",
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	}
+
+	instances := func() []string {
+		processors, err := dlyr.ReadProcessors(testCtx)
+		assert.NoError(t, err)
+		var instances []string
+		for _, processor := range processors.GetProcessor() {
+			if processor.GetName() == proc.GetName() {
+				for _, instance := range processor.GetInstances() {
+					instances = append(instances, instance.GetConnectionStr())
+				}
+			}
+		}
+		return instances
+	}
+
+	// each instance registers under the same processor
+	for ii, connStr := range listeners {
+		proc.InstanceId = fmt.Sprintf("instance-%d", ii)
+		proc.ConnectionStr = connStr
+		err = dlyr.RegisterProcessor(testCtx, &proc)
+		assert.NoError(t, err)
+	}
+	assert.ElementsMatch(t, listeners, instances())
+
+	// an instance registering again, from wherever, replaces itself
+	proc.InstanceId = "instance-2"
+	proc.ConnectionStr = "localhost:1"
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{listeners[0], listeners[1], "localhost:1"}, instances())
+
+	proc.ConnectionStr = listeners[2]
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, listeners, instances())
+
+	for ii := range 3 {
+		emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: int64(2800 + ii*100)},
+			TimeTo:            &timestamppb.Timestamp{Seconds: int64(2900 + ii*100)},
+			WindowTypeName:    windowType.GetName(),
+			WindowTypeVersion: windowType.GetVersion(),
+			Origin:            "Test",
+		})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	// the busy instance is passed over, as is the one not serving
+	assert.Equal(t, int32(3), idle.executions.Load())
+	assert.Equal(t, int32(0), busy.executions.Load())
+	assert.Equal(t, int32(0), down.executions.Load())
+}
+
+// TestProcessorHeartbeats tests that processors are health checked in the
+// background, and marked unavailable once they stop responding
+func TestProcessorHeartbeats(t *testing.T) {
+	os.Setenv("ORCA_HEARTBEAT_INTERVAL", "100ms")
+	os.Setenv("ORCA_PROCESSOR_UNAVAILABLE_AFTER", "500ms")
+	envs.ReloadConfig()
+	t.Cleanup(func() {
+		os.Unsetenv("ORCA_HEARTBEAT_INTERVAL")
+		os.Unsetenv("ORCA_PROCESSOR_UNAVAILABLE_AFTER")
+		envs.ReloadConfig()
+	})
+
+	mockProcessor, mockListener, err := startMockOrcaProcessor(0, &mockOrcaProcessorServer{activeTasks: 3})
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForHeartbeats",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestHeartbeatAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:                "TestHeartbeatProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	readProcessor := func() *pb.Processors_Processor {
+		processors, err := dlyr.ReadProcessors(testCtx)
+		assert.NoError(t, err)
+		for _, processor := range processors.GetProcessor() {
+			if processor.GetName() == proc.GetName() {
+				return processor
+			}
+		}
+		return nil
+	}
+
+	// the health and metrics of the processor are recorded
+	assert.Eventually(t, func() bool {
+		processor := readProcessor()
+		return processor.GetLastSeen() != nil &&
+			processor.GetStatus() == pb.HealthCheckResponse_STATUS_SERVING &&
+			processor.GetMetrics().GetActiveTasks() == 3
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.True(t, readProcessor().GetAvailable())
+
+	// and it is marked unavailable once it stops responding
+	mockProcessor.Stop()
+	assert.Eventually(t, func() bool {
+		return !readProcessor().GetAvailable()
+	}, 5*time.Second, 50*time.Millisecond)
+	processor := readProcessor()
+	assert.NotEmpty(t, processor.GetInstances()[0].GetStatusMessage())
+	assert.False(t, processor.GetInstances()[0].GetAvailable())
+
+	// so that tasks for it fail without being sent
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 3200},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 3300},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+// TestRetireAlgorithm tests that retired algorithms are no longer executed,
+// while the results they produced remain readable
+func TestRetireAlgorithm(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForRetirement",
+		Version: "1.0.0",
+	}
+
+	proc := pb.ProcessorRegistration{
+		Name:          "TestRetirementProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+	}
+
+	algo_1 := pb.Algorithm{
+		Name:       "TestRetiredAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	algo_2 := pb.Algorithm{
+		Name:       "TestRetiredDependentAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             algo_1.GetName(),
+				Version:          algo_1.GetVersion(),
+				ProcessorName:    proc.GetName(),
+				ProcessorRuntime: proc.GetRuntime(),
+			},
+		},
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&algo_1, &algo_2}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 3400},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 3500},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// the algorithm has a dependent, so is only retired when forced
+	err = dlyr.RetireAlgorithm(testCtx, &pb.AlgorithmRetirement{
+		Name:    algo_1.GetName(),
+		Version: algo_1.GetVersion(),
+	})
+	assert.ErrorIs(t, err, types.AlgorithmHasDependents)
+
+	// dependencies that do not exist cannot be removed
+	err = dlyr.RemoveAlgorithmDependency(testCtx, &pb.AlgorithmDependencyRemoval{
+		AlgorithmName:     algo_1.GetName(),
+		AlgorithmVersion:  algo_1.GetVersion(),
+		DependencyName:    algo_2.GetName(),
+		DependencyVersion: algo_2.GetVersion(),
+	})
+	assert.ErrorIs(t, err, types.AlgorithmDependencyNotFound)
+
+	err = dlyr.RetireAlgorithm(testCtx, &pb.AlgorithmRetirement{
+		Name:    algo_1.GetName(),
+		Version: algo_1.GetVersion(),
+		Force:   true,
+	})
+	assert.NoError(t, err)
+
+	// neither algorithm is triggered any more
+	emitStatus, err = dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 3500},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 3600},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS, emitStatus.GetStatus())
+
+	algorithms, err := dlyr.ReadAlgorithms(testCtx)
+	assert.NoError(t, err)
+	for _, algorithm := range algorithms.GetAlgorithm() {
+		assert.NotEqual(t, algo_1.GetName(), algorithm.GetName())
+		assert.NotEqual(t, algo_2.GetName(), algorithm.GetName())
+	}
+
+	// but the results they produced can still be read
+	for _, algo := range []*pb.Algorithm{&algo_1, &algo_2} {
+		results, err := dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+			TimeFrom:  &timestamppb.Timestamp{Seconds: 3400},
+			TimeTo:    &timestamppb.Timestamp{Seconds: 3500},
+			Algorithm: algo,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, results.GetResults(), 1)
+	}
+
+	// deregistered processors are no longer read
+	err = dlyr.DeregisterProcessor(testCtx, &pb.ProcessorDeregistration{
+		Name:    proc.GetName(),
+		Runtime: proc.GetRuntime(),
+	})
+	assert.NoError(t, err)
+
+	processors, err := dlyr.ReadProcessors(testCtx)
+	assert.NoError(t, err)
+	for _, processor := range processors.GetProcessor() {
+		assert.NotEqual(t, proc.GetName(), processor.GetName())
+	}
+
+	err = dlyr.DeregisterProcessor(testCtx, &pb.ProcessorDeregistration{
+		Name:    proc.GetName(),
+		Runtime: proc.GetRuntime(),
+	})
+	assert.ErrorIs(t, err, types.ProcessorNotFound)
+}
+
+// TestSubscribeTasks tests that processors subscribed for tasks are delivered
+// them, with the results they submit stored
+func TestSubscribeTasks(t *testing.T) {
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForSubscriptions",
+		Version: "1.0.0",
+	}
+
+	// subscribed processors have no connection string to dial out to
+	proc := pb.ProcessorRegistration{
+		Name:    "TestSubscribedProcessor",
+		Runtime: "Test",
+	}
+
+	algo_1 := pb.Algorithm{
+		Name:       "TestSubscribedAlgorithm1",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	algo_2 := pb.Algorithm{
+		Name:       "TestSubscribedAlgorithm2",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+		Dependencies: []*pb.AlgorithmDependency{
+			{
+				Name:             algo_1.GetName(),
+				Version:          algo_1.GetVersion(),
+				ProcessorName:    proc.GetName(),
+				ProcessorRuntime: proc.GetRuntime(),
+			},
+		},
+	}
+	proc.SupportedAlgorithms = []*pb.Algorithm{&algo_1, &algo_2}
+
+	err = dlyr.RegisterProcessor(testCtx, &proc)
+	assert.NoError(t, err)
+
+	// each task is answered with one more than the sum of its dependencies
+	received := make(chan *pb.ProcessingTask, 2)
+	subscribeCtx, unsubscribe := context.WithCancel(testCtx)
+	t.Cleanup(unsubscribe)
+	go dlyr.SubscribeTasks(
+		subscribeCtx,
+		&pb.TaskSubscription{Name: proc.GetName(), Runtime: proc.GetRuntime()},
+		func(task *pb.ProcessingTask) error {
+			received <- task
+			var sum float32
+			for _, result := range task.GetDependencyResults() {
+				sum += result.GetResult().GetSingleValue()
+			}
+			go dlyr.SubmitResult(testCtx, &pb.TaskResult{
+				TaskId: task.GetTaskId(),
+				Result: &pb.Result{
+					Status:     pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+					ResultData: &pb.Result_SingleValue{SingleValue: sum + 1},
+					Timestamp:  time.Now().Unix(),
+				},
+			})
+			return nil
+		},
+	)
+
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 3600},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 3700},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	task := <-received
+	assert.Equal(t, algo_1.GetName(), task.GetAlgorithm().GetName())
+	assert.Equal(t, emitStatus.GetExecId(), task.GetExecId())
+	task = <-received
+	assert.Equal(t, algo_2.GetName(), task.GetAlgorithm().GetName())
+	assert.Len(t, task.GetDependencyResults(), 1)
+
+	results, err := dlyr.ReadResultsForAlgorithm(testCtx, &pb.ResultsForAlgorithmRead{
+		TimeFrom:  &timestamppb.Timestamp{Seconds: 3600},
+		TimeTo:    &timestamppb.Timestamp{Seconds: 3700},
+		Algorithm: &algo_2,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 1)
+	assert.Equal(t, float32(2), results.GetResults()[0].GetSingleValue())
+
+	// results can only be submitted for tasks that are awaiting them
+	err = dlyr.SubmitResult(testCtx, &pb.TaskResult{
+		TaskId: task.GetTaskId(),
+		Result: &pb.Result{Status: pb.ResultStatus_RESULT_STATUS_SUCEEDED},
+	})
+	assert.ErrorIs(t, err, types.TaskNotFound)
+}
+
+// TestSubscribeResults tests that results are streamed to subscribers as they
+// are written, and that subscribers can resume from where they left off
+func TestSubscribeResults(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForResultSubscriptions",
+		Version: "1.0.0",
+	}
+
+	algo_1 := pb.Algorithm{
+		Name:       "TestResultSubscriptionAlgorithm1",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	algo_2 := pb.Algorithm{
+		Name:       "TestResultSubscriptionAlgorithm2",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestResultSubscriptionProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo_1, &algo_2},
+	})
+	assert.NoError(t, err)
+
+	subscribe := func(resumeAfter int64) (<-chan *pb.ResultUpdate, func()) {
+		updates := make(chan *pb.ResultUpdate, 10)
+		subscribeCtx, unsubscribe := context.WithCancel(testCtx)
+		go func() {
+			dlyr.SubscribeResults(subscribeCtx, &pb.ResultsSubscription{
+				Algorithms:  []*pb.Algorithm{&algo_1},
+				WindowType:  &windowType,
+				ResumeAfter: resumeAfter,
+			}, func(update *pb.ResultUpdate) error {
+				updates <- update
+				return nil
+			})
+		}()
+		time.Sleep(100 * time.Millisecond) // some time to subscribe
+		return updates, unsubscribe
+	}
+
+	emit := func(seconds int64) {
+		emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: seconds},
+			TimeTo:            &timestamppb.Timestamp{Seconds: seconds + 100},
+			WindowTypeName:    windowType.GetName(),
+			WindowTypeVersion: windowType.GetVersion(),
+			Origin:            "Test",
+		})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	// only results of the subscribed algorithm are streamed
+	updates, unsubscribe := subscribe(0)
+	emit(3800)
+
+	var update *pb.ResultUpdate
+	select {
+	case update = <-updates:
+	case <-time.After(5 * time.Second):
+		t.Fatal("result was not streamed")
+	}
+	assert.Equal(t, algo_1.GetName(), update.GetAlgorithmResult().GetAlgorithm().GetName())
+	assert.Equal(t, int64(3800), update.GetWindow().GetTimeFrom().GetSeconds())
+	assert.Empty(t, updates)
+	unsubscribe()
+
+	// results written while unsubscribed are streamed on resuming
+	emit(3900)
+	updates, unsubscribe = subscribe(update.GetCursor())
+	defer unsubscribe()
+
+	select {
+	case update = <-updates:
+	case <-time.After(5 * time.Second):
+		t.Fatal("result was not streamed on resuming")
+	}
+	assert.Equal(t, algo_1.GetName(), update.GetAlgorithmResult().GetAlgorithm().GetName())
+	assert.Equal(t, int64(3900), update.GetWindow().GetTimeFrom().GetSeconds())
+}
+
+// TestSubscribeResultsCommittedLate tests that a result committed after a
+// result written later is still streamed, rather than its cursor being passed
+func TestSubscribeResultsCommittedLate(t *testing.T) {
+	slowProcessor, slowListener, err := StartSlowMockOrcaProcessor(0, time.Second)
+	assert.NoError(t, err)
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		slowProcessor.GracefulStop()
+		slowListener.Close()
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	slowWindowType := pb.WindowType{Name: "TestSlowWindowForLateResults", Version: "1.0.0"}
+	windowType := pb.WindowType{Name: "TestWindowForLateResults", Version: "1.0.0"}
+	slowAlgo := pb.Algorithm{
+		Name:       "TestSlowLateResultAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &slowWindowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+	algo := pb.Algorithm{
+		Name:       "TestLateResultAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestSlowLateResultProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       slowListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&slowAlgo},
+	})
+	assert.NoError(t, err)
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestLateResultProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	})
+	assert.NoError(t, err)
+
+	updates := make(chan *pb.ResultUpdate, 10)
+	subscribeCtx, unsubscribe := context.WithCancel(testCtx)
+	defer unsubscribe()
+	go func() {
+		dlyr.SubscribeResults(subscribeCtx, &pb.ResultsSubscription{
+			Algorithms: []*pb.Algorithm{&slowAlgo, &algo},
+		}, func(update *pb.ResultUpdate) error {
+			updates <- update
+			return nil
+		})
+	}()
+	time.Sleep(100 * time.Millisecond) // some time to subscribe
+
+	slowStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 4000},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 4100},
+		WindowTypeName:    slowWindowType.GetName(),
+		WindowTypeVersion: slowWindowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: slowStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_RUNNING
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// hold the slow window's result open in another transaction, so that
+	// writing it waits on this one
+	conn, err := pgx.Connect(testCtx, testConnStr)
+	assert.NoError(t, err)
+	defer conn.Close(testCtx)
+	blockingTx, err := conn.Begin(testCtx)
+	assert.NoError(t, err)
+	_, err = blockingTx.Exec(testCtx, `
+		INSERT INTO results (windows_id, window_type_id, algorithm_id, result_value)
+		SELECT w.id, w.window_type_id, a.id, 0
+		FROM windows w
+		JOIN window_type wt ON w.window_type_id = wt.id
+		JOIN algorithm a ON a.window_type_id = wt.id
+		WHERE wt.name = $1 AND a.name = $2`,
+		slowWindowType.GetName(),
+		slowAlgo.GetName(),
+	)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		var waiting int
+		err := conn.QueryRow(testCtx, `
+			SELECT count(*) FROM pg_stat_activity
+			WHERE wait_event_type = 'Lock' AND query LIKE '%CreateResult%'`,
+		).Scan(&waiting)
+		return err == nil && waiting == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// a result written after it is committed first, and streamed
+	emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          &timestamppb.Timestamp{Seconds: 4000},
+		TimeTo:            &timestamppb.Timestamp{Seconds: 4100},
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "Test",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	var update *pb.ResultUpdate
+	select {
+	case update = <-updates:
+	case <-time.After(5 * time.Second):
+		t.Fatal("result was not streamed")
+	}
+	assert.Equal(t, algo.GetName(), update.GetAlgorithmResult().GetAlgorithm().GetName())
+	cursor := update.GetCursor()
+
+	// the slow window's result is streamed once committed, after the cursor
+	// already passed
+	err = blockingTx.Rollback(testCtx)
+	assert.NoError(t, err)
+
+	select {
+	case update = <-updates:
+	case <-time.After(5 * time.Second):
+		t.Fatal("result committed late was not streamed")
+	}
+	assert.Equal(t, slowAlgo.GetName(), update.GetAlgorithmResult().GetAlgorithm().GetName())
+	assert.Greater(t, update.GetCursor(), cursor)
+	assert.Empty(t, updates)
+}
+
+// TestAlerts tests that alert rules fire and resolve alerts as results are
+// written, deduplicated per origin and metadata
+func TestAlerts(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForAlerts",
+		Version: "1.0.0",
+		MetadataFields: []*pb.MetadataField{
+			{Name: "asset_id", Description: "Unique ID of the asset"},
+		},
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestAlertAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestAlertProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	})
+	assert.NoError(t, err)
+
+	// rules must evaluate to a bool
+	err = dlyr.CreateAlertRule(testCtx, &pb.AlertRule{
+		Name:       "TestAlertRule",
+		Algorithm:  &algo,
+		Expression: "result.single_value + 1.0",
+	})
+	assert.ErrorIs(t, err, types.InvalidAlertRule)
+
+	// the mock processor produces 0 for algorithms without dependencies
+	err = dlyr.CreateAlertRule(testCtx, &pb.AlertRule{
+		Name:        "TestAlertRule",
+		Algorithm:   &algo,
+		Expression:  "result.single_value == 0.0",
+		MetadataKey: "asset_id",
+	})
+	assert.NoError(t, err)
+
+	emit := func(seconds int64, assetId float64) {
+		metadata, err := structpb.NewStruct(map[string]any{"asset_id": assetId})
+		assert.NoError(t, err)
+		emitStatus, err := dlyr.EmitWindow(testCtx, &pb.Window{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: seconds},
+			TimeTo:            &timestamppb.Timestamp{Seconds: seconds + 100},
+			WindowTypeName:    windowType.GetName(),
+			WindowTypeVersion: windowType.GetVersion(),
+			Origin:            "Test",
+			Metadata:          metadata,
+		})
+		assert.NoError(t, err)
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	readAlerts := func(status pb.AlertStatus) []*pb.Alerts_Alert {
+		alerts, err := dlyr.ReadAlerts(testCtx, &pb.AlertsRead{
+			TimeFrom:      timestamppb.New(time.Now().Add(-time.Hour)),
+			TimeTo:        timestamppb.New(time.Now().Add(time.Hour)),
+			AlertRuleName: "TestAlertRule",
+			Status:        status,
+		})
+		assert.NoError(t, err)
+		return alerts.GetAlerts()
+	}
+
+	// a firing alert is not fired again for the same asset, but is for another
+	emit(4000, 1)
+	emit(4100, 1)
+	emit(4200, 2)
+	alerts := readAlerts(pb.AlertStatus_ALERT_STATUS_FIRING)
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, float64(1), alerts[0].GetMetadata().AsMap()["asset_id"])
+	assert.Equal(t, int64(4000), alerts[0].GetWindowTimeFrom().GetSeconds())
+
+	// the alert resolves once the rule no longer holds for the asset
+	err = dlyr.CreateAlertRule(testCtx, &pb.AlertRule{
+		Name:        "TestAlertRule",
+		Algorithm:   &algo,
+		Expression:  "result.single_value == 0.0 && metadata.asset_id != 1.0",
+		MetadataKey: "asset_id",
+	})
+	assert.NoError(t, err)
+	emit(4300, 1)
+
+	assert.Len(t, readAlerts(pb.AlertStatus_ALERT_STATUS_FIRING), 1)
+	resolved := readAlerts(pb.AlertStatus_ALERT_STATUS_RESOLVED)
+	assert.Len(t, resolved, 1)
+	assert.NotNil(t, resolved[0].GetResolved())
+
+	// acknowledged alerts are only read when asked for
+	err = dlyr.AcknowledgeAlert(testCtx, &pb.AlertAcknowledgement{
+		AlertId:        resolved[0].GetAlertId(),
+		AcknowledgedBy: "Test",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, readAlerts(pb.AlertStatus_ALERT_STATUS_UNSPECIFIED), 1)
+
+	err = dlyr.AcknowledgeAlert(testCtx, &pb.AlertAcknowledgement{AlertId: -1})
+	assert.ErrorIs(t, err, types.AlertNotFound)
+
+	err = dlyr.DeleteAlertRule(testCtx, &pb.AlertRuleDeletion{Name: "TestAlertRule"})
+	assert.NoError(t, err)
+	assert.Empty(t, readAlerts(pb.AlertStatus_ALERT_STATUS_UNSPECIFIED))
+}
+
+func TestEmitWindows(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForBatches",
+		Version: "1.0.0",
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestBatchAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestBatchProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	})
+	assert.NoError(t, err)
+
+	window := func(seconds int64, idempotencyKey string) *pb.Window {
+		return &pb.Window{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: seconds},
+			TimeTo:            &timestamppb.Timestamp{Seconds: seconds + 100},
+			WindowTypeName:    windowType.GetName(),
+			WindowTypeVersion: windowType.GetVersion(),
+			Origin:            "Test",
+			IdempotencyKey:    idempotencyKey,
+		}
+	}
+	windows := []*pb.Window{
+		window(4400, "batch-a"),
+		window(4500, ""),
+		window(4600, "batch-a"),
+		{
+			TimeFrom:          &timestamppb.Timestamp{Seconds: 4400},
+			TimeTo:            &timestamppb.Timestamp{Seconds: 4500},
+			WindowTypeName:    "TestUnknownWindow",
+			WindowTypeVersion: "1.0.0",
+			Origin:            "Test",
+		},
+	}
+
+	statuses, err := dlyr.EmitWindows(testCtx, windows)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, len(windows))
+
+	// new windows are triggered
+	for _, emitStatus := range statuses[:2] {
+		assert.False(t, emitStatus.GetDuplicate())
+		assert.NotEmpty(t, emitStatus.GetExecId())
+		assert.Eventually(t, func() bool {
+			execution, err := dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+			return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+	assert.NotEqual(t, statuses[0].GetExecId(), statuses[1].GetExecId())
+
+	// a window repeated within the batch shares the execution of the first
+	assert.True(t, statuses[2].GetDuplicate())
+	assert.Equal(t, statuses[0].GetExecId(), statuses[2].GetExecId())
+
+	// windows that cannot be emitted fail without failing the batch
+	assert.Equal(t, pb.WindowEmitStatus_TRIGGERING_FAILED, statuses[3].GetStatus())
+	assert.Contains(t, statuses[3].GetError(), "does not exist")
+
+	// emitting the batch again triggers nothing new
+	reemitted, err := dlyr.EmitWindows(testCtx, windows[:3])
+	assert.NoError(t, err)
+	for ii, emitStatus := range reemitted {
+		assert.True(t, emitStatus.GetDuplicate())
+		assert.Equal(t, statuses[ii].GetExecId(), emitStatus.GetExecId())
+	}
+}
+
+// TestWindowSchedules tests that scheduled windows are emitted in the
+// background, catching up on those missed, and are emitted only once when
+// several instances of orca-core are running
+func TestWindowSchedules(t *testing.T) {
+	os.Setenv("ORCA_SCHEDULE_INTERVAL", "100ms")
+	envs.ReloadConfig()
+	t.Cleanup(func() {
+		os.Unsetenv("ORCA_SCHEDULE_INTERVAL")
+		envs.ReloadConfig()
+	})
+
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	// two instances of orca-core sharing the same schedules
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+	_, err = NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForSchedules",
+		Version: "1.0.0",
+		MetadataFields: []*pb.MetadataField{
+			{Name: "shift", Description: "The shift the window covers"},
+		},
+	}
+
+	algo := pb.Algorithm{
+		Name:       "TestScheduleAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestScheduleProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{&algo},
+	})
+	assert.NoError(t, err)
+
+	metadata, err := structpb.NewStruct(map[string]any{"shift": "day"})
+	assert.NoError(t, err)
+
+	schedule := &pb.WindowSchedule{
+		Name:              "TestHourlySchedule",
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Cron:              "@hourly",
+		Timezone:          "Europe/London",
+		Origin:            "TestScheduler",
+		Metadata:          metadata,
+	}
+
+	// schedules are checked before they are stored
+	invalid := proto.Clone(schedule).(*pb.WindowSchedule)
+	invalid.Cron = "not a cron expression"
+	assert.ErrorIs(t, dlyr.CreateWindowSchedule(testCtx, invalid), types.InvalidWindowSchedule)
+
+	invalid = proto.Clone(schedule).(*pb.WindowSchedule)
+	invalid.Metadata = nil
+	assert.ErrorIs(t, dlyr.CreateWindowSchedule(testCtx, invalid), types.InvalidWindowSchedule)
+
+	invalid = proto.Clone(schedule).(*pb.WindowSchedule)
+	invalid.WindowTypeName = "TestUnknownWindow"
+	assert.ErrorIs(t, dlyr.CreateWindowSchedule(testCtx, invalid), types.WindowTypeNotFound)
+
+	// starting in the past catches up on the windows since
+	start := time.Now().UTC().Truncate(time.Hour).Add(-5 * time.Hour)
+	schedule.Start = timestamppb.New(start)
+	err = dlyr.CreateWindowSchedule(testCtx, schedule)
+	assert.NoError(t, err)
+
+	windowsRead := &pb.WindowsRead{
+		TimeFrom: timestamppb.New(start),
+		TimeTo:   timestamppb.New(start.Add(6 * time.Hour)),
+		Window:   &windowType,
+	}
+	assert.Eventually(t, func() bool {
+		schedules, err := dlyr.ReadWindowSchedules(testCtx)
+		if err != nil || len(schedules.GetWindowSchedules()) != 1 {
+			return false
+		}
+		return schedules.GetWindowSchedules()[0].GetEmittedUntil().AsTime().Equal(start.Add(5 * time.Hour))
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// give the other instance the chance to emit the windows again
+	time.Sleep(300 * time.Millisecond)
+
+	windows, err := dlyr.ReadWindows(testCtx, windowsRead)
+	assert.NoError(t, err)
+	assert.Len(t, windows.GetWindow(), 5)
+	for ii, window := range windows.GetWindow() {
+		assert.Equal(t, start.Add(time.Duration(ii)*time.Hour), window.GetTimeFrom().AsTime())
+		assert.Equal(t, start.Add(time.Duration(ii+1)*time.Hour), window.GetTimeTo().AsTime())
+		assert.Equal(t, "TestScheduler", window.GetOrigin())
+		assert.Equal(t, "day", window.GetMetadata().GetFields()["shift"].GetStringValue())
+	}
+
+	schedules, err := dlyr.ReadWindowSchedules(testCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/London", schedules.GetWindowSchedules()[0].GetTimezone())
+	assert.Equal(t, start.Add(6*time.Hour), schedules.GetWindowSchedules()[0].GetNextEmission().AsTime())
+
+	err = dlyr.DeleteWindowSchedule(testCtx, &pb.WindowScheduleDeletion{Name: schedule.GetName()})
+	assert.NoError(t, err)
+	err = dlyr.DeleteWindowSchedule(testCtx, &pb.WindowScheduleDeletion{Name: schedule.GetName()})
+	assert.ErrorIs(t, err, types.WindowScheduleNotFound)
+}
+
+func TestWindowRollups(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	hourlyWindowType := pb.WindowType{
+		Name:    "TestHourlyWindowForRollups",
+		Version: "1.0.0",
+		MetadataFields: []*pb.MetadataField{
+			{Name: "asset", Description: "The asset the window covers"},
+		},
+	}
+	dailyWindowType := pb.WindowType{
+		Name:    "TestDailyWindowForRollups",
+		Version: "1.0.0",
+		MetadataFields: []*pb.MetadataField{
+			{Name: "asset", Description: "The asset the window covers"},
+		},
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:          "TestRollupProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{
+			{
+				Name:       "TestHourlyRollupAlgorithm",
+				Version:    "1.0.0",
+				WindowType: &hourlyWindowType,
+				ResultType: pb.ResultType_VALUE,
+			},
+			{
+				Name:       "TestDailyRollupAlgorithm",
+				Version:    "1.0.0",
+				WindowType: &dailyWindowType,
+				ResultType: pb.ResultType_VALUE,
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	rollupRule := &pb.RollupRule{
+		Name:                    "TestDailyRollup",
+		ChildWindowTypeName:     hourlyWindowType.GetName(),
+		ChildWindowTypeVersion:  hourlyWindowType.GetVersion(),
+		ParentWindowTypeName:    dailyWindowType.GetName(),
+		ParentWindowTypeVersion: dailyWindowType.GetVersion(),
+		Cron:                    "@daily",
+		MetadataKeys:            []string{"asset"},
+	}
+
+	// rules are checked before they are stored
+	invalid := proto.Clone(rollupRule).(*pb.RollupRule)
+	invalid.Cron = "not a cron expression"
+	assert.ErrorIs(t, dlyr.CreateRollupRule(testCtx, invalid), types.InvalidRollupRule)
+
+	invalid = proto.Clone(rollupRule).(*pb.RollupRule)
+	invalid.MetadataKeys = nil
+	assert.ErrorIs(t, dlyr.CreateRollupRule(testCtx, invalid), types.InvalidRollupRule)
+
+	invalid = proto.Clone(rollupRule).(*pb.RollupRule)
+	invalid.ParentWindowTypeName = "TestUnknownWindow"
+	assert.ErrorIs(t, dlyr.CreateRollupRule(testCtx, invalid), types.WindowTypeNotFound)
+
+	err = dlyr.CreateRollupRule(testCtx, rollupRule)
+	assert.NoError(t, err)
+
+	rollupRules, err := dlyr.ReadRollupRules(testCtx)
+	assert.NoError(t, err)
+	assert.Len(t, rollupRules.GetRollupRules(), 1)
+	assert.Equal(t, "UTC", rollupRules.GetRollupRules()[0].GetTimezone())
+	assert.Equal(t, "TestDailyRollup", rollupRules.GetRollupRules()[0].GetOrigin())
+
+	// a complete day of hourly windows for one asset, and all but the last
+	// hour of the next day
+	day := time.Date(2001, 3, 4, 0, 0, 0, 0, time.UTC)
+	var windows []*pb.Window
+	for ii := range 47 {
+		metadata, err := structpb.NewStruct(map[string]any{"asset": "pump-1", "operator": "a"})
+		assert.NoError(t, err)
+		windows = append(windows, &pb.Window{
+			TimeFrom:          timestamppb.New(day.Add(time.Duration(ii) * time.Hour)),
+			TimeTo:            timestamppb.New(day.Add(time.Duration(ii+1) * time.Hour)),
+			WindowTypeName:    hourlyWindowType.GetName(),
+			WindowTypeVersion: hourlyWindowType.GetVersion(),
+			Origin:            "TestRollups",
+			Metadata:          metadata,
+		})
+	}
+	_, err = dlyr.EmitWindows(testCtx, windows)
+	assert.NoError(t, err)
+
+	dailyWindowsRead := &pb.WindowsRead{
+		TimeFrom: timestamppb.New(day),
+		TimeTo:   timestamppb.New(day.Add(72 * time.Hour)),
+		Window:   &dailyWindowType,
+	}
+	assert.Eventually(t, func() bool {
+		dailyWindows, err := dlyr.ReadWindows(testCtx, dailyWindowsRead)
+		return err == nil && len(dailyWindows.GetWindow()) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// give the incomplete day the chance to be rolled up too
+	time.Sleep(300 * time.Millisecond)
+
+	dailyWindows, err := dlyr.ReadWindows(testCtx, dailyWindowsRead)
+	assert.NoError(t, err)
+	assert.Len(t, dailyWindows.GetWindow(), 1)
+	dailyWindow := dailyWindows.GetWindow()[0]
+	assert.Equal(t, day, dailyWindow.GetTimeFrom().AsTime())
+	assert.Equal(t, day.Add(24*time.Hour), dailyWindow.GetTimeTo().AsTime())
+	assert.Equal(t, "TestDailyRollup", dailyWindow.GetOrigin())
+	// only the metadata the windows are rolled up by is carried over
+	assert.Equal(t, map[string]any{"asset": "pump-1"}, dailyWindow.GetMetadata().AsMap())
+
+	// the parent window triggers its own algorithms
+	executions, err := dlyr.ReadExecutions(testCtx, &pb.ExecutionsRead{
+		TimeFrom: dailyWindowsRead.GetTimeFrom(),
+		TimeTo:   dailyWindowsRead.GetTimeTo(),
+		Window:   &dailyWindowType,
+	})
+	if assert.NoError(t, err) {
+		assert.Len(t, executions.GetExecutions(), 1)
+	}
+
+	childWindows, err := dlyr.ReadChildWindows(testCtx, &pb.ChildWindowsRead{Window: dailyWindow})
+	assert.NoError(t, err)
+	assert.Len(t, childWindows.GetWindow(), 24)
+	for ii, window := range childWindows.GetWindow() {
+		assert.Equal(t, day.Add(time.Duration(ii)*time.Hour), window.GetTimeFrom().AsTime())
+		assert.Equal(t, hourlyWindowType.GetName(), window.GetWindowTypeName())
+	}
+
+	// the last hour completes the next day
+	metadata, err := structpb.NewStruct(map[string]any{"asset": "pump-1"})
+	assert.NoError(t, err)
+	_, err = dlyr.EmitWindow(testCtx, &pb.Window{
+		TimeFrom:          timestamppb.New(day.Add(47 * time.Hour)),
+		TimeTo:            timestamppb.New(day.Add(48 * time.Hour)),
+		WindowTypeName:    hourlyWindowType.GetName(),
+		WindowTypeVersion: hourlyWindowType.GetVersion(),
+		Origin:            "TestRollups",
+		Metadata:          metadata,
+	})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		dailyWindows, err := dlyr.ReadWindows(testCtx, dailyWindowsRead)
+		return err == nil && len(dailyWindows.GetWindow()) == 2
+	}, 5*time.Second, 50*time.Millisecond)
+
+	err = dlyr.DeleteRollupRule(testCtx, &pb.RollupRuleDeletion{Name: rollupRule.GetName()})
+	assert.NoError(t, err)
+	err = dlyr.DeleteRollupRule(testCtx, &pb.RollupRuleDeletion{Name: rollupRule.GetName()})
+	assert.ErrorIs(t, err, types.RollupRuleNotFound)
+}
+
+func TestWindowLifecycle(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForLifecycle",
+		Version: "1.0.0",
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:          "TestLifecycleProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{{
+			Name:       "TestLifecycleAlgorithm",
+			Version:    "1.0.0",
+			WindowType: &windowType,
+			ResultType: pb.ResultType_VALUE,
+		}},
+	})
+	assert.NoError(t, err)
+
+	start := time.Date(2002, 5, 6, 0, 0, 0, 0, time.UTC)
+	windowOpen := &pb.WindowOpen{
+		TimeFrom:          timestamppb.New(start),
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "TestLifecycle",
+		IdempotencyKey:    "fault-1",
+	}
+	emitStatus, err := dlyr.OpenWindow(testCtx, windowOpen)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS, emitStatus.GetStatus())
+	assert.False(t, emitStatus.GetDuplicate())
+
+	// opening the window again has no effect
+	emitStatus, err = dlyr.OpenWindow(testCtx, windowOpen)
+	assert.NoError(t, err)
+	assert.True(t, emitStatus.GetDuplicate())
+
+	windowExtension := &pb.WindowExtension{
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		IdempotencyKey:    "fault-1",
+		TimeTo:            timestamppb.New(start.Add(time.Hour)),
+	}
+	emitStatus, err = dlyr.ExtendWindow(testCtx, windowExtension)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS, emitStatus.GetStatus())
+
+	// windows are only ever extended forwards
+	windowExtension.TimeTo = timestamppb.New(start.Add(30 * time.Minute))
+	_, err = dlyr.ExtendWindow(testCtx, windowExtension)
+	assert.ErrorIs(t, err, types.InvalidWindowEnd)
+
+	// algorithms are not triggered while the window is open
+	executionsRead := &pb.ExecutionsRead{
+		TimeFrom: timestamppb.New(start),
+		TimeTo:   timestamppb.New(start.Add(24 * time.Hour)),
+		Window:   &windowType,
+	}
+	executions, err := dlyr.ReadExecutions(testCtx, executionsRead)
+	assert.NoError(t, err)
+	assert.Empty(t, executions.GetExecutions())
+
+	windowClosure := &pb.WindowClosure{
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		IdempotencyKey:    "fault-1",
+		TimeTo:            timestamppb.New(start.Add(2 * time.Hour)),
+	}
+	closeStatus, err := dlyr.CloseWindow(testCtx, windowClosure)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, closeStatus.GetExecId())
+	assert.False(t, closeStatus.GetDuplicate())
+
+	// closing the window again does not trigger it again
+	emitStatus, err = dlyr.CloseWindow(testCtx, windowClosure)
+	assert.NoError(t, err)
+	assert.True(t, emitStatus.GetDuplicate())
+	assert.Equal(t, closeStatus.GetExecId(), emitStatus.GetExecId())
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: closeStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, start.Add(2*time.Hour), execution.GetWindow().GetTimeTo().AsTime())
+	assert.False(t, execution.GetProvisional())
+
+	windowExtension.TimeTo = timestamppb.New(start.Add(3 * time.Hour))
+	_, err = dlyr.ExtendWindow(testCtx, windowExtension)
+	assert.ErrorIs(t, err, types.WindowNotOpen)
+
+	windowExtension.IdempotencyKey = "unknown"
+	_, err = dlyr.ExtendWindow(testCtx, windowExtension)
+	assert.ErrorIs(t, err, types.WindowNotFound)
+
+	// provisional windows are executed on what they cover so far as they are
+	// extended
+	windowOpen.TimeFrom = timestamppb.New(start.Add(4 * time.Hour))
+	windowOpen.IdempotencyKey = "fault-2"
+	windowOpen.Provisional = true
+	_, err = dlyr.OpenWindow(testCtx, windowOpen)
+	assert.NoError(t, err)
+
+	windowExtension.IdempotencyKey = "fault-2"
+	windowExtension.TimeTo = timestamppb.New(start.Add(5 * time.Hour))
+	provisionalStatus, err := dlyr.ExtendWindow(testCtx, windowExtension)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, provisionalStatus.GetExecId())
+
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: provisionalStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.True(t, execution.GetProvisional())
+	assert.Equal(t, start.Add(5*time.Hour), execution.GetWindow().GetTimeTo().AsTime())
+
+	windowClosure.IdempotencyKey = "fault-2"
+	windowClosure.TimeTo = timestamppb.New(start.Add(6 * time.Hour))
+	closeStatus, err = dlyr.CloseWindow(testCtx, windowClosure)
+	assert.NoError(t, err)
+	assert.NotEqual(t, provisionalStatus.GetExecId(), closeStatus.GetExecId())
+
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: closeStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.False(t, execution.GetProvisional())
+
+	windows, err := dlyr.ReadWindows(testCtx, &pb.WindowsRead{
+		TimeFrom: timestamppb.New(start),
+		TimeTo:   timestamppb.New(start.Add(24 * time.Hour)),
+		Window:   &windowType,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, windows.GetWindow(), 2)
+}
+
+// TestCloseWindowRejected tests that the provisional executions of a window
+// are only cancelled once the window has been closed
+func TestCloseWindowRejected(t *testing.T) {
+	os.Setenv("ORCA_EXECUTION_WORKERS", "1")
+	os.Setenv("ORCA_EXECUTION_QUEUE_SIZE", "0")
+	envs.ReloadConfig()
+	t.Cleanup(func() {
+		os.Unsetenv("ORCA_EXECUTION_WORKERS")
+		os.Unsetenv("ORCA_EXECUTION_QUEUE_SIZE")
+		envs.ReloadConfig()
+	})
+
+	mockProcessor, mockListener, err := StartSlowMockOrcaProcessor(0, time.Second)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForRejectedClosure",
+		Version: "1.0.0",
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:          "TestRejectedClosureProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{{
+			Name:       "TestRejectedClosureAlgorithm",
+			Version:    "1.0.0",
+			WindowType: &windowType,
+			ResultType: pb.ResultType_VALUE,
+		}},
+	})
+	assert.NoError(t, err)
+
+	start := time.Date(2002, 6, 6, 0, 0, 0, 0, time.UTC)
+	_, err = dlyr.OpenWindow(testCtx, &pb.WindowOpen{
+		TimeFrom:          timestamppb.New(start),
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "TestRejectedClosure",
+		IdempotencyKey:    "fault-1",
+		Provisional:       true,
+	})
+	assert.NoError(t, err)
+
+	// the provisional execution takes the only worker
+	provisionalStatus, err := dlyr.ExtendWindow(testCtx, &pb.WindowExtension{
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		IdempotencyKey:    "fault-1",
+		TimeTo:            timestamppb.New(start.Add(time.Hour)),
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, provisionalStatus.GetExecId())
+
+	// so the closure is rejected, and the provisional execution carries on
+	windowClosure := &pb.WindowClosure{
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		IdempotencyKey:    "fault-1",
+		TimeTo:            timestamppb.New(start.Add(2 * time.Hour)),
+	}
+	_, err = dlyr.CloseWindow(testCtx, windowClosure)
+	assert.ErrorIs(t, err, types.ExecutionQueueFull)
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: provisionalStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// the window is closed once a worker is free
+	assert.Eventually(t, func() bool {
+		_, err = dlyr.CloseWindow(testCtx, windowClosure)
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestWindowAmendments(t *testing.T) {
+	mockProcessor, mockListener, err := StartMockOrcaProcessor(0)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForAmendments",
+		Version: "1.0.0",
+	}
+	algorithm := &pb.Algorithm{
+		Name:       "TestAmendmentAlgorithm",
+		Version:    "1.0.0",
+		WindowType: &windowType,
+		ResultType: pb.ResultType_VALUE,
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:                "TestAmendmentProcessor",
+		Runtime:             "Test",
+		ConnectionStr:       mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{algorithm},
+	})
+	assert.NoError(t, err)
+
+	start := time.Date(2003, 7, 8, 0, 0, 0, 0, time.UTC)
+	window := &pb.Window{
+		TimeFrom:          timestamppb.New(start),
+		TimeTo:            timestamppb.New(start.Add(time.Hour)),
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "TestAmendments",
+		IdempotencyKey:    "shift-1",
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, window)
+	assert.NoError(t, err)
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	amendedWindow := proto.Clone(window).(*pb.Window)
+	amendedWindow.TimeTo = timestamppb.New(start.Add(90 * time.Minute))
+	amendedWindow.Metadata, err = structpb.NewStruct(map[string]any{"corrected": true})
+	assert.NoError(t, err)
+	amendStatus, err := dlyr.AmendWindow(testCtx, &pb.WindowAmendment{
+		Window:        window,
+		AmendedWindow: amendedWindow,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, amendStatus.GetExecId())
+	assert.NotEqual(t, emitStatus.GetExecId(), amendStatus.GetExecId())
+
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: amendStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, start.Add(90*time.Minute), execution.GetWindow().GetTimeTo().AsTime())
+
+	// the superseded window is hidden unless asked for
+	windowsRead := &pb.WindowsRead{
+		TimeFrom: timestamppb.New(start),
+		TimeTo:   timestamppb.New(start.Add(24 * time.Hour)),
+		Window:   &windowType,
+	}
+	windows, err := dlyr.ReadWindows(testCtx, windowsRead)
+	assert.NoError(t, err)
+	assert.Len(t, windows.GetWindow(), 1)
+	assert.Equal(t, start.Add(90*time.Minute), windows.GetWindow()[0].GetTimeTo().AsTime())
+
+	windowsRead.IncludeSuperseded = true
+	windows, err = dlyr.ReadWindows(testCtx, windowsRead)
+	assert.NoError(t, err)
+	assert.Len(t, windows.GetWindow(), 2)
+
+	// as are the results of the superseded window
+	resultsRead := &pb.ResultsForAlgorithmRead{
+		TimeFrom:  timestamppb.New(start),
+		TimeTo:    timestamppb.New(start.Add(24 * time.Hour)),
+		Algorithm: algorithm,
+	}
+	results, err := dlyr.ReadResultsForAlgorithm(testCtx, resultsRead)
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 1)
+	assert.False(t, results.GetResults()[0].GetSuperseded())
+
+	resultsRead.IncludeSuperseded = true
+	results, err = dlyr.ReadResultsForAlgorithm(testCtx, resultsRead)
+	assert.NoError(t, err)
+	assert.Len(t, results.GetResults(), 2)
+	superseded := 0
+	for _, result := range results.GetResults() {
+		if result.GetSuperseded() {
+			superseded++
+		}
+	}
+	assert.Equal(t, 1, superseded)
+
+	// the original window retried by its emitter is not triggered again
+	emitStatus, err = dlyr.EmitWindow(testCtx, window)
+	assert.NoError(t, err)
+	assert.True(t, emitStatus.GetDuplicate())
+	assert.Equal(t, amendStatus.GetExecId(), emitStatus.GetExecId())
+
+	// the amended window takes over the idempotency key, so it can be amended
+	// again
+	correctedWindow := proto.Clone(amendedWindow).(*pb.Window)
+	correctedWindow.TimeTo = timestamppb.New(start.Add(2 * time.Hour))
+	_, err = dlyr.AmendWindow(testCtx, &pb.WindowAmendment{
+		Window:        window,
+		AmendedWindow: correctedWindow,
+	})
+	assert.NoError(t, err)
+
+	windowsRead.IncludeSuperseded = false
+	windows, err = dlyr.ReadWindows(testCtx, windowsRead)
+	assert.NoError(t, err)
+	assert.Len(t, windows.GetWindow(), 1)
+	assert.Equal(t, start.Add(2*time.Hour), windows.GetWindow()[0].GetTimeTo().AsTime())
+
+	unknownWindow := proto.Clone(window).(*pb.Window)
+	unknownWindow.IdempotencyKey = "unknown"
+	_, err = dlyr.AmendWindow(testCtx, &pb.WindowAmendment{
+		Window:        unknownWindow,
+		AmendedWindow: correctedWindow,
+	})
+	assert.ErrorIs(t, err, types.WindowNotFound)
+
+	// windows are not amended to a different window type
+	otherWindow := proto.Clone(correctedWindow).(*pb.Window)
+	otherWindow.WindowTypeVersion = "2.0.0"
+	_, err = dlyr.AmendWindow(testCtx, &pb.WindowAmendment{
+		Window:        window,
+		AmendedWindow: otherWindow,
+	})
+	assert.ErrorIs(t, err, types.InvalidWindowAmendment)
+}
+
+// TestAmendWindowRejected tests that the executions of a window are only
+// cancelled once the window has been superseded
+func TestAmendWindowRejected(t *testing.T) {
+	os.Setenv("ORCA_EXECUTION_WORKERS", "1")
+	os.Setenv("ORCA_EXECUTION_QUEUE_SIZE", "0")
+	envs.ReloadConfig()
+	t.Cleanup(func() {
+		os.Unsetenv("ORCA_EXECUTION_WORKERS")
+		os.Unsetenv("ORCA_EXECUTION_QUEUE_SIZE")
+		envs.ReloadConfig()
+	})
+
+	mockProcessor, mockListener, err := StartSlowMockOrcaProcessor(0, time.Second)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		time.Sleep(100 * time.Millisecond) // some time for processing to complete
+		mockProcessor.GracefulStop()
+		mockListener.Close()
+	})
+
+	dlyr, err := NewDatalayerClient(testCtx, "postgresql", testConnStr)
+	assert.NoError(t, err)
+
+	windowType := pb.WindowType{
+		Name:    "TestWindowForRejectedAmendments",
+		Version: "1.0.0",
+	}
+
+	err = dlyr.RegisterProcessor(testCtx, &pb.ProcessorRegistration{
+		Name:          "TestRejectedAmendmentProcessor",
+		Runtime:       "Test",
+		ConnectionStr: mockListener.Addr().String(),
+		SupportedAlgorithms: []*pb.Algorithm{{
+			Name:       "TestRejectedAmendmentAlgorithm",
+			Version:    "1.0.0",
+			WindowType: &windowType,
+			ResultType: pb.ResultType_VALUE,
+		}},
+	})
+	assert.NoError(t, err)
+
+	// the window's execution takes the only worker
+	start := time.Date(2003, 8, 8, 0, 0, 0, 0, time.UTC)
+	window := &pb.Window{
+		TimeFrom:          timestamppb.New(start),
+		TimeTo:            timestamppb.New(start.Add(time.Hour)),
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+		Origin:            "TestRejectedAmendments",
+		IdempotencyKey:    "shift-1",
+	}
+	emitStatus, err := dlyr.EmitWindow(testCtx, window)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.WindowEmitStatus_PROCESSING_TRIGGERED, emitStatus.GetStatus())
+
+	// so the amendment is rejected, and the execution carries on
+	amendedWindow := proto.Clone(window).(*pb.Window)
+	amendedWindow.TimeTo = timestamppb.New(start.Add(90 * time.Minute))
+	windowAmendment := &pb.WindowAmendment{
+		Window:        window,
+		AmendedWindow: amendedWindow,
+	}
+	_, err = dlyr.AmendWindow(testCtx, windowAmendment)
+	assert.ErrorIs(t, err, types.ExecutionQueueFull)
+
+	var execution *pb.Execution
+	assert.Eventually(t, func() bool {
+		execution, err = dlyr.ReadExecution(testCtx, &pb.ExecutionRead{ExecId: emitStatus.GetExecId()})
+		return err == nil && execution.GetStatus() == pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	}, 5*time.Second, 50*time.Millisecond)
+
+	windowsRead := &pb.WindowsRead{
+		TimeFrom: timestamppb.New(start),
+		TimeTo:   timestamppb.New(start.Add(24 * time.Hour)),
+		Window:   &windowType,
+	}
+	windows, err := dlyr.ReadWindows(testCtx, windowsRead)
+	assert.NoError(t, err)
+	assert.Len(t, windows.GetWindow(), 1)
+	assert.Equal(t, start.Add(time.Hour), windows.GetWindow()[0].GetTimeTo().AsTime())
+
+	// the window is amended once a worker is free
+	assert.Eventually(t, func() bool {
+		_, err = dlyr.AmendWindow(testCtx, windowAmendment)
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,157 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+)
+
+// alertEvaluator checks results against the alert rules of their algorithm,
+// firing and resolving alerts. Rules are CEL expressions over the result
+// along with the origin and metadata of its window
+type alertEvaluator struct {
+	env *cel.Env
+
+	mu sync.Mutex
+	// compiled programs, by expression
+	programs map[string]cel.Program
+}
+
+func newAlertEvaluator() (*alertEvaluator, error) {
+	resultType := string((&pb.Result{}).ProtoReflect().Descriptor().FullName())
+	env, err := cel.NewEnv(
+		cel.Types(&pb.Result{}),
+		cel.Variable("result", cel.ObjectType(resultType)),
+		cel.Variable("origin", cel.StringType),
+		cel.Variable("metadata", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create alert rule environment: %v", err)
+	}
+	return &alertEvaluator{
+		env:      env,
+		programs: make(map[string]cel.Program),
+	}, nil
+}
+
+// program compiles an expression, which must evaluate to a bool
+func (a *alertEvaluator) program(expression string) (cel.Program, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if prg, ok := a.programs[expression]; ok {
+		return prg, nil
+	}
+
+	ast, issues := a.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %v", ast.OutputType())
+	}
+	prg, err := a.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	a.programs[expression] = prg
+	return prg, nil
+}
+
+// evaluate checks a succeeded result against the alert rules of its
+// algorithm. A rule that holds fires an alert, unless one is already firing
+// for the same origin and metadata, and a rule that no longer holds resolves
+// the alert firing for them. Issues are logged rather than returned, so that
+// alerting never fails an execution
+func (a *alertEvaluator) evaluate(
+	ctx context.Context,
+	queries *Queries,
+	algorithmId int64,
+	windowsId int64,
+	window *pb.Window,
+	result *pb.Result,
+) {
+	rules, err := queries.ReadAlertRulesForAlgorithm(ctx, algorithmId)
+	if err != nil {
+		slog.Error("could not read alert rules", "algo_id", algorithmId, "error", err)
+		return
+	}
+	if len(rules) == 0 {
+		return
+	}
+
+	metadata := window.GetMetadata().AsMap()
+	metadataJson, err := json.Marshal(metadata)
+	if err != nil {
+		slog.Error("could not marshal window metadata for alerts", "error", err)
+		return
+	}
+
+	for _, rule := range rules {
+		prg, err := a.program(rule.Expression)
+		if err != nil {
+			slog.Warn("could not compile alert rule", "alert_rule", rule.Name, "error", err)
+			continue
+		}
+		out, _, err := prg.Eval(map[string]any{
+			"result":   result,
+			"origin":   window.GetOrigin(),
+			"metadata": metadata,
+		})
+		if err != nil {
+			slog.Warn("could not evaluate alert rule", "alert_rule", rule.Name, "error", err)
+			continue
+		}
+
+		dedupeKey := alertDedupeKey(window.GetOrigin(), metadata, rule.MetadataKey)
+		if out == celtypes.True {
+			fired, err := queries.FireAlert(ctx, FireAlertParams{
+				AlertRuleID: rule.ID,
+				DedupeKey:   dedupeKey,
+				Origin:      window.GetOrigin(),
+				Metadata:    metadataJson,
+				WindowsID:   windowsId,
+			})
+			if err != nil {
+				slog.Error("could not fire alert", "alert_rule", rule.Name, "error", err)
+				continue
+			}
+			if fired > 0 {
+				slog.Info("alert fired", "alert_rule", rule.Name, "dedupe_key", dedupeKey)
+			}
+			continue
+		}
+
+		resolved, err := queries.ResolveAlert(ctx, ResolveAlertParams{
+			AlertRuleID: rule.ID,
+			DedupeKey:   dedupeKey,
+		})
+		if err != nil {
+			slog.Error("could not resolve alert", "alert_rule", rule.Name, "error", err)
+			continue
+		}
+		if resolved > 0 {
+			slog.Info("alert resolved", "alert_rule", rule.Name, "dedupe_key", dedupeKey)
+		}
+	}
+}
+
+// alertDedupeKey identifies the alerts of a rule that are deduplicated
+// together, being those of the same origin and value of the rule's metadata
+// key
+func alertDedupeKey(origin string, metadata map[string]any, metadataKey string) string {
+	if metadataKey == "" {
+		return origin
+	}
+	value, err := json.Marshal(metadata[metadataKey])
+	if err != nil {
+		return origin
+	}
+	return fmt.Sprintf("%v/%v=%s", origin, metadataKey, value)
+}
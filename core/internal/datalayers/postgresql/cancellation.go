@@ -0,0 +1,73 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+)
+
+// errExecutionCancelled is the cause given to the context of an execution
+// cancelled through CancelExecution
+var errExecutionCancelled = errors.New("execution cancelled")
+
+// inFlightExecutions tracks the executions being processed by this instance of
+// orca-core, so that they can be cancelled
+type inFlightExecutions struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelCauseFunc
+}
+
+func newInFlightExecutions() *inFlightExecutions {
+	return &inFlightExecutions{
+		cancels: make(map[string]context.CancelCauseFunc),
+	}
+}
+
+// start registers an execution as in flight, returning the context its work
+// must run under and a function to call once it is finished
+func (f *inFlightExecutions) start(
+	ctx context.Context,
+	execId string,
+) (context.Context, func()) {
+	execCtx, cancel := context.WithCancelCause(ctx)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cancels[execId] = cancel
+
+	return execCtx, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.cancels, execId)
+		cancel(nil)
+	}
+}
+
+// cancel cancels an in-flight execution, reporting whether it was found
+func (f *inFlightExecutions) cancel(execId string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	cancel, ok := f.cancels[execId]
+	if ok {
+		cancel(errExecutionCancelled)
+	}
+	return ok
+}
+
+// checkCancelled cancels an in-flight execution that has been recorded as
+// cancelled, e.g. through CancelExecution on another instance of orca-core,
+// reporting whether it was
+func (d *Datalayer) checkCancelled(ctx context.Context, execId string) bool {
+	executionRow, err := d.queries.ReadExecution(ctx, execId)
+	if err != nil {
+		slog.Error("could not read execution", "exec_id", execId, "error", err)
+		return false
+	}
+	if executionRow.Status != ExecutionStatusCancelled {
+		return false
+	}
+	d.inFlight.cancel(execId)
+	return true
+}
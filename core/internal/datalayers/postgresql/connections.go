@@ -0,0 +1,266 @@
+package postgresql
+
+import (
+	"crypto/tls"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/orc-analytics/orca/core/internal/envs"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// processorConn is a pooled connection to an instance of a processor
+type processorConn struct {
+	conn     *grpc.ClientConn
+	name     string
+	runtime  string
+	connStr  string
+	inUse    int
+	lastUsed time.Time
+
+	// retired connections have been removed from the pool, and are closed
+	// once the tasks still using them complete
+	retired bool
+}
+
+// connectionManager holds a single long-lived connection per processor
+// instance, which is shared between all tasks dispatched to that instance.
+// Connections are closed when left unused for longer than the idle timeout
+type connectionManager struct {
+	mu          sync.Mutex
+	conns       map[int64]*processorConn
+	idleTimeout time.Duration
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+func newConnectionManager(idleTimeout time.Duration) *connectionManager {
+	m := &connectionManager{
+		conns:       make(map[int64]*processorConn),
+		idleTimeout: idleTimeout,
+		done:        make(chan struct{}),
+	}
+	go m.run()
+	return m
+}
+
+// acquire returns the connection to an instance of a processor, creating it
+// if there is none. The returned release function must be called once the
+// connection is no longer needed
+func (m *connectionManager) acquire(
+	proc Processor,
+	instance ProcessorInstance,
+) (*grpc.ClientConn, func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pc, ok := m.conns[instance.ID]
+	if !ok {
+		conn, err := dialProcessor(instance.ConnectionString)
+		if err != nil {
+			return nil, nil, err
+		}
+		pc = &processorConn{
+			conn:    conn,
+			name:    proc.Name,
+			runtime: proc.Runtime,
+			connStr: instance.ConnectionString,
+		}
+		m.conns[instance.ID] = pc
+	}
+
+	pc.inUse++
+	pc.lastUsed = time.Now()
+	return pc.conn, func() { m.release(pc) }, nil
+}
+
+// probe returns the pooled connection to an instance of a processor if there
+// is one, or otherwise a new connection that is closed on release. Probing
+// neither opens long-lived connections nor keeps idle ones alive
+func (m *connectionManager) probe(
+	proc Processor,
+	instance ProcessorInstance,
+) (*grpc.ClientConn, func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if pc, ok := m.conns[instance.ID]; ok {
+		pc.inUse++
+		return pc.conn, func() {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			pc.inUse--
+			if pc.retired && pc.inUse == 0 {
+				closeProcessorConn(pc)
+			}
+		}, nil
+	}
+
+	conn, err := dialProcessor(instance.ConnectionString)
+	if err != nil {
+		return nil, nil, err
+	}
+	pc := &processorConn{
+		conn:    conn,
+		name:    proc.Name,
+		runtime: proc.Runtime,
+		connStr: instance.ConnectionString,
+	}
+	return conn, func() { closeProcessorConn(pc) }, nil
+}
+
+func (m *connectionManager) release(pc *processorConn) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pc.inUse--
+	pc.lastUsed = time.Now()
+	if pc.retired && pc.inUse == 0 {
+		closeProcessorConn(pc)
+	}
+}
+
+// retire removes a connection from the pool, closing it straight away if it
+// is not in use. Must be called with the lock held
+func (m *connectionManager) retire(instanceId int64, pc *processorConn) {
+	delete(m.conns, instanceId)
+	pc.retired = true
+	if pc.inUse == 0 {
+		closeProcessorConn(pc)
+	}
+}
+
+// remove closes the connections to instances that are no longer registered,
+// once the tasks still using them complete
+func (m *connectionManager) remove(instanceIds []int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, instanceId := range instanceIds {
+		if pc, ok := m.conns[instanceId]; ok {
+			slog.Debug(
+				"closing connection to replaced processor instance",
+				"processor",
+				pc.name,
+				"connection_string",
+				pc.connStr,
+			)
+			m.retire(instanceId, pc)
+		}
+	}
+}
+
+// evictIdle closes connections that have not been used within the idle timeout
+func (m *connectionManager) evictIdle() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for instanceId, pc := range m.conns {
+		if pc.inUse == 0 && time.Since(pc.lastUsed) > m.idleTimeout {
+			slog.Debug(
+				"evicting idle processor connection",
+				"processor",
+				pc.name,
+				"connection_string",
+				pc.connStr,
+			)
+			m.retire(instanceId, pc)
+		}
+	}
+}
+
+// state returns the state of the connection to an instance of a processor
+func (m *connectionManager) state(instanceId int64) pb.ConnectionState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pc, ok := m.conns[instanceId]
+	if !ok {
+		return pb.ConnectionState_CONNECTION_STATE_DISCONNECTED
+	}
+	return connectionStateToPb(pc.conn.GetState())
+}
+
+// run evicts idle connections until the manager is closed
+func (m *connectionManager) run() {
+	ticker := time.NewTicker(max(m.idleTimeout/2, time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			m.evictIdle()
+		}
+	}
+}
+
+// close closes all pooled connections and stops evicting idle ones
+func (m *connectionManager) close() {
+	m.closeOnce.Do(func() {
+		close(m.done)
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for instanceId, pc := range m.conns {
+			delete(m.conns, instanceId)
+			closeProcessorConn(pc)
+		}
+	})
+}
+
+// dialProcessor creates a client connection to a processor, using TLS when in
+// production
+func dialProcessor(connStr string) (*grpc.ClientConn, error) {
+	if envs.GetConfig().IsProduction {
+		host, _, err := net.SplitHostPort(connStr)
+		if err != nil {
+			host = connStr
+		}
+		return grpc.NewClient(
+			connStr,
+			grpc.WithTransportCredentials(
+				credentials.NewTLS(
+					&tls.Config{
+						ServerName: host,
+					},
+				),
+			),
+		)
+	}
+	return grpc.NewClient(
+		connStr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
+
+func closeProcessorConn(pc *processorConn) {
+	if err := pc.conn.Close(); err != nil {
+		slog.Warn("error closing gRPC connection", "processor", pc.name, "error", err)
+	}
+}
+
+// connectionStateToPb converts a gRPC connectivity state to its protobuf form
+func connectionStateToPb(state connectivity.State) pb.ConnectionState {
+	switch state {
+	case connectivity.Idle:
+		return pb.ConnectionState_CONNECTION_STATE_IDLE
+	case connectivity.Connecting:
+		return pb.ConnectionState_CONNECTION_STATE_CONNECTING
+	case connectivity.Ready:
+		return pb.ConnectionState_CONNECTION_STATE_READY
+	case connectivity.TransientFailure:
+		return pb.ConnectionState_CONNECTION_STATE_TRANSIENT_FAILURE
+	case connectivity.Shutdown:
+		return pb.ConnectionState_CONNECTION_STATE_SHUTDOWN
+	default:
+		return pb.ConnectionState_CONNECTION_STATE_UNSPECIFIED
+	}
+}
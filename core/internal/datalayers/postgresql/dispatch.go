@@ -0,0 +1,240 @@
+package postgresql
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/orc-analytics/orca/core/internal/envs"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dispatcher picks which instance of a processor each task is sent to. Only
+// available instances reporting that they are serving are picked
+type dispatcher struct {
+	policy      envs.DispatchPolicy
+	connections *connectionManager
+
+	mu sync.Mutex
+	// the position of each processor in its round robin
+	next map[int64]int
+}
+
+func newDispatcher(policy envs.DispatchPolicy, connections *connectionManager) *dispatcher {
+	return &dispatcher{
+		policy:      policy,
+		connections: connections,
+		next:        make(map[int64]int),
+	}
+}
+
+// candidate is an instance of a processor that is serving, along with the
+// load it reported
+type candidate struct {
+	instance ProcessorInstance
+	client   pb.OrcaProcessorClient
+	release  func()
+	metrics  *pb.ProcessorMetrics
+}
+
+// dispatch picks an instance of a processor to send a task to. The returned
+// release function must be called once the task is done with the instance
+func (d *dispatcher) dispatch(
+	ctx context.Context,
+	proc Processor,
+	instances []ProcessorInstance,
+) (pb.OrcaProcessorClient, func(), error) {
+	// instances the heartbeat monitor has not seen for a while are not tried
+	instances = slices.DeleteFunc(slices.Clone(instances), func(instance ProcessorInstance) bool {
+		return !instance.Available
+	})
+	if len(instances) == 0 {
+		return nil, nil, status.Errorf(
+			codes.Unavailable,
+			"processor %v has no available instances",
+			proc.Name,
+		)
+	}
+
+	var picked *candidate
+	var err error
+	switch d.policy {
+	case envs.DispatchRoundRobin:
+		picked, err = d.roundRobin(ctx, proc, instances)
+	default:
+		picked, err = d.leastLoaded(ctx, proc, instances)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	slog.Debug(
+		"dispatching task to processor instance",
+		"processor",
+		proc.Name,
+		"connection_string",
+		picked.instance.ConnectionString,
+		"active_tasks",
+		picked.metrics.GetActiveTasks(),
+		"cpu_percent",
+		picked.metrics.GetCpuPercent(),
+	)
+	return picked.client, picked.release, nil
+}
+
+// roundRobin picks the next instance in turn, passing over those that are not
+// serving
+func (d *dispatcher) roundRobin(
+	ctx context.Context,
+	proc Processor,
+	instances []ProcessorInstance,
+) (*candidate, error) {
+	slices.SortFunc(instances, func(a, b ProcessorInstance) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	d.mu.Lock()
+	start := d.next[proc.ID]
+	d.next[proc.ID] = (start + 1) % len(instances)
+	d.mu.Unlock()
+
+	var lastErr error
+	for ii := range instances {
+		c, err := d.check(ctx, proc, instances[(start+ii)%len(instances)])
+		if err == nil {
+			return c, nil
+		}
+		lastErr = err
+	}
+	return nil, status.Errorf(
+		codes.Unavailable,
+		"no instance of processor %v is serving: %v",
+		proc.Name,
+		lastErr,
+	)
+}
+
+// leastLoaded checks every instance, picking the serving instance with the
+// fewest active tasks and then the lowest CPU usage
+func (d *dispatcher) leastLoaded(
+	ctx context.Context,
+	proc Processor,
+	instances []ProcessorInstance,
+) (*candidate, error) {
+	candidates := make([]*candidate, len(instances))
+	errs := make([]error, len(instances))
+
+	var wg sync.WaitGroup
+	for ii, instance := range instances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			candidates[ii], errs[ii] = d.check(ctx, proc, instance)
+		}()
+	}
+	wg.Wait()
+
+	var picked *candidate
+	var lastErr error
+	for ii, c := range candidates {
+		if errs[ii] != nil {
+			lastErr = errs[ii]
+			continue
+		}
+		if picked == nil || lessLoaded(c.metrics, picked.metrics) {
+			if picked != nil {
+				picked.release()
+			}
+			picked = c
+			continue
+		}
+		c.release()
+	}
+	if picked == nil {
+		return nil, status.Errorf(
+			codes.Unavailable,
+			"no instance of processor %v is serving: %v",
+			proc.Name,
+			lastErr,
+		)
+	}
+	return picked, nil
+}
+
+// lessLoaded reports whether one instance is less loaded than another
+func lessLoaded(a *pb.ProcessorMetrics, b *pb.ProcessorMetrics) bool {
+	if a.GetActiveTasks() != b.GetActiveTasks() {
+		return a.GetActiveTasks() < b.GetActiveTasks()
+	}
+	return a.GetCpuPercent() < b.GetCpuPercent()
+}
+
+// check asks an instance for its health, returning it as a candidate if it is
+// serving
+func (d *dispatcher) check(
+	ctx context.Context,
+	proc Processor,
+	instance ProcessorInstance,
+) (*candidate, error) {
+	conn, release, err := d.connections.acquire(proc, instance)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.Unavailable,
+			"could not connect to processor %v at %v: %v",
+			proc.Name,
+			instance.ConnectionString,
+			err,
+		)
+	}
+	client := pb.NewOrcaProcessorClient(conn)
+
+	healthCheckResponse, err := client.HealthCheck(ctx, &pb.HealthCheckRequest{
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		release()
+		slog.Warn(
+			"issue contacting processor instance",
+			"processor",
+			proc.Name,
+			"connection_string",
+			instance.ConnectionString,
+			"error",
+			err,
+		)
+		return nil, err
+	}
+	if healthCheckResponse.GetStatus() != pb.HealthCheckResponse_STATUS_SERVING {
+		release()
+		slog.Warn(
+			"processor instance not serving",
+			"processor",
+			proc.Name,
+			"connection_string",
+			instance.ConnectionString,
+			"status",
+			healthCheckResponse.GetStatus(),
+			"message",
+			healthCheckResponse.GetMessage(),
+		)
+		return nil, status.Errorf(
+			codes.Unavailable,
+			"processor %v at %v not serving: %v",
+			proc.Name,
+			instance.ConnectionString,
+			healthCheckResponse.GetStatus(),
+		)
+	}
+
+	return &candidate{
+		instance: instance,
+		client:   client,
+		release:  release,
+		metrics:  healthCheckResponse.GetMetrics(),
+	}, nil
+}
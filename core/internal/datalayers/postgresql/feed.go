@@ -0,0 +1,145 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// resultPageSize is the most results read for a subscriber at once
+const resultPageSize = 500
+
+// resultPollInterval is how often results are numbered for subscribers
+// without being written by this instance, e.g. those written by another
+// instance of orca-core
+const resultPollInterval = 5 * time.Second
+
+// resultFeed numbers written results and notifies result subscribers of
+// them. Results are numbered by a single loop per instance of orca-core, and
+// only while it has subscribers, so that writing a result never waits on the
+// numbering of others. A notification only signals that there are new
+// results, which subscribers read from their own cursor, so a slow subscriber
+// never holds up the execution writing results
+type resultFeed struct {
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+
+	// signalled when results are written, coalescing writes made while
+	// earlier ones are being numbered
+	written   chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newResultFeed() *resultFeed {
+	return &resultFeed{
+		subscribers: make(map[chan struct{}]struct{}),
+		written:     make(chan struct{}, 1),
+		done:        make(chan struct{}),
+	}
+}
+
+// run numbers results as they are written, and at least every poll interval
+// for those written by other instances, until the feed is closed. Results
+// written while there are no subscribers are numbered once there are
+func (f *resultFeed) run(sequence func(ctx context.Context) error) {
+	ticker := time.NewTicker(resultPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-f.written:
+		case <-ticker.C:
+		}
+
+		f.mu.Lock()
+		subscribed := len(f.subscribers) > 0
+		f.mu.Unlock()
+		if !subscribed {
+			continue
+		}
+		if err := sequence(context.Background()); err != nil {
+			// left for the next write or poll to number
+			slog.Error("could not number results", "error", err)
+		}
+		f.notify()
+	}
+}
+
+func (f *resultFeed) close() {
+	f.closeOnce.Do(func() {
+		close(f.done)
+	})
+}
+
+// write signals that results have been written, without waiting for them to
+// be numbered
+func (f *resultFeed) write() {
+	select {
+	case f.written <- struct{}{}:
+	default:
+	}
+}
+
+// subscribe returns a channel that is signalled when results are numbered.
+// The returned function unsubscribes
+func (f *resultFeed) subscribe() (<-chan struct{}, func()) {
+	notify := make(chan struct{}, 1)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscribers[notify] = struct{}{}
+
+	// results written while there were no subscribers are numbered now
+	f.write()
+
+	return notify, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		delete(f.subscribers, notify)
+	}
+}
+
+// notify signals every subscriber that results have been numbered, without
+// waiting on those yet to handle an earlier signal
+func (f *resultFeed) notify() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for notify := range f.subscribers {
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// sequenceResults numbers the committed results awaiting a cursor. Results
+// are numbered after they are committed, by one instance of orca-core at a
+// time, so that cursors only ever increase in the order results become
+// visible and subscribers never pass a result that is committed late
+func (d *Datalayer) sequenceResults(ctx context.Context) error {
+	tx, err := d.WithTx(ctx)
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	if err != nil {
+		return fmt.Errorf("could not start a transaction: %v", err)
+	}
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	if err := qtx.LockResultSequence(ctx); err != nil {
+		return fmt.Errorf("could not lock the result sequence: %v", err)
+	}
+	if _, err := qtx.SequenceResults(ctx); err != nil {
+		return fmt.Errorf("could not number results: %v", err)
+	}
+	return tx.Commit(ctx)
+}
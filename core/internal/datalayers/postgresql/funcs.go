@@ -8,17 +8,33 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/orc-analytics/orca/core/internal/dag"
+	"github.com/orc-analytics/orca/core/internal/envs"
 	types "github.com/orc-analytics/orca/core/internal/types"
 	pb "github.com/orc-analytics/orca/core/protobufs/go"
+
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type Datalayer struct {
-	queries *Queries
-	conn    *pgxpool.Pool
-	closeFn func()
+	queries       *Queries
+	conn          *pgxpool.Pool
+	connections   *connectionManager
+	dispatcher    *dispatcher
+	heartbeats    *heartbeatMonitor
+	subscriptions *subscriptionManager
+	results       *resultFeed
+	alerts        *alertEvaluator
+	scheduler     *windowScheduler
+	inFlight      *inFlightExecutions
+	leases        *executionLeases
+	pool          *executionPool
+	closeFn       func()
 }
 
 type PgTx struct {
@@ -39,17 +55,70 @@ func NewClient(ctx context.Context, connStr string) (*Datalayer, error) {
 		return nil, errors.New("connection string empty")
 	}
 
+	alerts, err := newAlertEvaluator()
+	if err != nil {
+		return nil, err
+	}
+
 	connPool, err := pgxpool.New(ctx, connStr)
 	if err != nil {
 		slog.Error("Issue connecting to postgres", "error", err)
 		return nil, err
 	}
 
-	return &Datalayer{
-		queries: New(connPool),
-		conn:    connPool,
-		closeFn: connPool.Close,
-	}, nil
+	config := envs.GetConfig()
+	queries := New(connPool)
+	connections := newConnectionManager(config.ProcessorConnIdleTimeout)
+	heartbeats := newHeartbeatMonitor(
+		queries,
+		connections,
+		config.HeartbeatInterval,
+		config.ProcessorUnavailableAfter,
+	)
+	scheduler := newWindowScheduler(config.ScheduleInterval)
+	leases := newExecutionLeases(config.ExecutionLease)
+	results := newResultFeed()
+
+	d := &Datalayer{
+		queries:       queries,
+		conn:          connPool,
+		connections:   connections,
+		dispatcher:    newDispatcher(config.DispatchPolicy, connections),
+		heartbeats:    heartbeats,
+		subscriptions: newSubscriptionManager(),
+		results:       results,
+		alerts:        alerts,
+		scheduler:     scheduler,
+		inFlight:      newInFlightExecutions(),
+		leases:        leases,
+	}
+	pool := newExecutionPool(
+		config.ExecutionWorkers,
+		config.ExecutionQueueSize,
+		func(executionPlanId int64) error {
+			return processTasks(d, executionPlanId)
+		},
+	)
+	d.pool = pool
+	d.closeFn = func() {
+		scheduler.close()
+		leases.close()
+		results.close()
+		pool.close()
+		heartbeats.close()
+		connections.close()
+		connPool.Close()
+	}
+	go scheduler.run(d.emitScheduledWindows)
+	go leases.run(d.renewExecutionLeases)
+	go results.run(d.sequenceResults)
+	return d, nil
+}
+
+// Close stops the background work of the datalayer and closes its
+// connections
+func (d *Datalayer) Close() {
+	d.closeFn()
 }
 
 func (d *Datalayer) WithTx(ctx context.Context) (types.Tx, error) {
@@ -61,25 +130,64 @@ func (d *Datalayer) WithTx(ctx context.Context) (types.Tx, error) {
 	return &PgTx{tx: tx}, nil
 }
 
+// createProcessor creates or updates a processor and the instance
+// registering it, returning the ids of the instances it replaced
 func (d *Datalayer) createProcessor(
 	ctx context.Context,
 	tx types.Tx,
 	proc *pb.ProcessorRegistration,
-) error {
+) ([]int64, error) {
 	pgTx := tx.(*PgTx)
 
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	err := qtx.CreateProcessor(ctx, CreateProcessorParams{
+	retryPolicy, err := marshalRetryPolicy(proc.GetRetryPolicy())
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal processor retry policy: %v", err)
+	}
+
+	err = qtx.CreateProcessor(ctx, CreateProcessorParams{
 		Name:             proc.GetName(),
 		Runtime:          proc.GetRuntime(),
 		ConnectionString: proc.GetConnectionStr(),
+		RetryPolicy:      retryPolicy,
 	})
 	if err != nil {
 		slog.Error("could not create processor", "error", err)
-		return err
+		return nil, err
 	}
-	return nil
+
+	// processors without a connection string subscribe for their tasks, so
+	// have no instances to dial out to
+	if proc.GetConnectionStr() == "" {
+		return nil, nil
+	}
+
+	// an instance registering again from a new connection string replaces
+	// its previous registration, as does another instance registering from
+	// the same connection string. Registering with a new instance id adds an
+	// instance of the processor
+	replaced, err := qtx.DeleteReplacedProcessorInstances(ctx, DeleteReplacedProcessorInstancesParams{
+		Name:             proc.GetName(),
+		Runtime:          proc.GetRuntime(),
+		InstanceID:       proc.GetInstanceId(),
+		ConnectionString: proc.GetConnectionStr(),
+	})
+	if err != nil {
+		slog.Error("could not remove replaced processor instances", "error", err)
+		return nil, err
+	}
+	err = qtx.CreateProcessorInstance(ctx, CreateProcessorInstanceParams{
+		InstanceID:       proc.GetInstanceId(),
+		ConnectionString: proc.GetConnectionStr(),
+		Name:             proc.GetName(),
+		Runtime:          proc.GetRuntime(),
+	})
+	if err != nil {
+		slog.Error("could not create processor instance", "error", err)
+		return nil, err
+	}
+	return replaced, nil
 }
 
 func (d *Datalayer) createMetadataField(
@@ -186,6 +294,11 @@ func (d *Datalayer) addAlgorithm(
 		return fmt.Errorf("result type %v not supported", algo.GetResultType())
 	}
 
+	retryPolicy, err := marshalRetryPolicy(algo.GetRetryPolicy())
+	if err != nil {
+		return fmt.Errorf("could not marshal algorithm retry policy: %v", err)
+	}
+
 	params := CreateAlgorithmParams{
 		Name:              algo.GetName(),
 		Version:           algo.GetVersion(),
@@ -194,9 +307,13 @@ func (d *Datalayer) addAlgorithm(
 		WindowTypeName:    algo.GetWindowType().GetName(),
 		WindowTypeVersion: algo.GetWindowType().GetVersion(),
 		ResultType:        resultType,
+		RetryPolicy:       retryPolicy,
+	}
+	if algo.GetTimeoutMs() > 0 {
+		params.TimeoutMs = pgtype.Int8{Valid: true, Int64: algo.GetTimeoutMs()}
 	}
 
-	err := qtx.CreateAlgorithm(ctx, params)
+	err = qtx.CreateAlgorithm(ctx, params)
 	if err != nil {
 		slog.Error("error creating algorithm", "error", err)
 		return err
@@ -281,3 +398,683 @@ func (d *Datalayer) addOverwriteAlgorithmDependency(
 	}
 	return nil
 }
+
+// retire algorithms, removing the dependencies to and from them. Algorithms
+// that depend on them, directly or transitively, would be left unable to run,
+// so are retired as well when forced and are an error otherwise
+func (d *Datalayer) retireAlgorithms(
+	ctx context.Context,
+	qtx *Queries,
+	algorithmIds []int64,
+	force bool,
+) error {
+	dependents, err := qtx.ReadLiveAlgorithmDependents(ctx, algorithmIds)
+	if err != nil {
+		return fmt.Errorf("could not read dependents of algorithms: %v", err)
+	}
+	if len(dependents) > 0 && !force {
+		dependentNames := make([]string, len(dependents))
+		for ii, dependent := range dependents {
+			dependentNames[ii] = fmt.Sprintf("%v_%v", dependent.Name, dependent.Version)
+		}
+		return fmt.Errorf(
+			"%w: %v",
+			types.AlgorithmHasDependents,
+			strings.Join(dependentNames, ", "),
+		)
+	}
+	for _, dependent := range dependents {
+		slog.Info(
+			"retiring dependent algorithm",
+			"name",
+			dependent.Name,
+			"version",
+			dependent.Version,
+		)
+		algorithmIds = append(algorithmIds, dependent.ID)
+	}
+
+	err = qtx.RetireAlgorithms(ctx, algorithmIds)
+	if err != nil {
+		return fmt.Errorf("could not retire algorithms: %v", err)
+	}
+	err = qtx.DeleteAlgorithmDependencies(ctx, algorithmIds)
+	if err != nil {
+		return fmt.Errorf("could not remove dependencies of retired algorithms: %v", err)
+	}
+	return nil
+}
+
+// persist an execution plan, so that it can be picked back up if orca-core
+// restarts before the plan has been processed
+func (d *Datalayer) createExecutionPlan(
+	ctx context.Context,
+	tx types.Tx,
+	executionPlan dag.Plan,
+	windowId int64,
+) (int64, string, error) {
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	execId := newExecId()
+	executionPlanId, err := qtx.CreateExecutionPlan(ctx, CreateExecutionPlanParams{
+		WindowsID: windowId,
+		ExecID:    execId,
+		Owner:     d.leases.owner,
+		LeaseMs:   d.leases.duration.Milliseconds(),
+	})
+	if err != nil {
+		slog.Error("could not create execution plan", "error", err)
+		return 0, "", err
+	}
+
+	for stageIdx, stage := range executionPlan.Stages {
+		stageId, err := qtx.CreateExecutionStage(ctx, CreateExecutionStageParams{
+			ExecutionPlanID: executionPlanId,
+			StageIndex:      int32(stageIdx),
+		})
+		if err != nil {
+			return 0, "", fmt.Errorf("could not create execution stage: %v", err)
+		}
+
+		for taskIdx, task := range stage.Tasks {
+			taskId, err := qtx.CreateExecutionTask(ctx, CreateExecutionTaskParams{
+				ExecutionStageID: stageId,
+				TaskIndex:        int32(taskIdx),
+				ProcessorID:      task.ProcId,
+				ExecID:           newExecId(),
+			})
+			if err != nil {
+				return 0, "", fmt.Errorf("could not create execution task: %v", err)
+			}
+
+			for nodeIdx, node := range task.Nodes {
+				err = qtx.CreateExecutionNode(ctx, CreateExecutionNodeParams{
+					ExecutionTaskID: taskId,
+					NodeIndex:       int32(nodeIdx),
+					AlgorithmID:     node.AlgoId(),
+					WindowTypeID:    node.WindowId(),
+					AlgorithmDepIds: node.AlgoDepIds(),
+				})
+				if err != nil {
+					return 0, "", fmt.Errorf("could not create execution node: %v", err)
+				}
+			}
+		}
+	}
+	return executionPlanId, execId, nil
+}
+
+// persist the execution plans of many windows at once. Each level of the
+// plans is inserted in a single batch, rather than a round trip per record
+func (d *Datalayer) createExecutionPlans(
+	ctx context.Context,
+	queries *Queries,
+	executionPlans []dag.Plan,
+	windowIds []int64,
+) ([]int64, []string, error) {
+	var batchErr error
+	checkBatch := func(err error) bool {
+		if err != nil && batchErr == nil {
+			batchErr = err
+		}
+		return err == nil
+	}
+
+	execIds := make([]string, len(executionPlans))
+	planParams := make([]CreateExecutionPlansParams, len(executionPlans))
+	for ii := range executionPlans {
+		execIds[ii] = newExecId()
+		planParams[ii] = CreateExecutionPlansParams{
+			WindowsID: windowIds[ii],
+			ExecID:    execIds[ii],
+			Owner:     d.leases.owner,
+			LeaseMs:   d.leases.duration.Milliseconds(),
+		}
+	}
+	executionPlanIds := make([]int64, len(executionPlans))
+	queries.CreateExecutionPlans(ctx, planParams).QueryRow(func(ii int, id int64, err error) {
+		if checkBatch(err) {
+			executionPlanIds[ii] = id
+		}
+	})
+	if batchErr != nil {
+		return nil, nil, fmt.Errorf("could not create execution plans: %v", batchErr)
+	}
+
+	var stages []dag.Stage
+	var stageParams []CreateExecutionStagesParams
+	for planIdx, executionPlan := range executionPlans {
+		for stageIdx, stage := range executionPlan.Stages {
+			stages = append(stages, stage)
+			stageParams = append(stageParams, CreateExecutionStagesParams{
+				ExecutionPlanID: executionPlanIds[planIdx],
+				StageIndex:      int32(stageIdx),
+			})
+		}
+	}
+	stageIds := make([]int64, len(stageParams))
+	queries.CreateExecutionStages(ctx, stageParams).QueryRow(func(ii int, id int64, err error) {
+		if checkBatch(err) {
+			stageIds[ii] = id
+		}
+	})
+	if batchErr != nil {
+		return nil, nil, fmt.Errorf("could not create execution stages: %v", batchErr)
+	}
+
+	var tasks []dag.ProcessorTask
+	var taskParams []CreateExecutionTasksParams
+	for stageRow, stage := range stages {
+		for taskIdx, task := range stage.Tasks {
+			tasks = append(tasks, task)
+			taskParams = append(taskParams, CreateExecutionTasksParams{
+				ExecutionStageID: stageIds[stageRow],
+				TaskIndex:        int32(taskIdx),
+				ProcessorID:      task.ProcId,
+				ExecID:           newExecId(),
+			})
+		}
+	}
+	taskIds := make([]int64, len(taskParams))
+	queries.CreateExecutionTasks(ctx, taskParams).QueryRow(func(ii int, id int64, err error) {
+		if checkBatch(err) {
+			taskIds[ii] = id
+		}
+	})
+	if batchErr != nil {
+		return nil, nil, fmt.Errorf("could not create execution tasks: %v", batchErr)
+	}
+
+	var nodeParams []CreateExecutionNodesParams
+	for taskRow, task := range tasks {
+		for nodeIdx, node := range task.Nodes {
+			nodeParams = append(nodeParams, CreateExecutionNodesParams{
+				ExecutionTaskID: taskIds[taskRow],
+				NodeIndex:       int32(nodeIdx),
+				AlgorithmID:     node.AlgoId(),
+				WindowTypeID:    node.WindowId(),
+				AlgorithmDepIds: node.AlgoDepIds(),
+			})
+		}
+	}
+	queries.CreateExecutionNodes(ctx, nodeParams).Exec(func(_ int, err error) {
+		checkBatch(err)
+	})
+	if batchErr != nil {
+		return nil, nil, fmt.Errorf("could not create execution nodes: %v", batchErr)
+	}
+	return executionPlanIds, execIds, nil
+}
+
+// build the execution plan for the algorithms triggered by a type of window,
+// pruned to the target algorithms and their dependencies if any are given
+func (d *Datalayer) buildExecutionPlan(
+	ctx context.Context,
+	queries *Queries,
+	windowTypeId int64,
+	targetAlgoIds []int64,
+) (dag.Plan, error) {
+	execPaths, err := queries.ReadAlgorithmExecutionPaths(ctx, strconv.Itoa(int(windowTypeId)))
+	if err != nil {
+		return dag.Plan{}, fmt.Errorf("could not read execution paths for window type: %v", err)
+	}
+
+	// create the algo path args
+	var algoIDPaths []string
+	var windowTypeIDPaths []string
+	var procIDPaths []string
+	for _, path := range execPaths {
+		algoIDPaths = append(algoIDPaths, path.AlgoIDPath)
+		windowTypeIDPaths = append(windowTypeIDPaths, path.WindowTypeIDPath)
+		procIDPaths = append(procIDPaths, path.ProcIDPath)
+	}
+
+	return dag.BuildPlan(
+		algoIDPaths,
+		windowTypeIDPaths,
+		procIDPaths,
+		windowTypeId,
+		targetAlgoIds,
+	)
+}
+
+// resolve algorithms to the IDs of those triggered by a type of window. An
+// algorithm that is not triggered by the window type is an error
+func (d *Datalayer) readAlgorithmIdsForWindowType(
+	ctx context.Context,
+	queries *Queries,
+	windowTypeName string,
+	windowTypeVersion string,
+	selected []*pb.Algorithm,
+) ([]int64, error) {
+	algorithmIds := []int64{}
+	if len(selected) == 0 {
+		return algorithmIds, nil
+	}
+
+	algorithms, err := queries.ReadAlgorithmsForWindow(ctx, ReadAlgorithmsForWindowParams{
+		WindowTypeName:    windowTypeName,
+		WindowTypeVersion: windowTypeVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read algorithms for window type: %v", err)
+	}
+	for _, algorithm := range selected {
+		found := false
+		for _, algo := range algorithms {
+			if algo.Retired.Valid {
+				continue
+			}
+			if algo.Name == algorithm.GetName() && algo.Version == algorithm.GetVersion() {
+				algorithmIds = append(algorithmIds, algo.ID)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf(
+				"%w: %v_%v is not triggered by window type %v_%v",
+				types.AlgorithmNotFound,
+				algorithm.GetName(),
+				algorithm.GetVersion(),
+				windowTypeName,
+				windowTypeVersion,
+			)
+		}
+	}
+	return algorithmIds, nil
+}
+
+// rehydrate a persisted execution plan. The task records are returned
+// aligned with the stages and tasks of the plan
+func (d *Datalayer) readExecutionPlan(
+	ctx context.Context,
+	executionPlanId int64,
+) (dag.Plan, [][]ReadExecutionTasksRow, error) {
+	taskRows, err := d.queries.ReadExecutionTasks(ctx, executionPlanId)
+	if err != nil {
+		return dag.Plan{}, nil, fmt.Errorf("could not read execution tasks: %v", err)
+	}
+
+	nodeRows, err := d.queries.ReadExecutionNodes(ctx, executionPlanId)
+	if err != nil {
+		return dag.Plan{}, nil, fmt.Errorf("could not read execution nodes: %v", err)
+	}
+
+	nodesByTask := make(map[int64][]ReadExecutionNodesRow)
+	for _, nodeRow := range nodeRows {
+		nodesByTask[nodeRow.ExecutionTaskID] = append(nodesByTask[nodeRow.ExecutionTaskID], nodeRow)
+	}
+
+	var executionPlan dag.Plan
+	var executionTasks [][]ReadExecutionTasksRow
+	for _, taskRow := range taskRows {
+		stageIdx := int(taskRow.StageIndex)
+		for len(executionPlan.Stages) <= stageIdx {
+			executionPlan.Stages = append(executionPlan.Stages, dag.Stage{})
+			executionTasks = append(executionTasks, nil)
+		}
+
+		nodes := make([]dag.Node, len(nodesByTask[taskRow.ID]))
+		for ii, nodeRow := range nodesByTask[taskRow.ID] {
+			nodes[ii] = dag.NewNode(
+				nodeRow.AlgorithmID,
+				taskRow.ProcessorID,
+				nodeRow.WindowTypeID,
+				nodeRow.AlgorithmDepIds,
+			)
+		}
+
+		executionPlan.Stages[stageIdx].Tasks = append(
+			executionPlan.Stages[stageIdx].Tasks,
+			dag.ProcessorTask{ProcId: taskRow.ProcessorID, Nodes: nodes},
+		)
+		executionTasks[stageIdx] = append(executionTasks[stageIdx], taskRow)
+
+		if !slices.Contains(executionPlan.AffectedProcessors, taskRow.ProcessorID) {
+			executionPlan.AffectedProcessors = append(executionPlan.AffectedProcessors, taskRow.ProcessorID)
+		}
+	}
+	slices.Sort(executionPlan.AffectedProcessors)
+
+	return executionPlan, executionTasks, nil
+}
+
+// record the status of an execution plan. Failures are logged rather than
+// returned so that they do not interrupt processing
+func (d *Datalayer) setExecutionPlanStatus(
+	ctx context.Context,
+	executionPlanId int64,
+	status ExecutionStatus,
+) {
+	reprocessId, err := d.queries.UpdateExecutionPlanStatus(ctx, UpdateExecutionPlanStatusParams{
+		Status: status,
+		ID:     executionPlanId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// the plan has already finished, e.g. as it was cancelled through
+		// another instance of orca-core, which is not overwritten
+		slog.Debug(
+			"execution plan already finished",
+			"execution_plan_id",
+			executionPlanId,
+			"status",
+			status,
+		)
+		return
+	}
+	if err != nil {
+		slog.Error(
+			"could not update execution plan status",
+			"execution_plan_id",
+			executionPlanId,
+			"status",
+			status,
+			"error",
+			err,
+		)
+		return
+	}
+
+	// a reprocess finishes along with the last execution it triggered
+	if reprocessId.Valid && status != ExecutionStatusPending && status != ExecutionStatusRunning {
+		d.finishReprocess(ctx, reprocessId.Int64)
+	}
+}
+
+// record the status of an execution stage
+func (d *Datalayer) setExecutionStageStatus(
+	ctx context.Context,
+	executionStageId int64,
+	status ExecutionStatus,
+) {
+	err := d.queries.UpdateExecutionStageStatus(ctx, UpdateExecutionStageStatusParams{
+		Status: status,
+		ID:     executionStageId,
+	})
+	if err != nil {
+		slog.Error(
+			"could not update execution stage status",
+			"execution_stage_id",
+			executionStageId,
+			"status",
+			status,
+			"error",
+			err,
+		)
+	}
+}
+
+// record the status of an execution task, along with the error that caused
+// it to fail (if any)
+func (d *Datalayer) setExecutionTaskStatus(
+	ctx context.Context,
+	executionTaskId int64,
+	status ExecutionStatus,
+	taskErr error,
+) {
+	var errorMessage pgtype.Text
+	if taskErr != nil {
+		errorMessage = pgtype.Text{String: taskErr.Error(), Valid: true}
+	}
+	err := d.queries.UpdateExecutionTaskStatus(ctx, UpdateExecutionTaskStatusParams{
+		Status:       status,
+		ErrorMessage: errorMessage,
+		ID:           executionTaskId,
+	})
+	if err != nil {
+		slog.Error(
+			"could not update execution task status",
+			"execution_task_id",
+			executionTaskId,
+			"status",
+			status,
+			"error",
+			err,
+		)
+	}
+}
+
+// record an attempt made at executing a task, building up its retry history.
+// backoff is the time waited before the next attempt, if one is made
+func (d *Datalayer) recordExecutionAttempt(
+	ctx context.Context,
+	executionTaskId int64,
+	attempt int,
+	started time.Time,
+	attemptErr error,
+	backoff time.Duration,
+) {
+	params := CreateExecutionAttemptParams{
+		ExecutionTaskID: executionTaskId,
+		Attempt:         int32(attempt),
+		Status:          ExecutionStatusSucceeded,
+		Started:         pgtype.Timestamp{Time: started, Valid: true},
+	}
+	if attemptErr != nil {
+		params.Status = ExecutionStatusFailed
+		params.ErrorCode = pgtype.Text{String: errorCode(attemptErr), Valid: true}
+		params.ErrorMessage = pgtype.Text{String: attemptErr.Error(), Valid: true}
+	}
+	if backoff > 0 {
+		params.BackoffMs = pgtype.Int8{Int64: backoff.Milliseconds(), Valid: true}
+	}
+	err := d.queries.CreateExecutionAttempt(ctx, params)
+	if err != nil {
+		slog.Error(
+			"could not record execution attempt",
+			"execution_task_id",
+			executionTaskId,
+			"attempt",
+			attempt,
+			"error",
+			err,
+		)
+	}
+}
+
+// record a task that failed after exhausting its retries, along with the
+// request of its last attempt, so that it can be inspected and requeued
+func (d *Datalayer) recordDeadLetter(
+	ctx context.Context,
+	taskRow ReadExecutionTasksRow,
+	procId int64,
+	algorithmIds []int64,
+	request *pb.ExecutionRequest,
+	attempts int,
+	taskErr error,
+) {
+	requestBytes, err := protojson.Marshal(request)
+	if err != nil {
+		slog.Error("could not marshal dead letter request", "exec_id", taskRow.ExecID, "error", err)
+		return
+	}
+	err = d.queries.CreateDeadLetter(ctx, CreateDeadLetterParams{
+		ExecutionTaskID: taskRow.ID,
+		ProcessorID:     procId,
+		AlgorithmIds:    algorithmIds,
+		Request:         requestBytes,
+		ErrorCode:       errorCode(taskErr),
+		ErrorMessage:    taskErr.Error(),
+		Attempts:        int32(attempts),
+	})
+	if err != nil {
+		slog.Error(
+			"could not record dead letter",
+			"execution_task_id",
+			taskRow.ID,
+			"error",
+			err,
+		)
+	}
+}
+
+// record the status of an algorithm within an execution task, along with the
+// error that caused it to fail or be skipped (if any)
+func (d *Datalayer) setExecutionNodeStatus(
+	ctx context.Context,
+	executionTaskId int64,
+	algorithmId int64,
+	status ExecutionStatus,
+	nodeErr error,
+) {
+	var errorMessage pgtype.Text
+	if nodeErr != nil {
+		errorMessage = pgtype.Text{String: nodeErr.Error(), Valid: true}
+	}
+	err := d.queries.UpdateExecutionNodeStatus(ctx, UpdateExecutionNodeStatusParams{
+		Status:          status,
+		ErrorMessage:    errorMessage,
+		ExecutionTaskID: executionTaskId,
+		AlgorithmID:     algorithmId,
+	})
+	if err != nil {
+		slog.Error(
+			"could not update execution node status",
+			"execution_task_id",
+			executionTaskId,
+			"algorithm_id",
+			algorithmId,
+			"status",
+			status,
+			"error",
+			err,
+		)
+	}
+}
+
+// record the status of the algorithms within an execution task that have
+// not yet finished, along with the error that caused them to fail (if any)
+func (d *Datalayer) setUnfinishedExecutionNodesStatus(
+	ctx context.Context,
+	executionTaskId int64,
+	status ExecutionStatus,
+	taskErr error,
+) {
+	var errorMessage pgtype.Text
+	if taskErr != nil {
+		errorMessage = pgtype.Text{String: taskErr.Error(), Valid: true}
+	}
+	err := d.queries.UpdateUnfinishedExecutionNodesStatus(ctx, UpdateUnfinishedExecutionNodesStatusParams{
+		Status:          status,
+		ErrorMessage:    errorMessage,
+		ExecutionTaskID: executionTaskId,
+	})
+	if err != nil {
+		slog.Error(
+			"could not update execution node statuses",
+			"execution_task_id",
+			executionTaskId,
+			"status",
+			status,
+			"error",
+			err,
+		)
+	}
+}
+
+// mark the work of an execution plan that never started as skipped, e.g.
+// after an upstream stage has failed
+func (d *Datalayer) skipPendingExecutionWork(
+	ctx context.Context,
+	executionPlanId int64,
+) {
+	err := d.queries.SkipPendingExecutionWork(ctx, executionPlanId)
+	if err != nil {
+		slog.Error(
+			"could not skip pending execution work",
+			"execution_plan_id",
+			executionPlanId,
+			"error",
+			err,
+		)
+	}
+}
+
+// mark the unfinished work of an execution plan as cancelled
+func (d *Datalayer) cancelUnfinishedExecutionWork(
+	ctx context.Context,
+	executionPlanId int64,
+	cause error,
+) {
+	err := d.queries.CancelUnfinishedExecutionWork(ctx, CancelUnfinishedExecutionWorkParams{
+		ExecutionPlanID: executionPlanId,
+		ErrorMessage:    pgtype.Text{Valid: true, String: cause.Error()},
+	})
+	if err != nil {
+		slog.Error(
+			"could not cancel unfinished execution work",
+			"execution_plan_id",
+			executionPlanId,
+			"error",
+			err,
+		)
+	}
+}
+
+// cancelExecutions cancels the unfinished executions of a window that have
+// been replaced. Executions that finished in the meantime are left as they are
+func (d *Datalayer) cancelExecutions(ctx context.Context, execIds []string) {
+	for _, execId := range execIds {
+		err := d.CancelExecution(ctx, &pb.ExecutionCancel{ExecId: execId})
+		if err != nil && !errors.Is(err, types.ExecutionFinished) {
+			slog.Warn("could not cancel replaced execution", "exec_id", execId, "error", err)
+		}
+	}
+}
+
+// trigger the algorithms of a window that has already been stored, such as
+// an open window that is being closed. The transaction is committed before
+// the plan is submitted for processing
+func (d *Datalayer) triggerStoredWindow(
+	ctx context.Context,
+	tx types.Tx,
+	windowTypeId int64,
+	windowId int64,
+	provisional bool,
+) (*pb.WindowEmitStatus, error) {
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	executionPlan, err := d.buildExecutionPlan(ctx, qtx, windowTypeId, nil)
+	if err != nil {
+		return &pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, err
+	}
+	if len(executionPlan.Stages) == 0 {
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		return &pb.WindowEmitStatus{Status: pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS}, nil
+	}
+
+	if !d.pool.reserve() {
+		return &pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, types.ExecutionQueueFull
+	}
+	executionPlanId, execId, err := d.createExecutionPlan(ctx, tx, executionPlan, windowId)
+	if err != nil {
+		d.pool.release()
+		return &pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, err
+	}
+	if provisional {
+		if err := qtx.SetExecutionPlanProvisional(ctx, executionPlanId); err != nil {
+			d.pool.release()
+			return &pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, fmt.Errorf(
+				"could not mark execution plan as provisional: %v",
+				err,
+			)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		d.pool.release()
+		return &pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, err
+	}
+
+	emitStatus := pb.WindowEmitStatus_PROCESSING_TRIGGERED
+	if queued := d.pool.submit(executionPlanId); queued {
+		emitStatus = pb.WindowEmitStatus_QUEUED
+	}
+	return &pb.WindowEmitStatus{
+		Status: emitStatus,
+		ExecId: execId,
+	}, nil
+}
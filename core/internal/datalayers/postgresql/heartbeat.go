@@ -0,0 +1,164 @@
+package postgresql
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+)
+
+// heartbeatMonitor periodically health checks every registered processor
+// instance, recording when it was last seen along with the health and metrics
+// it reported. Instances unseen for longer than unavailableAfter are marked
+// unavailable, and are not dispatched to until they are seen again
+type heartbeatMonitor struct {
+	queries          *Queries
+	connections      *connectionManager
+	interval         time.Duration
+	unavailableAfter time.Duration
+	done             chan struct{}
+	closeOnce        sync.Once
+}
+
+func newHeartbeatMonitor(
+	queries *Queries,
+	connections *connectionManager,
+	interval time.Duration,
+	unavailableAfter time.Duration,
+) *heartbeatMonitor {
+	m := &heartbeatMonitor{
+		queries:          queries,
+		connections:      connections,
+		interval:         interval,
+		unavailableAfter: unavailableAfter,
+		done:             make(chan struct{}),
+	}
+	go m.run()
+	return m
+}
+
+// run checks every instance each interval until the monitor is closed
+func (m *heartbeatMonitor) run() {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-ticker.C:
+			m.checkAll()
+		}
+	}
+}
+
+// checkAll health checks every registered instance at once
+func (m *heartbeatMonitor) checkAll() {
+	ctx := context.Background()
+	instances, err := m.queries.ReadAllProcessorInstances(ctx)
+	if err != nil {
+		slog.Error("could not read processor instances for heartbeat", "error", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, instance := range instances {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.check(ctx, instance)
+		}()
+	}
+	wg.Wait()
+}
+
+// check health checks a single instance and records the outcome
+func (m *heartbeatMonitor) check(ctx context.Context, row ReadAllProcessorInstancesRow) {
+	proc := Processor{ID: row.ProcessorID, Name: row.Name, Runtime: row.Runtime}
+	instance := ProcessorInstance{ID: row.ID, ConnectionString: row.ConnectionString}
+
+	params := UpdateProcessorInstanceHealthParams{
+		ID:                      row.ID,
+		HealthStatus:            pb.HealthCheckResponse_STATUS_UNKNOWN.String(),
+		UnavailableAfterSeconds: m.unavailableAfter.Seconds(),
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, min(m.interval, 10*time.Second))
+	defer cancel()
+
+	conn, release, err := m.connections.probe(proc, instance)
+	if err == nil {
+		var response *pb.HealthCheckResponse
+		response, err = pb.NewOrcaProcessorClient(conn).HealthCheck(checkCtx, &pb.HealthCheckRequest{
+			Timestamp: time.Now().Unix(),
+		})
+		release()
+		if err == nil {
+			params.Seen = true
+			params.HealthStatus = response.GetStatus().String()
+			params.HealthMessage = pgtype.Text{
+				String: response.GetMessage(),
+				Valid:  response.GetMessage() != "",
+			}
+			if metrics := response.GetMetrics(); metrics != nil {
+				params.ActiveTasks = pgtype.Int4{Int32: metrics.GetActiveTasks(), Valid: true}
+				params.MemoryBytes = pgtype.Int8{Int64: metrics.GetMemoryBytes(), Valid: true}
+				params.CpuPercent = pgtype.Float4{Float32: metrics.GetCpuPercent(), Valid: true}
+				params.UptimeSeconds = pgtype.Int8{Int64: metrics.GetUptimeSeconds(), Valid: true}
+			}
+		}
+	}
+	if err != nil {
+		slog.Debug(
+			"processor instance missed heartbeat",
+			"processor",
+			row.Name,
+			"connection_string",
+			row.ConnectionString,
+			"error",
+			err,
+		)
+		params.HealthMessage = pgtype.Text{String: err.Error(), Valid: true}
+	}
+
+	if err := m.queries.UpdateProcessorInstanceHealth(ctx, params); err != nil {
+		slog.Error(
+			"could not record processor instance heartbeat",
+			"processor",
+			row.Name,
+			"connection_string",
+			row.ConnectionString,
+			"error",
+			err,
+		)
+	}
+}
+
+// close stops the monitor
+func (m *heartbeatMonitor) close() {
+	m.closeOnce.Do(func() {
+		close(m.done)
+	})
+}
+
+// instanceHealthToPb converts the health recorded for an instance into its
+// protobuf form
+func instanceHealthToPb(instance ProcessorInstance) (
+	pb.HealthCheckResponse_Status,
+	*pb.ProcessorMetrics,
+) {
+	status := pb.HealthCheckResponse_Status(
+		pb.HealthCheckResponse_Status_value[instance.HealthStatus],
+	)
+	if !instance.ActiveTasks.Valid {
+		return status, nil
+	}
+	return status, &pb.ProcessorMetrics{
+		ActiveTasks:   instance.ActiveTasks.Int32,
+		MemoryBytes:   instance.MemoryBytes.Int64,
+		CpuPercent:    instance.CpuPercent.Float32,
+		UptimeSeconds: instance.UptimeSeconds.Int64,
+	}
+}
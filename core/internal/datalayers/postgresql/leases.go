@@ -0,0 +1,125 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// executionLeases keeps the unfinished execution plans of this instance of
+// orca-core leased to it, renewing their leases well before they expire.
+// Plans whose lease has expired, e.g. because the instance running them died,
+// are taken over, so that each plan is executed by only one instance at a time
+type executionLeases struct {
+	owner     string
+	duration  time.Duration
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newExecutionLeases(duration time.Duration) *executionLeases {
+	return &executionLeases{
+		owner:    uuid.NewString(),
+		duration: duration,
+		done:     make(chan struct{}),
+	}
+}
+
+// run renews leases a third of the way through each lease until closed
+func (l *executionLeases) run(renew func(ctx context.Context)) {
+	ticker := time.NewTicker(l.duration / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-ticker.C:
+			renew(context.Background())
+		}
+	}
+}
+
+func (l *executionLeases) close() {
+	l.closeOnce.Do(func() {
+		close(l.done)
+	})
+}
+
+// renewExecutionLeases renews the leases of the unfinished execution plans and
+// reprocesses of this instance, and takes over those whose lease has expired
+func (d *Datalayer) renewExecutionLeases(ctx context.Context) {
+	err := d.queries.RenewExecutionLeases(ctx, RenewExecutionLeasesParams{
+		LeaseMs: d.leases.duration.Milliseconds(),
+		Owner:   d.leases.owner,
+	})
+	if err != nil {
+		slog.Error("could not renew execution leases", "error", err)
+	}
+	err = d.queries.RenewReprocessLeases(ctx, RenewReprocessLeasesParams{
+		LeaseMs: d.leases.duration.Milliseconds(),
+		Owner:   d.leases.owner,
+	})
+	if err != nil {
+		slog.Error("could not renew reprocess leases", "error", err)
+	}
+
+	if err := d.resumeExecutionPlans(ctx); err != nil {
+		slog.Error("could not resume execution plans", "error", err)
+	}
+	if err := d.resumeReprocesses(ctx); err != nil {
+		slog.Error("could not resume reprocesses", "error", err)
+	}
+}
+
+// resumeExecutionPlans claims the unfinished execution plans that are not
+// leased to a running instance of orca-core, and processes them in the
+// background
+func (d *Datalayer) resumeExecutionPlans(ctx context.Context) error {
+	executionPlanIds, err := d.queries.ClaimExecutionPlans(ctx, ClaimExecutionPlansParams{
+		Owner:   d.leases.owner,
+		LeaseMs: d.leases.duration.Milliseconds(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not claim unfinished execution plans: %v", err)
+	}
+	if len(executionPlanIds) == 0 {
+		return nil
+	}
+	slices.Sort(executionPlanIds)
+
+	slog.Info("resuming unfinished execution plans", "count", len(executionPlanIds))
+	go func() {
+		// resumed plans wait for room in the pool rather than being rejected
+		for _, executionPlanId := range executionPlanIds {
+			d.pool.enqueue(executionPlanId)
+		}
+	}()
+	return nil
+}
+
+// resumeReprocesses claims the reprocesses with windows left to trigger that
+// are not leased to a running instance of orca-core, carrying each on from
+// the last window it triggered
+func (d *Datalayer) resumeReprocesses(ctx context.Context) error {
+	reprocessIds, err := d.queries.ClaimReprocesses(ctx, ClaimReprocessesParams{
+		Owner:   d.leases.owner,
+		LeaseMs: d.leases.duration.Milliseconds(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not claim unfinished reprocesses: %v", err)
+	}
+	if len(reprocessIds) == 0 {
+		return nil
+	}
+
+	slog.Info("resuming unfinished reprocesses", "count", len(reprocessIds))
+	for _, reprocessId := range reprocessIds {
+		go d.runReprocess(reprocessId)
+	}
+	return nil
+}
@@ -3,17 +3,23 @@ package postgresql
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"slices"
-	"strconv"
 	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/orc-analytics/orca/core/internal/dag"
+	"github.com/orc-analytics/orca/core/internal/envs"
+	types "github.com/orc-analytics/orca/core/internal/types"
 	pb "github.com/orc-analytics/orca/core/protobufs/go"
 )
 
@@ -38,7 +44,7 @@ func (d *Datalayer) RegisterProcessor(
 	}
 
 	// register the processor
-	err = d.createProcessor(ctx, tx, proc)
+	replacedInstances, err := d.createProcessor(ctx, tx, proc)
 
 	if err != nil {
 		slog.Error("could not create processor", "error", err)
@@ -125,7 +131,11 @@ func (d *Datalayer) RegisterProcessor(
 		}
 	}
 
-	return tx.Commit(ctx)
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	d.connections.remove(replacedInstances)
+	return nil
 }
 
 // EmitWindow with Orca core
@@ -168,23 +178,37 @@ func (d *Datalayer) EmitWindow(
 	}
 
 	// confident that any required metadata is being supplied to the processor
-	if len(metadataFields) > 0 {
-		var metadataMap map[string]any
-		if err := json.Unmarshal(metadataBytes, &metadataMap); err != nil {
-			return pb.WindowEmitStatus{}, fmt.Errorf("could not unmarshal metadata for validation: %v", err)
-		}
+	if err := checkRequiredMetadata(metadataBytes, metadataFields); err != nil {
+		return pb.WindowEmitStatus{}, err
+	}
 
-		for _, mDataField := range metadataFields {
-			fieldName := mDataField.MetadataFieldName
-			if _, exists := metadataMap[fieldName]; !exists {
-				return pb.WindowEmitStatus{}, fmt.Errorf("required metadata field '%s' is missing", fieldName)
-			}
-		}
+	// only the target algorithms and their dependencies are triggered
+	targetAlgoIds, err := d.readAlgorithmIdsForWindowType(
+		ctx,
+		qtx,
+		window.GetWindowTypeName(),
+		window.GetWindowTypeVersion(),
+		window.GetTargetAlgorithms(),
+	)
+	if err != nil {
+		return pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, err
 	}
 
-	insertedWindow, err := qtx.RegisterWindow(ctx, RegisterWindowParams{
+	// windows re-emitted by a retrying emitter are not triggered again.
+	// Emissions of the same window are serialised so that concurrent retries
+	// cannot both be inserted
+	idempotencyKey := pgtype.Text{
+		String: window.GetIdempotencyKey(),
+		Valid:  window.GetIdempotencyKey() != "",
+	}
+	if err := qtx.LockWindowEmission(ctx, windowDedupeKey(window)); err != nil {
+		return pb.WindowEmitStatus{}, fmt.Errorf("could not lock window emission: %v", err)
+	}
+
+	duplicate, err := qtx.ReadDuplicateWindow(ctx, ReadDuplicateWindowParams{
 		WindowTypeName:    window.GetWindowTypeName(),
 		WindowTypeVersion: window.GetWindowTypeVersion(),
+		IdempotencyKey:    idempotencyKey,
 		TimeFrom: pgtype.Timestamp{
 			Time:  window.GetTimeFrom().AsTime().UTC(),
 			Valid: true,
@@ -196,48 +220,72 @@ func (d *Datalayer) EmitWindow(
 		Origin:   window.GetOrigin(),
 		Metadata: metadataBytes,
 	})
-	if err != nil {
-		slog.Error("could not insert window", "error", err)
-		if strings.Contains(err.Error(), "(SQLSTATE 23503)") {
-			return pb.WindowEmitStatus{}, fmt.Errorf(
-				"window type does not exist - insert via window type registration: %v",
-				err.Error(),
-			)
-		}
-	}
-	slog.Debug("window record inserted into the datalayer", "window", insertedWindow)
-	execPaths, err := qtx.ReadAlgorithmExecutionPaths(
-		ctx,
-		strconv.Itoa(int(insertedWindow.WindowTypeID)),
-	)
-	if err != nil {
-		slog.Error(
-			"could not read execution paths for window id",
+	// a stored window emitted again for target algorithms, e.g. to rerun an
+	// analysis on demand, is triggered again for those algorithms. Retries
+	// are still recognised by their idempotency key
+	rerun := err == nil && !idempotencyKey.Valid && len(targetAlgoIds) > 0
+	if err == nil && !rerun {
+		slog.Info(
+			"window has already been emitted",
 			"window_id",
-			insertedWindow,
-			"error",
-			err,
+			duplicate.ID,
+			"exec_id",
+			duplicate.ExecID.String,
 		)
-		return pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, err
+		if !duplicate.ExecID.Valid {
+			return pb.WindowEmitStatus{
+				Status:    pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS,
+				Duplicate: true,
+			}, nil
+		}
+		return pb.WindowEmitStatus{
+			Status:    pb.WindowEmitStatus_PROCESSING_TRIGGERED,
+			ExecId:    duplicate.ExecID.String,
+			Duplicate: true,
+		}, nil
+	} else if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return pb.WindowEmitStatus{}, fmt.Errorf("could not read duplicate windows: %v", err)
 	}
 
-	// create the algo path args
-	var algoIDPaths []string
-	var windowTypeIDPaths []string
-	var procIDPaths []string
-	for _, path := range execPaths {
-		algoIDPaths = append(algoIDPaths, path.AlgoIDPath)
-		windowTypeIDPaths = append(windowTypeIDPaths, path.WindowTypeIDPath)
-		procIDPaths = append(procIDPaths, path.ProcIDPath)
+	var insertedWindow RegisterWindowRow
+	if rerun {
+		insertedWindow.ID = duplicate.ID
+		insertedWindow.WindowTypeID, err = qtx.ReadWindowTypeId(ctx, ReadWindowTypeIdParams{
+			WindowTypeName:    window.GetWindowTypeName(),
+			WindowTypeVersion: window.GetWindowTypeVersion(),
+		})
+		if err != nil {
+			return pb.WindowEmitStatus{}, fmt.Errorf("could not read window type: %v", err)
+		}
+		slog.Info("rerunning target algorithms of stored window", "window_id", duplicate.ID)
+	} else {
+		insertedWindow, err = qtx.RegisterWindow(ctx, RegisterWindowParams{
+			WindowTypeName:    window.GetWindowTypeName(),
+			WindowTypeVersion: window.GetWindowTypeVersion(),
+			TimeFrom: pgtype.Timestamp{
+				Time:  window.GetTimeFrom().AsTime().UTC(),
+				Valid: true,
+			},
+			TimeTo: pgtype.Timestamp{
+				Time:  window.GetTimeTo().AsTime().UTC(),
+				Valid: true,
+			},
+			Origin:         window.GetOrigin(),
+			Metadata:       metadataBytes,
+			IdempotencyKey: idempotencyKey,
+		})
+		if err != nil {
+			slog.Error("could not insert window", "error", err)
+			if strings.Contains(err.Error(), "(SQLSTATE 23503)") {
+				return pb.WindowEmitStatus{}, fmt.Errorf(
+					"window type does not exist - insert via window type registration: %v",
+					err.Error(),
+				)
+			}
+		}
+		slog.Debug("window record inserted into the datalayer", "window", insertedWindow)
 	}
-
-	// fire off processings
-	executionPlan, err := dag.BuildPlan(
-		algoIDPaths,
-		windowTypeIDPaths,
-		procIDPaths,
-		int64(insertedWindow.WindowTypeID),
-	)
+	executionPlan, err := d.buildExecutionPlan(ctx, qtx, int64(insertedWindow.WindowTypeID), targetAlgoIds)
 	if err != nil {
 		slog.Error(
 			"failed to construct execution paths for window",
@@ -250,440 +298,903 @@ func (d *Datalayer) EmitWindow(
 	}
 
 	if len(executionPlan.Stages) > 0 {
-		go processTasks(d, executionPlan, window, insertedWindow)
+		// reject the window before anything is stored if there is no room for
+		// its execution, so that the caller can back off and retry
+		if !d.pool.reserve() {
+			return pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, types.ExecutionQueueFull
+		}
+
+		// persist the plan so that it survives orca-core restarting
+		executionPlanId, execId, err := d.createExecutionPlan(ctx, tx, executionPlan, insertedWindow.ID)
+		if err != nil {
+			d.pool.release()
+			slog.Error(
+				"failed to persist execution plan for window",
+				"window",
+				insertedWindow,
+				"error",
+				err,
+			)
+			return pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			d.pool.release()
+			return pb.WindowEmitStatus{Status: pb.WindowEmitStatus_TRIGGERING_FAILED}, err
+		}
+
+		if !rerun {
+			go d.rollUpWindows(context.Background(), []*pb.Window{window})
+		}
+
+		emitStatus := pb.WindowEmitStatus_PROCESSING_TRIGGERED
+		if queued := d.pool.submit(executionPlanId); queued {
+			emitStatus = pb.WindowEmitStatus_QUEUED
+		}
 
 		return pb.WindowEmitStatus{
-			Status: pb.WindowEmitStatus_PROCESSING_TRIGGERED,
-		}, tx.Commit(ctx)
+			Status: emitStatus,
+			ExecId: execId,
+		}, nil
+	}
+
+	// the window is kept without an execution, so that it can still be
+	// rolled up into its parent windows
+	if err := tx.Commit(ctx); err != nil {
+		return pb.WindowEmitStatus{}, err
 	}
+	go d.rollUpWindows(context.Background(), []*pb.Window{window})
+
 	return pb.WindowEmitStatus{
 		Status: pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS,
 	}, nil
 }
 
-// ReadWindowTypes reads the types of windows registered with Orca core
-func (d *Datalayer) ReadWindowTypes(
+// windowEmission is a window of a batch that is to be inserted
+type windowEmission struct {
+	index          int
+	window         *pb.Window
+	windowTypeId   int64
+	metadata       []byte
+	idempotencyKey pgtype.Text
+	dedupeKey      string
+	executionPlan  dag.Plan
+
+	windowId        int64
+	executionPlanId int64
+	execId          string
+}
+
+// EmitWindows emits a batch of windows with Orca core in a single
+// transaction. A window that cannot be emitted fails on its own, with the
+// reason given in its status, without failing the rest of the batch
+func (d *Datalayer) EmitWindows(
 	ctx context.Context,
-) (*pb.WindowTypes, error) {
-	tx, err := d.WithTx(ctx)
-	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
+	windows []*pb.Window,
+) ([]*pb.WindowEmitStatus, error) {
+	slog.Debug("recieved emitted windows", "count", len(windows))
+
+	statuses := make([]*pb.WindowEmitStatus, len(windows))
+	failWindow := func(index int, err error) {
+		statuses[index] = &pb.WindowEmitStatus{
+			Status: pb.WindowEmitStatus_TRIGGERING_FAILED,
+			Error:  err.Error(),
+		}
 	}
 
+	tx, err := d.WithTx(ctx)
+
 	defer func() {
 		if tx != nil {
 			tx.Rollback(ctx)
 		}
 	}()
 
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
 	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	windowTypes, err := qtx.ReadWindowTypes(ctx)
-	if err != nil {
-		return &pb.WindowTypes{}, fmt.Errorf("could not read window types: %v", err)
+	// window types and execution plans are read once for each type of window
+	// and set of target algorithms in the batch, rather than for each window
+	type windowTypeInfo struct {
+		id             int64
+		metadataFields []ReadMetadataFieldsByWindowTypeRow
+		err            error
+	}
+	type executionPlanInfo struct {
+		executionPlan dag.Plan
+		err           error
 	}
+	windowTypes := make(map[string]windowTypeInfo)
+	executionPlans := make(map[string]executionPlanInfo)
+
+	var emissions []*windowEmission
+	emissionsByKey := make(map[string]*windowEmission)
+	// windows repeated within the batch, by the index of the repeat
+	repeats := make(map[int]*windowEmission)
+
+	for index, window := range windows {
+		windowTypeKey := fmt.Sprintf("%s/%s", window.GetWindowTypeName(), window.GetWindowTypeVersion())
+		windowType, ok := windowTypes[windowTypeKey]
+		if !ok {
+			windowType.id, err = qtx.ReadWindowTypeId(ctx, ReadWindowTypeIdParams{
+				WindowTypeName:    window.GetWindowTypeName(),
+				WindowTypeVersion: window.GetWindowTypeVersion(),
+			})
+			if errors.Is(err, pgx.ErrNoRows) {
+				windowType.err = fmt.Errorf(
+					"window type %v does not exist - insert via window type registration",
+					windowTypeKey,
+				)
+			} else if err != nil {
+				return nil, fmt.Errorf("could not read window type: %v", err)
+			} else {
+				windowType.metadataFields, err = qtx.ReadMetadataFieldsByWindowType(
+					ctx,
+					ReadMetadataFieldsByWindowTypeParams{
+						WindowTypeName:    window.GetWindowTypeName(),
+						WindowTypeVersion: window.GetWindowTypeVersion(),
+					},
+				)
+				if err != nil {
+					return nil, fmt.Errorf("could not read metadata for window: %v", err)
+				}
+			}
+			windowTypes[windowTypeKey] = windowType
+		}
+		if windowType.err != nil {
+			failWindow(index, windowType.err)
+			continue
+		}
 
-	windowTypesPb := pb.WindowTypes{
-		Windows: make([]*pb.WindowType, len(windowTypes)),
+		metadataBytes, err := window.GetMetadata().MarshalJSON()
+		if err != nil {
+			failWindow(index, fmt.Errorf("could not marshal metadata: %v", err))
+			continue
+		}
+		if err := checkRequiredMetadata(metadataBytes, windowType.metadataFields); err != nil {
+			failWindow(index, err)
+			continue
+		}
+
+		executionPlanKey := windowTypeKey
+		for _, algorithm := range window.GetTargetAlgorithms() {
+			executionPlanKey += fmt.Sprintf("/%s_%s", algorithm.GetName(), algorithm.GetVersion())
+		}
+		executionPlan, ok := executionPlans[executionPlanKey]
+		if !ok {
+			targetAlgoIds, err := d.readAlgorithmIdsForWindowType(
+				ctx,
+				qtx,
+				window.GetWindowTypeName(),
+				window.GetWindowTypeVersion(),
+				window.GetTargetAlgorithms(),
+			)
+			if err == nil {
+				executionPlan.executionPlan, err = d.buildExecutionPlan(
+					ctx,
+					qtx,
+					windowType.id,
+					targetAlgoIds,
+				)
+			}
+			executionPlan.err = err
+			executionPlans[executionPlanKey] = executionPlan
+		}
+		if executionPlan.err != nil {
+			failWindow(index, executionPlan.err)
+			continue
+		}
+
+		emission := &windowEmission{
+			index:        index,
+			window:       window,
+			windowTypeId: windowType.id,
+			metadata:     metadataBytes,
+			idempotencyKey: pgtype.Text{
+				String: window.GetIdempotencyKey(),
+				Valid:  window.GetIdempotencyKey() != "",
+			},
+			dedupeKey:     windowDedupeKey(window),
+			executionPlan: executionPlan.executionPlan,
+		}
+		// without an idempotency key, windows only repeat one another if their
+		// metadata matches too
+		repeatKey := emission.dedupeKey
+		if !emission.idempotencyKey.Valid {
+			metadataJson, err := json.Marshal(window.GetMetadata().AsMap())
+			if err != nil {
+				failWindow(index, fmt.Errorf("could not marshal metadata: %v", err))
+				continue
+			}
+			repeatKey = fmt.Sprintf("%s/%s", repeatKey, metadataJson)
+		}
+		if original, ok := emissionsByKey[repeatKey]; ok {
+			repeats[index] = original
+			continue
+		}
+		emissionsByKey[repeatKey] = emission
+		emissions = append(emissions, emission)
+	}
+	if len(emissions) == 0 {
+		return statuses, nil
 	}
 
-	for ii, window := range windowTypes {
-		windowTypesPb.Windows[ii] = &pb.WindowType{
-			Name:        window.Name,
-			Version:     window.Version,
-			Description: window.Description,
+	// windows already emitted, e.g. by a retrying emitter, are not triggered
+	// again. As with single windows, emissions of the same window are
+	// serialised so that concurrent batches cannot both insert it
+	dedupeKeys := make([]string, len(emissions))
+	duplicateParams := make([]ReadDuplicateWindowsParams, len(emissions))
+	for ii, emission := range emissions {
+		dedupeKeys[ii] = emission.dedupeKey
+		duplicateParams[ii] = ReadDuplicateWindowsParams{
+			WindowTypeName:    emission.window.GetWindowTypeName(),
+			WindowTypeVersion: emission.window.GetWindowTypeVersion(),
+			IdempotencyKey:    emission.idempotencyKey,
+			TimeFrom: pgtype.Timestamp{
+				Time:  emission.window.GetTimeFrom().AsTime().UTC(),
+				Valid: true,
+			},
+			TimeTo: pgtype.Timestamp{
+				Time:  emission.window.GetTimeTo().AsTime().UTC(),
+				Valid: true,
+			},
+			Origin:   emission.window.GetOrigin(),
+			Metadata: emission.metadata,
 		}
 	}
-	return &windowTypesPb, tx.Commit(ctx)
-}
+	if err := qtx.LockWindowEmissions(ctx, dedupeKeys); err != nil {
+		return nil, fmt.Errorf("could not lock window emissions: %v", err)
+	}
 
-// ReadAlgorithms read the agorithms registered with Orca core
-func (d *Datalayer) ReadAlgorithms(
-	ctx context.Context,
-) (*pb.Algorithms, error) {
-	tx, err := d.WithTx(ctx)
-	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
+	var batchErr error
+	var newEmissions []*windowEmission
+	var reruns []*windowEmission
+	qtx.ReadDuplicateWindows(ctx, duplicateParams).QueryRow(
+		func(ii int, duplicate ReadDuplicateWindowsRow, err error) {
+			switch {
+			case err == nil && !emissions[ii].idempotencyKey.Valid && len(emissions[ii].window.GetTargetAlgorithms()) > 0:
+				// as with single windows, stored windows emitted again for
+				// target algorithms are triggered again for them
+				emissions[ii].windowId = duplicate.ID
+				reruns = append(reruns, emissions[ii])
+			case err == nil:
+				emitStatus := pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS
+				if duplicate.ExecID.Valid {
+					emitStatus = pb.WindowEmitStatus_PROCESSING_TRIGGERED
+				}
+				statuses[emissions[ii].index] = &pb.WindowEmitStatus{
+					Status:    emitStatus,
+					ExecId:    duplicate.ExecID.String,
+					Duplicate: true,
+				}
+			case errors.Is(err, pgx.ErrNoRows):
+				newEmissions = append(newEmissions, emissions[ii])
+			case batchErr == nil:
+				batchErr = err
+			}
+		},
+	)
+	if batchErr != nil {
+		return nil, fmt.Errorf("could not read duplicate windows: %v", batchErr)
 	}
+
+	// windows are rejected before anything is stored if there is no room
+	// for their execution, so that the caller can back off and retry them
+	var inserts []*windowEmission
+	var triggered []*windowEmission
+	submitted := false
 	defer func() {
-		if tx != nil {
-			tx.Rollback(ctx)
+		if !submitted {
+			for range triggered {
+				d.pool.release()
+			}
 		}
 	}()
+	for _, emission := range newEmissions {
+		if len(emission.executionPlan.Stages) > 0 {
+			if !d.pool.reserve() {
+				failWindow(emission.index, types.ExecutionQueueFull)
+				continue
+			}
+			triggered = append(triggered, emission)
+		}
+		inserts = append(inserts, emission)
+	}
+	var rerunsTriggered []*windowEmission
+	for _, emission := range reruns {
+		if !d.pool.reserve() {
+			failWindow(emission.index, types.ExecutionQueueFull)
+			continue
+		}
+		triggered = append(triggered, emission)
+		rerunsTriggered = append(rerunsTriggered, emission)
+	}
 
-	pgTx := tx.(*PgTx)
-	qtx := d.queries.WithTx(pgTx.tx)
+	registerParams := make([]RegisterWindowsParams, len(inserts))
+	for ii, emission := range inserts {
+		registerParams[ii] = RegisterWindowsParams{
+			WindowTypeID: emission.windowTypeId,
+			TimeFrom: pgtype.Timestamp{
+				Time:  emission.window.GetTimeFrom().AsTime().UTC(),
+				Valid: true,
+			},
+			TimeTo: pgtype.Timestamp{
+				Time:  emission.window.GetTimeTo().AsTime().UTC(),
+				Valid: true,
+			},
+			Origin:         emission.window.GetOrigin(),
+			Metadata:       emission.metadata,
+			IdempotencyKey: emission.idempotencyKey,
+		}
+	}
+	if len(inserts) > 0 {
+		qtx.RegisterWindows(ctx, registerParams).QueryRow(func(ii int, windowId int64, err error) {
+			if err != nil {
+				if batchErr == nil {
+					batchErr = err
+				}
+				return
+			}
+			inserts[ii].windowId = windowId
+		})
+		if batchErr != nil {
+			slog.Error("could not insert windows", "error", batchErr)
+			return nil, fmt.Errorf("could not insert windows: %v", batchErr)
+		}
+	}
 
-	algorithms, err := qtx.ReadAlgorithms(ctx)
-	if err != nil {
-		return &pb.Algorithms{}, fmt.Errorf("could not read algorithms: %v", err)
+	if len(triggered) > 0 {
+		// persist the plans so that they survive orca-core restarting
+		plans := make([]dag.Plan, len(triggered))
+		windowIds := make([]int64, len(triggered))
+		for ii, emission := range triggered {
+			plans[ii] = emission.executionPlan
+			windowIds[ii] = emission.windowId
+		}
+		executionPlanIds, execIds, err := d.createExecutionPlans(ctx, qtx, plans, windowIds)
+		if err != nil {
+			slog.Error("failed to persist execution plans for windows", "error", err)
+			return nil, err
+		}
+		for ii, emission := range triggered {
+			emission.executionPlanId = executionPlanIds[ii]
+			emission.execId = execIds[ii]
+		}
 	}
 
-	algorithmsPb := pb.Algorithms{
-		Algorithm: make([]*pb.Algorithm, len(algorithms)),
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
 	}
 
-	for ii, algorithm := range algorithms {
-		var resultType pb.ResultType
-		switch algorithm.ResultType {
-		case ResultTypeNone:
-			resultType = pb.ResultType_NONE
-		case ResultTypeArray:
-			resultType = pb.ResultType_ARRAY
-		case ResultTypeStruct:
-			resultType = pb.ResultType_STRUCT
-		case ResultTypeValue:
-			resultType = pb.ResultType_VALUE
-		default:
-			return &pb.Algorithms{}, fmt.Errorf(
-				"read a result type that is not supported: %v",
-				algorithm.ResultType,
-			)
+	submitted = true
+	insertedWindows := make([]*pb.Window, len(inserts))
+	for ii, emission := range inserts {
+		insertedWindows[ii] = emission.window
+	}
+	if len(insertedWindows) > 0 {
+		go d.rollUpWindows(context.Background(), insertedWindows)
+	}
+	for _, emission := range slices.Concat(inserts, rerunsTriggered) {
+		if len(emission.executionPlan.Stages) == 0 {
+			statuses[emission.index] = &pb.WindowEmitStatus{
+				Status: pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS,
+			}
+			continue
+		}
+		emitStatus := pb.WindowEmitStatus_PROCESSING_TRIGGERED
+		if queued := d.pool.submit(emission.executionPlanId); queued {
+			emitStatus = pb.WindowEmitStatus_QUEUED
 		}
+		statuses[emission.index] = &pb.WindowEmitStatus{
+			Status: emitStatus,
+			ExecId: emission.execId,
+		}
+	}
 
-		algorithmsPb.Algorithm[ii] = &pb.Algorithm{
-			Name:    algorithm.Name,
-			Version: algorithm.Version,
-			WindowType: &pb.WindowType{
-				Name:    algorithm.WindowName,
-				Version: algorithm.WindowVersion,
-			},
-			ResultType: resultType,
+	// a window repeated within the batch shares the status of its first
+	// emission
+	for index, original := range repeats {
+		originalStatus := statuses[original.index]
+		statuses[index] = &pb.WindowEmitStatus{
+			Status:    originalStatus.GetStatus(),
+			ExecId:    originalStatus.GetExecId(),
+			Duplicate: originalStatus.GetStatus() != pb.WindowEmitStatus_TRIGGERING_FAILED,
+			Error:     originalStatus.GetError(),
 		}
 	}
-	return &algorithmsPb, tx.Commit(ctx)
+
+	slog.Info(
+		"emitted windows",
+		"count",
+		len(windows),
+		"inserted",
+		len(inserts),
+		"triggered",
+		len(triggered),
+	)
+	return statuses, nil
 }
 
-// ReadProcessors reads processors registered with Orca core
-func (d *Datalayer) ReadProcessors(
+// OpenWindow opens a window whose end is not yet known. The window is stored
+// straight away, but its algorithms are not triggered until it is closed
+func (d *Datalayer) OpenWindow(
 	ctx context.Context,
-) (*pb.Processors, error) {
-	tx, err := d.WithTx(ctx)
+	windowOpen *pb.WindowOpen,
+) (*pb.WindowEmitStatus, error) {
+	metadataBytes, err := windowOpen.GetMetadata().MarshalJSON()
 	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
+		return nil, fmt.Errorf("could not marshal metadata: %v", err)
 	}
+
+	tx, err := d.WithTx(ctx)
+
 	defer func() {
 		if tx != nil {
 			tx.Rollback(ctx)
 		}
 	}()
 
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
 	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	processors, err := qtx.ReadProcessors(ctx)
+	windowTypeId, err := qtx.ReadWindowTypeId(ctx, ReadWindowTypeIdParams{
+		WindowTypeName:    windowOpen.GetWindowTypeName(),
+		WindowTypeVersion: windowOpen.GetWindowTypeVersion(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"%w: %v_%v",
+			types.WindowTypeNotFound,
+			windowOpen.GetWindowTypeName(),
+			windowOpen.GetWindowTypeVersion(),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read window type: %v", err)
+	}
+
+	metadataFields, err := qtx.ReadMetadataFieldsByWindowType(ctx, ReadMetadataFieldsByWindowTypeParams{
+		WindowTypeName:    windowOpen.GetWindowTypeName(),
+		WindowTypeVersion: windowOpen.GetWindowTypeVersion(),
+	})
 	if err != nil {
-		return &pb.Processors{}, fmt.Errorf("could not read processors: %v", err)
+		return nil, fmt.Errorf("could not read metadata for window: %v", err)
+	}
+	if err := checkRequiredMetadata(metadataBytes, metadataFields); err != nil {
+		return nil, err
 	}
 
-	processorsPb := pb.Processors{
-		Processor: make([]*pb.Processors_Processor, len(processors)),
+	// a window opened again by a retrying emitter is not opened twice
+	window := &pb.Window{
+		TimeFrom:          windowOpen.GetTimeFrom(),
+		WindowTypeName:    windowOpen.GetWindowTypeName(),
+		WindowTypeVersion: windowOpen.GetWindowTypeVersion(),
+		Origin:            windowOpen.GetOrigin(),
+		Metadata:          windowOpen.GetMetadata(),
+		IdempotencyKey:    windowOpen.GetIdempotencyKey(),
+	}
+	if err := qtx.LockWindowEmission(ctx, windowDedupeKey(window)); err != nil {
+		return nil, fmt.Errorf("could not lock window emission: %v", err)
+	}
+	windowRow, err := qtx.ReadWindowForUpdate(ctx, ReadWindowForUpdateParams{
+		WindowTypeName:    windowOpen.GetWindowTypeName(),
+		WindowTypeVersion: windowOpen.GetWindowTypeVersion(),
+		IdempotencyKey:    windowOpen.GetIdempotencyKey(),
+	})
+	if err == nil {
+		return d.windowStatus(ctx, qtx, windowRow)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("could not read window: %v", err)
 	}
 
-	for ii, processor := range processors {
-		processorsPb.Processor[ii] = &pb.Processors_Processor{
-			Name:    processor.Name,
-			Runtime: processor.Runtime,
-		}
+	windowId, err := qtx.OpenWindow(ctx, OpenWindowParams{
+		WindowTypeID: windowTypeId,
+		TimeFrom: pgtype.Timestamp{
+			Time:  windowOpen.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		Origin:         windowOpen.GetOrigin(),
+		Metadata:       metadataBytes,
+		IdempotencyKey: windowOpen.GetIdempotencyKey(),
+		Provisional:    windowOpen.GetProvisional(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not open window: %v", err)
 	}
-	return &processorsPb, tx.Commit(ctx)
+	slog.Debug("window opened", "window_id", windowId)
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.WindowEmitStatus{
+		Status: pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS,
+	}, nil
 }
 
-func (d *Datalayer) ReadResultsStats(
+// ExtendWindow extends an open window up to a later time. A provisional
+// window is executed on what it covers so far, unless an execution of it is
+// still unfinished
+func (d *Datalayer) ExtendWindow(
 	ctx context.Context,
-) (*pb.ResultsStats, error) {
+	windowExtension *pb.WindowExtension,
+) (*pb.WindowEmitStatus, error) {
 	tx, err := d.WithTx(ctx)
-	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
-	}
+
 	defer func() {
 		if tx != nil {
 			tx.Rollback(ctx)
 		}
 	}()
 
-	pgTx := tx.(*PgTx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
+	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	resultsStats, err := qtx.ReadResultsStats(ctx)
+	windowRow, err := qtx.ReadWindowForUpdate(ctx, ReadWindowForUpdateParams{
+		WindowTypeName:    windowExtension.GetWindowTypeName(),
+		WindowTypeVersion: windowExtension.GetWindowTypeVersion(),
+		IdempotencyKey:    windowExtension.GetIdempotencyKey(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %v", types.WindowNotFound, windowExtension.GetIdempotencyKey())
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read window: %v", err)
+	}
+	if windowRow.State != WindowStateOpen {
+		return nil, fmt.Errorf("%w: %v", types.WindowNotOpen, windowExtension.GetIdempotencyKey())
+	}
+
+	timeTo := windowExtension.GetTimeTo().AsTime().UTC()
+	if !timeTo.After(windowRow.TimeTo.Time) {
+		return nil, fmt.Errorf(
+			"%w: the window has already been extended to %v",
+			types.InvalidWindowEnd,
+			windowRow.TimeTo.Time,
+		)
+	}
+	err = qtx.ExtendWindow(ctx, ExtendWindowParams{
+		TimeTo: pgtype.Timestamp{
+			Time:  timeTo,
+			Valid: true,
+		},
+		ID: windowRow.ID,
+	})
 	if err != nil {
-		return &pb.ResultsStats{}, fmt.Errorf("could not read results: %v", err)
+		return nil, fmt.Errorf("could not extend window: %v", err)
 	}
 
-	resultsStatsPb := pb.ResultsStats{
-		Count: resultsStats,
+	if !windowRow.Provisional {
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		return &pb.WindowEmitStatus{
+			Status: pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS,
+		}, nil
 	}
 
-	return &resultsStatsPb, tx.Commit(ctx)
+	// provisional executions do not pile up behind one another. An execution
+	// reads how far the window extends when it starts, so extensions made
+	// while one is unfinished are picked up by the next
+	unfinished, err := qtx.ReadUnfinishedWindowExecutions(ctx, windowRow.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read unfinished executions of window: %v", err)
+	}
+	if len(unfinished) > 0 {
+		if err := tx.Commit(ctx); err != nil {
+			return nil, err
+		}
+		return &pb.WindowEmitStatus{
+			Status:    pb.WindowEmitStatus_PROCESSING_TRIGGERED,
+			ExecId:    unfinished[0],
+			Duplicate: true,
+		}, nil
+	}
+	return d.triggerStoredWindow(ctx, tx, windowRow.WindowTypeID, windowRow.ID, true)
 }
 
-func (d *Datalayer) ReadResultFieldsForAlgorithm(
+// CloseWindow closes an open window at its end, triggering its algorithms.
+// Provisional executions of the window that are unfinished are cancelled, as
+// their results would be replaced anyway
+func (d *Datalayer) CloseWindow(
 	ctx context.Context,
-	resultFieldsRead *pb.AlgorithmFieldsRead,
-) (*pb.AlgorithmFields, error) {
+	windowClosure *pb.WindowClosure,
+) (*pb.WindowEmitStatus, error) {
 	tx, err := d.WithTx(ctx)
-	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
-	}
+
 	defer func() {
 		if tx != nil {
 			tx.Rollback(ctx)
 		}
 	}()
 
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
 	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	algorithmFields, err := qtx.ReadDistinctJsonResultFieldsForAlgorithm(
-		ctx,
-		ReadDistinctJsonResultFieldsForAlgorithmParams{
-			TimeFrom: pgtype.Timestamp{
-				Time:  resultFieldsRead.GetTimeFrom().AsTime().UTC(),
-				Valid: true,
-			},
-			TimeTo: pgtype.Timestamp{
-				Time:  resultFieldsRead.GetTimeTo().AsTime().UTC(),
-				Valid: true,
-			},
-			AlgorithmName:    resultFieldsRead.GetAlgorithm().GetName(),
-			AlgorithmVersion: resultFieldsRead.GetAlgorithm().GetVersion(),
+	windowRow, err := qtx.ReadWindowForUpdate(ctx, ReadWindowForUpdateParams{
+		WindowTypeName:    windowClosure.GetWindowTypeName(),
+		WindowTypeVersion: windowClosure.GetWindowTypeVersion(),
+		IdempotencyKey:    windowClosure.GetIdempotencyKey(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %v", types.WindowNotFound, windowClosure.GetIdempotencyKey())
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read window: %v", err)
+	}
+
+	// a window closed again by a retrying emitter is not triggered again
+	if windowRow.State == WindowStateClosed {
+		return d.windowStatus(ctx, qtx, windowRow)
+	}
+
+	timeTo := windowClosure.GetTimeTo().AsTime().UTC()
+	if !timeTo.After(windowRow.TimeFrom.Time) || timeTo.Before(windowRow.TimeTo.Time) {
+		return nil, fmt.Errorf(
+			"%w: the window must end after it starts at %v and no earlier than %v",
+			types.InvalidWindowEnd,
+			windowRow.TimeFrom.Time,
+			windowRow.TimeTo.Time,
+		)
+	}
+	err = qtx.CloseWindow(ctx, CloseWindowParams{
+		TimeTo: pgtype.Timestamp{
+			Time:  timeTo,
+			Valid: true,
 		},
-	)
+		ID: windowRow.ID,
+	})
 	if err != nil {
-		return &pb.AlgorithmFields{}, fmt.Errorf("could not read results: %v", err)
+		return nil, fmt.Errorf("could not close window: %v", err)
 	}
-	algorithmFieldsResult := pb.AlgorithmFields{
-		Field: make([]string, len(algorithmFields)),
+
+	unfinished, err := qtx.ReadUnfinishedWindowExecutions(ctx, windowRow.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read unfinished executions of window: %v", err)
 	}
-	copy(algorithmFieldsResult.Field, algorithmFields)
-	return &algorithmFieldsResult, tx.Commit(ctx)
+
+	emitStatus, err := d.triggerStoredWindow(ctx, tx, windowRow.WindowTypeID, windowRow.ID, false)
+	if err != nil {
+		return emitStatus, err
+	}
+	// only once the window is closed, so that a window failing to close
+	// keeps its provisional results coming
+	d.cancelExecutions(ctx, unfinished)
+
+	metadata, err := unmarshalToStruct(windowRow.Metadata)
+	if err != nil {
+		slog.Warn("could not unpack window metadata", "window_id", windowRow.ID, "error", err)
+		return emitStatus, nil
+	}
+	go d.rollUpWindows(context.Background(), []*pb.Window{{
+		TimeFrom:          timestamppb.New(windowRow.TimeFrom.Time),
+		TimeTo:            timestamppb.New(timeTo),
+		WindowTypeName:    windowClosure.GetWindowTypeName(),
+		WindowTypeVersion: windowClosure.GetWindowTypeVersion(),
+		Origin:            windowRow.Origin,
+		Metadata:          metadata,
+		IdempotencyKey:    windowClosure.GetIdempotencyKey(),
+	}})
+	return emitStatus, nil
 }
 
-func (d *Datalayer) ReadResultsForAlgorithm(
+// AmendWindow supersedes a stored window with a corrected one, triggering
+// the corrected window's algorithms. The results of the superseded window are
+// kept, but marked superseded, and its unfinished executions are cancelled
+func (d *Datalayer) AmendWindow(
 	ctx context.Context,
-	resultsForAlgorithmRead *pb.ResultsForAlgorithmRead,
-) (*pb.ResultsForAlgorithm, error) {
-	if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_NONE {
-		return &pb.ResultsForAlgorithm{}, fmt.Errorf(
-			"cannot get results for algorithm that does not produce results",
+	windowAmendment *pb.WindowAmendment,
+) (*pb.WindowEmitStatus, error) {
+	window := windowAmendment.GetWindow()
+	amendedWindow := windowAmendment.GetAmendedWindow()
+	if window.GetWindowTypeName() != amendedWindow.GetWindowTypeName() ||
+		window.GetWindowTypeVersion() != amendedWindow.GetWindowTypeVersion() {
+		return nil, fmt.Errorf(
+			"%w: a window of type %v_%v cannot be amended to one of type %v_%v",
+			types.InvalidWindowAmendment,
+			window.GetWindowTypeName(),
+			window.GetWindowTypeVersion(),
+			amendedWindow.GetWindowTypeName(),
+			amendedWindow.GetWindowTypeVersion(),
 		)
 	}
+	if !amendedWindow.GetTimeTo().AsTime().After(amendedWindow.GetTimeFrom().AsTime()) {
+		return nil, fmt.Errorf("%w: the amended window must end after it starts", types.InvalidWindowAmendment)
+	}
 
-	tx, err := d.WithTx(ctx)
+	metadataBytes, err := window.GetMetadata().MarshalJSON()
 	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
+		return nil, fmt.Errorf("could not marshal metadata: %v", err)
+	}
+	amendedMetadataBytes, err := amendedWindow.GetMetadata().MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal metadata: %v", err)
 	}
+
+	tx, err := d.WithTx(ctx)
+
 	defer func() {
 		if tx != nil {
 			tx.Rollback(ctx)
 		}
 	}()
 
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
 	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	results, err := qtx.ReadResultsForAlgorithm(ctx, ReadResultsForAlgorithmParams{
-		TimeFrom: pgtype.Timestamp{
-			Time:  resultsForAlgorithmRead.GetTimeFrom().AsTime().UTC(),
-			Valid: true,
-		},
-		TimeTo: pgtype.Timestamp{
-			Time:  resultsForAlgorithmRead.GetTimeTo().AsTime().UTC(),
-			Valid: true,
-		},
-		AlgorithmName:    resultsForAlgorithmRead.GetAlgorithm().GetName(),
-		AlgorithmVersion: resultsForAlgorithmRead.GetAlgorithm().GetVersion(),
+	metadataFields, err := qtx.ReadMetadataFieldsByWindowType(ctx, ReadMetadataFieldsByWindowTypeParams{
+		WindowTypeName:    amendedWindow.GetWindowTypeName(),
+		WindowTypeVersion: amendedWindow.GetWindowTypeVersion(),
 	})
 	if err != nil {
-		return &pb.ResultsForAlgorithm{}, fmt.Errorf(
-			"could not read results for algorithm: %v",
-			err,
-		)
+		return nil, fmt.Errorf("could not read metadata for window: %v", err)
 	}
-	resultsPb := pb.ResultsForAlgorithm{
-		Results: make([]*pb.ResultsForAlgorithm_ResultsRow, len(results)),
+	if err := checkRequiredMetadata(amendedMetadataBytes, metadataFields); err != nil {
+		return nil, err
 	}
-	var _midpointPb *timestamppb.Timestamp
-	if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_VALUE {
-		for ii, res := range results {
-			_midpointPb = timestamppb.New(
-				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
-			)
-
-			resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
-				Time: _midpointPb,
-				ResultData: &pb.ResultsForAlgorithm_ResultsRow_SingleValue{
-					SingleValue: float32(res.ResultValue.Float64),
-				},
-			}
-		}
-	} else if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_ARRAY {
-		for ii, res := range results {
-			_midpointPb = timestamppb.New(
-				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
-			)
-			resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
-				Time: _midpointPb,
-				ResultData: &pb.ResultsForAlgorithm_ResultsRow_ArrayValues{
-					ArrayValues: &pb.FloatArray{
-						Values: convertFloat64ToFloat32(res.ResultArray),
-					},
-				},
-			}
-		}
-	} else if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_STRUCT {
-		for ii, res := range results {
-			_midpointPb = timestamppb.New(
-				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
-			)
-			newStruct, err := unmarshalToStruct(res.ResultJson)
-			if err != nil {
-				return &pb.ResultsForAlgorithm{}, fmt.Errorf("unable to parse struct data for algorithm %v: %v", resultsForAlgorithmRead.Algorithm, err)
-			}
 
-			resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
-				Time: _midpointPb,
-				ResultData: &pb.ResultsForAlgorithm_ResultsRow_StructValue{
-					StructValue: newStruct,
-				},
-			}
-		}
-	} else {
-		return &pb.ResultsForAlgorithm{}, fmt.Errorf("unhandled result type: %v", resultsForAlgorithmRead.GetAlgorithm().GetResultType())
+	// the amended window takes over the idempotency key of the window it
+	// supersedes, unless it is given its own, so that an emitter retrying
+	// either window is not triggered again
+	idempotencyKey := amendedWindow.GetIdempotencyKey()
+	if idempotencyKey == "" {
+		idempotencyKey = window.GetIdempotencyKey()
 	}
-	return &resultsPb, tx.Commit(ctx)
-}
+	amendedWindow = proto.Clone(amendedWindow).(*pb.Window)
+	amendedWindow.IdempotencyKey = idempotencyKey
 
-func (d *Datalayer) ReadWindows(
-	ctx context.Context,
-	windowsRead *pb.WindowsRead,
-) (*pb.Windows, error) {
-	tx, err := d.WithTx(ctx)
+	// amendments are serialised with emissions of both the window and the
+	// amended window
+	err = qtx.LockWindowEmissions(ctx, []string{windowDedupeKey(window), windowDedupeKey(amendedWindow)})
 	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
+		return nil, fmt.Errorf("could not lock window emissions: %v", err)
 	}
-	defer func() {
-		if tx != nil {
-			tx.Rollback(ctx)
-		}
-	}()
-
-	pgTx := tx.(*PgTx)
-	qtx := d.queries.WithTx(pgTx.tx)
 
-	readWindowsRows, err := qtx.ReadWindows(ctx, ReadWindowsParams{
+	windowRow, err := qtx.ReadWindowForAmendment(ctx, ReadWindowForAmendmentParams{
+		WindowTypeName:    window.GetWindowTypeName(),
+		WindowTypeVersion: window.GetWindowTypeVersion(),
+		IdempotencyKey: pgtype.Text{
+			String: window.GetIdempotencyKey(),
+			Valid:  window.GetIdempotencyKey() != "",
+		},
 		TimeFrom: pgtype.Timestamp{
-			Time:  windowsRead.GetTimeFrom().AsTime().UTC(),
+			Time:  window.GetTimeFrom().AsTime().UTC(),
 			Valid: true,
 		},
 		TimeTo: pgtype.Timestamp{
-			Time:  windowsRead.GetTimeTo().AsTime().UTC(),
+			Time:  window.GetTimeTo().AsTime().UTC(),
 			Valid: true,
 		},
-		WindowTypeName:    windowsRead.GetWindow().GetName(),
-		WindowTypeVersion: windowsRead.GetWindow().GetVersion(),
+		Origin:   window.GetOrigin(),
+		Metadata: metadataBytes,
 	})
-	if err != nil {
-		return &pb.Windows{}, fmt.Errorf("could not read windows: %v", err)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %v", types.WindowNotFound, windowDedupeKey(window))
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read window: %v", err)
 	}
-	windowsPb := pb.Windows{
-		Window: make([]*pb.Window, len(readWindowsRows)),
+	// open windows are corrected by extending or closing them instead
+	if windowRow.State == WindowStateOpen {
+		return nil, fmt.Errorf("%w: the window is still open", types.InvalidWindowAmendment)
 	}
 
-	var _timeFrom *timestamppb.Timestamp
-	var _timeTo *timestamppb.Timestamp
-	for ii, windowRow := range readWindowsRows {
-		metadata, err := unmarshalToStruct(windowRow.Metadata)
-		if err != nil {
-			return &pb.Windows{}, fmt.Errorf("could not unpack specific window metadata: %v", err)
-		}
-		_timeFrom = timestamppb.New(windowRow.TimeFrom.Time)
-		_timeTo = timestamppb.New(windowRow.TimeTo.Time)
-
-		windowsPb.Window[ii] = &pb.Window{
-			TimeFrom:          _timeFrom,
-			TimeTo:            _timeTo,
-			Origin:            windowRow.Origin,
-			Metadata:          metadata,
-			WindowTypeName:    windowRow.Name,
-			WindowTypeVersion: windowRow.Version,
-		}
+	if err := qtx.SupersedeWindow(ctx, windowRow.ID); err != nil {
+		return nil, fmt.Errorf("could not supersede window: %v", err)
 	}
-	return &windowsPb, tx.Commit(ctx)
-}
-
-func (d *Datalayer) ReadDistinctMetadataForWindowType(
-	ctx context.Context,
-	windowMetadataRead *pb.DistinctMetadataForWindowTypeRead,
-) (*pb.DistinctMetadataForWindowType, error) {
-	tx, err := d.WithTx(ctx)
-	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
+	if err := qtx.SupersedeResults(ctx, windowRow.ID); err != nil {
+		return nil, fmt.Errorf("could not supersede results: %v", err)
 	}
-	defer func() {
-		if tx != nil {
-			tx.Rollback(ctx)
-		}
-	}()
-
-	pgTx := tx.(*PgTx)
-	qtx := d.queries.WithTx(pgTx.tx)
 
-	windowMetadata, err := qtx.ReadDistinctWindowMetadata(ctx, ReadDistinctWindowMetadataParams{
+	amendedWindowId, err := qtx.AmendWindow(ctx, AmendWindowParams{
+		WindowTypeID: windowRow.WindowTypeID,
 		TimeFrom: pgtype.Timestamp{
-			Time:  windowMetadataRead.GetTimeFrom().AsTime(),
+			Time:  amendedWindow.GetTimeFrom().AsTime().UTC(),
 			Valid: true,
 		},
 		TimeTo: pgtype.Timestamp{
-			Time:  windowMetadataRead.GetTimeTo().AsTime(),
+			Time:  amendedWindow.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+		Origin:   amendedWindow.GetOrigin(),
+		Metadata: amendedMetadataBytes,
+		IdempotencyKey: pgtype.Text{
+			String: idempotencyKey,
+			Valid:  idempotencyKey != "",
+		},
+		Supersedes: pgtype.Int8{
+			Int64: windowRow.ID,
 			Valid: true,
 		},
-		WindowTypeName:    windowMetadataRead.GetWindowType().GetName(),
-		WindowTypeVersion: windowMetadataRead.GetWindowType().GetVersion(),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("could not read window metadata: %w", err)
+		return nil, fmt.Errorf("could not amend window: %v", err)
 	}
+	slog.Debug("window amended", "window_id", windowRow.ID, "amended_window_id", amendedWindowId)
 
-	// Convert raw JSON blobs into []any of map[string]any
-	metadataList := make([]any, len(windowMetadata))
-	for i, raw := range windowMetadata {
-		var m map[string]any
-		if err := json.Unmarshal(raw, &m); err != nil {
-			return nil, fmt.Errorf("could not unmarshal metadata at index %d: %w", i, err)
-		}
-		metadataList[i] = m
+	unfinished, err := qtx.ReadUnfinishedWindowExecutions(ctx, windowRow.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read unfinished executions of window: %v", err)
 	}
 
-	// Build a protobuf ListValue directly from []any
-	listValue, err := structpb.NewList(metadataList)
+	emitStatus, err := d.triggerStoredWindow(ctx, tx, windowRow.WindowTypeID, amendedWindowId, false)
 	if err != nil {
-		return nil, fmt.Errorf("could not convert metadata list to protobuf ListValue: %w", err)
+		return emitStatus, err
 	}
-
-	return &pb.DistinctMetadataForWindowType{
-		Metadata: listValue,
-	}, tx.Commit(ctx)
+	// only once the window is superseded, so that a failed amendment leaves
+	// the window's executions running
+	d.cancelExecutions(ctx, unfinished)
+	go d.rollUpWindows(context.Background(), []*pb.Window{amendedWindow})
+	return emitStatus, nil
 }
 
-func (d *Datalayer) ReadWindowsForMetadata(
+// windowStatus reports the status of a window that has already been opened
+// or closed, for an emitter that is retrying
+func (d *Datalayer) windowStatus(
 	ctx context.Context,
-	windowsForMetadataRead *pb.WindowsForMetadataRead,
-) (*pb.WindowsForMetadata, error) {
-	tx, err := d.WithTx(ctx)
-	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
+	queries *Queries,
+	windowRow ReadWindowForUpdateRow,
+) (*pb.WindowEmitStatus, error) {
+	if windowRow.State == WindowStateOpen {
+		return &pb.WindowEmitStatus{
+			Status:    pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS,
+			Duplicate: true,
+		}, nil
+	}
+	execId, err := queries.ReadWindowExecId(ctx, windowRow.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &pb.WindowEmitStatus{
+			Status:    pb.WindowEmitStatus_NO_TRIGGERED_ALGORITHMS,
+			Duplicate: true,
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read execution of window: %v", err)
+	}
+	return &pb.WindowEmitStatus{
+		Status:    pb.WindowEmitStatus_PROCESSING_TRIGGERED,
+		ExecId:    execId,
+		Duplicate: true,
+	}, nil
+}
+
+// ResumeExecutions picks up execution plans and reprocesses left unfinished
+// by a previous run of Orca core (e.g. after a crash or restart) and
+// processes them in the background. Plans still leased to another running
+// instance are left to it
+func (d *Datalayer) ResumeExecutions(ctx context.Context) error {
+	if err := d.resumeExecutionPlans(ctx); err != nil {
+		return err
 	}
+	return d.resumeReprocesses(ctx)
+}
+
+// DeregisterProcessor removes a processor from Orca core. Its instances are
+// forgotten and its algorithms retired, along with their live dependents when
+// forced. The processor is revived if it registers again
+func (d *Datalayer) DeregisterProcessor(
+	ctx context.Context,
+	processorDeregistration *pb.ProcessorDeregistration,
+) error {
+	tx, err := d.WithTx(ctx)
 
 	defer func() {
 		if tx != nil {
@@ -691,82 +1202,55 @@ func (d *Datalayer) ReadWindowsForMetadata(
 		}
 	}()
 
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return err
+	}
 	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	metadataFields := windowsForMetadataRead.GetMetadata()
-	metadata := make(map[string]any, len(metadataFields))
-
-	for _, m := range metadataFields {
-		switch m.GetValue().GetKind().(type) {
-		case *structpb.Value_BoolValue:
-			metadata[m.Field] = m.Value.GetBoolValue()
-		case *structpb.Value_NumberValue:
-			metadata[m.Field] = m.Value.GetNumberValue()
-		case *structpb.Value_StringValue:
-			metadata[m.Field] = m.Value.GetStringValue()
-		case *structpb.Value_NullValue:
-			metadata[m.Field] = nil
-		case *structpb.Value_ListValue:
-			metadata[m.Field] = m.Value.GetListValue()
-		case *structpb.Value_StructValue:
-			metadata[m.Field] = m.Value.GetStructValue()
-		}
+	processorId, err := qtx.ReadLiveProcessorId(ctx, ReadLiveProcessorIdParams{
+		Name:    processorDeregistration.GetName(),
+		Runtime: processorDeregistration.GetRuntime(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf(
+			"%w: %v_%v",
+			types.ProcessorNotFound,
+			processorDeregistration.GetName(),
+			processorDeregistration.GetRuntime(),
+		)
 	}
-	metadataJson, err := json.Marshal(metadata)
 	if err != nil {
-		return nil, fmt.Errorf("could not parse metadata as json: %v", err)
+		return fmt.Errorf("could not read processor: %v", err)
 	}
 
-	rows, err := qtx.ReadWindowsForMetadata(ctx, ReadWindowsForMetadataParams{
-		WindowTypeName:    windowsForMetadataRead.GetWindow().GetName(),
-		WindowTypeVersion: windowsForMetadataRead.GetWindow().GetVersion(),
-		TimeFrom: pgtype.Timestamp{
-			Time:  windowsForMetadataRead.GetTimeFrom().AsTime(),
-			Valid: true,
-		},
-		TimeTo: pgtype.Timestamp{
-			Time:  windowsForMetadataRead.GetTimeTo().AsTime(),
-			Valid: true,
-		},
-		MetadataFilter: metadataJson,
-	})
+	algorithmIds, err := qtx.ReadLiveProcessorAlgorithmIds(ctx, processorId)
 	if err != nil {
-		return nil, fmt.Errorf("could not read rows: %v", err)
+		return fmt.Errorf("could not read algorithms of processor: %v", err)
 	}
-	result := pb.WindowsForMetadata{
-		Window: make([]*pb.Window, len(rows)),
-	}
-	for ii, row := range rows {
-		metadataStructPb, err := unmarshalToStruct(row.Metadata)
+	if len(algorithmIds) > 0 {
+		err = d.retireAlgorithms(ctx, qtx, algorithmIds, processorDeregistration.GetForce())
 		if err != nil {
-			return nil, fmt.Errorf(
-				"could not unmarshal postgres metadata jsonb to structpb: %v",
-				err,
-			)
-		}
-		result.Window[ii] = &pb.Window{
-			TimeFrom:          timestamppb.New(row.TimeFrom.Time),
-			TimeTo:            timestamppb.New(row.TimeTo.Time),
-			Origin:            row.Origin,
-			WindowTypeName:    row.Name,
-			WindowTypeVersion: row.Version,
-			Metadata:          metadataStructPb,
+			return err
 		}
 	}
 
-	return &result, tx.Commit(ctx)
+	err = qtx.DeregisterProcessor(ctx, processorId)
+	if err != nil {
+		return fmt.Errorf("could not deregister processor: %v", err)
+	}
+	return tx.Commit(ctx)
 }
 
-func (d *Datalayer) ReadResultsForAlgorithmAndMetadata(
+// RetireAlgorithm stops an algorithm from being executed, along with its live
+// dependents when forced. Results the algorithm has produced remain readable,
+// and it is revived if its processor registers it again
+func (d *Datalayer) RetireAlgorithm(
 	ctx context.Context,
-	resultsForAlgorithmAndMetadata *pb.ResultsForAlgorithmAndMetadataRead,
-) (*pb.ResultsForAlgorithmAndMetadata, error) {
+	algorithmRetirement *pb.AlgorithmRetirement,
+) error {
 	tx, err := d.WithTx(ctx)
-	if err != nil {
-		slog.Error("could not start a transaction", "error", err)
-		return nil, err
-	}
 
 	defer func() {
 		if tx != nil {
@@ -774,115 +1258,188 @@ func (d *Datalayer) ReadResultsForAlgorithmAndMetadata(
 		}
 	}()
 
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return err
+	}
 	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	metadataFields := resultsForAlgorithmAndMetadata.GetMetadata()
-	metadata := make(map[string]any, len(metadataFields))
+	algorithmId, err := qtx.ReadLiveAlgorithmId(ctx, ReadLiveAlgorithmIdParams{
+		Name:    algorithmRetirement.GetName(),
+		Version: algorithmRetirement.GetVersion(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf(
+			"%w: %v_%v",
+			types.AlgorithmNotFound,
+			algorithmRetirement.GetName(),
+			algorithmRetirement.GetVersion(),
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("could not read algorithm: %v", err)
+	}
 
-	for _, m := range metadataFields {
-		switch m.GetValue().GetKind().(type) {
-		case *structpb.Value_BoolValue:
-			metadata[m.Field] = m.Value.GetBoolValue()
-		case *structpb.Value_NumberValue:
-			metadata[m.Field] = m.Value.GetNumberValue()
-		case *structpb.Value_StringValue:
-			metadata[m.Field] = m.Value.GetStringValue()
-		case *structpb.Value_NullValue:
-			metadata[m.Field] = nil
-		case *structpb.Value_ListValue:
-			metadata[m.Field] = m.Value.GetListValue()
-		case *structpb.Value_StructValue:
-			metadata[m.Field] = m.Value.GetStructValue()
-		}
+	err = d.retireAlgorithms(ctx, qtx, []int64{algorithmId}, algorithmRetirement.GetForce())
+	if err != nil {
+		return err
 	}
-	metadataJson, err := json.Marshal(metadata)
+	return tx.Commit(ctx)
+}
+
+// RemoveAlgorithmDependency removes the dependency of one algorithm on
+// another. Unless forced, this is refused while executions of the dependent
+// algorithm are in progress, as they expect the dependency's results
+func (d *Datalayer) RemoveAlgorithmDependency(
+	ctx context.Context,
+	algorithmDependencyRemoval *pb.AlgorithmDependencyRemoval,
+) error {
+	tx, err := d.WithTx(ctx)
+
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
 	if err != nil {
-		return nil, fmt.Errorf("could not parse metadata as json: %v", err)
+		slog.Error("could not start a transaction", "error", err)
+		return err
 	}
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
 
-	rows, err := qtx.ReadResultsForAlgorithmAndMetadata(
-		ctx,
-		ReadResultsForAlgorithmAndMetadataParams{
-			TimeFrom: pgtype.Timestamp{
-				Time:  resultsForAlgorithmAndMetadata.GetTimeFrom().AsTime(),
-				Valid: true,
-			},
-			TimeTo: pgtype.Timestamp{
-				Time:  resultsForAlgorithmAndMetadata.GetTimeTo().AsTime(),
-				Valid: true,
-			},
-			MetadataFilter:   metadataJson,
-			AlgorithmName:    resultsForAlgorithmAndMetadata.GetAlgorithm().GetName(),
-			AlgorithmVersion: resultsForAlgorithmAndMetadata.GetAlgorithm().GetVersion(),
-		},
-	)
+	algorithmId, err := qtx.ReadLiveAlgorithmId(ctx, ReadLiveAlgorithmIdParams{
+		Name:    algorithmDependencyRemoval.GetAlgorithmName(),
+		Version: algorithmDependencyRemoval.GetAlgorithmVersion(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf(
+			"%w: %v_%v",
+			types.AlgorithmNotFound,
+			algorithmDependencyRemoval.GetAlgorithmName(),
+			algorithmDependencyRemoval.GetAlgorithmVersion(),
+		)
+	}
 	if err != nil {
-		return nil, fmt.Errorf(
-			"could not read results for algorithm: %v",
-			err,
+		return fmt.Errorf("could not read algorithm: %v", err)
+	}
+	dependencyId, err := qtx.ReadLiveAlgorithmId(ctx, ReadLiveAlgorithmIdParams{
+		Name:    algorithmDependencyRemoval.GetDependencyName(),
+		Version: algorithmDependencyRemoval.GetDependencyVersion(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf(
+			"%w: %v_%v",
+			types.AlgorithmNotFound,
+			algorithmDependencyRemoval.GetDependencyName(),
+			algorithmDependencyRemoval.GetDependencyVersion(),
 		)
 	}
-
-	resultsPb := pb.ResultsForAlgorithmAndMetadata{
-		Results: make([]*pb.ResultsForAlgorithmAndMetadata_ResultsRow, len(rows)),
+	if err != nil {
+		return fmt.Errorf("could not read dependency: %v", err)
 	}
-	var _midpointPb *timestamppb.Timestamp
-	if resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType() == pb.ResultType_VALUE {
-		for ii, res := range rows {
-			_midpointPb = timestamppb.New(
-				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
-			)
 
-			resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
-				Time: _midpointPb,
-				ResultData: &pb.ResultsForAlgorithmAndMetadata_ResultsRow_SingleValue{
-					SingleValue: float32(res.ResultValue.Float64),
-				},
-			}
+	if !algorithmDependencyRemoval.GetForce() {
+		unfinished, err := qtx.CountUnfinishedExecutionsOfAlgorithm(ctx, algorithmId)
+		if err != nil {
+			return fmt.Errorf("could not count unfinished executions: %v", err)
 		}
-	} else if resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType() == pb.ResultType_ARRAY {
-		for ii, res := range rows {
-			_midpointPb = timestamppb.New(
-				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
+		if unfinished > 0 {
+			return fmt.Errorf(
+				"%w: %v executions of %v_%v are unfinished",
+				types.AlgorithmExecutionsInProgress,
+				unfinished,
+				algorithmDependencyRemoval.GetAlgorithmName(),
+				algorithmDependencyRemoval.GetAlgorithmVersion(),
 			)
-			resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
-				Time: _midpointPb,
-				ResultData: &pb.ResultsForAlgorithmAndMetadata_ResultsRow_ArrayValues{
-					ArrayValues: &pb.FloatArray{
-						Values: convertFloat64ToFloat32(res.ResultArray),
-					},
-				},
-			}
 		}
-	} else if resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType() == pb.ResultType_STRUCT {
-		for ii, res := range rows {
-			_midpointPb = timestamppb.New(
-				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
-			)
-			newStruct, err := unmarshalToStruct(res.ResultJson)
-			if err != nil {
-				return nil, fmt.Errorf("unable to parse struct data for algorithm %v: %v", resultsForAlgorithmAndMetadata.GetAlgorithm(), err)
-			}
+	}
 
-			resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
-				Time: _midpointPb,
-				ResultData: &pb.ResultsForAlgorithmAndMetadata_ResultsRow_StructValue{
-					StructValue: newStruct,
-				},
+	removed, err := qtx.DeleteAlgorithmDependency(ctx, DeleteAlgorithmDependencyParams{
+		FromAlgorithmID: dependencyId,
+		ToAlgorithmID:   algorithmId,
+	})
+	if err != nil {
+		return fmt.Errorf("could not remove algorithm dependency: %v", err)
+	}
+	if removed == 0 {
+		return fmt.Errorf(
+			"%w: %v_%v does not depend on %v_%v",
+			types.AlgorithmDependencyNotFound,
+			algorithmDependencyRemoval.GetAlgorithmName(),
+			algorithmDependencyRemoval.GetAlgorithmVersion(),
+			algorithmDependencyRemoval.GetDependencyName(),
+			algorithmDependencyRemoval.GetDependencyVersion(),
+		)
+	}
+	return tx.Commit(ctx)
+}
+
+// SubscribeTasks delivers the tasks of a registered processor through send,
+// until the context is done or sending fails. While subscribed, the processor
+// is delivered its tasks rather than being dialled out to
+func (d *Datalayer) SubscribeTasks(
+	ctx context.Context,
+	taskSubscription *pb.TaskSubscription,
+	send func(*pb.ProcessingTask) error,
+) error {
+	processorId, err := d.queries.ReadLiveProcessorId(ctx, ReadLiveProcessorIdParams{
+		Name:    taskSubscription.GetName(),
+		Runtime: taskSubscription.GetRuntime(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf(
+			"%w: %v_%v",
+			types.ProcessorNotFound,
+			taskSubscription.GetName(),
+			taskSubscription.GetRuntime(),
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("could not read processor: %v", err)
+	}
+
+	sub, unsubscribe := d.subscriptions.subscribe(processorId)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case task := <-sub.tasks:
+			err := send(task)
+			if err != nil {
+				slog.Warn(
+					"could not send task to subscribed processor",
+					"processor",
+					taskSubscription.GetName(),
+					"task_id",
+					task.GetTaskId(),
+					"error",
+					err,
+				)
+				return err
 			}
 		}
-	} else {
-		return nil, fmt.Errorf("unhandled result type: %v", resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType())
 	}
-	return &resultsPb, tx.Commit(ctx)
 }
 
-// Annotate a section of time
-func (d *Datalayer) Annotate(
+// SubmitResult hands the result of a task to the execution awaiting it
+func (d *Datalayer) SubmitResult(ctx context.Context, taskResult *pb.TaskResult) error {
+	return d.subscriptions.submit(taskResult.GetTaskId(), taskResult.GetResult())
+}
+
+// ReadExecutionQueue reports the depth and saturation of the execution pool
+func (d *Datalayer) ReadExecutionQueue(ctx context.Context) (*pb.ExecutionQueue, error) {
+	return d.pool.stats(), nil
+}
+
+// ReadWindowTypes reads the types of windows registered with Orca core
+func (d *Datalayer) ReadWindowTypes(
 	ctx context.Context,
-	annotateWrite *pb.AnnotateWrite,
-) (*pb.AnnotateResponse, error) {
+) (*pb.WindowTypes, error) {
 	tx, err := d.WithTx(ctx)
 	if err != nil {
 		slog.Error("could not start a transaction", "error", err)
@@ -898,55 +1455,1643 @@ func (d *Datalayer) Annotate(
 	pgTx := tx.(*PgTx)
 	qtx := d.queries.WithTx(pgTx.tx)
 
-	// insert annotation
-	metadata := annotateWrite.GetMetadata()
-	metadataJson, err := metadata.MarshalJSON()
-	if err != nil {
-		return nil, fmt.Errorf("could not marshal provided metadata to json: %v", err)
-	}
-	annotationId, err := qtx.CreateAnnotation(ctx, CreateAnnotationParams{
-		TimeFrom: pgtype.Timestamp{
-			Time:  annotateWrite.GetTimeFrom().AsTime(),
-			Valid: true,
-		},
-		TimeTo: pgtype.Timestamp{
-			Time:  annotateWrite.GetTimeTo().AsTime(),
-			Valid: true,
-		},
-		Description: pgtype.Text{
-			String: annotateWrite.GetDescription(),
-			Valid:  true,
-		},
-		Metadata: metadataJson,
-	})
+	windowTypes, err := qtx.ReadWindowTypes(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("could not create annotation: %v", err)
+		return &pb.WindowTypes{}, fmt.Errorf("could not read window types: %v", err)
 	}
 
-	// link annotation to window type
+	windowTypesPb := pb.WindowTypes{
+		Windows: make([]*pb.WindowType, len(windowTypes)),
+	}
+
+	for ii, window := range windowTypes {
+		windowTypesPb.Windows[ii] = &pb.WindowType{
+			Name:        window.Name,
+			Version:     window.Version,
+			Description: window.Description,
+		}
+	}
+	return &windowTypesPb, tx.Commit(ctx)
+}
+
+// ReadAlgorithms read the agorithms registered with Orca core
+func (d *Datalayer) ReadAlgorithms(
+	ctx context.Context,
+) (*pb.Algorithms, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	algorithms, err := qtx.ReadAlgorithms(ctx)
+	if err != nil {
+		return &pb.Algorithms{}, fmt.Errorf("could not read algorithms: %v", err)
+	}
+
+	algorithmsPb := pb.Algorithms{
+		Algorithm: make([]*pb.Algorithm, len(algorithms)),
+	}
+
+	for ii, algorithm := range algorithms {
+		var resultType pb.ResultType
+		switch algorithm.ResultType {
+		case ResultTypeNone:
+			resultType = pb.ResultType_NONE
+		case ResultTypeArray:
+			resultType = pb.ResultType_ARRAY
+		case ResultTypeStruct:
+			resultType = pb.ResultType_STRUCT
+		case ResultTypeValue:
+			resultType = pb.ResultType_VALUE
+		default:
+			return &pb.Algorithms{}, fmt.Errorf(
+				"read a result type that is not supported: %v",
+				algorithm.ResultType,
+			)
+		}
+
+		algorithmsPb.Algorithm[ii] = &pb.Algorithm{
+			Name:    algorithm.Name,
+			Version: algorithm.Version,
+			WindowType: &pb.WindowType{
+				Name:    algorithm.WindowName,
+				Version: algorithm.WindowVersion,
+			},
+			ResultType: resultType,
+		}
+	}
+	return &algorithmsPb, tx.Commit(ctx)
+}
+
+// ReadProcessors reads processors registered with Orca core
+func (d *Datalayer) ReadProcessors(
+	ctx context.Context,
+) (*pb.Processors, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	processors, err := qtx.ReadProcessors(ctx)
+	if err != nil {
+		return &pb.Processors{}, fmt.Errorf("could not read processors: %v", err)
+	}
+
+	processorsPb := pb.Processors{
+		Processor: make([]*pb.Processors_Processor, len(processors)),
+	}
+
+	for ii, processor := range processors {
+		instances, err := qtx.ReadProcessorInstances(ctx, processor.ID)
+		if err != nil {
+			return &pb.Processors{}, fmt.Errorf("could not read processor instances: %v", err)
+		}
+
+		processorPb := &pb.Processors_Processor{
+			Name:            processor.Name,
+			Runtime:         processor.Runtime,
+			ConnectionState: pb.ConnectionState_CONNECTION_STATE_DISCONNECTED,
+			Instances:       make([]*pb.Processors_Instance, len(instances)),
+		}
+		for jj, instance := range instances {
+			healthStatus, metrics := instanceHealthToPb(instance)
+			instancePb := &pb.Processors_Instance{
+				InstanceId:      instance.InstanceID,
+				ConnectionStr:   instance.ConnectionString,
+				ConnectionState: d.connections.state(instance.ID),
+				Registered:      timestamppb.New(instance.Registered.Time),
+				Status:          healthStatus,
+				StatusMessage:   instance.HealthMessage.String,
+				Metrics:         metrics,
+				Available:       instance.Available,
+			}
+			if instance.LastSeen.Valid {
+				instancePb.LastSeen = timestamppb.New(instance.LastSeen.Time)
+			}
+			processorPb.Instances[jj] = instancePb
+
+			// the processor reports the health of its most recently seen instance
+			if instancePb.GetLastSeen().AsTime().After(processorPb.GetLastSeen().AsTime()) {
+				processorPb.LastSeen = instancePb.GetLastSeen()
+				processorPb.Status = instancePb.GetStatus()
+				processorPb.StatusMessage = instancePb.GetStatusMessage()
+				processorPb.Metrics = instancePb.GetMetrics()
+			}
+			processorPb.Available = processorPb.GetAvailable() || instance.Available
+		}
+		// instances are ordered from the most recently registered
+		if len(instances) > 0 {
+			processorPb.ConnectionState = processorPb.Instances[0].GetConnectionState()
+		}
+		processorsPb.Processor[ii] = processorPb
+	}
+	return &processorsPb, tx.Commit(ctx)
+}
+
+func (d *Datalayer) ReadResultsStats(
+	ctx context.Context,
+) (*pb.ResultsStats, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	resultsStats, err := qtx.ReadResultsStats(ctx)
+	if err != nil {
+		return &pb.ResultsStats{}, fmt.Errorf("could not read results: %v", err)
+	}
+
+	resultsStatsPb := pb.ResultsStats{
+		Count: resultsStats,
+	}
+
+	return &resultsStatsPb, tx.Commit(ctx)
+}
+
+func (d *Datalayer) ReadResultFieldsForAlgorithm(
+	ctx context.Context,
+	resultFieldsRead *pb.AlgorithmFieldsRead,
+) (*pb.AlgorithmFields, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	algorithmFields, err := qtx.ReadDistinctJsonResultFieldsForAlgorithm(
+		ctx,
+		ReadDistinctJsonResultFieldsForAlgorithmParams{
+			TimeFrom: pgtype.Timestamp{
+				Time:  resultFieldsRead.GetTimeFrom().AsTime().UTC(),
+				Valid: true,
+			},
+			TimeTo: pgtype.Timestamp{
+				Time:  resultFieldsRead.GetTimeTo().AsTime().UTC(),
+				Valid: true,
+			},
+			AlgorithmName:    resultFieldsRead.GetAlgorithm().GetName(),
+			AlgorithmVersion: resultFieldsRead.GetAlgorithm().GetVersion(),
+		},
+	)
+	if err != nil {
+		return &pb.AlgorithmFields{}, fmt.Errorf("could not read results: %v", err)
+	}
+	algorithmFieldsResult := pb.AlgorithmFields{
+		Field: make([]string, len(algorithmFields)),
+	}
+	copy(algorithmFieldsResult.Field, algorithmFields)
+	return &algorithmFieldsResult, tx.Commit(ctx)
+}
+
+func (d *Datalayer) ReadResultsForAlgorithm(
+	ctx context.Context,
+	resultsForAlgorithmRead *pb.ResultsForAlgorithmRead,
+) (*pb.ResultsForAlgorithm, error) {
+	if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_NONE {
+		return &pb.ResultsForAlgorithm{}, fmt.Errorf(
+			"cannot get results for algorithm that does not produce results",
+		)
+	}
+
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	results, err := qtx.ReadResultsForAlgorithm(ctx, ReadResultsForAlgorithmParams{
+		TimeFrom: pgtype.Timestamp{
+			Time:  resultsForAlgorithmRead.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  resultsForAlgorithmRead.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+		AlgorithmName:     resultsForAlgorithmRead.GetAlgorithm().GetName(),
+		AlgorithmVersion:  resultsForAlgorithmRead.GetAlgorithm().GetVersion(),
+		IncludeSuperseded: resultsForAlgorithmRead.GetIncludeSuperseded(),
+	})
+	if err != nil {
+		return &pb.ResultsForAlgorithm{}, fmt.Errorf(
+			"could not read results for algorithm: %v",
+			err,
+		)
+	}
+	resultsPb := pb.ResultsForAlgorithm{
+		Results: make([]*pb.ResultsForAlgorithm_ResultsRow, len(results)),
+	}
+	var _midpointPb *timestamppb.Timestamp
+	if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_VALUE {
+		for ii, res := range results {
+			_midpointPb = timestamppb.New(
+				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
+			)
+			if res.Status != ResultStatusSucceeded {
+				resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
+					Time:         _midpointPb,
+					Status:       resultStatusToPb(res.Status),
+					ErrorMessage: res.ErrorMessage.String,
+					Superseded:   res.Superseded,
+				}
+				continue
+			}
+
+			resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
+				Time:       _midpointPb,
+				Status:     pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+				Superseded: res.Superseded,
+				ResultData: &pb.ResultsForAlgorithm_ResultsRow_SingleValue{
+					SingleValue: float32(res.ResultValue.Float64),
+				},
+			}
+		}
+	} else if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_ARRAY {
+		for ii, res := range results {
+			_midpointPb = timestamppb.New(
+				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
+			)
+			if res.Status != ResultStatusSucceeded {
+				resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
+					Time:         _midpointPb,
+					Status:       resultStatusToPb(res.Status),
+					ErrorMessage: res.ErrorMessage.String,
+					Superseded:   res.Superseded,
+				}
+				continue
+			}
+			resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
+				Time:       _midpointPb,
+				Status:     pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+				Superseded: res.Superseded,
+				ResultData: &pb.ResultsForAlgorithm_ResultsRow_ArrayValues{
+					ArrayValues: &pb.FloatArray{
+						Values: convertFloat64ToFloat32(res.ResultArray),
+					},
+				},
+			}
+		}
+	} else if resultsForAlgorithmRead.GetAlgorithm().GetResultType() == pb.ResultType_STRUCT {
+		for ii, res := range results {
+			_midpointPb = timestamppb.New(
+				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
+			)
+			if res.Status != ResultStatusSucceeded {
+				resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
+					Time:         _midpointPb,
+					Status:       resultStatusToPb(res.Status),
+					ErrorMessage: res.ErrorMessage.String,
+					Superseded:   res.Superseded,
+				}
+				continue
+			}
+			newStruct, err := unmarshalToStruct(res.ResultJson)
+			if err != nil {
+				return &pb.ResultsForAlgorithm{}, fmt.Errorf("unable to parse struct data for algorithm %v: %v", resultsForAlgorithmRead.Algorithm, err)
+			}
+
+			resultsPb.Results[ii] = &pb.ResultsForAlgorithm_ResultsRow{
+				Time:       _midpointPb,
+				Status:     pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+				Superseded: res.Superseded,
+				ResultData: &pb.ResultsForAlgorithm_ResultsRow_StructValue{
+					StructValue: newStruct,
+				},
+			}
+		}
+	} else {
+		return &pb.ResultsForAlgorithm{}, fmt.Errorf("unhandled result type: %v", resultsForAlgorithmRead.GetAlgorithm().GetResultType())
+	}
+	return &resultsPb, tx.Commit(ctx)
+}
+
+func (d *Datalayer) ReadWindows(
+	ctx context.Context,
+	windowsRead *pb.WindowsRead,
+) (*pb.Windows, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	readWindowsRows, err := qtx.ReadWindows(ctx, ReadWindowsParams{
+		TimeFrom: pgtype.Timestamp{
+			Time:  windowsRead.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  windowsRead.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+		WindowTypeName:    windowsRead.GetWindow().GetName(),
+		WindowTypeVersion: windowsRead.GetWindow().GetVersion(),
+		IncludeSuperseded: windowsRead.GetIncludeSuperseded(),
+	})
+	if err != nil {
+		return &pb.Windows{}, fmt.Errorf("could not read windows: %v", err)
+	}
+	windowsPb := pb.Windows{
+		Window: make([]*pb.Window, len(readWindowsRows)),
+	}
+
+	var _timeFrom *timestamppb.Timestamp
+	var _timeTo *timestamppb.Timestamp
+	for ii, windowRow := range readWindowsRows {
+		metadata, err := unmarshalToStruct(windowRow.Metadata)
+		if err != nil {
+			return &pb.Windows{}, fmt.Errorf("could not unpack specific window metadata: %v", err)
+		}
+		_timeFrom = timestamppb.New(windowRow.TimeFrom.Time)
+		_timeTo = timestamppb.New(windowRow.TimeTo.Time)
+
+		windowsPb.Window[ii] = &pb.Window{
+			TimeFrom:          _timeFrom,
+			TimeTo:            _timeTo,
+			Origin:            windowRow.Origin,
+			Metadata:          metadata,
+			WindowTypeName:    windowRow.Name,
+			WindowTypeVersion: windowRow.Version,
+		}
+	}
+	return &windowsPb, tx.Commit(ctx)
+}
+
+func (d *Datalayer) ReadDistinctMetadataForWindowType(
+	ctx context.Context,
+	windowMetadataRead *pb.DistinctMetadataForWindowTypeRead,
+) (*pb.DistinctMetadataForWindowType, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	windowMetadata, err := qtx.ReadDistinctWindowMetadata(ctx, ReadDistinctWindowMetadataParams{
+		TimeFrom: pgtype.Timestamp{
+			Time:  windowMetadataRead.GetTimeFrom().AsTime(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  windowMetadataRead.GetTimeTo().AsTime(),
+			Valid: true,
+		},
+		WindowTypeName:    windowMetadataRead.GetWindowType().GetName(),
+		WindowTypeVersion: windowMetadataRead.GetWindowType().GetVersion(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read window metadata: %w", err)
+	}
+
+	// Convert raw JSON blobs into []any of map[string]any
+	metadataList := make([]any, len(windowMetadata))
+	for i, raw := range windowMetadata {
+		var m map[string]any
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return nil, fmt.Errorf("could not unmarshal metadata at index %d: %w", i, err)
+		}
+		metadataList[i] = m
+	}
+
+	// Build a protobuf ListValue directly from []any
+	listValue, err := structpb.NewList(metadataList)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert metadata list to protobuf ListValue: %w", err)
+	}
+
+	return &pb.DistinctMetadataForWindowType{
+		Metadata: listValue,
+	}, tx.Commit(ctx)
+}
+
+func (d *Datalayer) ReadWindowsForMetadata(
+	ctx context.Context,
+	windowsForMetadataRead *pb.WindowsForMetadataRead,
+) (*pb.WindowsForMetadata, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	metadataFields := windowsForMetadataRead.GetMetadata()
+	metadata := make(map[string]any, len(metadataFields))
+
+	for _, m := range metadataFields {
+		switch m.GetValue().GetKind().(type) {
+		case *structpb.Value_BoolValue:
+			metadata[m.Field] = m.Value.GetBoolValue()
+		case *structpb.Value_NumberValue:
+			metadata[m.Field] = m.Value.GetNumberValue()
+		case *structpb.Value_StringValue:
+			metadata[m.Field] = m.Value.GetStringValue()
+		case *structpb.Value_NullValue:
+			metadata[m.Field] = nil
+		case *structpb.Value_ListValue:
+			metadata[m.Field] = m.Value.GetListValue()
+		case *structpb.Value_StructValue:
+			metadata[m.Field] = m.Value.GetStructValue()
+		}
+	}
+	metadataJson, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse metadata as json: %v", err)
+	}
+
+	rows, err := qtx.ReadWindowsForMetadata(ctx, ReadWindowsForMetadataParams{
+		WindowTypeName:    windowsForMetadataRead.GetWindow().GetName(),
+		WindowTypeVersion: windowsForMetadataRead.GetWindow().GetVersion(),
+		TimeFrom: pgtype.Timestamp{
+			Time:  windowsForMetadataRead.GetTimeFrom().AsTime(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  windowsForMetadataRead.GetTimeTo().AsTime(),
+			Valid: true,
+		},
+		MetadataFilter: metadataJson,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read rows: %v", err)
+	}
+	result := pb.WindowsForMetadata{
+		Window: make([]*pb.Window, len(rows)),
+	}
+	for ii, row := range rows {
+		metadataStructPb, err := unmarshalToStruct(row.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not unmarshal postgres metadata jsonb to structpb: %v",
+				err,
+			)
+		}
+		result.Window[ii] = &pb.Window{
+			TimeFrom:          timestamppb.New(row.TimeFrom.Time),
+			TimeTo:            timestamppb.New(row.TimeTo.Time),
+			Origin:            row.Origin,
+			WindowTypeName:    row.Name,
+			WindowTypeVersion: row.Version,
+			Metadata:          metadataStructPb,
+		}
+	}
+
+	return &result, tx.Commit(ctx)
+}
+
+func (d *Datalayer) ReadResultsForAlgorithmAndMetadata(
+	ctx context.Context,
+	resultsForAlgorithmAndMetadata *pb.ResultsForAlgorithmAndMetadataRead,
+) (*pb.ResultsForAlgorithmAndMetadata, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	metadataFields := resultsForAlgorithmAndMetadata.GetMetadata()
+	metadata := make(map[string]any, len(metadataFields))
+
+	for _, m := range metadataFields {
+		switch m.GetValue().GetKind().(type) {
+		case *structpb.Value_BoolValue:
+			metadata[m.Field] = m.Value.GetBoolValue()
+		case *structpb.Value_NumberValue:
+			metadata[m.Field] = m.Value.GetNumberValue()
+		case *structpb.Value_StringValue:
+			metadata[m.Field] = m.Value.GetStringValue()
+		case *structpb.Value_NullValue:
+			metadata[m.Field] = nil
+		case *structpb.Value_ListValue:
+			metadata[m.Field] = m.Value.GetListValue()
+		case *structpb.Value_StructValue:
+			metadata[m.Field] = m.Value.GetStructValue()
+		}
+	}
+	metadataJson, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse metadata as json: %v", err)
+	}
+
+	rows, err := qtx.ReadResultsForAlgorithmAndMetadata(
+		ctx,
+		ReadResultsForAlgorithmAndMetadataParams{
+			TimeFrom: pgtype.Timestamp{
+				Time:  resultsForAlgorithmAndMetadata.GetTimeFrom().AsTime(),
+				Valid: true,
+			},
+			TimeTo: pgtype.Timestamp{
+				Time:  resultsForAlgorithmAndMetadata.GetTimeTo().AsTime(),
+				Valid: true,
+			},
+			MetadataFilter:   metadataJson,
+			AlgorithmName:    resultsForAlgorithmAndMetadata.GetAlgorithm().GetName(),
+			AlgorithmVersion: resultsForAlgorithmAndMetadata.GetAlgorithm().GetVersion(),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not read results for algorithm: %v",
+			err,
+		)
+	}
+
+	resultsPb := pb.ResultsForAlgorithmAndMetadata{
+		Results: make([]*pb.ResultsForAlgorithmAndMetadata_ResultsRow, len(rows)),
+	}
+	var _midpointPb *timestamppb.Timestamp
+	if resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType() == pb.ResultType_VALUE {
+		for ii, res := range rows {
+			_midpointPb = timestamppb.New(
+				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
+			)
+			if res.Status != ResultStatusSucceeded {
+				resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
+					Time:         _midpointPb,
+					Status:       resultStatusToPb(res.Status),
+					ErrorMessage: res.ErrorMessage.String,
+				}
+				continue
+			}
+
+			resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
+				Time:   _midpointPb,
+				Status: pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+				ResultData: &pb.ResultsForAlgorithmAndMetadata_ResultsRow_SingleValue{
+					SingleValue: float32(res.ResultValue.Float64),
+				},
+			}
+		}
+	} else if resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType() == pb.ResultType_ARRAY {
+		for ii, res := range rows {
+			_midpointPb = timestamppb.New(
+				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
+			)
+			if res.Status != ResultStatusSucceeded {
+				resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
+					Time:         _midpointPb,
+					Status:       resultStatusToPb(res.Status),
+					ErrorMessage: res.ErrorMessage.String,
+				}
+				continue
+			}
+			resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
+				Time:   _midpointPb,
+				Status: pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+				ResultData: &pb.ResultsForAlgorithmAndMetadata_ResultsRow_ArrayValues{
+					ArrayValues: &pb.FloatArray{
+						Values: convertFloat64ToFloat32(res.ResultArray),
+					},
+				},
+			}
+		}
+	} else if resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType() == pb.ResultType_STRUCT {
+		for ii, res := range rows {
+			_midpointPb = timestamppb.New(
+				res.TimeFrom.Time.Add(res.TimeTo.Time.Sub(res.TimeFrom.Time) / 2),
+			)
+			if res.Status != ResultStatusSucceeded {
+				resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
+					Time:         _midpointPb,
+					Status:       resultStatusToPb(res.Status),
+					ErrorMessage: res.ErrorMessage.String,
+				}
+				continue
+			}
+			newStruct, err := unmarshalToStruct(res.ResultJson)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse struct data for algorithm %v: %v", resultsForAlgorithmAndMetadata.GetAlgorithm(), err)
+			}
+
+			resultsPb.Results[ii] = &pb.ResultsForAlgorithmAndMetadata_ResultsRow{
+				Time:   _midpointPb,
+				Status: pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+				ResultData: &pb.ResultsForAlgorithmAndMetadata_ResultsRow_StructValue{
+					StructValue: newStruct,
+				},
+			}
+		}
+	} else {
+		return nil, fmt.Errorf("unhandled result type: %v", resultsForAlgorithmAndMetadata.GetAlgorithm().GetResultType())
+	}
+	return &resultsPb, tx.Commit(ctx)
+}
+
+// SubscribeResults sends results through send as they are written, from the
+// cursor to resume after, until the context is done or sending fails. Results
+// are read from the database at the subscriber's own pace
+func (d *Datalayer) SubscribeResults(
+	ctx context.Context,
+	resultsSubscription *pb.ResultsSubscription,
+	send func(*pb.ResultUpdate) error,
+) error {
+	params := ReadResultsAfterParams{
+		After:             resultsSubscription.GetResumeAfter(),
+		AlgorithmNames:    make([]string, len(resultsSubscription.GetAlgorithms())),
+		AlgorithmVersions: make([]string, len(resultsSubscription.GetAlgorithms())),
+		PageSize:          resultPageSize,
+	}
+	for ii, algorithm := range resultsSubscription.GetAlgorithms() {
+		params.AlgorithmNames[ii] = algorithm.GetName()
+		params.AlgorithmVersions[ii] = algorithm.GetVersion()
+	}
+	if windowType := resultsSubscription.GetWindowType(); windowType != nil {
+		params.WindowTypeName = pgtype.Text{String: windowType.GetName(), Valid: true}
+		params.WindowTypeVersion = pgtype.Text{String: windowType.GetVersion(), Valid: true}
+	}
+
+	metadata := make(map[string]any, len(resultsSubscription.GetMetadata()))
+	for _, m := range resultsSubscription.GetMetadata() {
+		metadata[m.GetField()] = m.GetValue().AsInterface()
+	}
+	metadataJson, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("could not parse metadata as json: %v", err)
+	}
+	params.Metadata = metadataJson
+
+	// subscribe before reading where to start from, so that no result
+	// written in between is missed
+	notify, unsubscribe := d.results.subscribe()
+	defer unsubscribe()
+
+	if params.After == 0 {
+		// results written before subscribing, while they were left
+		// unnumbered, are not streamed
+		if err := d.sequenceResults(ctx); err != nil {
+			return err
+		}
+		params.After, err = d.queries.ReadLatestResultSeq(ctx)
+		if err != nil {
+			return fmt.Errorf("could not read latest result: %v", err)
+		}
+	}
+
+	for {
+		rows, err := d.queries.ReadResultsAfter(ctx, params)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("could not read results: %v", err)
+		}
+		for _, row := range rows {
+			update, err := resultUpdateToPb(row)
+			if err != nil {
+				return err
+			}
+			err = send(update)
+			if err != nil {
+				return err
+			}
+			params.After = row.Seq
+		}
+
+		// a full page means there are more results to catch up on
+		if len(rows) == int(params.PageSize) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+		}
+	}
+}
+
+// Annotate a section of time
+func (d *Datalayer) Annotate(
+	ctx context.Context,
+	annotateWrite *pb.AnnotateWrite,
+) (*pb.AnnotateResponse, error) {
+	tx, err := d.WithTx(ctx)
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	// insert annotation
+	metadata := annotateWrite.GetMetadata()
+	metadataJson, err := metadata.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal provided metadata to json: %v", err)
+	}
+	annotationId, err := qtx.CreateAnnotation(ctx, CreateAnnotationParams{
+		TimeFrom: pgtype.Timestamp{
+			Time:  annotateWrite.GetTimeFrom().AsTime(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  annotateWrite.GetTimeTo().AsTime(),
+			Valid: true,
+		},
+		Description: pgtype.Text{
+			String: annotateWrite.GetDescription(),
+			Valid:  true,
+		},
+		Metadata: metadataJson,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create annotation: %v", err)
+	}
+
+	// link annotation to window type
 	for _, capturedWindow := range annotateWrite.GetCapturedWindows() {
 
-		err := qtx.LinkAnnotationToWindowType(ctx, LinkAnnotationToWindowTypeParams{
-			AnnotationID:  annotationId,
-			WindowName:    capturedWindow.GetName(),
-			WindowVersion: capturedWindow.GetVersion(),
-		})
+		err := qtx.LinkAnnotationToWindowType(ctx, LinkAnnotationToWindowTypeParams{
+			AnnotationID:  annotationId,
+			WindowName:    capturedWindow.GetName(),
+			WindowVersion: capturedWindow.GetVersion(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not link annotation to window: %v", err)
+		}
+	}
+
+	// link annotation to algorithm type
+	for _, capturedAlgorithm := range annotateWrite.GetCapturedAlgorithms() {
+		err := qtx.LinkAnnotationToAlgorithm(ctx, LinkAnnotationToAlgorithmParams{
+			AnnotationID:     annotationId,
+			AlgorithmName:    capturedAlgorithm.GetName(),
+			AlgorithmVersion: capturedAlgorithm.GetVersion(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not link annotation to algorithm: %v", err)
+		}
+	}
+
+	return &pb.AnnotateResponse{}, tx.Commit(ctx)
+}
+
+// ReadExecution reads the state of the execution triggered by an emitted window
+func (d *Datalayer) ReadExecution(
+	ctx context.Context,
+	executionRead *pb.ExecutionRead,
+) (*pb.Execution, error) {
+	executionRow, err := d.queries.ReadExecution(ctx, executionRead.GetExecId())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %v", types.ExecutionNotFound, executionRead.GetExecId())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read execution: %v", err)
+	}
+
+	executions, err := d.readExecutionsPb(ctx, []ReadExecutionsRow{ReadExecutionsRow(executionRow)})
+	if err != nil {
+		return nil, err
+	}
+	return executions[0], nil
+}
+
+// CancelExecution cancels an execution that has not yet finished. Work in
+// flight on processors is cancelled along with it, whereas an execution being
+// processed by another instance of orca-core stops before its next stage or
+// task
+func (d *Datalayer) CancelExecution(
+	ctx context.Context,
+	executionCancel *pb.ExecutionCancel,
+) error {
+	executionRow, err := d.queries.ReadExecution(ctx, executionCancel.GetExecId())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %v", types.ExecutionNotFound, executionCancel.GetExecId())
+	}
+	if err != nil {
+		return fmt.Errorf("could not read execution: %v", err)
+	}
+	if executionRow.Status != ExecutionStatusPending && executionRow.Status != ExecutionStatusRunning {
+		return fmt.Errorf("%w: %v", types.ExecutionFinished, executionRow.Status)
+	}
+
+	// the goroutine processing the execution records its cancellation
+	if d.inFlight.cancel(executionCancel.GetExecId()) {
+		return nil
+	}
+
+	// otherwise the execution is waiting to be resumed, or is being processed
+	// by another instance of orca-core, so is marked cancelled here
+	d.cancelUnfinishedExecutionWork(ctx, executionRow.ID, errExecutionCancelled)
+	d.setExecutionPlanStatus(ctx, executionRow.ID, ExecutionStatusCancelled)
+	return nil
+}
+
+// ReadExecutions reads the state of the executions triggered by windows
+// within a time range
+func (d *Datalayer) ReadExecutions(
+	ctx context.Context,
+	executionsRead *pb.ExecutionsRead,
+) (*pb.Executions, error) {
+	params := ReadExecutionsParams{
+		TimeFrom: pgtype.Timestamp{
+			Time:  executionsRead.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  executionsRead.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+	}
+	if executionsRead.GetWindow().GetName() != "" {
+		params.WindowTypeName = pgtype.Text{String: executionsRead.GetWindow().GetName(), Valid: true}
+	}
+	if executionsRead.GetWindow().GetVersion() != "" {
+		params.WindowTypeVersion = pgtype.Text{String: executionsRead.GetWindow().GetVersion(), Valid: true}
+	}
+	if executionsRead.GetStatus() != pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED {
+		status, err := executionStatusFromPb(executionsRead.GetStatus())
+		if err != nil {
+			return nil, err
+		}
+		params.Status = NullExecutionStatus{ExecutionStatus: status, Valid: true}
+	}
+
+	executionRows, err := d.queries.ReadExecutions(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("could not read executions: %v", err)
+	}
+
+	executions, err := d.readExecutionsPb(ctx, executionRows)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Executions{Executions: executions}, nil
+}
+
+// ReprocessWindows starts reprocessing the stored windows of a window type in
+// the background, returning its progress so far
+func (d *Datalayer) ReprocessWindows(
+	ctx context.Context,
+	windowsReprocess *pb.WindowsReprocess,
+) (*pb.Reprocess, error) {
+	windowType := windowsReprocess.GetWindow()
+
+	algorithmIds, err := d.readAlgorithmIdsForWindowType(
+		ctx,
+		d.queries,
+		windowType.GetName(),
+		windowType.GetVersion(),
+		windowsReprocess.GetAlgorithms(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataBytes := []byte("{}")
+	if windowsReprocess.GetMetadata() != nil {
+		metadataBytes, err = windowsReprocess.GetMetadata().MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal metadata: %v", err)
+		}
+	}
+
+	windowsPerSecond := windowsReprocess.GetWindowsPerSecond()
+	if windowsPerSecond == 0 {
+		windowsPerSecond = envs.GetConfig().ReprocessRate
+	}
+
+	reprocessRow, err := d.queries.CreateReprocess(ctx, CreateReprocessParams{
+		TimeFrom: pgtype.Timestamp{
+			Time:  windowsReprocess.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  windowsReprocess.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+		Metadata:          metadataBytes,
+		AlgorithmIds:      algorithmIds,
+		WindowsPerSecond:  windowsPerSecond,
+		WindowTypeName:    windowType.GetName(),
+		WindowTypeVersion: windowType.GetVersion(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf(
+			"window type does not exist: %v_%v",
+			windowType.GetName(),
+			windowType.GetVersion(),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not create reprocess: %v", err)
+	}
+
+	go d.runReprocess(reprocessRow.ID)
+
+	return d.ReadReprocess(ctx, &pb.ReprocessRead{ReprocessId: reprocessRow.ReprocessID})
+}
+
+// ReadReprocess reads the progress of a reprocess
+func (d *Datalayer) ReadReprocess(
+	ctx context.Context,
+	reprocessRead *pb.ReprocessRead,
+) (*pb.Reprocess, error) {
+	reprocessRow, err := d.queries.ReadReprocess(ctx, reprocessRead.GetReprocessId())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %v", types.ReprocessNotFound, reprocessRead.GetReprocessId())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read reprocess: %v", err)
+	}
+	return reprocessRowToPb(reprocessRow), nil
+}
+
+// ReadFailedExecutions reads the processor tasks that failed after exhausting
+// their retries
+func (d *Datalayer) ReadFailedExecutions(
+	ctx context.Context,
+	failedExecutionsRead *pb.FailedExecutionsRead,
+) (*pb.FailedExecutions, error) {
+	params := ReadFailedExecutionsParams{
+		IncludeRequeued: failedExecutionsRead.GetIncludeRequeued(),
+		TimeFrom: pgtype.Timestamp{
+			Time:  failedExecutionsRead.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  failedExecutionsRead.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+	}
+	if failedExecutionsRead.GetProcessorName() != "" {
+		params.ProcessorName = pgtype.Text{String: failedExecutionsRead.GetProcessorName(), Valid: true}
+	}
+	if failedExecutionsRead.GetProcessorRuntime() != "" {
+		params.ProcessorRuntime = pgtype.Text{String: failedExecutionsRead.GetProcessorRuntime(), Valid: true}
+	}
+	if failedExecutionsRead.GetAlgorithm().GetName() != "" {
+		params.AlgorithmName = pgtype.Text{String: failedExecutionsRead.GetAlgorithm().GetName(), Valid: true}
+	}
+	if failedExecutionsRead.GetAlgorithm().GetVersion() != "" {
+		params.AlgorithmVersion = pgtype.Text{String: failedExecutionsRead.GetAlgorithm().GetVersion(), Valid: true}
+	}
+
+	failedRows, err := d.queries.ReadFailedExecutions(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("could not read failed executions: %v", err)
+	}
+
+	failedExecutions := make([]*pb.FailedExecution, len(failedRows))
+	for ii, failedRow := range failedRows {
+		request := &pb.ExecutionRequest{}
+		if err := protojson.Unmarshal(failedRow.Request, request); err != nil {
+			return nil, fmt.Errorf("could not unmarshal failed execution request: %v", err)
+		}
+		failedExecutions[ii] = &pb.FailedExecution{
+			ExecId:           failedRow.ExecID,
+			TaskExecId:       failedRow.TaskExecID,
+			ProcessorName:    failedRow.ProcessorName,
+			ProcessorRuntime: failedRow.ProcessorRuntime,
+			Request:          request,
+			ErrorCode:        failedRow.ErrorCode,
+			ErrorMessage:     failedRow.ErrorMessage,
+			Attempts:         failedRow.Attempts,
+			Failed:           timestampToPb(failedRow.Created),
+			Requeued:         timestampToPb(failedRow.Requeued),
+		}
+	}
+	return &pb.FailedExecutions{FailedExecutions: failedExecutions}, nil
+}
+
+// RequeueFailedExecutions re-executes the executions of failed processor
+// tasks. The failed work of each execution is reset, along with the work that
+// was skipped because of it, and the execution processed again in the
+// background
+func (d *Datalayer) RequeueFailedExecutions(
+	ctx context.Context,
+	failedExecutionsRequeue *pb.FailedExecutionsRequeue,
+) (*pb.RequeuedExecutions, error) {
+	tx, err := d.WithTx(ctx)
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return nil, err
+	}
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	params := ReadFailedExecutionPlansParams{
+		TimeFrom: pgtype.Timestamp{
+			Time:  failedExecutionsRequeue.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  failedExecutionsRequeue.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+	}
+	if failedExecutionsRequeue.GetProcessorName() != "" {
+		params.ProcessorName = pgtype.Text{String: failedExecutionsRequeue.GetProcessorName(), Valid: true}
+	}
+	if failedExecutionsRequeue.GetProcessorRuntime() != "" {
+		params.ProcessorRuntime = pgtype.Text{String: failedExecutionsRequeue.GetProcessorRuntime(), Valid: true}
+	}
+	if failedExecutionsRequeue.GetAlgorithm().GetName() != "" {
+		params.AlgorithmName = pgtype.Text{String: failedExecutionsRequeue.GetAlgorithm().GetName(), Valid: true}
+	}
+	if failedExecutionsRequeue.GetAlgorithm().GetVersion() != "" {
+		params.AlgorithmVersion = pgtype.Text{String: failedExecutionsRequeue.GetAlgorithm().GetVersion(), Valid: true}
+	}
+
+	failedPlans, err := qtx.ReadFailedExecutionPlans(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("could not read failed executions: %v", err)
+	}
+
+	execIds := make([]string, len(failedPlans))
+	for ii, failedPlan := range failedPlans {
+		if err := qtx.ResetFailedExecutionWork(ctx, ResetFailedExecutionWorkParams{
+			Owner:           d.leases.owner,
+			LeaseMs:         d.leases.duration.Milliseconds(),
+			ExecutionPlanID: failedPlan.ID,
+		}); err != nil {
+			return nil, fmt.Errorf("could not reset failed execution work: %v", err)
+		}
+		// a reprocess is unfinished again until its requeued executions are
+		if err := qtx.ReopenReprocess(ctx, failedPlan.ID); err != nil {
+			return nil, fmt.Errorf("could not reopen reprocess: %v", err)
+		}
+		execIds[ii] = failedPlan.ExecID
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	slog.Info("requeuing failed executions", "count", len(failedPlans))
+	go func() {
+		// requeued executions wait for room in the pool rather than being
+		// rejected, and are resumed on restart if still pending
+		for _, failedPlan := range failedPlans {
+			d.pool.enqueue(failedPlan.ID)
+		}
+	}()
+	return &pb.RequeuedExecutions{ExecIds: execIds}, nil
+}
+
+// CreateWindowSchedule creates a schedule that windows of a type are emitted
+// on, or replaces the schedule of the same name
+func (d *Datalayer) CreateWindowSchedule(
+	ctx context.Context,
+	windowSchedule *pb.WindowSchedule,
+) error {
+	timezone := windowSchedule.GetTimezone()
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	cronSchedule, location, err := parseWindowSchedule(windowSchedule.GetCron(), timezone)
+	if err != nil {
+		return err
+	}
+
+	metadataBytes, err := windowSchedule.GetMetadata().MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("could not marshal metadata: %v", err)
+	}
+
+	tx, err := d.WithTx(ctx)
+
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return err
+	}
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	_, err = qtx.ReadWindowTypeId(ctx, ReadWindowTypeIdParams{
+		WindowTypeName:    windowSchedule.GetWindowTypeName(),
+		WindowTypeVersion: windowSchedule.GetWindowTypeVersion(),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf(
+			"%w: %v_%v",
+			types.WindowTypeNotFound,
+			windowSchedule.GetWindowTypeName(),
+			windowSchedule.GetWindowTypeVersion(),
+		)
+	} else if err != nil {
+		return fmt.Errorf("could not read window type: %v", err)
+	}
+
+	// the windows emitted must carry the metadata of their window type
+	metadataFields, err := qtx.ReadMetadataFieldsByWindowType(ctx, ReadMetadataFieldsByWindowTypeParams{
+		WindowTypeName:    windowSchedule.GetWindowTypeName(),
+		WindowTypeVersion: windowSchedule.GetWindowTypeVersion(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not read metadata for window type: %v", err)
+	}
+	if err := checkRequiredMetadata(metadataBytes, metadataFields); err != nil {
+		return fmt.Errorf("%w: %v", types.InvalidWindowSchedule, err)
+	}
+
+	// a replaced schedule carries on from the last window it emitted, unless
+	// it is given a new start
+	var emittedUntil time.Time
+	if windowSchedule.GetStart() != nil {
+		start := windowSchedule.GetStart().AsTime().In(location).Truncate(time.Second)
+		emittedUntil = cronSchedule.Next(start.Add(-time.Second))
+	} else {
+		existing, err := qtx.ReadWindowScheduleEmittedUntil(ctx, windowSchedule.GetName())
+		if err == nil {
+			emittedUntil = existing.Time
+		} else if errors.Is(err, pgx.ErrNoRows) {
+			emittedUntil = cronSchedule.Next(time.Now().In(location))
+		} else {
+			return fmt.Errorf("could not read window schedule: %v", err)
+		}
+	}
+	nextEmission := cronSchedule.Next(emittedUntil.In(location))
+	if emittedUntil.IsZero() || nextEmission.IsZero() {
+		return fmt.Errorf(
+			"%w: cron expression %v never falls due",
+			types.InvalidWindowSchedule,
+			windowSchedule.GetCron(),
+		)
+	}
+
+	_, err = qtx.CreateWindowSchedule(ctx, CreateWindowScheduleParams{
+		Name:     windowSchedule.GetName(),
+		Cron:     windowSchedule.GetCron(),
+		Timezone: timezone,
+		Origin:   windowSchedule.GetOrigin(),
+		Metadata: metadataBytes,
+		EmittedUntil: pgtype.Timestamp{
+			Time:  emittedUntil.UTC(),
+			Valid: true,
+		},
+		NextEmission: pgtype.Timestamp{
+			Time:  nextEmission.UTC(),
+			Valid: true,
+		},
+		WindowTypeName:    windowSchedule.GetWindowTypeName(),
+		WindowTypeVersion: windowSchedule.GetWindowTypeVersion(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create window schedule: %v", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// ReadWindowSchedules reads the window schedules
+func (d *Datalayer) ReadWindowSchedules(ctx context.Context) (*pb.WindowSchedules, error) {
+	rows, err := d.queries.ReadWindowSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read window schedules: %v", err)
+	}
+	windowSchedules := &pb.WindowSchedules{
+		WindowSchedules: make([]*pb.WindowSchedule, len(rows)),
+	}
+	for ii, row := range rows {
+		metadata, err := unmarshalToStruct(row.Metadata)
 		if err != nil {
-			return nil, fmt.Errorf("could not link annotation to window: %v", err)
+			return nil, fmt.Errorf("could not unmarshal schedule metadata: %v", err)
+		}
+		windowSchedules.WindowSchedules[ii] = &pb.WindowSchedule{
+			Name:              row.Name,
+			WindowTypeName:    row.WindowTypeName,
+			WindowTypeVersion: row.WindowTypeVersion,
+			Cron:              row.Cron,
+			Timezone:          row.Timezone,
+			Origin:            row.Origin,
+			Metadata:          metadata,
+			EmittedUntil:      timestampToPb(row.EmittedUntil),
+			NextEmission:      timestampToPb(row.NextEmission),
 		}
 	}
+	return windowSchedules, nil
+}
 
-	// link annotation to algorithm type
-	for _, capturedAlgorithm := range annotateWrite.GetCapturedAlgorithms() {
-		err := qtx.LinkAnnotationToAlgorithm(ctx, LinkAnnotationToAlgorithmParams{
-			AnnotationID:     annotationId,
-			AlgorithmName:    capturedAlgorithm.GetName(),
-			AlgorithmVersion: capturedAlgorithm.GetVersion(),
-		})
+// DeleteWindowSchedule deletes a window schedule. The windows it has already
+// emitted are kept
+func (d *Datalayer) DeleteWindowSchedule(
+	ctx context.Context,
+	windowScheduleDeletion *pb.WindowScheduleDeletion,
+) error {
+	deleted, err := d.queries.DeleteWindowSchedule(ctx, windowScheduleDeletion.GetName())
+	if err != nil {
+		return fmt.Errorf("could not delete window schedule: %v", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %v", types.WindowScheduleNotFound, windowScheduleDeletion.GetName())
+	}
+	return nil
+}
+
+// CreateRollupRule creates a rule that rolls windows of one type up into
+// parent windows of another, or replaces the rule of the same name
+func (d *Datalayer) CreateRollupRule(ctx context.Context, rollupRule *pb.RollupRule) error {
+	timezone := rollupRule.GetTimezone()
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, _, err := parseWindowSchedule(rollupRule.GetCron(), timezone); err != nil {
+		return fmt.Errorf("%w: %v", types.InvalidRollupRule, err)
+	}
+	if rollupRule.GetChildWindowTypeName() == rollupRule.GetParentWindowTypeName() &&
+		rollupRule.GetChildWindowTypeVersion() == rollupRule.GetParentWindowTypeVersion() {
+		return fmt.Errorf("%w: windows cannot be rolled up into their own type", types.InvalidRollupRule)
+	}
+	origin := rollupRule.GetOrigin()
+	if origin == "" {
+		origin = rollupRule.GetName()
+	}
+
+	tx, err := d.WithTx(ctx)
+
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return err
+	}
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	// parent windows only carry the metadata they are rolled up by, which
+	// must include any their window type requires
+	metadataFields, err := qtx.ReadMetadataFieldsByWindowType(ctx, ReadMetadataFieldsByWindowTypeParams{
+		WindowTypeName:    rollupRule.GetParentWindowTypeName(),
+		WindowTypeVersion: rollupRule.GetParentWindowTypeVersion(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not read metadata for window type: %v", err)
+	}
+	for _, metadataField := range metadataFields {
+		if !slices.Contains(rollupRule.GetMetadataKeys(), metadataField.MetadataFieldName) {
+			return fmt.Errorf(
+				"%w: metadata keys must include '%s', which the parent window type requires",
+				types.InvalidRollupRule,
+				metadataField.MetadataFieldName,
+			)
+		}
+	}
+
+	created, err := qtx.CreateRollupRule(ctx, CreateRollupRuleParams{
+		Name:                    rollupRule.GetName(),
+		Cron:                    rollupRule.GetCron(),
+		Timezone:                timezone,
+		MetadataKeys:            rollupRule.GetMetadataKeys(),
+		Origin:                  origin,
+		ChildWindowTypeName:     rollupRule.GetChildWindowTypeName(),
+		ChildWindowTypeVersion:  rollupRule.GetChildWindowTypeVersion(),
+		ParentWindowTypeName:    rollupRule.GetParentWindowTypeName(),
+		ParentWindowTypeVersion: rollupRule.GetParentWindowTypeVersion(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create roll-up rule: %v", err)
+	}
+	if created == 0 {
+		return fmt.Errorf(
+			"%w: %v_%v or %v_%v",
+			types.WindowTypeNotFound,
+			rollupRule.GetChildWindowTypeName(),
+			rollupRule.GetChildWindowTypeVersion(),
+			rollupRule.GetParentWindowTypeName(),
+			rollupRule.GetParentWindowTypeVersion(),
+		)
+	}
+	return tx.Commit(ctx)
+}
+
+// ReadRollupRules reads the roll-up rules
+func (d *Datalayer) ReadRollupRules(ctx context.Context) (*pb.RollupRules, error) {
+	rows, err := d.queries.ReadRollupRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read roll-up rules: %v", err)
+	}
+	rollupRules := &pb.RollupRules{
+		RollupRules: make([]*pb.RollupRule, len(rows)),
+	}
+	for ii, row := range rows {
+		rollupRules.RollupRules[ii] = &pb.RollupRule{
+			Name:                    row.Name,
+			ChildWindowTypeName:     row.ChildWindowTypeName,
+			ChildWindowTypeVersion:  row.ChildWindowTypeVersion,
+			ParentWindowTypeName:    row.ParentWindowTypeName,
+			ParentWindowTypeVersion: row.ParentWindowTypeVersion,
+			Cron:                    row.Cron,
+			Timezone:                row.Timezone,
+			MetadataKeys:            row.MetadataKeys,
+			Origin:                  row.Origin,
+		}
+	}
+	return rollupRules, nil
+}
+
+// DeleteRollupRule deletes a roll-up rule. The parent windows it has already
+// emitted are kept
+func (d *Datalayer) DeleteRollupRule(
+	ctx context.Context,
+	rollupRuleDeletion *pb.RollupRuleDeletion,
+) error {
+	deleted, err := d.queries.DeleteRollupRule(ctx, rollupRuleDeletion.GetName())
+	if err != nil {
+		return fmt.Errorf("could not delete roll-up rule: %v", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %v", types.RollupRuleNotFound, rollupRuleDeletion.GetName())
+	}
+	return nil
+}
+
+// ReadChildWindows reads the windows that a parent window was rolled up from
+func (d *Datalayer) ReadChildWindows(
+	ctx context.Context,
+	childWindowsRead *pb.ChildWindowsRead,
+) (*pb.Windows, error) {
+	parent := childWindowsRead.GetWindow()
+	metadataBytes := []byte("{}")
+	if parent.GetMetadata() != nil {
+		var err error
+		metadataBytes, err = parent.GetMetadata().MarshalJSON()
 		if err != nil {
-			return nil, fmt.Errorf("could not link annotation to algorithm: %v", err)
+			return nil, fmt.Errorf("could not marshal metadata: %v", err)
 		}
 	}
 
-	return &pb.AnnotateResponse{}, tx.Commit(ctx)
+	rows, err := d.queries.ReadChildWindows(ctx, ReadChildWindowsParams{
+		WindowTypeName:    parent.GetWindowTypeName(),
+		WindowTypeVersion: parent.GetWindowTypeVersion(),
+		TimeFrom: pgtype.Timestamp{
+			Time:  parent.GetTimeFrom().AsTime().UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  parent.GetTimeTo().AsTime().UTC(),
+			Valid: true,
+		},
+		Origin:   parent.GetOrigin(),
+		Metadata: metadataBytes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read child windows: %v", err)
+	}
+	windowsPb := &pb.Windows{
+		Window: make([]*pb.Window, len(rows)),
+	}
+	for ii, row := range rows {
+		metadata, err := unmarshalToStruct(row.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("could not unpack child window metadata: %v", err)
+		}
+		windowsPb.Window[ii] = &pb.Window{
+			TimeFrom:          timestamppb.New(row.TimeFrom.Time),
+			TimeTo:            timestamppb.New(row.TimeTo.Time),
+			Origin:            row.Origin,
+			Metadata:          metadata,
+			WindowTypeName:    row.Name,
+			WindowTypeVersion: row.Version,
+		}
+	}
+	return windowsPb, nil
+}
+
+// CreateAlertRule creates an alert rule, or replaces the rule of the same name
+func (d *Datalayer) CreateAlertRule(ctx context.Context, alertRule *pb.AlertRule) error {
+	_, err := d.alerts.program(alertRule.GetExpression())
+	if err != nil {
+		return fmt.Errorf("%w: %v", types.InvalidAlertRule, err)
+	}
+
+	created, err := d.queries.CreateAlertRule(ctx, CreateAlertRuleParams{
+		Name:             alertRule.GetName(),
+		Expression:       alertRule.GetExpression(),
+		Description:      alertRule.GetDescription(),
+		MetadataKey:      alertRule.GetMetadataKey(),
+		AlgorithmName:    alertRule.GetAlgorithm().GetName(),
+		AlgorithmVersion: alertRule.GetAlgorithm().GetVersion(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not create alert rule: %v", err)
+	}
+	if created == 0 {
+		return fmt.Errorf(
+			"%w: %v_%v",
+			types.AlgorithmNotFound,
+			alertRule.GetAlgorithm().GetName(),
+			alertRule.GetAlgorithm().GetVersion(),
+		)
+	}
+	return nil
+}
+
+// ReadAlertRules reads the alert rules
+func (d *Datalayer) ReadAlertRules(ctx context.Context) (*pb.AlertRules, error) {
+	rows, err := d.queries.ReadAlertRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read alert rules: %v", err)
+	}
+	alertRules := &pb.AlertRules{
+		AlertRules: make([]*pb.AlertRule, len(rows)),
+	}
+	for ii, row := range rows {
+		alertRules.AlertRules[ii] = &pb.AlertRule{
+			Name: row.Name,
+			Algorithm: &pb.Algorithm{
+				Name:    row.AlgorithmName,
+				Version: row.AlgorithmVersion,
+			},
+			Expression:  row.Expression,
+			Description: row.Description,
+			MetadataKey: row.MetadataKey,
+		}
+	}
+	return alertRules, nil
+}
+
+// DeleteAlertRule deletes an alert rule along with its alerts
+func (d *Datalayer) DeleteAlertRule(
+	ctx context.Context,
+	alertRuleDeletion *pb.AlertRuleDeletion,
+) error {
+	deleted, err := d.queries.DeleteAlertRule(ctx, alertRuleDeletion.GetName())
+	if err != nil {
+		return fmt.Errorf("could not delete alert rule: %v", err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %v", types.AlertRuleNotFound, alertRuleDeletion.GetName())
+	}
+	return nil
+}
+
+// ReadAlerts reads the alerts fired within a time range
+func (d *Datalayer) ReadAlerts(ctx context.Context, alertsRead *pb.AlertsRead) (*pb.Alerts, error) {
+	params := ReadAlertsParams{
+		FiredFrom: pgtype.Timestamp{
+			Time:  alertsRead.GetTimeFrom().AsTime(),
+			Valid: true,
+		},
+		FiredTo: pgtype.Timestamp{
+			Time:  alertsRead.GetTimeTo().AsTime(),
+			Valid: true,
+		},
+		AlertRuleName: pgtype.Text{
+			String: alertsRead.GetAlertRuleName(),
+			Valid:  alertsRead.GetAlertRuleName() != "",
+		},
+		IncludeAcknowledged: alertsRead.GetIncludeAcknowledged(),
+	}
+	switch alertsRead.GetStatus() {
+	case pb.AlertStatus_ALERT_STATUS_FIRING:
+		params.Status = NullAlertStatus{AlertStatus: AlertStatusFiring, Valid: true}
+	case pb.AlertStatus_ALERT_STATUS_RESOLVED:
+		params.Status = NullAlertStatus{AlertStatus: AlertStatusResolved, Valid: true}
+	}
+
+	rows, err := d.queries.ReadAlerts(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("could not read alerts: %v", err)
+	}
+	alerts := &pb.Alerts{
+		Alerts: make([]*pb.Alerts_Alert, len(rows)),
+	}
+	for ii, row := range rows {
+		metadata, err := unmarshalToStruct(row.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("could not unpack alert metadata: %v", err)
+		}
+		alertStatus := pb.AlertStatus_ALERT_STATUS_FIRING
+		if row.Status == AlertStatusResolved {
+			alertStatus = pb.AlertStatus_ALERT_STATUS_RESOLVED
+		}
+		alerts.Alerts[ii] = &pb.Alerts_Alert{
+			AlertId:       row.ID,
+			AlertRuleName: row.AlertRuleName,
+			Algorithm: &pb.Algorithm{
+				Name:    row.AlgorithmName,
+				Version: row.AlgorithmVersion,
+			},
+			Status:         alertStatus,
+			Origin:         row.Origin,
+			Metadata:       metadata,
+			WindowTimeFrom: timestampToPb(row.TimeFrom),
+			WindowTimeTo:   timestampToPb(row.TimeTo),
+			Fired:          timestampToPb(row.Fired),
+			Resolved:       timestampToPb(row.Resolved),
+			Acknowledged:   timestampToPb(row.Acknowledged),
+			AcknowledgedBy: row.AcknowledgedBy.String,
+		}
+	}
+	return alerts, nil
+}
+
+// AcknowledgeAlert records that an alert has been seen to
+func (d *Datalayer) AcknowledgeAlert(
+	ctx context.Context,
+	alertAcknowledgement *pb.AlertAcknowledgement,
+) error {
+	acknowledged, err := d.queries.AcknowledgeAlert(ctx, AcknowledgeAlertParams{
+		AcknowledgedBy: pgtype.Text{
+			String: alertAcknowledgement.GetAcknowledgedBy(),
+			Valid:  alertAcknowledgement.GetAcknowledgedBy() != "",
+		},
+		ID: alertAcknowledgement.GetAlertId(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not acknowledge alert: %v", err)
+	}
+	if acknowledged == 0 {
+		return fmt.Errorf("%w: %v", types.AlertNotFound, alertAcknowledgement.GetAlertId())
+	}
+	return nil
 }
@@ -0,0 +1,11 @@
+-- Drop index
+DROP INDEX IF EXISTS idx_execution_plan_status;
+
+-- Drop tables in reverse order of dependencies
+DROP TABLE IF EXISTS execution_node;
+DROP TABLE IF EXISTS execution_task;
+DROP TABLE IF EXISTS execution_stage;
+DROP TABLE IF EXISTS execution_plan;
+
+-- Drop type
+DROP TYPE IF EXISTS execution_status;
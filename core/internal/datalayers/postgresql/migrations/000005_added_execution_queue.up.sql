@@ -0,0 +1,54 @@
+CREATE TYPE execution_status AS ENUM ('pending', 'running', 'succeeded', 'failed');
+
+-- Execution plans constructed when a window is emitted
+CREATE TABLE execution_plan (
+  id BIGSERIAL PRIMARY KEY,
+  windows_id BIGINT NOT NULL,
+  status execution_status NOT NULL DEFAULT 'pending',
+  created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (windows_id) REFERENCES windows(id)
+);
+
+-- Stages of an execution plan, executed in order of stage_index
+CREATE TABLE execution_stage (
+  id BIGSERIAL PRIMARY KEY,
+  execution_plan_id BIGINT NOT NULL,
+  stage_index INT NOT NULL,
+  status execution_status NOT NULL DEFAULT 'pending',
+  UNIQUE (execution_plan_id, stage_index),
+  FOREIGN KEY (execution_plan_id) REFERENCES execution_plan(id) ON DELETE CASCADE
+);
+
+-- Tasks within a stage, each farmed off to a single processor
+CREATE TABLE execution_task (
+  id BIGSERIAL PRIMARY KEY,
+  execution_stage_id BIGINT NOT NULL,
+  task_index INT NOT NULL,
+  processor_id BIGINT NOT NULL,
+  exec_id TEXT NOT NULL UNIQUE,
+  status execution_status NOT NULL DEFAULT 'pending',
+  error_message TEXT,
+  created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (execution_stage_id, task_index),
+  FOREIGN KEY (execution_stage_id) REFERENCES execution_stage(id) ON DELETE CASCADE,
+  FOREIGN KEY (processor_id) REFERENCES processor(id)
+);
+
+-- Algorithms executed by a task, along with the algorithms they depend on
+CREATE TABLE execution_node (
+  id BIGSERIAL PRIMARY KEY,
+  execution_task_id BIGINT NOT NULL,
+  node_index INT NOT NULL,
+  algorithm_id BIGINT NOT NULL,
+  window_type_id BIGINT NOT NULL,
+  algorithm_dep_ids BIGINT[] NOT NULL DEFAULT '{}',
+  UNIQUE (execution_task_id, node_index),
+  FOREIGN KEY (execution_task_id) REFERENCES execution_task(id) ON DELETE CASCADE,
+  FOREIGN KEY (algorithm_id) REFERENCES algorithm(id),
+  FOREIGN KEY (window_type_id) REFERENCES window_type(id)
+);
+
+-- Index to find unfinished plans on startup
+CREATE INDEX idx_execution_plan_status ON execution_plan(status);
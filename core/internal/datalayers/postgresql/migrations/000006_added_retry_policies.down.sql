@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_execution_attempt_task;
+DROP TABLE IF EXISTS execution_attempt;
+
+ALTER TABLE algorithm DROP COLUMN IF EXISTS retry_policy;
+ALTER TABLE processor DROP COLUMN IF EXISTS retry_policy;
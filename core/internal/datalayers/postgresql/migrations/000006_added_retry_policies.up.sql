@@ -0,0 +1,19 @@
+-- Retry policies overriding the global policy, stored as protojson
+ALTER TABLE processor ADD COLUMN retry_policy JSONB;
+ALTER TABLE algorithm ADD COLUMN retry_policy JSONB;
+
+-- Every attempt made at executing a task, providing its retry history
+CREATE TABLE execution_attempt (
+  id BIGSERIAL PRIMARY KEY,
+  execution_task_id BIGINT NOT NULL,
+  attempt INT NOT NULL,
+  status execution_status NOT NULL,
+  error_code TEXT,
+  error_message TEXT,
+  backoff_ms BIGINT,
+  started TIMESTAMP NOT NULL,
+  finished TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (execution_task_id) REFERENCES execution_task(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_execution_attempt_task ON execution_attempt(execution_task_id);
@@ -0,0 +1,15 @@
+ALTER TABLE execution_node
+  DROP COLUMN IF EXISTS finished,
+  DROP COLUMN IF EXISTS started,
+  DROP COLUMN IF EXISTS error_message,
+  DROP COLUMN IF EXISTS status;
+
+ALTER TABLE execution_plan
+  DROP COLUMN IF EXISTS finished,
+  DROP COLUMN IF EXISTS started,
+  DROP COLUMN IF EXISTS exec_id;
+
+-- values cannot be removed from an enum, so skipped work is marked as failed
+-- and the 'skipped' value left in place
+UPDATE execution_stage SET status = 'failed' WHERE status = 'skipped';
+UPDATE execution_task SET status = 'failed' WHERE status = 'skipped';
@@ -0,0 +1,15 @@
+-- Algorithms that were not executed as an upstream part of the execution failed
+ALTER TYPE execution_status ADD VALUE IF NOT EXISTS 'skipped';
+
+-- Execution IDs returned when a window is emitted, along with timings
+ALTER TABLE execution_plan
+  ADD COLUMN exec_id TEXT NOT NULL UNIQUE DEFAULT replace(gen_random_uuid()::TEXT, '-', ''),
+  ADD COLUMN started TIMESTAMP,
+  ADD COLUMN finished TIMESTAMP;
+
+-- The state of each algorithm within an execution
+ALTER TABLE execution_node
+  ADD COLUMN status execution_status NOT NULL DEFAULT 'pending',
+  ADD COLUMN error_message TEXT,
+  ADD COLUMN started TIMESTAMP,
+  ADD COLUMN finished TIMESTAMP;
@@ -0,0 +1,5 @@
+ALTER TABLE results
+  DROP COLUMN IF EXISTS error_message,
+  DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS result_status;
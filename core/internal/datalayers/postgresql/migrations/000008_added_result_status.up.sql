@@ -0,0 +1,7 @@
+CREATE TYPE result_status AS ENUM ('succeeded', 'handled_failed', 'unhandled_failed');
+
+-- The outcome of the algorithm that produced the result. Failed results carry
+-- an error message in place of result data
+ALTER TABLE results
+  ADD COLUMN status result_status NOT NULL DEFAULT 'succeeded',
+  ADD COLUMN error_message TEXT;
@@ -0,0 +1,8 @@
+ALTER TABLE algorithm DROP COLUMN IF EXISTS timeout_ms;
+
+-- values cannot be removed from an enum, so cancelled work is marked as failed
+-- and the 'cancelled' value left in place
+UPDATE execution_plan SET status = 'failed' WHERE status = 'cancelled';
+UPDATE execution_stage SET status = 'failed' WHERE status = 'cancelled';
+UPDATE execution_task SET status = 'failed' WHERE status = 'cancelled';
+UPDATE execution_node SET status = 'failed' WHERE status = 'cancelled';
@@ -0,0 +1,6 @@
+-- The longest a processor may take to execute an algorithm. When not set, the
+-- global default applies
+ALTER TABLE algorithm ADD COLUMN timeout_ms BIGINT;
+
+-- Executions that were cancelled before they could complete
+ALTER TYPE execution_status ADD VALUE IF NOT EXISTS 'cancelled';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_execution_plan_reprocess_id;
+DROP INDEX IF EXISTS idx_reprocess_status;
+
+ALTER TABLE execution_plan DROP COLUMN IF EXISTS reprocess_id;
+
+DROP TABLE IF EXISTS reprocess;
@@ -0,0 +1,26 @@
+-- Reprocesses of windows that have already been emitted. The cursor is the
+-- last window triggered, from which an interrupted reprocess resumes
+CREATE TABLE reprocess (
+  id BIGSERIAL PRIMARY KEY,
+  reprocess_id TEXT NOT NULL UNIQUE DEFAULT replace(gen_random_uuid()::TEXT, '-', ''),
+  window_type_id BIGINT NOT NULL,
+  time_from TIMESTAMP NOT NULL,
+  time_to TIMESTAMP NOT NULL,
+  metadata JSONB NOT NULL DEFAULT '{}', -- windows must contain these fields
+  algorithm_ids BIGINT[] NOT NULL DEFAULT '{}', -- empty for all algorithms
+  windows_per_second DOUBLE PRECISION NOT NULL,
+  status execution_status NOT NULL DEFAULT 'pending',
+  total_windows INT NOT NULL DEFAULT 0,
+  triggered_windows INT NOT NULL DEFAULT 0,
+  last_window_id BIGINT NOT NULL DEFAULT 0,
+  error_message TEXT,
+  created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  finished TIMESTAMP,
+  FOREIGN KEY (window_type_id) REFERENCES window_type(id)
+);
+
+-- Execution plans triggered by a reprocess, rather than an emitted window
+ALTER TABLE execution_plan ADD COLUMN reprocess_id BIGINT REFERENCES reprocess(id);
+
+CREATE INDEX idx_reprocess_status ON reprocess(status);
+CREATE INDEX idx_execution_plan_reprocess_id ON execution_plan(reprocess_id);
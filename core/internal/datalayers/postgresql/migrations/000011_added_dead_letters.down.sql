@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_dead_letter_requeued;
+
+DROP TABLE IF EXISTS dead_letter;
@@ -0,0 +1,19 @@
+-- Processor tasks that failed after exhausting their retries, along with the
+-- request sent to the processor so that the work is not lost
+CREATE TABLE dead_letter (
+  id BIGSERIAL PRIMARY KEY,
+  execution_task_id BIGINT NOT NULL UNIQUE,
+  processor_id BIGINT NOT NULL,
+  algorithm_ids BIGINT[] NOT NULL DEFAULT '{}',
+  request JSONB NOT NULL, -- the ExecutionRequest of the last attempt
+  error_code TEXT NOT NULL,
+  error_message TEXT NOT NULL,
+  attempts INT NOT NULL,
+  created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  requeued TIMESTAMP,
+  FOREIGN KEY (execution_task_id) REFERENCES execution_task(id) ON DELETE CASCADE,
+  FOREIGN KEY (processor_id) REFERENCES processor(id)
+);
+
+-- Index to find dead letters that have not been requeued
+CREATE INDEX idx_dead_letter_requeued ON dead_letter(requeued);
@@ -0,0 +1,4 @@
+ALTER TABLE results DROP CONSTRAINT IF EXISTS results_windows_id_algorithm_id_key;
+ALTER TABLE results DROP COLUMN IF EXISTS revision;
+
+DROP TABLE IF EXISTS result_revision;
@@ -0,0 +1,70 @@
+-- Earlier revisions of results that were rewritten, e.g. by a reprocess, kept
+-- when the result write policy is to keep revisions
+CREATE TABLE result_revision (
+  id BIGSERIAL PRIMARY KEY,
+  result_id BIGINT NOT NULL,
+  revision INT NOT NULL,
+  result_value DOUBLE PRECISION,
+  result_array DOUBLE PRECISION[],
+  result_json JSONB,
+  status result_status NOT NULL,
+  error_message TEXT,
+  archived TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (result_id, revision),
+  FOREIGN KEY (result_id) REFERENCES results(id) ON DELETE CASCADE
+);
+
+-- The number of times a result has been written
+ALTER TABLE results ADD COLUMN revision INT NOT NULL DEFAULT 1;
+
+-- Deduplicate results written more than once for a window and algorithm.
+-- The latest is kept as the result, with the earlier ones kept as revisions
+WITH ranked AS (
+  SELECT
+    id,
+    ROW_NUMBER() OVER (PARTITION BY windows_id, algorithm_id ORDER BY id) AS revision,
+    FIRST_VALUE(id) OVER (PARTITION BY windows_id, algorithm_id ORDER BY id DESC) AS latest_id
+  FROM results
+  WHERE windows_id IS NOT NULL AND algorithm_id IS NOT NULL
+)
+INSERT INTO result_revision (
+  result_id,
+  revision,
+  result_value,
+  result_array,
+  result_json,
+  status,
+  error_message
+)
+SELECT
+  ranked.latest_id,
+  ranked.revision,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  r.status,
+  r.error_message
+FROM ranked
+JOIN results r ON r.id = ranked.id
+WHERE ranked.id != ranked.latest_id;
+
+UPDATE results r
+SET revision = counts.revisions
+FROM (
+  SELECT windows_id, algorithm_id, COUNT(*) AS revisions
+  FROM results
+  WHERE windows_id IS NOT NULL AND algorithm_id IS NOT NULL
+  GROUP BY windows_id, algorithm_id
+  HAVING COUNT(*) > 1
+) counts
+WHERE r.windows_id = counts.windows_id
+AND r.algorithm_id = counts.algorithm_id;
+
+DELETE FROM results r
+USING results latest
+WHERE r.windows_id = latest.windows_id
+AND r.algorithm_id = latest.algorithm_id
+AND r.id < latest.id;
+
+-- A single result per window and algorithm, which is rewritten in place
+ALTER TABLE results ADD CONSTRAINT results_windows_id_algorithm_id_key UNIQUE (windows_id, algorithm_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_windows_dedupe;
+
+DROP INDEX IF EXISTS idx_windows_idempotency_key;
+
+ALTER TABLE windows DROP COLUMN IF EXISTS idempotency_key;
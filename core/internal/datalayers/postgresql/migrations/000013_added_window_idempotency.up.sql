@@ -0,0 +1,10 @@
+-- Key given by an emitter to identify a window across its retries
+ALTER TABLE windows ADD COLUMN idempotency_key TEXT;
+
+-- Index to find windows by their idempotency key, which is unique within a
+-- window type
+CREATE UNIQUE INDEX idx_windows_idempotency_key ON windows(window_type_id, idempotency_key)
+  WHERE idempotency_key IS NOT NULL;
+
+-- Index to find windows emitted without a key by their contents
+CREATE INDEX idx_windows_dedupe ON windows(window_type_id, time_from, time_to, origin);
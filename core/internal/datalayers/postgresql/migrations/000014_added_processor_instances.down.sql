@@ -0,0 +1 @@
+DROP TABLE IF EXISTS processor_instance;
@@ -0,0 +1,15 @@
+-- Instances of a processor, each registered under the same processor
+-- identity with its own connection string, that tasks are dispatched between
+CREATE TABLE processor_instance (
+  id BIGSERIAL PRIMARY KEY,
+  processor_id BIGINT NOT NULL,
+  connection_string TEXT NOT NULL,
+  created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  registered TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- when the instance last registered
+  UNIQUE (processor_id, connection_string),
+  FOREIGN KEY (processor_id) REFERENCES processor(id) ON DELETE CASCADE
+);
+
+-- Each existing processor has a single instance
+INSERT INTO processor_instance (processor_id, connection_string)
+SELECT id, connection_string FROM processor;
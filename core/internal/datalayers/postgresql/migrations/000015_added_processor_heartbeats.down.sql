@@ -0,0 +1,10 @@
+ALTER TABLE processor_instance
+  DROP COLUMN IF EXISTS available,
+  DROP COLUMN IF EXISTS uptime_seconds,
+  DROP COLUMN IF EXISTS cpu_percent,
+  DROP COLUMN IF EXISTS memory_bytes,
+  DROP COLUMN IF EXISTS active_tasks,
+  DROP COLUMN IF EXISTS health_message,
+  DROP COLUMN IF EXISTS health_status,
+  DROP COLUMN IF EXISTS last_checked,
+  DROP COLUMN IF EXISTS last_seen;
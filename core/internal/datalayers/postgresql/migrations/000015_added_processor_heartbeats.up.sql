@@ -0,0 +1,12 @@
+-- The health last reported by each processor instance to the heartbeat
+-- monitor, along with a snapshot of its metrics
+ALTER TABLE processor_instance
+  ADD COLUMN last_seen TIMESTAMP, -- when the instance last responded to a health check
+  ADD COLUMN last_checked TIMESTAMP,
+  ADD COLUMN health_status TEXT NOT NULL DEFAULT 'STATUS_UNKNOWN',
+  ADD COLUMN health_message TEXT,
+  ADD COLUMN active_tasks INT,
+  ADD COLUMN memory_bytes BIGINT,
+  ADD COLUMN cpu_percent REAL,
+  ADD COLUMN uptime_seconds BIGINT,
+  ADD COLUMN available BOOLEAN NOT NULL DEFAULT TRUE; -- false once unseen for too long
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_results_seq;
+
+ALTER TABLE results DROP COLUMN seq;
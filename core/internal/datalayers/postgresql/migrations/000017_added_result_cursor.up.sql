@@ -0,0 +1,5 @@
+-- Results are numbered in the order they are written, so that subscribers to
+-- results can resume from the last result they received
+ALTER TABLE results ADD COLUMN seq BIGSERIAL;
+
+CREATE INDEX idx_results_seq ON results (seq);
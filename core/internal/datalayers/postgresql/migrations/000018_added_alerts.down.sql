@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS alert;
+DROP TABLE IF EXISTS alert_rule;
+DROP TYPE IF EXISTS alert_status;
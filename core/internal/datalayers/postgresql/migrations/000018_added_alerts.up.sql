@@ -0,0 +1,37 @@
+CREATE TYPE alert_status AS ENUM ('firing', 'resolved');
+
+-- Rules checked against the results of an algorithm as they are written. The
+-- expression is CEL, evaluated over the result and the metadata of its window
+CREATE TABLE alert_rule (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  algorithm_id BIGINT NOT NULL REFERENCES algorithm(id) ON DELETE CASCADE,
+  expression TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  -- alerts are deduplicated per origin and the value of this metadata field
+  metadata_key TEXT NOT NULL DEFAULT '',
+  created TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_alert_rule_algorithm ON alert_rule (algorithm_id);
+
+CREATE TABLE alert (
+  id BIGSERIAL PRIMARY KEY,
+  alert_rule_id BIGINT NOT NULL REFERENCES alert_rule(id) ON DELETE CASCADE,
+  dedupe_key TEXT NOT NULL,
+  origin TEXT NOT NULL,
+  metadata JSONB NOT NULL DEFAULT '{}',
+  -- the window whose result fired the alert
+  windows_id BIGINT NOT NULL REFERENCES windows(id),
+  status alert_status NOT NULL DEFAULT 'firing',
+  fired TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  resolved TIMESTAMP,
+  acknowledged TIMESTAMP,
+  acknowledged_by TEXT
+);
+
+-- a rule has at most one firing alert per origin and metadata value
+CREATE UNIQUE INDEX idx_alert_firing ON alert (alert_rule_id, dedupe_key)
+WHERE status = 'firing';
+
+CREATE INDEX idx_alert_fired ON alert (fired);
@@ -0,0 +1,19 @@
+-- Schedules that windows of a type are emitted on. Each window runs from one
+-- scheduled time to the next, taken from the cron expression in the time zone
+-- of the schedule
+CREATE TABLE window_schedule (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  window_type_id BIGINT NOT NULL REFERENCES window_type(id) ON DELETE CASCADE,
+  cron TEXT NOT NULL,
+  timezone TEXT NOT NULL DEFAULT 'UTC',
+  origin TEXT NOT NULL,
+  metadata JSONB NOT NULL DEFAULT '{}',
+  -- the end of the last window emitted, where the next window starts
+  emitted_until TIMESTAMP NOT NULL,
+  -- the end of the next window, once which it is due
+  next_emission TIMESTAMP NOT NULL,
+  created TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_window_schedule_next_emission ON window_schedule (next_emission);
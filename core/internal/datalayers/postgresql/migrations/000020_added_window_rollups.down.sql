@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS window_link;
+DROP TABLE IF EXISTS rollup_rule;
@@ -0,0 +1,26 @@
+-- Rules that roll windows of a child type up into windows of a parent type.
+-- Parent windows run from one time of the cron expression to the next, and
+-- are emitted once child windows with the same values of the metadata keys
+-- cover the whole of one
+CREATE TABLE rollup_rule (
+  id BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  child_window_type_id BIGINT NOT NULL REFERENCES window_type(id) ON DELETE CASCADE,
+  parent_window_type_id BIGINT NOT NULL REFERENCES window_type(id) ON DELETE CASCADE,
+  cron TEXT NOT NULL,
+  timezone TEXT NOT NULL DEFAULT 'UTC',
+  metadata_keys TEXT[] NOT NULL DEFAULT '{}',
+  origin TEXT NOT NULL,
+  created TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_rollup_rule_child ON rollup_rule (child_window_type_id);
+
+-- the child windows that a parent window was rolled up from
+CREATE TABLE window_link (
+  parent_windows_id BIGINT NOT NULL REFERENCES windows(id) ON DELETE CASCADE,
+  child_windows_id BIGINT NOT NULL REFERENCES windows(id) ON DELETE CASCADE,
+  PRIMARY KEY (parent_windows_id, child_windows_id)
+);
+
+CREATE INDEX idx_window_link_child ON window_link (child_windows_id);
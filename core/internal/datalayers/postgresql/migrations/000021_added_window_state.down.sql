@@ -0,0 +1,4 @@
+ALTER TABLE execution_plan DROP COLUMN IF EXISTS provisional;
+ALTER TABLE windows DROP COLUMN IF EXISTS provisional;
+ALTER TABLE windows DROP COLUMN IF EXISTS state;
+DROP TYPE IF EXISTS window_state;
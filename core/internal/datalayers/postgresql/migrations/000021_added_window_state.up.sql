@@ -0,0 +1,12 @@
+CREATE TYPE window_state AS ENUM ('open', 'closed');
+
+-- Windows are open while their end is not yet known. The time_to of an open
+-- window is how far it has been extended so far
+ALTER TABLE windows ADD COLUMN state window_state NOT NULL DEFAULT 'closed';
+
+-- Open windows that are executed as they are extended, ahead of closing
+ALTER TABLE windows ADD COLUMN provisional BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Execution plans of open windows, whose results are replaced once the window
+-- closes
+ALTER TABLE execution_plan ADD COLUMN provisional BOOLEAN NOT NULL DEFAULT FALSE;
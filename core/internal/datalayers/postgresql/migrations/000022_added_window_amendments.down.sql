@@ -0,0 +1,3 @@
+ALTER TABLE results DROP COLUMN IF EXISTS superseded;
+ALTER TABLE windows DROP COLUMN IF EXISTS supersedes;
+ALTER TABLE windows DROP COLUMN IF EXISTS superseded;
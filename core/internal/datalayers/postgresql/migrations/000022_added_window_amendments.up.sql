@@ -0,0 +1,7 @@
+-- Windows that have been amended are superseded by the corrected window,
+-- which records the window it supersedes
+ALTER TABLE windows ADD COLUMN superseded BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE windows ADD COLUMN supersedes BIGINT REFERENCES windows(id);
+
+-- Results of superseded windows, which are hidden from reads by default
+ALTER TABLE results ADD COLUMN superseded BOOLEAN NOT NULL DEFAULT FALSE;
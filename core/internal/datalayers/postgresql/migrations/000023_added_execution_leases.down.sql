@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_execution_plan_owner;
+ALTER TABLE execution_plan DROP COLUMN IF EXISTS lease_expires;
+ALTER TABLE execution_plan DROP COLUMN IF EXISTS owner;
@@ -0,0 +1,7 @@
+-- The instance of orca-core that an unfinished execution plan belongs to, and
+-- until when. Instances renew the leases of their plans while they run, and
+-- take over plans whose lease has expired, e.g. because their instance died
+ALTER TABLE execution_plan ADD COLUMN owner TEXT;
+ALTER TABLE execution_plan ADD COLUMN lease_expires TIMESTAMP;
+
+CREATE INDEX idx_execution_plan_owner ON execution_plan(owner);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_results_unsequenced;
+
+UPDATE results SET seq = nextval('results_seq_seq') WHERE seq IS NULL;
+ALTER TABLE results ALTER COLUMN seq SET DEFAULT nextval('results_seq_seq');
+ALTER TABLE results ALTER COLUMN seq SET NOT NULL;
@@ -0,0 +1,7 @@
+-- Results are numbered once they are committed rather than when they are
+-- written, so that a result committed late is never numbered behind results
+-- that subscribers have already passed. Results await numbering until then
+ALTER TABLE results ALTER COLUMN seq DROP DEFAULT;
+ALTER TABLE results ALTER COLUMN seq DROP NOT NULL;
+
+CREATE INDEX idx_results_unsequenced ON results (id) WHERE seq IS NULL;
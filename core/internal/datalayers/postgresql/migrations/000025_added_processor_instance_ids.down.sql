@@ -0,0 +1 @@
+ALTER TABLE processor_instance DROP COLUMN instance_id;
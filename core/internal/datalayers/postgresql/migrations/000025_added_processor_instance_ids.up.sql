@@ -0,0 +1,20 @@
+-- Instances of a processor are identified by the instance id they register
+-- with, so that an instance registering again from a new connection string
+-- replaces its previous registration rather than adding another. Processors
+-- registered without instance ids have a single instance, which the most
+-- recently registered existing instance becomes
+ALTER TABLE processor_instance ADD COLUMN instance_id TEXT;
+
+UPDATE processor_instance pi
+SET instance_id = CASE
+  WHEN pi.id = (
+    SELECT latest.id FROM processor_instance latest
+    WHERE latest.processor_id = pi.processor_id
+    ORDER BY latest.registered DESC, latest.id DESC
+    LIMIT 1
+  ) THEN ''
+  ELSE pi.connection_string
+END;
+
+ALTER TABLE processor_instance ALTER COLUMN instance_id SET NOT NULL;
+ALTER TABLE processor_instance ADD UNIQUE (processor_id, instance_id);
@@ -0,0 +1,10 @@
+UPDATE reprocess
+SET
+  status = 'succeeded',
+  finished = triggered
+WHERE triggered IS NOT NULL
+AND status = 'running';
+
+ALTER TABLE reprocess DROP COLUMN lease_expires;
+ALTER TABLE reprocess DROP COLUMN owner;
+ALTER TABLE reprocess DROP COLUMN triggered;
@@ -0,0 +1,35 @@
+-- Reprocesses finish once the executions they triggered have, rather than
+-- once their last window has been triggered, which is recorded separately
+ALTER TABLE reprocess ADD COLUMN triggered TIMESTAMP;
+
+-- The instance of orca-core triggering the windows of a reprocess, and until
+-- when, so that a reprocess is only ever run by one instance at a time
+ALTER TABLE reprocess ADD COLUMN owner TEXT;
+ALTER TABLE reprocess ADD COLUMN lease_expires TIMESTAMP;
+
+-- Reprocesses that succeeded had only triggered their windows
+UPDATE reprocess r
+SET
+  triggered = r.finished,
+  status = CASE
+    WHEN EXISTS (
+      SELECT 1 FROM execution_plan ep
+      WHERE ep.reprocess_id = r.id
+      AND ep.status IN ('pending', 'running')
+    ) THEN 'running'
+    WHEN EXISTS (
+      SELECT 1 FROM execution_plan ep
+      WHERE ep.reprocess_id = r.id
+      AND ep.status IN ('failed', 'cancelled')
+    ) THEN 'failed'
+    ELSE 'succeeded'
+  END::execution_status,
+  finished = CASE
+    WHEN EXISTS (
+      SELECT 1 FROM execution_plan ep
+      WHERE ep.reprocess_id = r.id
+      AND ep.status IN ('pending', 'running')
+    ) THEN NULL
+    ELSE r.finished
+  END
+WHERE r.status = 'succeeded';
@@ -11,6 +11,137 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AlertStatus string
+
+const (
+	AlertStatusFiring   AlertStatus = "firing"
+	AlertStatusResolved AlertStatus = "resolved"
+)
+
+func (e *AlertStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AlertStatus(s)
+	case string:
+		*e = AlertStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AlertStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAlertStatus struct {
+	AlertStatus AlertStatus
+	Valid       bool // Valid is true if AlertStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAlertStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AlertStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AlertStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAlertStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AlertStatus), nil
+}
+
+type ExecutionStatus string
+
+const (
+	ExecutionStatusPending   ExecutionStatus = "pending"
+	ExecutionStatusRunning   ExecutionStatus = "running"
+	ExecutionStatusSucceeded ExecutionStatus = "succeeded"
+	ExecutionStatusFailed    ExecutionStatus = "failed"
+	ExecutionStatusSkipped   ExecutionStatus = "skipped"
+	ExecutionStatusCancelled ExecutionStatus = "cancelled"
+)
+
+func (e *ExecutionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ExecutionStatus(s)
+	case string:
+		*e = ExecutionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ExecutionStatus: %T", src)
+	}
+	return nil
+}
+
+type NullExecutionStatus struct {
+	ExecutionStatus ExecutionStatus
+	Valid           bool // Valid is true if ExecutionStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullExecutionStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ExecutionStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ExecutionStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullExecutionStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ExecutionStatus), nil
+}
+
+type ResultStatus string
+
+const (
+	ResultStatusSucceeded       ResultStatus = "succeeded"
+	ResultStatusHandledFailed   ResultStatus = "handled_failed"
+	ResultStatusUnhandledFailed ResultStatus = "unhandled_failed"
+)
+
+func (e *ResultStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ResultStatus(s)
+	case string:
+		*e = ResultStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ResultStatus: %T", src)
+	}
+	return nil
+}
+
+type NullResultStatus struct {
+	ResultStatus ResultStatus
+	Valid        bool // Valid is true if ResultStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullResultStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ResultStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ResultStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullResultStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ResultStatus), nil
+}
+
 type ResultType string
 
 const (
@@ -55,6 +186,72 @@ func (ns NullResultType) Value() (driver.Value, error) {
 	return string(ns.ResultType), nil
 }
 
+type WindowState string
+
+const (
+	WindowStateOpen   WindowState = "open"
+	WindowStateClosed WindowState = "closed"
+)
+
+func (e *WindowState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WindowState(s)
+	case string:
+		*e = WindowState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WindowState: %T", src)
+	}
+	return nil
+}
+
+type NullWindowState struct {
+	WindowState WindowState
+	Valid       bool // Valid is true if WindowState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWindowState) Scan(value interface{}) error {
+	if value == nil {
+		ns.WindowState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WindowState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWindowState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WindowState), nil
+}
+
+type Alert struct {
+	ID             int64
+	AlertRuleID    int64
+	DedupeKey      string
+	Origin         string
+	Metadata       []byte
+	WindowsID      int64
+	Status         AlertStatus
+	Fired          pgtype.Timestamp
+	Resolved       pgtype.Timestamp
+	Acknowledged   pgtype.Timestamp
+	AcknowledgedBy pgtype.Text
+}
+
+type AlertRule struct {
+	ID          int64
+	Name        string
+	AlgorithmID int64
+	Expression  string
+	Description string
+	MetadataKey string
+	Created     pgtype.Timestamp
+}
+
 type Algorithm struct {
 	ID           int64
 	Name         string
@@ -63,6 +260,9 @@ type Algorithm struct {
 	WindowTypeID int64
 	ResultType   ResultType
 	Created      pgtype.Timestamp
+	RetryPolicy  []byte
+	TimeoutMs    pgtype.Int8
+	Retired      pgtype.Timestamp
 }
 
 type AlgorithmDependency struct {
@@ -109,6 +309,78 @@ type AnnotationWindowType struct {
 	WindowTypeID int64
 }
 
+type DeadLetter struct {
+	ID              int64
+	ExecutionTaskID int64
+	ProcessorID     int64
+	AlgorithmIds    []int64
+	Request         []byte
+	ErrorCode       string
+	ErrorMessage    string
+	Attempts        int32
+	Created         pgtype.Timestamp
+	Requeued        pgtype.Timestamp
+}
+
+type ExecutionAttempt struct {
+	ID              int64
+	ExecutionTaskID int64
+	Attempt         int32
+	Status          ExecutionStatus
+	ErrorCode       pgtype.Text
+	ErrorMessage    pgtype.Text
+	BackoffMs       pgtype.Int8
+	Started         pgtype.Timestamp
+	Finished        pgtype.Timestamp
+}
+
+type ExecutionNode struct {
+	ID              int64
+	ExecutionTaskID int64
+	NodeIndex       int32
+	AlgorithmID     int64
+	WindowTypeID    int64
+	AlgorithmDepIds []int64
+	Status          ExecutionStatus
+	ErrorMessage    pgtype.Text
+	Started         pgtype.Timestamp
+	Finished        pgtype.Timestamp
+}
+
+type ExecutionPlan struct {
+	ID           int64
+	WindowsID    int64
+	Status       ExecutionStatus
+	Created      pgtype.Timestamp
+	Updated      pgtype.Timestamp
+	ExecID       string
+	Started      pgtype.Timestamp
+	Finished     pgtype.Timestamp
+	ReprocessID  pgtype.Int8
+	Provisional  bool
+	Owner        pgtype.Text
+	LeaseExpires pgtype.Timestamp
+}
+
+type ExecutionStage struct {
+	ID              int64
+	ExecutionPlanID int64
+	StageIndex      int32
+	Status          ExecutionStatus
+}
+
+type ExecutionTask struct {
+	ID               int64
+	ExecutionStageID int64
+	TaskIndex        int32
+	ProcessorID      int64
+	ExecID           string
+	Status           ExecutionStatus
+	ErrorMessage     pgtype.Text
+	Created          pgtype.Timestamp
+	Updated          pgtype.Timestamp
+}
+
 type MetadataField struct {
 	ID          int64
 	Name        string
@@ -126,6 +398,47 @@ type Processor struct {
 	Runtime          string
 	ConnectionString string
 	Created          pgtype.Timestamp
+	RetryPolicy      []byte
+	Deregistered     pgtype.Timestamp
+}
+
+type ProcessorInstance struct {
+	ID               int64
+	ProcessorID      int64
+	ConnectionString string
+	Created          pgtype.Timestamp
+	Registered       pgtype.Timestamp
+	LastSeen         pgtype.Timestamp
+	LastChecked      pgtype.Timestamp
+	HealthStatus     string
+	HealthMessage    pgtype.Text
+	ActiveTasks      pgtype.Int4
+	MemoryBytes      pgtype.Int8
+	CpuPercent       pgtype.Float4
+	UptimeSeconds    pgtype.Int8
+	Available        bool
+	InstanceID       string
+}
+
+type Reprocess struct {
+	ID               int64
+	ReprocessID      string
+	WindowTypeID     int64
+	TimeFrom         pgtype.Timestamp
+	TimeTo           pgtype.Timestamp
+	Metadata         []byte
+	AlgorithmIds     []int64
+	WindowsPerSecond float64
+	Status           ExecutionStatus
+	TotalWindows     int32
+	TriggeredWindows int32
+	LastWindowID     int64
+	ErrorMessage     pgtype.Text
+	Created          pgtype.Timestamp
+	Finished         pgtype.Timestamp
+	Triggered        pgtype.Timestamp
+	Owner            pgtype.Text
+	LeaseExpires     pgtype.Timestamp
 }
 
 type Result struct {
@@ -136,15 +449,67 @@ type Result struct {
 	ResultValue  pgtype.Float8
 	ResultArray  []float64
 	ResultJson   []byte
+	Status       ResultStatus
+	ErrorMessage pgtype.Text
+	Revision     int32
+	Seq          pgtype.Int8
+	Superseded   bool
+}
+
+type ResultRevision struct {
+	ID           int64
+	ResultID     int64
+	Revision     int32
+	ResultValue  pgtype.Float8
+	ResultArray  []float64
+	ResultJson   []byte
+	Status       ResultStatus
+	ErrorMessage pgtype.Text
+	Archived     pgtype.Timestamp
+}
+
+type RollupRule struct {
+	ID                 int64
+	Name               string
+	ChildWindowTypeID  int64
+	ParentWindowTypeID int64
+	Cron               string
+	Timezone           string
+	MetadataKeys       []string
+	Origin             string
+	Created            pgtype.Timestamp
 }
 
 type Window struct {
+	ID             int64
+	WindowTypeID   int64
+	TimeFrom       pgtype.Timestamp
+	TimeTo         pgtype.Timestamp
+	Origin         string
+	Metadata       []byte
+	Created        pgtype.Timestamp
+	IdempotencyKey pgtype.Text
+	State          WindowState
+	Provisional    bool
+	Superseded     bool
+	Supersedes     pgtype.Int8
+}
+
+type WindowLink struct {
+	ParentWindowsID int64
+	ChildWindowsID  int64
+}
+
+type WindowSchedule struct {
 	ID           int64
+	Name         string
 	WindowTypeID int64
-	TimeFrom     pgtype.Timestamp
-	TimeTo       pgtype.Timestamp
+	Cron         string
+	Timezone     string
 	Origin       string
 	Metadata     []byte
+	EmittedUntil pgtype.Timestamp
+	NextEmission pgtype.Timestamp
 	Created      pgtype.Timestamp
 }
 
@@ -0,0 +1,168 @@
+package postgresql
+
+import (
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+)
+
+// executionPool bounds how many execution plans are processed at once. Plans
+// beyond the number of workers wait in a bounded queue, and once that is full
+// further plans are rejected rather than piling up
+type executionPool struct {
+	workers   int
+	queueSize int
+
+	// a slot is held by each plan from being reserved until it is processed,
+	// bounding the plans that are active or queued
+	slots  chan struct{}
+	queue  chan int64
+	active atomic.Int64
+
+	// guards the queue being closed against plans being submitted to it
+	mu        sync.RWMutex
+	closed    bool
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newExecutionPool(
+	workers int,
+	queueSize int,
+	process func(executionPlanId int64) error,
+) *executionPool {
+	p := &executionPool{
+		workers:   workers,
+		queueSize: queueSize,
+		slots:     make(chan struct{}, workers+queueSize),
+		queue:     make(chan int64, workers+queueSize),
+		done:      make(chan struct{}),
+	}
+	for range workers {
+		go p.work(process)
+	}
+	return p
+}
+
+// work processes plans from the queue until it is closed and drained
+func (p *executionPool) work(process func(executionPlanId int64) error) {
+	for executionPlanId := range p.queue {
+		if p.isClosed() {
+			<-p.slots
+			continue
+		}
+		p.active.Add(1)
+		if err := process(executionPlanId); err != nil {
+			slog.Error(
+				"could not process execution plan",
+				"execution_plan_id",
+				executionPlanId,
+				"error",
+				err,
+			)
+		}
+		p.active.Add(-1)
+		<-p.slots
+	}
+}
+
+// reserve reserves a slot for a plan, reporting false if the pool is full.
+// A reserved slot must be either submitted to or released
+func (p *executionPool) reserve() bool {
+	select {
+	case p.slots <- struct{}{}:
+		return true
+	default:
+		slog.Warn(
+			"execution pool is saturated",
+			"workers",
+			p.workers,
+			"queue_size",
+			p.queueSize,
+		)
+		return false
+	}
+}
+
+// reserveIdle reserves a slot for a plan only if a worker is free to process
+// it straight away, leaving the queue to plans that cannot wait
+func (p *executionPool) reserveIdle() bool {
+	if len(p.slots) >= p.workers {
+		return false
+	}
+	select {
+	case p.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// release gives up a reserved slot that was not submitted to
+func (p *executionPool) release() {
+	<-p.slots
+}
+
+// submit hands a plan with a reserved slot to the workers, reporting whether
+// it has to wait in the queue for a worker to become free. Plans submitted
+// once the pool is closed are left persisted, to be resumed by whichever
+// instance of orca-core takes over their lease
+func (p *executionPool) submit(executionPlanId int64) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		<-p.slots
+		return false
+	}
+
+	queued := int(p.active.Load())+len(p.queue) >= p.workers
+	// never blocks, as every plan in the queue holds one of its slots
+	p.queue <- executionPlanId
+	return queued
+}
+
+// enqueue hands a plan to the workers, waiting for a slot to free up if the
+// pool is full
+func (p *executionPool) enqueue(executionPlanId int64) {
+	select {
+	case p.slots <- struct{}{}:
+		p.submit(executionPlanId)
+	case <-p.done:
+	}
+}
+
+// close stops the workers once they finish the plans in hand. Queued plans
+// are drained without being processed, and are left persisted to be resumed
+// by whichever instance of orca-core takes over their lease
+func (p *executionPool) close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.closed = true
+		close(p.queue)
+	})
+}
+
+func (p *executionPool) isClosed() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// stats reports the depth and saturation of the pool
+func (p *executionPool) stats() *pb.ExecutionQueue {
+	return &pb.ExecutionQueue{
+		Workers:    int32(p.workers),
+		Active:     int32(p.active.Load()),
+		Queued:     int32(len(p.queue)),
+		QueueSize:  int32(p.queueSize),
+		Saturation: float64(len(p.slots)) / float64(cap(p.slots)),
+	}
+}
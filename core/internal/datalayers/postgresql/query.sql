@@ -3,18 +3,50 @@
 INSERT INTO processor (
   name,
   runtime,
-  connection_string
+  connection_string,
+  retry_policy
 ) VALUES (
   sqlc.arg('name'),
   sqlc.arg('runtime'),
-  sqlc.arg('connection_string')
+  sqlc.arg('connection_string'),
+  sqlc.narg('retry_policy')
 ) ON CONFLICT (name, runtime) DO UPDATE 
 SET 
   name = EXCLUDED.name,
   runtime = EXCLUDED.runtime,
-  connection_string = EXCLUDED.connection_string
+  connection_string = EXCLUDED.connection_string,
+  retry_policy = EXCLUDED.retry_policy,
+  deregistered = NULL
 RETURNING id;
 
+-- name: DeleteReplacedProcessorInstances :many
+DELETE FROM processor_instance pi
+USING processor p
+WHERE pi.processor_id = p.id
+AND p.name = sqlc.arg('name')
+AND p.runtime = sqlc.arg('runtime')
+AND (
+  (pi.instance_id = sqlc.arg('instance_id') AND pi.connection_string <> sqlc.arg('connection_string'))
+  OR (pi.connection_string = sqlc.arg('connection_string') AND pi.instance_id <> sqlc.arg('instance_id'))
+)
+RETURNING pi.id;
+
+-- name: CreateProcessorInstance :exec
+INSERT INTO processor_instance (
+  processor_id,
+  instance_id,
+  connection_string
+)
+SELECT
+  p.id,
+  sqlc.arg('instance_id'),
+  sqlc.arg('connection_string')
+FROM processor p
+WHERE p.name = sqlc.arg('name')
+AND p.runtime = sqlc.arg('runtime')
+ON CONFLICT (processor_id, instance_id) DO UPDATE
+SET registered = CURRENT_TIMESTAMP;
+
 -- name: CreateMetadataField :one
 INSERT INTO metadata_fields (
   name,
@@ -72,14 +104,96 @@ INSERT INTO algorithm (
   version,
   processor_id,
   window_type_id,
-  result_type
+  result_type,
+  retry_policy,
+  timeout_ms
 ) VALUES (
   sqlc.arg('name'),
   sqlc.arg('version'),
   (SELECT id FROM processor_id),
   (SELECT id FROM window_type_id),
-  sqlc.arg('result_type')
-) ON CONFLICT DO NOTHING ;
+  sqlc.arg('result_type'),
+  sqlc.narg('retry_policy'),
+  sqlc.narg('timeout_ms')
+) ON CONFLICT (name, version, window_type_id, processor_id) DO UPDATE
+SET
+  retry_policy = EXCLUDED.retry_policy,
+  timeout_ms = EXCLUDED.timeout_ms,
+  retired = NULL;
+
+-- name: ReadLiveProcessorId :one
+SELECT id FROM processor
+WHERE name = sqlc.arg('name')
+AND runtime = sqlc.arg('runtime')
+AND deregistered IS NULL;
+
+-- name: ReadLiveAlgorithmId :one
+SELECT id FROM algorithm
+WHERE name = sqlc.arg('name')
+AND version = sqlc.arg('version')
+AND retired IS NULL;
+
+-- name: ReadLiveProcessorAlgorithmIds :many
+SELECT id FROM algorithm
+WHERE processor_id = sqlc.arg('processor_id')
+AND retired IS NULL
+ORDER BY id;
+
+-- name: ReadLiveAlgorithmDependents :many
+WITH RECURSIVE dependents AS (
+  SELECT ad.to_algorithm_id AS algorithm_id
+  FROM algorithm_dependency ad
+  WHERE ad.from_algorithm_id = ANY(sqlc.arg('algorithm_ids')::BIGINT[])
+
+  UNION
+
+  SELECT ad.to_algorithm_id
+  FROM algorithm_dependency ad
+  JOIN dependents d ON ad.from_algorithm_id = d.algorithm_id
+)
+SELECT
+  a.id,
+  a.name,
+  a.version
+FROM algorithm a
+JOIN dependents d ON a.id = d.algorithm_id
+WHERE a.retired IS NULL
+AND NOT a.id = ANY(sqlc.arg('algorithm_ids')::BIGINT[])
+ORDER BY a.id;
+
+-- name: RetireAlgorithms :exec
+UPDATE algorithm
+SET retired = CURRENT_TIMESTAMP
+WHERE id = ANY(sqlc.arg('algorithm_ids')::BIGINT[])
+AND retired IS NULL;
+
+-- name: DeleteAlgorithmDependencies :exec
+DELETE FROM algorithm_dependency
+WHERE from_algorithm_id = ANY(sqlc.arg('algorithm_ids')::BIGINT[])
+OR to_algorithm_id = ANY(sqlc.arg('algorithm_ids')::BIGINT[]);
+
+-- name: DeleteAlgorithmDependency :execrows
+DELETE FROM algorithm_dependency
+WHERE from_algorithm_id = sqlc.arg('from_algorithm_id')
+AND to_algorithm_id = sqlc.arg('to_algorithm_id');
+
+-- name: DeregisterProcessor :exec
+WITH removed_instances AS (
+  DELETE FROM processor_instance
+  WHERE processor_id = sqlc.arg('processor_id')
+)
+UPDATE processor
+SET deregistered = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('processor_id');
+
+-- name: CountUnfinishedExecutionsOfAlgorithm :one
+SELECT COUNT(DISTINCT ep.id)
+FROM execution_node en
+JOIN execution_task et ON en.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN execution_plan ep ON es.execution_plan_id = ep.id
+WHERE en.algorithm_id = sqlc.arg('algorithm_id')
+AND ep.status IN ('pending', 'running');
 
 -- name: ReadAlgorithmsForWindow :many
 SELECT a.* FROM algorithm a
@@ -87,6 +201,10 @@ JOIN window_type wt ON a.window_type_id = wt.id
 WHERE wt.name = sqlc.arg('window_type_name') 
 AND wt.version = sqlc.arg('window_type_version');
 
+-- name: ReadAlgorithmsByIDs :many
+SELECT * FROM algorithm
+WHERE id = ANY(sqlc.arg('algorithm_ids')::bigint[]);
+
 -- name: CreateAlgorithmDependency :exec
 WITH from_algo AS (
   SELECT a.id, a.window_type_id, a.processor_id FROM algorithm a
@@ -164,31 +282,331 @@ INSERT INTO windows (
   time_from, 
   time_to,
   origin, 
-  metadata
+  metadata,
+  idempotency_key
 ) VALUES (
   (SELECT id FROM window_type_id),
   sqlc.arg('time_from'),
   sqlc.arg('time_to'),
   sqlc.arg('origin'),
-  sqlc.arg('metadata')
+  sqlc.arg('metadata'),
+  sqlc.narg('idempotency_key')
 ) RETURNING window_type_id, id;
 
+-- name: LockWindowEmission :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg('dedupe_key')::TEXT, 0));
+
+-- name: ReadDuplicateWindow :one
+SELECT
+  w.id,
+  ep.exec_id
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+LEFT JOIN LATERAL (
+  SELECT exec_id FROM execution_plan
+  WHERE windows_id = w.id
+  AND reprocess_id IS NULL
+  AND NOT provisional
+  ORDER BY id
+  LIMIT 1
+) ep ON TRUE
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+AND CASE
+  WHEN sqlc.narg('idempotency_key')::TEXT IS NOT NULL
+  THEN w.idempotency_key = sqlc.narg('idempotency_key')::TEXT
+  ELSE w.time_from = sqlc.arg('time_from')
+    AND w.time_to = sqlc.arg('time_to')
+    AND w.origin = sqlc.arg('origin')
+    AND w.metadata = sqlc.arg('metadata')::JSONB
+END
+ORDER BY w.id
+LIMIT 1;
+
+-- name: ReadWindowTypeId :one
+SELECT id FROM window_type
+WHERE name = sqlc.arg('window_type_name')
+AND version = sqlc.arg('window_type_version');
+
+-- name: LockWindowEmissions :exec
+SELECT pg_advisory_xact_lock(hashtextextended(dedupe_key, 0))
+FROM (
+  SELECT unnest(sqlc.arg('dedupe_keys')::TEXT[]) AS dedupe_key
+  ORDER BY dedupe_key
+) dedupe_keys;
+
+-- name: ReadDuplicateWindows :batchone
+SELECT
+  w.id,
+  ep.exec_id
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+LEFT JOIN LATERAL (
+  SELECT exec_id FROM execution_plan
+  WHERE windows_id = w.id
+  AND reprocess_id IS NULL
+  AND NOT provisional
+  ORDER BY id
+  LIMIT 1
+) ep ON TRUE
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+AND CASE
+  WHEN sqlc.narg('idempotency_key')::TEXT IS NOT NULL
+  THEN w.idempotency_key = sqlc.narg('idempotency_key')::TEXT
+  ELSE w.time_from = sqlc.arg('time_from')
+    AND w.time_to = sqlc.arg('time_to')
+    AND w.origin = sqlc.arg('origin')
+    AND w.metadata = sqlc.arg('metadata')::JSONB
+END
+ORDER BY w.id
+LIMIT 1;
+
+-- name: RegisterWindows :batchone
+INSERT INTO windows (
+  window_type_id,
+  time_from,
+  time_to,
+  origin,
+  metadata,
+  idempotency_key
+) VALUES (
+  sqlc.arg('window_type_id'),
+  sqlc.arg('time_from'),
+  sqlc.arg('time_to'),
+  sqlc.arg('origin'),
+  sqlc.arg('metadata'),
+  sqlc.narg('idempotency_key')
+) RETURNING id;
+
+-- name: OpenWindow :one
+INSERT INTO windows (
+  window_type_id,
+  time_from,
+  time_to,
+  origin,
+  metadata,
+  idempotency_key,
+  state,
+  provisional
+) VALUES (
+  sqlc.arg('window_type_id'),
+  sqlc.arg('time_from'),
+  sqlc.arg('time_from'),
+  sqlc.arg('origin'),
+  sqlc.arg('metadata'),
+  sqlc.arg('idempotency_key')::TEXT,
+  'open',
+  sqlc.arg('provisional')
+) RETURNING id;
+
+-- name: ReadWindowForUpdate :one
+SELECT
+  w.id,
+  w.window_type_id,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  w.state,
+  w.provisional
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+AND w.idempotency_key = sqlc.arg('idempotency_key')::TEXT
+FOR NO KEY UPDATE OF w;
+
+-- name: ReadWindowForAmendment :one
+SELECT
+  w.id,
+  w.window_type_id,
+  w.idempotency_key,
+  w.state
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+AND NOT w.superseded
+AND CASE
+  WHEN sqlc.narg('idempotency_key')::TEXT IS NOT NULL
+  THEN w.idempotency_key = sqlc.narg('idempotency_key')::TEXT
+  ELSE w.time_from = sqlc.arg('time_from')
+    AND w.time_to = sqlc.arg('time_to')
+    AND w.origin = sqlc.arg('origin')
+    AND w.metadata = sqlc.arg('metadata')::JSONB
+END
+ORDER BY w.id
+LIMIT 1
+FOR UPDATE OF w;
+
+-- name: SupersedeWindow :exec
+UPDATE windows
+SET
+  superseded = TRUE,
+  idempotency_key = NULL
+WHERE id = sqlc.arg('id');
+
+-- name: SupersedeResults :exec
+UPDATE results
+SET superseded = TRUE
+WHERE windows_id = sqlc.arg('windows_id');
+
+-- name: AmendWindow :one
+INSERT INTO windows (
+  window_type_id,
+  time_from,
+  time_to,
+  origin,
+  metadata,
+  idempotency_key,
+  supersedes
+) VALUES (
+  sqlc.arg('window_type_id'),
+  sqlc.arg('time_from'),
+  sqlc.arg('time_to'),
+  sqlc.arg('origin'),
+  sqlc.arg('metadata'),
+  sqlc.narg('idempotency_key'),
+  sqlc.arg('supersedes')
+) RETURNING id;
+
+-- name: ExtendWindow :exec
+UPDATE windows
+SET time_to = sqlc.arg('time_to')
+WHERE id = sqlc.arg('id');
+
+-- name: CloseWindow :exec
+UPDATE windows
+SET
+  time_to = sqlc.arg('time_to'),
+  state = 'closed'
+WHERE id = sqlc.arg('id');
+
 -- name: CreateResult :one
+WITH archived AS (
+  INSERT INTO result_revision (
+    result_id,
+    revision,
+    result_value,
+    result_array,
+    result_json,
+    status,
+    error_message
+  )
+  SELECT
+    r.id,
+    r.revision,
+    r.result_value,
+    r.result_array,
+    r.result_json,
+    r.status,
+    r.error_message
+  FROM results r
+  WHERE sqlc.arg('keep_revision')::BOOLEAN
+  AND r.windows_id = sqlc.arg('windows_id')
+  AND r.algorithm_id = sqlc.arg('algorithm_id')
+  AND (r.result_value, r.result_array, r.result_json, r.status, r.error_message) IS DISTINCT FROM (
+    sqlc.arg('result_value')::DOUBLE PRECISION,
+    sqlc.arg('result_array')::DOUBLE PRECISION[],
+    sqlc.arg('result_json')::JSONB,
+    sqlc.arg('status')::result_status,
+    sqlc.narg('error_message')::TEXT
+  )
+)
 INSERT INTO results (
   windows_id,
   window_type_id, 
   algorithm_id, 
   result_value,
   result_array,
-  result_json
+  result_json,
+  status,
+  error_message,
+  superseded
 ) VALUES (
   sqlc.arg('windows_id'),
   sqlc.arg('window_type_id'),
   sqlc.arg('algorithm_id'),
   sqlc.arg('result_value'),
   sqlc.arg('result_array'),
-  sqlc.arg('result_json')
-) RETURNING id;
+  sqlc.arg('result_json'),
+  sqlc.arg('status'),
+  sqlc.narg('error_message'),
+  -- results that arrive late for an amended window are superseded with it
+  (SELECT w.superseded FROM windows w WHERE w.id = sqlc.arg('windows_id'))
+)
+ON CONFLICT (windows_id, algorithm_id) DO UPDATE SET
+  result_value = EXCLUDED.result_value,
+  result_array = EXCLUDED.result_array,
+  result_json = EXCLUDED.result_json,
+  status = EXCLUDED.status,
+  error_message = EXCLUDED.error_message,
+  superseded = EXCLUDED.superseded,
+  revision = results.revision + CASE
+    WHEN (results.result_value, results.result_array, results.result_json, results.status, results.error_message)
+      IS DISTINCT FROM (EXCLUDED.result_value, EXCLUDED.result_array, EXCLUDED.result_json, EXCLUDED.status, EXCLUDED.error_message)
+    THEN 1 ELSE 0
+  END,
+  seq = CASE
+    WHEN (results.result_value, results.result_array, results.result_json, results.status, results.error_message)
+      IS DISTINCT FROM (EXCLUDED.result_value, EXCLUDED.result_array, EXCLUDED.result_json, EXCLUDED.status, EXCLUDED.error_message)
+    THEN NULL
+    ELSE results.seq
+  END
+RETURNING id;
+
+-- name: LockResultSequence :exec
+SELECT pg_advisory_xact_lock(hashtextextended('results_seq', 0));
+
+-- name: SequenceResults :execrows
+UPDATE results
+SET seq = nextval('results_seq_seq')
+WHERE id IN (
+  SELECT id FROM results
+  WHERE seq IS NULL
+  ORDER BY id
+  FOR UPDATE SKIP LOCKED
+);
+
+-- name: ReadLatestResultSeq :one
+SELECT COALESCE(MAX(seq), 0)::BIGINT AS seq FROM results;
+
+-- name: ReadResultsAfter :many
+SELECT
+  r.seq::BIGINT AS seq,
+  a.name AS algorithm_name,
+  a.version AS algorithm_version,
+  a.result_type,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  r.status,
+  r.error_message
+FROM results r
+JOIN algorithm a ON r.algorithm_id = a.id
+JOIN windows w ON r.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE r.seq > sqlc.arg('after')
+  AND (
+    cardinality(sqlc.arg('algorithm_names')::TEXT[]) = 0
+    OR (a.name, a.version) IN (
+      SELECT * FROM unnest(sqlc.arg('algorithm_names')::TEXT[], sqlc.arg('algorithm_versions')::TEXT[])
+    )
+  )
+  AND (
+    sqlc.narg('window_type_name')::TEXT IS NULL
+    OR (wt.name = sqlc.narg('window_type_name') AND wt.version = sqlc.narg('window_type_version'))
+  )
+  AND COALESCE(w.metadata, '{}') @> sqlc.arg('metadata')::JSONB
+ORDER BY r.seq
+LIMIT sqlc.arg('page_size');
 
 -- name: ReadAllProcessors :many
 SELECT 
@@ -196,7 +614,9 @@ SELECT
   name,
   runtime,
   connection_string,
-  created
+  created,
+  retry_policy,
+  deregistered
 FROM processor
 ORDER BY name, runtime;
 
@@ -206,7 +626,9 @@ SELECT
   name,
   runtime,
   connection_string,
-  created
+  created,
+  retry_policy,
+  deregistered
 FROM processor
 WHERE id = ANY(sqlc.arg('processor_ids')::bigint[])
 ORDER BY name, runtime;
@@ -221,6 +643,741 @@ WHERE window_type_name = sqlc.arg('window_type_name')
   AND window_type_version = sqlc.arg('window_type_version')
 ORDER BY metadata_field_name;
 
+---------------------- Execution operations ----------------------
+-- name: CreateExecutionPlan :one
+INSERT INTO execution_plan (
+  windows_id,
+  exec_id,
+  owner,
+  lease_expires
+) VALUES (
+  sqlc.arg('windows_id'),
+  sqlc.arg('exec_id'),
+  sqlc.arg('owner')::TEXT,
+  CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+) RETURNING id;
+
+-- name: CreateExecutionStage :one
+INSERT INTO execution_stage (
+  execution_plan_id,
+  stage_index
+) VALUES (
+  sqlc.arg('execution_plan_id'),
+  sqlc.arg('stage_index')
+) RETURNING id;
+
+-- name: CreateExecutionTask :one
+INSERT INTO execution_task (
+  execution_stage_id,
+  task_index,
+  processor_id,
+  exec_id
+) VALUES (
+  sqlc.arg('execution_stage_id'),
+  sqlc.arg('task_index'),
+  sqlc.arg('processor_id'),
+  sqlc.arg('exec_id')
+) RETURNING id;
+
+-- name: CreateExecutionNode :exec
+INSERT INTO execution_node (
+  execution_task_id,
+  node_index,
+  algorithm_id,
+  window_type_id,
+  algorithm_dep_ids
+) VALUES (
+  sqlc.arg('execution_task_id'),
+  sqlc.arg('node_index'),
+  sqlc.arg('algorithm_id'),
+  sqlc.arg('window_type_id'),
+  COALESCE(sqlc.arg('algorithm_dep_ids')::bigint[], '{}')
+);
+
+-- name: CreateExecutionPlans :batchone
+INSERT INTO execution_plan (
+  windows_id,
+  exec_id,
+  owner,
+  lease_expires
+) VALUES (
+  sqlc.arg('windows_id'),
+  sqlc.arg('exec_id'),
+  sqlc.arg('owner')::TEXT,
+  CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+) RETURNING id;
+
+-- name: CreateExecutionStages :batchone
+INSERT INTO execution_stage (
+  execution_plan_id,
+  stage_index
+) VALUES (
+  sqlc.arg('execution_plan_id'),
+  sqlc.arg('stage_index')
+) RETURNING id;
+
+-- name: CreateExecutionTasks :batchone
+INSERT INTO execution_task (
+  execution_stage_id,
+  task_index,
+  processor_id,
+  exec_id
+) VALUES (
+  sqlc.arg('execution_stage_id'),
+  sqlc.arg('task_index'),
+  sqlc.arg('processor_id'),
+  sqlc.arg('exec_id')
+) RETURNING id;
+
+-- name: CreateExecutionNodes :batchexec
+INSERT INTO execution_node (
+  execution_task_id,
+  node_index,
+  algorithm_id,
+  window_type_id,
+  algorithm_dep_ids
+) VALUES (
+  sqlc.arg('execution_task_id'),
+  sqlc.arg('node_index'),
+  sqlc.arg('algorithm_id'),
+  sqlc.arg('window_type_id'),
+  COALESCE(sqlc.arg('algorithm_dep_ids')::bigint[], '{}')
+);
+
+-- name: ClaimExecutionPlans :many
+UPDATE execution_plan ep
+SET
+  owner = sqlc.arg('owner')::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+FROM (
+  SELECT id FROM execution_plan
+  WHERE status IN ('pending', 'running')
+  AND (lease_expires IS NULL OR lease_expires < CURRENT_TIMESTAMP)
+  AND owner IS DISTINCT FROM sqlc.arg('owner')::TEXT
+  ORDER BY id
+  FOR UPDATE SKIP LOCKED
+) expired
+WHERE ep.id = expired.id
+RETURNING ep.id;
+
+-- name: ClaimExecutionPlan :execrows
+UPDATE execution_plan
+SET
+  owner = sqlc.arg('owner')::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+WHERE id = sqlc.arg('id')
+AND status IN ('pending', 'running')
+AND (
+  owner IS NULL
+  OR owner = sqlc.arg('owner')::TEXT
+  OR lease_expires IS NULL
+  OR lease_expires < CURRENT_TIMESTAMP
+);
+
+-- name: RenewExecutionLeases :exec
+UPDATE execution_plan
+SET lease_expires = CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+WHERE owner = sqlc.arg('owner')::TEXT
+AND status IN ('pending', 'running');
+
+-- name: ReadExecutionPlanWindow :one
+SELECT
+  w.id,
+  w.window_type_id,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  wt.name,
+  wt.version,
+  ep.exec_id
+FROM execution_plan ep
+JOIN windows w ON ep.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE ep.id = sqlc.arg('execution_plan_id');
+
+-- name: ReadExecutionTasks :many
+SELECT
+  es.id AS execution_stage_id,
+  es.stage_index,
+  es.status AS stage_status,
+  et.id,
+  et.task_index,
+  et.processor_id,
+  et.exec_id,
+  et.status
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE es.execution_plan_id = sqlc.arg('execution_plan_id')
+ORDER BY es.stage_index, et.task_index;
+
+-- name: ReadExecutionNodes :many
+SELECT
+  en.execution_task_id,
+  en.algorithm_id,
+  en.window_type_id,
+  en.algorithm_dep_ids
+FROM execution_node en
+JOIN execution_task et ON en.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE es.execution_plan_id = sqlc.arg('execution_plan_id')
+ORDER BY en.execution_task_id, en.node_index;
+
+-- name: UpdateExecutionPlanStatus :one
+UPDATE execution_plan
+SET
+  status = sqlc.arg('status'),
+  started = CASE WHEN sqlc.arg('status') = 'running' THEN COALESCE(started, CURRENT_TIMESTAMP) ELSE started END,
+  finished = CASE WHEN sqlc.arg('status') IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END,
+  updated = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+AND status NOT IN ('succeeded', 'failed', 'cancelled')
+RETURNING reprocess_id;
+
+-- name: UpdateExecutionStageStatus :exec
+UPDATE execution_stage
+SET
+  status = sqlc.arg('status')
+WHERE id = sqlc.arg('id')
+AND status <> 'cancelled';
+
+-- name: UpdateExecutionTaskStatus :exec
+UPDATE execution_task
+SET
+  status = sqlc.arg('status'),
+  error_message = sqlc.narg('error_message'),
+  updated = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id')
+AND status <> 'cancelled';
+
+-- name: UpdateExecutionNodeStatus :exec
+UPDATE execution_node
+SET
+  status = sqlc.arg('status'),
+  error_message = sqlc.narg('error_message'),
+  started = CASE WHEN sqlc.arg('status') = 'running' THEN COALESCE(started, CURRENT_TIMESTAMP) ELSE started END,
+  finished = CASE WHEN sqlc.arg('status') IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END
+WHERE execution_task_id = sqlc.arg('execution_task_id')
+AND algorithm_id = sqlc.arg('algorithm_id')
+AND status <> 'cancelled';
+
+-- name: UpdateUnfinishedExecutionNodesStatus :exec
+UPDATE execution_node
+SET
+  status = sqlc.arg('status'),
+  error_message = sqlc.narg('error_message'),
+  started = CASE WHEN sqlc.arg('status') = 'running' THEN COALESCE(started, CURRENT_TIMESTAMP) ELSE started END,
+  finished = CASE WHEN sqlc.arg('status') IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END
+WHERE execution_task_id = sqlc.arg('execution_task_id')
+AND status IN ('pending', 'running');
+
+-- name: SkipPendingExecutionWork :exec
+WITH skipped_stages AS (
+  UPDATE execution_stage es
+  SET status = 'skipped'
+  WHERE es.execution_plan_id = sqlc.arg('execution_plan_id')
+  AND es.status = 'pending'
+),
+skipped_tasks AS (
+  UPDATE execution_task et
+  SET
+    status = 'skipped',
+    updated = CURRENT_TIMESTAMP
+  FROM execution_stage es
+  WHERE et.execution_stage_id = es.id
+  AND es.execution_plan_id = sqlc.arg('execution_plan_id')
+  AND et.status = 'pending'
+)
+UPDATE execution_node en
+SET status = 'skipped'
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE en.execution_task_id = et.id
+AND es.execution_plan_id = sqlc.arg('execution_plan_id')
+AND en.status = 'pending';
+
+-- name: CancelUnfinishedExecutionWork :exec
+WITH cancelled_stages AS (
+  UPDATE execution_stage es
+  SET status = 'cancelled'
+  WHERE es.execution_plan_id = sqlc.arg('execution_plan_id')
+  AND es.status IN ('pending', 'running')
+),
+cancelled_tasks AS (
+  UPDATE execution_task et
+  SET
+    status = 'cancelled',
+    error_message = sqlc.narg('error_message'),
+    updated = CURRENT_TIMESTAMP
+  FROM execution_stage es
+  WHERE et.execution_stage_id = es.id
+  AND es.execution_plan_id = sqlc.arg('execution_plan_id')
+  AND et.status IN ('pending', 'running')
+)
+UPDATE execution_node en
+SET
+  status = 'cancelled',
+  error_message = sqlc.narg('error_message'),
+  finished = CASE WHEN en.status = 'running' THEN CURRENT_TIMESTAMP END
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE en.execution_task_id = et.id
+AND es.execution_plan_id = sqlc.arg('execution_plan_id')
+AND en.status IN ('pending', 'running');
+
+-- name: ReadExecution :one
+SELECT
+  ep.id,
+  ep.exec_id,
+  ep.status,
+  ep.created,
+  ep.started,
+  ep.finished,
+  ep.provisional,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  wt.name,
+  wt.version
+FROM execution_plan ep
+JOIN windows w ON ep.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE ep.exec_id = sqlc.arg('exec_id');
+
+-- name: ReadExecutions :many
+SELECT
+  ep.id,
+  ep.exec_id,
+  ep.status,
+  ep.created,
+  ep.started,
+  ep.finished,
+  ep.provisional,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  wt.name,
+  wt.version
+FROM execution_plan ep
+JOIN windows w ON ep.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE w.time_from >= sqlc.arg('time_from') AND w.time_to <= sqlc.arg('time_to')
+AND (sqlc.narg('window_type_name')::TEXT IS NULL OR wt.name = sqlc.narg('window_type_name'))
+AND (sqlc.narg('window_type_version')::TEXT IS NULL OR wt.version = sqlc.narg('window_type_version'))
+AND (sqlc.narg('status')::execution_status IS NULL OR ep.status = sqlc.narg('status'))
+ORDER BY w.time_from, w.time_to, ep.id;
+
+-- name: ReadAlgorithmExecutions :many
+SELECT
+  es.execution_plan_id,
+  en.execution_task_id,
+  a.name,
+  a.version,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version,
+  p.name AS processor_name,
+  p.runtime AS processor_runtime,
+  en.status,
+  en.error_message,
+  en.started,
+  en.finished
+FROM execution_node en
+JOIN execution_task et ON en.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN algorithm a ON en.algorithm_id = a.id
+JOIN window_type wt ON en.window_type_id = wt.id
+JOIN processor p ON et.processor_id = p.id
+WHERE es.execution_plan_id = ANY(sqlc.arg('execution_plan_ids')::BIGINT[])
+ORDER BY es.execution_plan_id, es.stage_index, et.task_index, en.node_index;
+
+-- name: ReadExecutionAttempts :many
+SELECT
+  ea.execution_task_id,
+  ea.attempt,
+  ea.status,
+  ea.error_code,
+  ea.error_message,
+  ea.backoff_ms,
+  ea.started,
+  ea.finished
+FROM execution_attempt ea
+JOIN execution_task et ON ea.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE es.execution_plan_id = ANY(sqlc.arg('execution_plan_ids')::BIGINT[])
+ORDER BY ea.execution_task_id, ea.attempt;
+
+-- name: CreateExecutionAttempt :exec
+INSERT INTO execution_attempt (
+  execution_task_id,
+  attempt,
+  status,
+  error_code,
+  error_message,
+  backoff_ms,
+  started
+) VALUES (
+  sqlc.arg('execution_task_id'),
+  sqlc.arg('attempt'),
+  sqlc.arg('status'),
+  sqlc.narg('error_code'),
+  sqlc.narg('error_message'),
+  sqlc.narg('backoff_ms'),
+  sqlc.arg('started')
+);
+
+-- name: ReadResultsForWindow :many
+SELECT
+  r.algorithm_id,
+  a.name,
+  a.version,
+  a.result_type,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  r.status,
+  r.error_message
+FROM results r
+JOIN algorithm a ON r.algorithm_id = a.id
+WHERE r.windows_id = sqlc.arg('windows_id')
+ORDER BY r.id;
+
+-- name: ReadDependencyResults :many
+SELECT
+  r.algorithm_id,
+  a.name,
+  a.version,
+  a.result_type,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  w.time_from,
+  w.time_to
+FROM results r
+JOIN algorithm a ON r.algorithm_id = a.id
+JOIN windows w ON r.windows_id = w.id
+WHERE r.algorithm_id = ANY(sqlc.arg('algorithm_ids')::BIGINT[])
+  AND r.status = 'succeeded'
+  AND NOT r.superseded
+  AND w.time_from >= sqlc.arg('time_from')
+  AND w.time_to <= sqlc.arg('time_to')
+  -- metadata fields shared between the windows must match
+  AND NOT EXISTS (
+    SELECT 1 FROM jsonb_each(w.metadata) m
+    WHERE sqlc.arg('metadata')::JSONB -> m.key <> m.value
+  )
+ORDER BY w.time_from, w.time_to, r.id;
+
+-- name: CreateReprocess :one
+INSERT INTO reprocess (
+  window_type_id,
+  time_from,
+  time_to,
+  metadata,
+  algorithm_ids,
+  windows_per_second,
+  total_windows
+)
+SELECT
+  wt.id,
+  sqlc.arg('time_from')::TIMESTAMP,
+  sqlc.arg('time_to')::TIMESTAMP,
+  sqlc.arg('metadata')::JSONB,
+  sqlc.arg('algorithm_ids')::BIGINT[],
+  sqlc.arg('windows_per_second')::DOUBLE PRECISION,
+  (
+    SELECT COUNT(*) FROM windows w
+    WHERE w.window_type_id = wt.id
+    AND w.time_from >= sqlc.arg('time_from')::TIMESTAMP
+    AND w.time_to <= sqlc.arg('time_to')::TIMESTAMP
+    AND COALESCE(w.metadata, '{}') @> sqlc.arg('metadata')::JSONB
+    AND w.state = 'closed'
+    AND NOT w.superseded
+  )
+FROM window_type wt
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+RETURNING id, reprocess_id;
+
+-- name: ReadReprocess :one
+SELECT
+  r.id,
+  r.reprocess_id,
+  r.status,
+  r.total_windows,
+  r.triggered_windows,
+  r.error_message,
+  r.created,
+  r.finished,
+  COUNT(ep.id) FILTER (WHERE ep.status = 'succeeded') AS succeeded_windows,
+  COUNT(ep.id) FILTER (WHERE ep.status IN ('failed', 'cancelled')) AS failed_windows
+FROM reprocess r
+LEFT JOIN execution_plan ep ON ep.reprocess_id = r.id
+WHERE r.reprocess_id = sqlc.arg('reprocess_id')
+GROUP BY r.id;
+
+-- name: ReadReprocessByID :one
+SELECT * FROM reprocess
+WHERE id = sqlc.arg('id');
+
+-- name: ClaimReprocesses :many
+UPDATE reprocess r
+SET
+  owner = sqlc.arg('owner')::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+FROM (
+  SELECT id FROM reprocess
+  WHERE status IN ('pending', 'running')
+  AND triggered IS NULL
+  AND (lease_expires IS NULL OR lease_expires < CURRENT_TIMESTAMP)
+  AND owner IS DISTINCT FROM sqlc.arg('owner')::TEXT
+  ORDER BY id
+  FOR UPDATE SKIP LOCKED
+) expired
+WHERE r.id = expired.id
+RETURNING r.id;
+
+-- name: ClaimReprocess :execrows
+UPDATE reprocess
+SET
+  owner = sqlc.arg('owner')::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+WHERE id = sqlc.arg('id')
+AND status IN ('pending', 'running')
+AND triggered IS NULL
+AND (
+  owner IS NULL
+  OR owner = sqlc.arg('owner')::TEXT
+  OR lease_expires IS NULL
+  OR lease_expires < CURRENT_TIMESTAMP
+);
+
+-- name: RenewReprocessLeases :exec
+UPDATE reprocess
+SET lease_expires = CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+WHERE owner = sqlc.arg('owner')::TEXT
+AND status IN ('pending', 'running')
+AND triggered IS NULL;
+
+-- name: ReadWindowsToReprocess :many
+SELECT w.id FROM windows w
+JOIN reprocess r ON w.window_type_id = r.window_type_id
+WHERE r.id = sqlc.arg('reprocess_id')
+AND w.time_from >= r.time_from
+AND w.time_to <= r.time_to
+AND COALESCE(w.metadata, '{}') @> r.metadata
+AND w.created <= r.created
+AND w.id > r.last_window_id
+AND w.state = 'closed'
+AND NOT w.superseded
+ORDER BY w.id
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateReprocessProgress :execrows
+UPDATE reprocess
+SET
+  last_window_id = sqlc.arg('last_window_id'),
+  triggered_windows = triggered_windows + 1
+WHERE id = sqlc.arg('id')
+AND owner = sqlc.arg('owner')::TEXT;
+
+-- name: SetReprocessTriggered :exec
+UPDATE reprocess
+SET triggered = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg('id');
+
+-- name: FinishReprocess :exec
+UPDATE reprocess r
+SET
+  status = CASE
+    WHEN EXISTS (
+      SELECT 1 FROM execution_plan ep
+      WHERE ep.reprocess_id = r.id
+      AND ep.status IN ('failed', 'cancelled')
+    ) THEN 'failed'
+    ELSE 'succeeded'
+  END::execution_status,
+  finished = CURRENT_TIMESTAMP
+WHERE r.id = sqlc.arg('id')
+AND r.status = 'running'
+AND r.triggered IS NOT NULL
+AND NOT EXISTS (
+  SELECT 1 FROM execution_plan ep
+  WHERE ep.reprocess_id = r.id
+  AND ep.status IN ('pending', 'running')
+);
+
+-- name: ReopenReprocess :exec
+UPDATE reprocess r
+SET
+  status = 'running',
+  finished = NULL
+FROM execution_plan ep
+WHERE ep.id = sqlc.arg('execution_plan_id')
+AND r.id = ep.reprocess_id
+AND r.triggered IS NOT NULL;
+
+-- name: UpdateReprocessStatus :exec
+UPDATE reprocess
+SET
+  status = sqlc.arg('status'),
+  error_message = sqlc.narg('error_message'),
+  finished = CASE WHEN sqlc.arg('status') IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END
+WHERE id = sqlc.arg('id');
+
+-- name: SetExecutionPlanReprocess :exec
+UPDATE execution_plan
+SET reprocess_id = sqlc.arg('reprocess_id')
+WHERE id = sqlc.arg('id');
+
+-- name: SetExecutionPlanProvisional :exec
+UPDATE execution_plan
+SET provisional = TRUE
+WHERE id = sqlc.arg('id');
+
+-- name: ReadWindowExecId :one
+SELECT exec_id FROM execution_plan
+WHERE windows_id = sqlc.arg('windows_id')
+AND reprocess_id IS NULL
+AND NOT provisional
+ORDER BY id
+LIMIT 1;
+
+-- name: ReadUnfinishedWindowExecutions :many
+SELECT exec_id FROM execution_plan
+WHERE windows_id = sqlc.arg('windows_id')
+AND status IN ('pending', 'running')
+ORDER BY id;
+
+-- name: CreateDeadLetter :exec
+INSERT INTO dead_letter (
+  execution_task_id,
+  processor_id,
+  algorithm_ids,
+  request,
+  error_code,
+  error_message,
+  attempts
+) VALUES (
+  sqlc.arg('execution_task_id'),
+  sqlc.arg('processor_id'),
+  sqlc.arg('algorithm_ids'),
+  sqlc.arg('request'),
+  sqlc.arg('error_code'),
+  sqlc.arg('error_message'),
+  sqlc.arg('attempts')
+)
+ON CONFLICT (execution_task_id) DO UPDATE SET
+  algorithm_ids = EXCLUDED.algorithm_ids,
+  request = EXCLUDED.request,
+  error_code = EXCLUDED.error_code,
+  error_message = EXCLUDED.error_message,
+  attempts = dead_letter.attempts + EXCLUDED.attempts,
+  created = CURRENT_TIMESTAMP,
+  requeued = NULL;
+
+-- name: ReadFailedExecutions :many
+SELECT
+  ep.exec_id,
+  et.exec_id AS task_exec_id,
+  p.name AS processor_name,
+  p.runtime AS processor_runtime,
+  dl.request,
+  dl.error_code,
+  dl.error_message,
+  dl.attempts,
+  dl.created,
+  dl.requeued
+FROM dead_letter dl
+JOIN execution_task et ON dl.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN execution_plan ep ON es.execution_plan_id = ep.id
+JOIN windows w ON ep.windows_id = w.id
+JOIN processor p ON dl.processor_id = p.id
+WHERE (sqlc.arg('include_requeued')::BOOLEAN OR dl.requeued IS NULL)
+AND w.time_from >= sqlc.arg('time_from') AND w.time_to <= sqlc.arg('time_to')
+AND (sqlc.narg('processor_name')::TEXT IS NULL OR p.name = sqlc.narg('processor_name'))
+AND (sqlc.narg('processor_runtime')::TEXT IS NULL OR p.runtime = sqlc.narg('processor_runtime'))
+AND (sqlc.narg('algorithm_name')::TEXT IS NULL OR EXISTS (
+  SELECT 1 FROM algorithm a
+  WHERE a.id = ANY(dl.algorithm_ids)
+  AND a.name = sqlc.narg('algorithm_name')
+  AND (sqlc.narg('algorithm_version')::TEXT IS NULL OR a.version = sqlc.narg('algorithm_version'))
+))
+ORDER BY w.time_from, w.time_to, dl.id;
+
+-- name: ReadFailedExecutionPlans :many
+SELECT DISTINCT
+  ep.id,
+  ep.exec_id
+FROM dead_letter dl
+JOIN execution_task et ON dl.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN execution_plan ep ON es.execution_plan_id = ep.id
+JOIN windows w ON ep.windows_id = w.id
+JOIN processor p ON dl.processor_id = p.id
+WHERE dl.requeued IS NULL
+AND ep.status = 'failed'
+AND w.time_from >= sqlc.arg('time_from') AND w.time_to <= sqlc.arg('time_to')
+AND (sqlc.narg('processor_name')::TEXT IS NULL OR p.name = sqlc.narg('processor_name'))
+AND (sqlc.narg('processor_runtime')::TEXT IS NULL OR p.runtime = sqlc.narg('processor_runtime'))
+AND (sqlc.narg('algorithm_name')::TEXT IS NULL OR EXISTS (
+  SELECT 1 FROM algorithm a
+  WHERE a.id = ANY(dl.algorithm_ids)
+  AND a.name = sqlc.narg('algorithm_name')
+  AND (sqlc.narg('algorithm_version')::TEXT IS NULL OR a.version = sqlc.narg('algorithm_version'))
+))
+ORDER BY ep.id;
+
+-- name: ResetFailedExecutionWork :exec
+WITH reset_plan AS (
+  UPDATE execution_plan
+  SET
+    status = 'pending',
+    finished = NULL,
+    updated = CURRENT_TIMESTAMP,
+    owner = sqlc.arg('owner')::TEXT,
+    lease_expires = CURRENT_TIMESTAMP + sqlc.arg('lease_ms')::BIGINT * INTERVAL '1 millisecond'
+  WHERE id = sqlc.arg('execution_plan_id')
+),
+reset_stages AS (
+  UPDATE execution_stage es
+  SET status = 'pending'
+  WHERE es.execution_plan_id = sqlc.arg('execution_plan_id')
+  AND es.status != 'succeeded'
+),
+reset_tasks AS (
+  UPDATE execution_task et
+  SET
+    status = 'pending',
+    error_message = NULL,
+    updated = CURRENT_TIMESTAMP
+  FROM execution_stage es
+  WHERE et.execution_stage_id = es.id
+  AND es.execution_plan_id = sqlc.arg('execution_plan_id')
+  AND et.status != 'succeeded'
+),
+requeued_dead_letters AS (
+  UPDATE dead_letter dl
+  SET requeued = COALESCE(dl.requeued, CURRENT_TIMESTAMP)
+  FROM execution_task et
+  JOIN execution_stage es ON et.execution_stage_id = es.id
+  WHERE dl.execution_task_id = et.id
+  AND es.execution_plan_id = sqlc.arg('execution_plan_id')
+)
+UPDATE execution_node en
+SET
+  status = 'pending',
+  error_message = NULL,
+  started = NULL,
+  finished = NULL
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE en.execution_task_id = et.id
+AND es.execution_plan_id = sqlc.arg('execution_plan_id')
+AND en.status != 'succeeded';
+
 ---------------------- Data operations ---------------------- 
 -- name: ReadWindowTypes :many
 SELECT
@@ -246,6 +1403,7 @@ SELECT
 FROM algorithm a
   JOIN window_type w ON a.window_type_id = w.id
   JOIN processor p ON a.processor_id = p.id
+WHERE a.retired IS NULL
 ORDER BY a.processor_id, a.created DESC;
 
 -- name: ReadProcessors :many
@@ -255,8 +1413,56 @@ SELECT
   runtime, 
   created
 FROM processor
+WHERE deregistered IS NULL
 ORDER BY created DESC;
 
+-- name: ReadProcessorInstances :many
+SELECT
+  id,
+  processor_id,
+  connection_string,
+  created,
+  registered,
+  last_seen,
+  last_checked,
+  health_status,
+  health_message,
+  active_tasks,
+  memory_bytes,
+  cpu_percent,
+  uptime_seconds,
+  available,
+  instance_id
+FROM processor_instance
+WHERE processor_id = sqlc.arg('processor_id')
+ORDER BY registered DESC, id DESC;
+
+-- name: ReadAllProcessorInstances :many
+SELECT
+  pi.id,
+  pi.processor_id,
+  pi.connection_string,
+  p.name,
+  p.runtime
+FROM processor_instance pi
+JOIN processor p ON pi.processor_id = p.id
+ORDER BY pi.id;
+
+-- name: UpdateProcessorInstanceHealth :exec
+UPDATE processor_instance
+SET
+  last_checked = CURRENT_TIMESTAMP,
+  last_seen = CASE WHEN sqlc.arg('seen')::BOOLEAN THEN CURRENT_TIMESTAMP ELSE last_seen END,
+  health_status = sqlc.arg('health_status'),
+  health_message = sqlc.narg('health_message'),
+  active_tasks = COALESCE(sqlc.narg('active_tasks'), active_tasks),
+  memory_bytes = COALESCE(sqlc.narg('memory_bytes'), memory_bytes),
+  cpu_percent = COALESCE(sqlc.narg('cpu_percent'), cpu_percent),
+  uptime_seconds = COALESCE(sqlc.narg('uptime_seconds'), uptime_seconds),
+  available = sqlc.arg('seen')::BOOLEAN
+    OR COALESCE(last_seen, registered) > CURRENT_TIMESTAMP - make_interval(secs => sqlc.arg('unavailable_after_seconds')::DOUBLE PRECISION)
+WHERE id = sqlc.arg('id');
+
 -- name: ReadResultsStats :one
 SELECT
   COUNT(r.id)
@@ -269,7 +1475,8 @@ WHERE
   w.time_from  >= sqlc.arg('time_from')
   AND w.time_to <= sqlc.arg('time_to')
   AND wt.name = sqlc.arg('window_type_name')
-  AND wt.version = sqlc.arg('window_type_version');
+  AND wt.version = sqlc.arg('window_type_version')
+  AND NOT w.superseded;
 
 -- name: ReadResultsForAlgorithm :many
 select
@@ -277,7 +1484,10 @@ select
   w.time_to,
   r.result_value,
   r.result_array,
-  r.result_json
+  r.result_json,
+  r.status,
+  r.error_message,
+  r.superseded
 from results r
 join algorithm a on r.algorithm_id = a.id
 join windows w on r.windows_id = w.id
@@ -285,6 +1495,7 @@ where
 	w.time_from  >= sqlc.arg('time_from') and w.time_to <= sqlc.arg('time_to')
 	and a."name" = sqlc.arg('algorithm_name')
 	and a."version" = sqlc.arg('algorithm_version')
+	and (sqlc.arg('include_superseded')::BOOLEAN or not r.superseded)
 ORDER BY w.time_from, w.time_to ASC;
 
 -- name: ReadDistinctJsonResultFieldsForAlgorithm :many
@@ -294,7 +1505,8 @@ join windows w on r.windows_id = w.id
 where
 	w.time_from  >= sqlc.arg('time_from') and w.time_to <= sqlc.arg('time_to')
 	and a."name" = sqlc.arg('algorithm_name')
-	and a."version" = sqlc.arg('algorithm_version');
+	and a."version" = sqlc.arg('algorithm_version')
+	and not r.superseded;
 
 -- name: ReadAlgorithmJsonField :many
 select w.time_from, w.time_to, (r.result_json::json->>sqlc.arg('field_name')) as result from results r
@@ -318,6 +1530,7 @@ join window_type wt on w.window_type_id =wt.id
 where
 	wt."name" = sqlc.arg('window_type_name') and wt."version" = sqlc.arg('window_type_version')
 	and w.time_from  >= sqlc.arg('time_from') and w.time_to <= sqlc.arg('time_to')
+	and (sqlc.arg('include_superseded')::BOOLEAN or not w.superseded)
 ORDER BY w.time_from, w.time_to ASC;
 
 -- name: ReadWindowsForMetadata :many
@@ -334,6 +1547,7 @@ where
 	wt."name" = sqlc.arg('window_type_name') and wt."version" = sqlc.arg('window_type_version')
 	and w.time_from  >= sqlc.arg('time_from') and w.time_to <= sqlc.arg('time_to')
 	and w.metadata::jsonb @> sqlc.arg('metadata_filter')::jsonb
+	and not w.superseded
 ORDER BY w.time_from, w.time_to ASC;
 
 
@@ -347,14 +1561,17 @@ SELECT
   w.metadata,
   r.result_value,
   r.result_array,
-  r.result_json
+  r.result_json,
+  r.status,
+  r.error_message
 FROM results r
 JOIN windows w ON r.windows_id  = w.id
 WHERE
   w.time_from >= sqlc.arg('time_from') AND
   w.time_to <= sqlc.arg('time_to') AND
   w.metadata::jsonb @> sqlc.arg('metadata_filter')::jsonb AND
-  r.algorithm_id = (SELECT id FROM algorithmId)
+  r.algorithm_id = (SELECT id FROM algorithmId) AND
+  NOT r.superseded
 ORDER BY w.time_from, w.time_to ASC;
 
 ---------------------- Annotation operations ---------------------- 
@@ -387,3 +1604,316 @@ WITH window_type_id AS (
 )
 INSERT INTO annotation_window_types (annotation_id, window_type_id)
 VALUES (sqlc.arg('annotation_id'), (SELECT id FROM window_type_id));
+
+-- name: CreateAlertRule :execrows
+INSERT INTO alert_rule (
+  name,
+  algorithm_id,
+  expression,
+  description,
+  metadata_key
+)
+SELECT
+  sqlc.arg('name'),
+  a.id,
+  sqlc.arg('expression'),
+  sqlc.arg('description'),
+  sqlc.arg('metadata_key')
+FROM algorithm a
+WHERE a.name = sqlc.arg('algorithm_name')
+AND a.version = sqlc.arg('algorithm_version')
+AND a.retired IS NULL
+ON CONFLICT (name) DO UPDATE
+SET
+  algorithm_id = EXCLUDED.algorithm_id,
+  expression = EXCLUDED.expression,
+  description = EXCLUDED.description,
+  metadata_key = EXCLUDED.metadata_key;
+
+-- name: ReadAlertRules :many
+SELECT
+  ar.name,
+  ar.expression,
+  ar.description,
+  ar.metadata_key,
+  a.name AS algorithm_name,
+  a.version AS algorithm_version
+FROM alert_rule ar
+JOIN algorithm a ON ar.algorithm_id = a.id
+ORDER BY ar.name;
+
+-- name: ReadAlertRulesForAlgorithm :many
+SELECT
+  id,
+  name,
+  expression,
+  metadata_key
+FROM alert_rule
+WHERE algorithm_id = sqlc.arg('algorithm_id')
+ORDER BY id;
+
+-- name: DeleteAlertRule :execrows
+DELETE FROM alert_rule
+WHERE name = sqlc.arg('name');
+
+-- name: FireAlert :execrows
+INSERT INTO alert (
+  alert_rule_id,
+  dedupe_key,
+  origin,
+  metadata,
+  windows_id
+) VALUES (
+  sqlc.arg('alert_rule_id'),
+  sqlc.arg('dedupe_key'),
+  sqlc.arg('origin'),
+  sqlc.arg('metadata'),
+  sqlc.arg('windows_id')
+)
+ON CONFLICT (alert_rule_id, dedupe_key) WHERE status = 'firing' DO NOTHING;
+
+-- name: ResolveAlert :execrows
+UPDATE alert
+SET
+  status = 'resolved',
+  resolved = CURRENT_TIMESTAMP
+WHERE alert_rule_id = sqlc.arg('alert_rule_id')
+AND dedupe_key = sqlc.arg('dedupe_key')
+AND status = 'firing';
+
+-- name: ReadAlerts :many
+SELECT
+  al.id,
+  ar.name AS alert_rule_name,
+  a.name AS algorithm_name,
+  a.version AS algorithm_version,
+  al.status,
+  al.origin,
+  al.metadata,
+  al.fired,
+  al.resolved,
+  al.acknowledged,
+  al.acknowledged_by,
+  w.time_from,
+  w.time_to
+FROM alert al
+JOIN alert_rule ar ON al.alert_rule_id = ar.id
+JOIN algorithm a ON ar.algorithm_id = a.id
+JOIN windows w ON al.windows_id = w.id
+WHERE al.fired >= sqlc.arg('fired_from') AND al.fired <= sqlc.arg('fired_to')
+AND (sqlc.narg('alert_rule_name')::TEXT IS NULL OR ar.name = sqlc.narg('alert_rule_name'))
+AND (sqlc.narg('status')::alert_status IS NULL OR al.status = sqlc.narg('status'))
+AND (sqlc.arg('include_acknowledged')::BOOLEAN OR al.acknowledged IS NULL)
+ORDER BY al.fired, al.id;
+
+-- name: AcknowledgeAlert :execrows
+UPDATE alert
+SET
+  acknowledged = CURRENT_TIMESTAMP,
+  acknowledged_by = sqlc.narg('acknowledged_by')
+WHERE id = sqlc.arg('id');
+
+---------------------- Schedule operations ----------------------
+-- name: CreateWindowSchedule :execrows
+INSERT INTO window_schedule (
+  name,
+  window_type_id,
+  cron,
+  timezone,
+  origin,
+  metadata,
+  emitted_until,
+  next_emission
+)
+SELECT
+  sqlc.arg('name'),
+  wt.id,
+  sqlc.arg('cron'),
+  sqlc.arg('timezone'),
+  sqlc.arg('origin'),
+  sqlc.arg('metadata'),
+  sqlc.arg('emitted_until'),
+  sqlc.arg('next_emission')
+FROM window_type wt
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+ON CONFLICT (name) DO UPDATE
+SET
+  window_type_id = EXCLUDED.window_type_id,
+  cron = EXCLUDED.cron,
+  timezone = EXCLUDED.timezone,
+  origin = EXCLUDED.origin,
+  metadata = EXCLUDED.metadata,
+  emitted_until = EXCLUDED.emitted_until,
+  next_emission = EXCLUDED.next_emission;
+
+-- name: ReadWindowScheduleEmittedUntil :one
+SELECT emitted_until FROM window_schedule
+WHERE name = sqlc.arg('name')
+FOR UPDATE;
+
+-- name: ReadWindowSchedules :many
+SELECT
+  ws.name,
+  ws.cron,
+  ws.timezone,
+  ws.origin,
+  ws.metadata,
+  ws.emitted_until,
+  ws.next_emission,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version
+FROM window_schedule ws
+JOIN window_type wt ON ws.window_type_id = wt.id
+ORDER BY ws.name;
+
+-- name: DeleteWindowSchedule :execrows
+DELETE FROM window_schedule
+WHERE name = sqlc.arg('name');
+
+-- name: ClaimDueWindowSchedules :many
+SELECT
+  ws.id,
+  ws.name,
+  ws.cron,
+  ws.timezone,
+  ws.origin,
+  ws.metadata,
+  ws.emitted_until,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version
+FROM window_schedule ws
+JOIN window_type wt ON ws.window_type_id = wt.id
+WHERE ws.next_emission <= sqlc.arg('now')
+ORDER BY ws.next_emission
+FOR UPDATE OF ws SKIP LOCKED;
+
+-- name: AdvanceWindowSchedule :exec
+UPDATE window_schedule
+SET
+  emitted_until = sqlc.arg('emitted_until'),
+  next_emission = sqlc.arg('next_emission')
+WHERE id = sqlc.arg('id');
+
+-- name: CreateRollupRule :execrows
+INSERT INTO rollup_rule (
+  name,
+  child_window_type_id,
+  parent_window_type_id,
+  cron,
+  timezone,
+  metadata_keys,
+  origin
+)
+SELECT
+  sqlc.arg('name'),
+  child.id,
+  parent.id,
+  sqlc.arg('cron'),
+  sqlc.arg('timezone'),
+  sqlc.arg('metadata_keys')::TEXT[],
+  sqlc.arg('origin')
+FROM window_type child, window_type parent
+WHERE child.name = sqlc.arg('child_window_type_name')
+AND child.version = sqlc.arg('child_window_type_version')
+AND parent.name = sqlc.arg('parent_window_type_name')
+AND parent.version = sqlc.arg('parent_window_type_version')
+ON CONFLICT (name) DO UPDATE
+SET
+  child_window_type_id = EXCLUDED.child_window_type_id,
+  parent_window_type_id = EXCLUDED.parent_window_type_id,
+  cron = EXCLUDED.cron,
+  timezone = EXCLUDED.timezone,
+  metadata_keys = EXCLUDED.metadata_keys,
+  origin = EXCLUDED.origin;
+
+-- name: ReadRollupRules :many
+SELECT
+  rr.name,
+  rr.cron,
+  rr.timezone,
+  rr.metadata_keys,
+  rr.origin,
+  child.name AS child_window_type_name,
+  child.version AS child_window_type_version,
+  parent.name AS parent_window_type_name,
+  parent.version AS parent_window_type_version
+FROM rollup_rule rr
+JOIN window_type child ON rr.child_window_type_id = child.id
+JOIN window_type parent ON rr.parent_window_type_id = parent.id
+ORDER BY rr.name;
+
+-- name: ReadRollupRulesForWindowType :many
+SELECT
+  rr.name,
+  rr.cron,
+  rr.timezone,
+  rr.metadata_keys,
+  rr.origin,
+  parent.name AS parent_window_type_name,
+  parent.version AS parent_window_type_version
+FROM rollup_rule rr
+JOIN window_type child ON rr.child_window_type_id = child.id
+JOIN window_type parent ON rr.parent_window_type_id = parent.id
+WHERE child.name = sqlc.arg('window_type_name')
+AND child.version = sqlc.arg('window_type_version')
+ORDER BY rr.name;
+
+-- name: DeleteRollupRule :execrows
+DELETE FROM rollup_rule
+WHERE name = sqlc.arg('name');
+
+-- name: ReadRollupChildWindows :many
+SELECT
+  w.id,
+  w.time_from,
+  w.time_to
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+AND w.time_from >= sqlc.arg('time_from')
+AND w.time_to <= sqlc.arg('time_to')
+AND w.metadata @> sqlc.arg('metadata')::JSONB
+AND w.state = 'closed'
+AND NOT w.superseded
+ORDER BY w.time_from, w.time_to;
+
+-- name: LinkChildWindows :exec
+INSERT INTO window_link (
+  parent_windows_id,
+  child_windows_id
+)
+SELECT
+  parent.id,
+  child_windows_id
+FROM windows parent
+JOIN window_type wt ON parent.window_type_id = wt.id
+CROSS JOIN unnest(sqlc.arg('child_windows_ids')::BIGINT[]) AS child_windows_id
+WHERE wt.name = sqlc.arg('window_type_name')
+AND wt.version = sqlc.arg('window_type_version')
+AND parent.idempotency_key = sqlc.arg('idempotency_key')::TEXT
+ON CONFLICT DO NOTHING;
+
+-- name: ReadChildWindows :many
+SELECT
+  child.time_from,
+  child.time_to,
+  child.origin,
+  child.metadata,
+  cwt.name,
+  cwt.version
+FROM windows parent
+JOIN window_type pwt ON parent.window_type_id = pwt.id
+JOIN window_link wl ON wl.parent_windows_id = parent.id
+JOIN windows child ON wl.child_windows_id = child.id
+JOIN window_type cwt ON child.window_type_id = cwt.id
+WHERE pwt.name = sqlc.arg('window_type_name')
+AND pwt.version = sqlc.arg('window_type_version')
+AND parent.time_from = sqlc.arg('time_from')
+AND parent.time_to = sqlc.arg('time_to')
+AND parent.origin = sqlc.arg('origin')
+AND parent.metadata @> sqlc.arg('metadata')::JSONB
+AND NOT parent.superseded
+AND NOT child.superseded
+ORDER BY child.time_from, child.time_to, child.id;
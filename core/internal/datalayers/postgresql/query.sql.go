@@ -11,36 +11,460 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const acknowledgeAlert = `-- name: AcknowledgeAlert :execrows
+UPDATE alert
+SET
+  acknowledged = CURRENT_TIMESTAMP,
+  acknowledged_by = $1
+WHERE id = $2
+`
+
+type AcknowledgeAlertParams struct {
+	AcknowledgedBy pgtype.Text
+	ID             int64
+}
+
+func (q *Queries) AcknowledgeAlert(ctx context.Context, arg AcknowledgeAlertParams) (int64, error) {
+	result, err := q.db.Exec(ctx, acknowledgeAlert, arg.AcknowledgedBy, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const advanceWindowSchedule = `-- name: AdvanceWindowSchedule :exec
+UPDATE window_schedule
+SET
+  emitted_until = $1,
+  next_emission = $2
+WHERE id = $3
+`
+
+type AdvanceWindowScheduleParams struct {
+	EmittedUntil pgtype.Timestamp
+	NextEmission pgtype.Timestamp
+	ID           int64
+}
+
+func (q *Queries) AdvanceWindowSchedule(ctx context.Context, arg AdvanceWindowScheduleParams) error {
+	_, err := q.db.Exec(ctx, advanceWindowSchedule, arg.EmittedUntil, arg.NextEmission, arg.ID)
+	return err
+}
+
+const amendWindow = `-- name: AmendWindow :one
+INSERT INTO windows (
+  window_type_id,
+  time_from,
+  time_to,
+  origin,
+  metadata,
+  idempotency_key,
+  supersedes
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+) RETURNING id
+`
+
+type AmendWindowParams struct {
+	WindowTypeID   int64
+	TimeFrom       pgtype.Timestamp
+	TimeTo         pgtype.Timestamp
+	Origin         string
+	Metadata       []byte
+	IdempotencyKey pgtype.Text
+	Supersedes     pgtype.Int8
+}
+
+func (q *Queries) AmendWindow(ctx context.Context, arg AmendWindowParams) (int64, error) {
+	row := q.db.QueryRow(ctx, amendWindow,
+		arg.WindowTypeID,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Origin,
+		arg.Metadata,
+		arg.IdempotencyKey,
+		arg.Supersedes,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const cancelUnfinishedExecutionWork = `-- name: CancelUnfinishedExecutionWork :exec
+WITH cancelled_stages AS (
+  UPDATE execution_stage es
+  SET status = 'cancelled'
+  WHERE es.execution_plan_id = $1
+  AND es.status IN ('pending', 'running')
+),
+cancelled_tasks AS (
+  UPDATE execution_task et
+  SET
+    status = 'cancelled',
+    error_message = $2,
+    updated = CURRENT_TIMESTAMP
+  FROM execution_stage es
+  WHERE et.execution_stage_id = es.id
+  AND es.execution_plan_id = $1
+  AND et.status IN ('pending', 'running')
+)
+UPDATE execution_node en
+SET
+  status = 'cancelled',
+  error_message = $2,
+  finished = CASE WHEN en.status = 'running' THEN CURRENT_TIMESTAMP END
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE en.execution_task_id = et.id
+AND es.execution_plan_id = $1
+AND en.status IN ('pending', 'running')
+`
+
+type CancelUnfinishedExecutionWorkParams struct {
+	ExecutionPlanID int64
+	ErrorMessage    pgtype.Text
+}
+
+func (q *Queries) CancelUnfinishedExecutionWork(ctx context.Context, arg CancelUnfinishedExecutionWorkParams) error {
+	_, err := q.db.Exec(ctx, cancelUnfinishedExecutionWork, arg.ExecutionPlanID, arg.ErrorMessage)
+	return err
+}
+
+const claimDueWindowSchedules = `-- name: ClaimDueWindowSchedules :many
+SELECT
+  ws.id,
+  ws.name,
+  ws.cron,
+  ws.timezone,
+  ws.origin,
+  ws.metadata,
+  ws.emitted_until,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version
+FROM window_schedule ws
+JOIN window_type wt ON ws.window_type_id = wt.id
+WHERE ws.next_emission <= $1
+ORDER BY ws.next_emission
+FOR UPDATE OF ws SKIP LOCKED
+`
+
+type ClaimDueWindowSchedulesRow struct {
+	ID                int64
+	Name              string
+	Cron              string
+	Timezone          string
+	Origin            string
+	Metadata          []byte
+	EmittedUntil      pgtype.Timestamp
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+func (q *Queries) ClaimDueWindowSchedules(ctx context.Context, now pgtype.Timestamp) ([]ClaimDueWindowSchedulesRow, error) {
+	rows, err := q.db.Query(ctx, claimDueWindowSchedules, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueWindowSchedulesRow
+	for rows.Next() {
+		var i ClaimDueWindowSchedulesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Cron,
+			&i.Timezone,
+			&i.Origin,
+			&i.Metadata,
+			&i.EmittedUntil,
+			&i.WindowTypeName,
+			&i.WindowTypeVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimExecutionPlan = `-- name: ClaimExecutionPlan :execrows
+UPDATE execution_plan
+SET
+  owner = $1::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + $2::BIGINT * INTERVAL '1 millisecond'
+WHERE id = $3
+AND status IN ('pending', 'running')
+AND (
+  owner IS NULL
+  OR owner = $1::TEXT
+  OR lease_expires IS NULL
+  OR lease_expires < CURRENT_TIMESTAMP
+)
+`
+
+type ClaimExecutionPlanParams struct {
+	Owner   string
+	LeaseMs int64
+	ID      int64
+}
+
+func (q *Queries) ClaimExecutionPlan(ctx context.Context, arg ClaimExecutionPlanParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimExecutionPlan, arg.Owner, arg.LeaseMs, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimExecutionPlans = `-- name: ClaimExecutionPlans :many
+UPDATE execution_plan ep
+SET
+  owner = $1::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + $2::BIGINT * INTERVAL '1 millisecond'
+FROM (
+  SELECT id FROM execution_plan
+  WHERE status IN ('pending', 'running')
+  AND (lease_expires IS NULL OR lease_expires < CURRENT_TIMESTAMP)
+  AND owner IS DISTINCT FROM $1::TEXT
+  ORDER BY id
+  FOR UPDATE SKIP LOCKED
+) expired
+WHERE ep.id = expired.id
+RETURNING ep.id
+`
+
+type ClaimExecutionPlansParams struct {
+	Owner   string
+	LeaseMs int64
+}
+
+func (q *Queries) ClaimExecutionPlans(ctx context.Context, arg ClaimExecutionPlansParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, claimExecutionPlans, arg.Owner, arg.LeaseMs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimReprocess = `-- name: ClaimReprocess :execrows
+UPDATE reprocess
+SET
+  owner = $1::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + $2::BIGINT * INTERVAL '1 millisecond'
+WHERE id = $3
+AND status IN ('pending', 'running')
+AND triggered IS NULL
+AND (
+  owner IS NULL
+  OR owner = $1::TEXT
+  OR lease_expires IS NULL
+  OR lease_expires < CURRENT_TIMESTAMP
+)
+`
+
+type ClaimReprocessParams struct {
+	Owner   string
+	LeaseMs int64
+	ID      int64
+}
+
+func (q *Queries) ClaimReprocess(ctx context.Context, arg ClaimReprocessParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimReprocess, arg.Owner, arg.LeaseMs, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimReprocesses = `-- name: ClaimReprocesses :many
+UPDATE reprocess r
+SET
+  owner = $1::TEXT,
+  lease_expires = CURRENT_TIMESTAMP + $2::BIGINT * INTERVAL '1 millisecond'
+FROM (
+  SELECT id FROM reprocess
+  WHERE status IN ('pending', 'running')
+  AND triggered IS NULL
+  AND (lease_expires IS NULL OR lease_expires < CURRENT_TIMESTAMP)
+  AND owner IS DISTINCT FROM $1::TEXT
+  ORDER BY id
+  FOR UPDATE SKIP LOCKED
+) expired
+WHERE r.id = expired.id
+RETURNING r.id
+`
+
+type ClaimReprocessesParams struct {
+	Owner   string
+	LeaseMs int64
+}
+
+func (q *Queries) ClaimReprocesses(ctx context.Context, arg ClaimReprocessesParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, claimReprocesses, arg.Owner, arg.LeaseMs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const closeWindow = `-- name: CloseWindow :exec
+UPDATE windows
+SET
+  time_to = $1,
+  state = 'closed'
+WHERE id = $2
+`
+
+type CloseWindowParams struct {
+	TimeTo pgtype.Timestamp
+	ID     int64
+}
+
+func (q *Queries) CloseWindow(ctx context.Context, arg CloseWindowParams) error {
+	_, err := q.db.Exec(ctx, closeWindow, arg.TimeTo, arg.ID)
+	return err
+}
+
+const countUnfinishedExecutionsOfAlgorithm = `-- name: CountUnfinishedExecutionsOfAlgorithm :one
+SELECT COUNT(DISTINCT ep.id)
+FROM execution_node en
+JOIN execution_task et ON en.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN execution_plan ep ON es.execution_plan_id = ep.id
+WHERE en.algorithm_id = $1
+AND ep.status IN ('pending', 'running')
+`
+
+func (q *Queries) CountUnfinishedExecutionsOfAlgorithm(ctx context.Context, algorithmID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnfinishedExecutionsOfAlgorithm, algorithmID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAlertRule = `-- name: CreateAlertRule :execrows
+INSERT INTO alert_rule (
+  name,
+  algorithm_id,
+  expression,
+  description,
+  metadata_key
+)
+SELECT
+  $1,
+  a.id,
+  $2,
+  $3,
+  $4
+FROM algorithm a
+WHERE a.name = $5
+AND a.version = $6
+AND a.retired IS NULL
+ON CONFLICT (name) DO UPDATE
+SET
+  algorithm_id = EXCLUDED.algorithm_id,
+  expression = EXCLUDED.expression,
+  description = EXCLUDED.description,
+  metadata_key = EXCLUDED.metadata_key
+`
+
+type CreateAlertRuleParams struct {
+	Name             string
+	Expression       string
+	Description      string
+	MetadataKey      string
+	AlgorithmName    string
+	AlgorithmVersion string
+}
+
+func (q *Queries) CreateAlertRule(ctx context.Context, arg CreateAlertRuleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createAlertRule,
+		arg.Name,
+		arg.Expression,
+		arg.Description,
+		arg.MetadataKey,
+		arg.AlgorithmName,
+		arg.AlgorithmVersion,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createAlgorithm = `-- name: CreateAlgorithm :exec
 WITH processor_id AS (
   SELECT id FROM processor p
-  WHERE p.name = $4 
-  AND p.runtime = $5
+  WHERE p.name = $6 
+  AND p.runtime = $7
 ),
 window_type_id AS (
   SELECT id FROM window_type w
-  WHERE w.name = $6 
-  AND w.version = $7
+  WHERE w.name = $8 
+  AND w.version = $9
 )
 INSERT INTO algorithm (
   name,
   version,
   processor_id,
   window_type_id,
-  result_type
+  result_type,
+  retry_policy,
+  timeout_ms
 ) VALUES (
   $1,
   $2,
   (SELECT id FROM processor_id),
   (SELECT id FROM window_type_id),
-  $3
-) ON CONFLICT DO NOTHING
+  $3,
+  $4,
+  $5
+) ON CONFLICT (name, version, window_type_id, processor_id) DO UPDATE
+SET
+  retry_policy = EXCLUDED.retry_policy,
+  timeout_ms = EXCLUDED.timeout_ms,
+  retired = NULL
 `
 
 type CreateAlgorithmParams struct {
 	Name              string
 	Version           string
 	ResultType        ResultType
+	RetryPolicy       []byte
+	TimeoutMs         pgtype.Int8
 	ProcessorName     string
 	ProcessorRuntime  string
 	WindowTypeName    string
@@ -52,6 +476,8 @@ func (q *Queries) CreateAlgorithm(ctx context.Context, arg CreateAlgorithmParams
 		arg.Name,
 		arg.Version,
 		arg.ResultType,
+		arg.RetryPolicy,
+		arg.TimeoutMs,
 		arg.ProcessorName,
 		arg.ProcessorRuntime,
 		arg.WindowTypeName,
@@ -150,86 +576,468 @@ func (q *Queries) CreateAnnotation(ctx context.Context, arg CreateAnnotationPara
 	return id, err
 }
 
-const createMetadataField = `-- name: CreateMetadataField :one
-INSERT INTO metadata_fields (
-  name,
-  description
+const createDeadLetter = `-- name: CreateDeadLetter :exec
+INSERT INTO dead_letter (
+  execution_task_id,
+  processor_id,
+  algorithm_ids,
+  request,
+  error_code,
+  error_message,
+  attempts
 ) VALUES (
   $1,
-  $2
-) ON CONFLICT (name) DO UPDATE
-SET
-  name = EXCLUDED.name,
-  description = EXCLUDED.description
-RETURNING id
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+ON CONFLICT (execution_task_id) DO UPDATE SET
+  algorithm_ids = EXCLUDED.algorithm_ids,
+  request = EXCLUDED.request,
+  error_code = EXCLUDED.error_code,
+  error_message = EXCLUDED.error_message,
+  attempts = dead_letter.attempts + EXCLUDED.attempts,
+  created = CURRENT_TIMESTAMP,
+  requeued = NULL
 `
 
-type CreateMetadataFieldParams struct {
-	Name        string
-	Description string
+type CreateDeadLetterParams struct {
+	ExecutionTaskID int64
+	ProcessorID     int64
+	AlgorithmIds    []int64
+	Request         []byte
+	ErrorCode       string
+	ErrorMessage    string
+	Attempts        int32
 }
 
-func (q *Queries) CreateMetadataField(ctx context.Context, arg CreateMetadataFieldParams) (int64, error) {
-	row := q.db.QueryRow(ctx, createMetadataField, arg.Name, arg.Description)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) CreateDeadLetter(ctx context.Context, arg CreateDeadLetterParams) error {
+	_, err := q.db.Exec(ctx, createDeadLetter,
+		arg.ExecutionTaskID,
+		arg.ProcessorID,
+		arg.AlgorithmIds,
+		arg.Request,
+		arg.ErrorCode,
+		arg.ErrorMessage,
+		arg.Attempts,
+	)
+	return err
 }
 
-const createProcessor = `-- name: CreateProcessor :exec
-INSERT INTO processor (
-  name,
-  runtime,
-  connection_string
+const createExecutionAttempt = `-- name: CreateExecutionAttempt :exec
+INSERT INTO execution_attempt (
+  execution_task_id,
+  attempt,
+  status,
+  error_code,
+  error_message,
+  backoff_ms,
+  started
 ) VALUES (
   $1,
   $2,
-  $3
-) ON CONFLICT (name, runtime) DO UPDATE 
-SET 
-  name = EXCLUDED.name,
-  runtime = EXCLUDED.runtime,
-  connection_string = EXCLUDED.connection_string
-RETURNING id
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
 `
 
-type CreateProcessorParams struct {
-	Name             string
-	Runtime          string
-	ConnectionString string
+type CreateExecutionAttemptParams struct {
+	ExecutionTaskID int64
+	Attempt         int32
+	Status          ExecutionStatus
+	ErrorCode       pgtype.Text
+	ErrorMessage    pgtype.Text
+	BackoffMs       pgtype.Int8
+	Started         pgtype.Timestamp
 }
 
-// -------------------- Core Operations ----------------------
-func (q *Queries) CreateProcessor(ctx context.Context, arg CreateProcessorParams) error {
-	_, err := q.db.Exec(ctx, createProcessor, arg.Name, arg.Runtime, arg.ConnectionString)
+func (q *Queries) CreateExecutionAttempt(ctx context.Context, arg CreateExecutionAttemptParams) error {
+	_, err := q.db.Exec(ctx, createExecutionAttempt,
+		arg.ExecutionTaskID,
+		arg.Attempt,
+		arg.Status,
+		arg.ErrorCode,
+		arg.ErrorMessage,
+		arg.BackoffMs,
+		arg.Started,
+	)
 	return err
 }
 
-const createResult = `-- name: CreateResult :one
-INSERT INTO results (
-  windows_id,
-  window_type_id, 
-  algorithm_id, 
-  result_value,
-  result_array,
-  result_json
+const createExecutionNode = `-- name: CreateExecutionNode :exec
+INSERT INTO execution_node (
+  execution_task_id,
+  node_index,
+  algorithm_id,
+  window_type_id,
+  algorithm_dep_ids
 ) VALUES (
   $1,
   $2,
   $3,
   $4,
-  $5,
-  $6
-) RETURNING id
+  COALESCE($5::bigint[], '{}')
+)
 `
 
-type CreateResultParams struct {
-	WindowsID    pgtype.Int8
-	WindowTypeID pgtype.Int8
-	AlgorithmID  pgtype.Int8
-	ResultValue  pgtype.Float8
+type CreateExecutionNodeParams struct {
+	ExecutionTaskID int64
+	NodeIndex       int32
+	AlgorithmID     int64
+	WindowTypeID    int64
+	AlgorithmDepIds []int64
+}
+
+func (q *Queries) CreateExecutionNode(ctx context.Context, arg CreateExecutionNodeParams) error {
+	_, err := q.db.Exec(ctx, createExecutionNode,
+		arg.ExecutionTaskID,
+		arg.NodeIndex,
+		arg.AlgorithmID,
+		arg.WindowTypeID,
+		arg.AlgorithmDepIds,
+	)
+	return err
+}
+
+const createExecutionPlan = `-- name: CreateExecutionPlan :one
+INSERT INTO execution_plan (
+  windows_id,
+  exec_id,
+  owner,
+  lease_expires
+) VALUES (
+  $1,
+  $2,
+  $3::TEXT,
+  CURRENT_TIMESTAMP + $4::BIGINT * INTERVAL '1 millisecond'
+) RETURNING id
+`
+
+type CreateExecutionPlanParams struct {
+	WindowsID int64
+	ExecID    string
+	Owner     string
+	LeaseMs   int64
+}
+
+func (q *Queries) CreateExecutionPlan(ctx context.Context, arg CreateExecutionPlanParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createExecutionPlan,
+		arg.WindowsID,
+		arg.ExecID,
+		arg.Owner,
+		arg.LeaseMs,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createExecutionStage = `-- name: CreateExecutionStage :one
+INSERT INTO execution_stage (
+  execution_plan_id,
+  stage_index
+) VALUES (
+  $1,
+  $2
+) RETURNING id
+`
+
+type CreateExecutionStageParams struct {
+	ExecutionPlanID int64
+	StageIndex      int32
+}
+
+func (q *Queries) CreateExecutionStage(ctx context.Context, arg CreateExecutionStageParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createExecutionStage, arg.ExecutionPlanID, arg.StageIndex)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createExecutionTask = `-- name: CreateExecutionTask :one
+INSERT INTO execution_task (
+  execution_stage_id,
+  task_index,
+  processor_id,
+  exec_id
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4
+) RETURNING id
+`
+
+type CreateExecutionTaskParams struct {
+	ExecutionStageID int64
+	TaskIndex        int32
+	ProcessorID      int64
+	ExecID           string
+}
+
+func (q *Queries) CreateExecutionTask(ctx context.Context, arg CreateExecutionTaskParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createExecutionTask,
+		arg.ExecutionStageID,
+		arg.TaskIndex,
+		arg.ProcessorID,
+		arg.ExecID,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createMetadataField = `-- name: CreateMetadataField :one
+INSERT INTO metadata_fields (
+  name,
+  description
+) VALUES (
+  $1,
+  $2
+) ON CONFLICT (name) DO UPDATE
+SET
+  name = EXCLUDED.name,
+  description = EXCLUDED.description
+RETURNING id
+`
+
+type CreateMetadataFieldParams struct {
+	Name        string
+	Description string
+}
+
+func (q *Queries) CreateMetadataField(ctx context.Context, arg CreateMetadataFieldParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createMetadataField, arg.Name, arg.Description)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createProcessor = `-- name: CreateProcessor :exec
+INSERT INTO processor (
+  name,
+  runtime,
+  connection_string,
+  retry_policy
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4
+) ON CONFLICT (name, runtime) DO UPDATE 
+SET 
+  name = EXCLUDED.name,
+  runtime = EXCLUDED.runtime,
+  connection_string = EXCLUDED.connection_string,
+  retry_policy = EXCLUDED.retry_policy,
+  deregistered = NULL
+RETURNING id
+`
+
+type CreateProcessorParams struct {
+	Name             string
+	Runtime          string
+	ConnectionString string
+	RetryPolicy      []byte
+}
+
+// -------------------- Core Operations ----------------------
+func (q *Queries) CreateProcessor(ctx context.Context, arg CreateProcessorParams) error {
+	_, err := q.db.Exec(ctx, createProcessor,
+		arg.Name,
+		arg.Runtime,
+		arg.ConnectionString,
+		arg.RetryPolicy,
+	)
+	return err
+}
+
+const createProcessorInstance = `-- name: CreateProcessorInstance :exec
+INSERT INTO processor_instance (
+  processor_id,
+  instance_id,
+  connection_string
+)
+SELECT
+  p.id,
+  $1,
+  $2
+FROM processor p
+WHERE p.name = $3
+AND p.runtime = $4
+ON CONFLICT (processor_id, instance_id) DO UPDATE
+SET registered = CURRENT_TIMESTAMP
+`
+
+type CreateProcessorInstanceParams struct {
+	InstanceID       string
+	ConnectionString string
+	Name             string
+	Runtime          string
+}
+
+func (q *Queries) CreateProcessorInstance(ctx context.Context, arg CreateProcessorInstanceParams) error {
+	_, err := q.db.Exec(ctx, createProcessorInstance,
+		arg.InstanceID,
+		arg.ConnectionString,
+		arg.Name,
+		arg.Runtime,
+	)
+	return err
+}
+
+const createReprocess = `-- name: CreateReprocess :one
+INSERT INTO reprocess (
+  window_type_id,
+  time_from,
+  time_to,
+  metadata,
+  algorithm_ids,
+  windows_per_second,
+  total_windows
+)
+SELECT
+  wt.id,
+  $1::TIMESTAMP,
+  $2::TIMESTAMP,
+  $3::JSONB,
+  $4::BIGINT[],
+  $5::DOUBLE PRECISION,
+  (
+    SELECT COUNT(*) FROM windows w
+    WHERE w.window_type_id = wt.id
+    AND w.time_from >= $1::TIMESTAMP
+    AND w.time_to <= $2::TIMESTAMP
+    AND COALESCE(w.metadata, '{}') @> $3::JSONB
+    AND w.state = 'closed'
+    AND NOT w.superseded
+  )
+FROM window_type wt
+WHERE wt.name = $6
+AND wt.version = $7
+RETURNING id, reprocess_id
+`
+
+type CreateReprocessParams struct {
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	Metadata          []byte
+	AlgorithmIds      []int64
+	WindowsPerSecond  float64
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+type CreateReprocessRow struct {
+	ID          int64
+	ReprocessID string
+}
+
+func (q *Queries) CreateReprocess(ctx context.Context, arg CreateReprocessParams) (CreateReprocessRow, error) {
+	row := q.db.QueryRow(ctx, createReprocess,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Metadata,
+		arg.AlgorithmIds,
+		arg.WindowsPerSecond,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+	)
+	var i CreateReprocessRow
+	err := row.Scan(&i.ID, &i.ReprocessID)
+	return i, err
+}
+
+const createResult = `-- name: CreateResult :one
+WITH archived AS (
+  INSERT INTO result_revision (
+    result_id,
+    revision,
+    result_value,
+    result_array,
+    result_json,
+    status,
+    error_message
+  )
+  SELECT
+    r.id,
+    r.revision,
+    r.result_value,
+    r.result_array,
+    r.result_json,
+    r.status,
+    r.error_message
+  FROM results r
+  WHERE $9::BOOLEAN
+  AND r.windows_id = $1
+  AND r.algorithm_id = $3
+  AND (r.result_value, r.result_array, r.result_json, r.status, r.error_message) IS DISTINCT FROM (
+    $4::DOUBLE PRECISION,
+    $5::DOUBLE PRECISION[],
+    $6::JSONB,
+    $7::result_status,
+    $8::TEXT
+  )
+)
+INSERT INTO results (
+  windows_id,
+  window_type_id, 
+  algorithm_id, 
+  result_value,
+  result_array,
+  result_json,
+  status,
+  error_message,
+  superseded
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  -- results that arrive late for an amended window are superseded with it
+  (SELECT w.superseded FROM windows w WHERE w.id = $1)
+)
+ON CONFLICT (windows_id, algorithm_id) DO UPDATE SET
+  result_value = EXCLUDED.result_value,
+  result_array = EXCLUDED.result_array,
+  result_json = EXCLUDED.result_json,
+  status = EXCLUDED.status,
+  error_message = EXCLUDED.error_message,
+  superseded = EXCLUDED.superseded,
+  revision = results.revision + CASE
+    WHEN (results.result_value, results.result_array, results.result_json, results.status, results.error_message)
+      IS DISTINCT FROM (EXCLUDED.result_value, EXCLUDED.result_array, EXCLUDED.result_json, EXCLUDED.status, EXCLUDED.error_message)
+    THEN 1 ELSE 0
+  END,
+  seq = CASE
+    WHEN (results.result_value, results.result_array, results.result_json, results.status, results.error_message)
+      IS DISTINCT FROM (EXCLUDED.result_value, EXCLUDED.result_array, EXCLUDED.result_json, EXCLUDED.status, EXCLUDED.error_message)
+    THEN NULL
+    ELSE results.seq
+  END
+RETURNING id
+`
+
+type CreateResultParams struct {
+	WindowsID    pgtype.Int8
+	WindowTypeID pgtype.Int8
+	AlgorithmID  pgtype.Int8
+	ResultValue  pgtype.Float8
 	ResultArray  []float64
 	ResultJson   []byte
+	Status       ResultStatus
+	ErrorMessage pgtype.Text
+	KeepRevision bool
 }
 
 func (q *Queries) CreateResult(ctx context.Context, arg CreateResultParams) (int64, error) {
@@ -240,12 +1048,142 @@ func (q *Queries) CreateResult(ctx context.Context, arg CreateResultParams) (int
 		arg.ResultValue,
 		arg.ResultArray,
 		arg.ResultJson,
+		arg.Status,
+		arg.ErrorMessage,
+		arg.KeepRevision,
 	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
 }
 
+const createRollupRule = `-- name: CreateRollupRule :execrows
+INSERT INTO rollup_rule (
+  name,
+  child_window_type_id,
+  parent_window_type_id,
+  cron,
+  timezone,
+  metadata_keys,
+  origin
+)
+SELECT
+  $1,
+  child.id,
+  parent.id,
+  $2,
+  $3,
+  $4::TEXT[],
+  $5
+FROM window_type child, window_type parent
+WHERE child.name = $6
+AND child.version = $7
+AND parent.name = $8
+AND parent.version = $9
+ON CONFLICT (name) DO UPDATE
+SET
+  child_window_type_id = EXCLUDED.child_window_type_id,
+  parent_window_type_id = EXCLUDED.parent_window_type_id,
+  cron = EXCLUDED.cron,
+  timezone = EXCLUDED.timezone,
+  metadata_keys = EXCLUDED.metadata_keys,
+  origin = EXCLUDED.origin
+`
+
+type CreateRollupRuleParams struct {
+	Name                    string
+	Cron                    string
+	Timezone                string
+	MetadataKeys            []string
+	Origin                  string
+	ChildWindowTypeName     string
+	ChildWindowTypeVersion  string
+	ParentWindowTypeName    string
+	ParentWindowTypeVersion string
+}
+
+func (q *Queries) CreateRollupRule(ctx context.Context, arg CreateRollupRuleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createRollupRule,
+		arg.Name,
+		arg.Cron,
+		arg.Timezone,
+		arg.MetadataKeys,
+		arg.Origin,
+		arg.ChildWindowTypeName,
+		arg.ChildWindowTypeVersion,
+		arg.ParentWindowTypeName,
+		arg.ParentWindowTypeVersion,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const createWindowSchedule = `-- name: CreateWindowSchedule :execrows
+INSERT INTO window_schedule (
+  name,
+  window_type_id,
+  cron,
+  timezone,
+  origin,
+  metadata,
+  emitted_until,
+  next_emission
+)
+SELECT
+  $1,
+  wt.id,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+FROM window_type wt
+WHERE wt.name = $8
+AND wt.version = $9
+ON CONFLICT (name) DO UPDATE
+SET
+  window_type_id = EXCLUDED.window_type_id,
+  cron = EXCLUDED.cron,
+  timezone = EXCLUDED.timezone,
+  origin = EXCLUDED.origin,
+  metadata = EXCLUDED.metadata,
+  emitted_until = EXCLUDED.emitted_until,
+  next_emission = EXCLUDED.next_emission
+`
+
+type CreateWindowScheduleParams struct {
+	Name              string
+	Cron              string
+	Timezone          string
+	Origin            string
+	Metadata          []byte
+	EmittedUntil      pgtype.Timestamp
+	NextEmission      pgtype.Timestamp
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+func (q *Queries) CreateWindowSchedule(ctx context.Context, arg CreateWindowScheduleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createWindowSchedule,
+		arg.Name,
+		arg.Cron,
+		arg.Timezone,
+		arg.Origin,
+		arg.Metadata,
+		arg.EmittedUntil,
+		arg.NextEmission,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createWindowType = `-- name: CreateWindowType :one
 INSERT INTO window_type (
   name,
@@ -299,40 +1237,251 @@ func (q *Queries) CreateWindowTypeMetadataFieldBridge(ctx context.Context, arg C
 	return err
 }
 
-const linkAnnotationToAlgorithm = `-- name: LinkAnnotationToAlgorithm :exec
-WITH algorithm_id AS (
-  SELECT
-    id
-  FROM algorithm
-  WHERE
-    name = $2
-    AND version = $3
-)
-INSERT INTO 
-  annotation_algorithms (annotation_id, algorithm_id)
-VALUES ($1, (SELECT id FROM algorithm_id))
+const deleteAlertRule = `-- name: DeleteAlertRule :execrows
+DELETE FROM alert_rule
+WHERE name = $1
 `
 
-type LinkAnnotationToAlgorithmParams struct {
-	AnnotationID     int64
-	AlgorithmName    string
-	AlgorithmVersion string
+func (q *Queries) DeleteAlertRule(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAlertRule, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-func (q *Queries) LinkAnnotationToAlgorithm(ctx context.Context, arg LinkAnnotationToAlgorithmParams) error {
-	_, err := q.db.Exec(ctx, linkAnnotationToAlgorithm, arg.AnnotationID, arg.AlgorithmName, arg.AlgorithmVersion)
+const deleteAlgorithmDependencies = `-- name: DeleteAlgorithmDependencies :exec
+DELETE FROM algorithm_dependency
+WHERE from_algorithm_id = ANY($1::BIGINT[])
+OR to_algorithm_id = ANY($1::BIGINT[])
+`
+
+func (q *Queries) DeleteAlgorithmDependencies(ctx context.Context, algorithmIds []int64) error {
+	_, err := q.db.Exec(ctx, deleteAlgorithmDependencies, algorithmIds)
 	return err
 }
 
-const linkAnnotationToWindowType = `-- name: LinkAnnotationToWindowType :exec
-WITH window_type_id AS (
-  SELECT
-    id
-  FROM window_type
-  WHERE
-    name = $2
-    AND version = $3
-)
+const deleteAlgorithmDependency = `-- name: DeleteAlgorithmDependency :execrows
+DELETE FROM algorithm_dependency
+WHERE from_algorithm_id = $1
+AND to_algorithm_id = $2
+`
+
+type DeleteAlgorithmDependencyParams struct {
+	FromAlgorithmID int64
+	ToAlgorithmID   int64
+}
+
+func (q *Queries) DeleteAlgorithmDependency(ctx context.Context, arg DeleteAlgorithmDependencyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAlgorithmDependency, arg.FromAlgorithmID, arg.ToAlgorithmID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteReplacedProcessorInstances = `-- name: DeleteReplacedProcessorInstances :many
+DELETE FROM processor_instance pi
+USING processor p
+WHERE pi.processor_id = p.id
+AND p.name = $1
+AND p.runtime = $2
+AND (
+  (pi.instance_id = $3 AND pi.connection_string <> $4)
+  OR (pi.connection_string = $4 AND pi.instance_id <> $3)
+)
+RETURNING pi.id
+`
+
+type DeleteReplacedProcessorInstancesParams struct {
+	Name             string
+	Runtime          string
+	InstanceID       string
+	ConnectionString string
+}
+
+func (q *Queries) DeleteReplacedProcessorInstances(ctx context.Context, arg DeleteReplacedProcessorInstancesParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, deleteReplacedProcessorInstances,
+		arg.Name,
+		arg.Runtime,
+		arg.InstanceID,
+		arg.ConnectionString,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteRollupRule = `-- name: DeleteRollupRule :execrows
+DELETE FROM rollup_rule
+WHERE name = $1
+`
+
+func (q *Queries) DeleteRollupRule(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRollupRule, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteWindowSchedule = `-- name: DeleteWindowSchedule :execrows
+DELETE FROM window_schedule
+WHERE name = $1
+`
+
+func (q *Queries) DeleteWindowSchedule(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWindowSchedule, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deregisterProcessor = `-- name: DeregisterProcessor :exec
+WITH removed_instances AS (
+  DELETE FROM processor_instance
+  WHERE processor_id = $1
+)
+UPDATE processor
+SET deregistered = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) DeregisterProcessor(ctx context.Context, processorID int64) error {
+	_, err := q.db.Exec(ctx, deregisterProcessor, processorID)
+	return err
+}
+
+const extendWindow = `-- name: ExtendWindow :exec
+UPDATE windows
+SET time_to = $1
+WHERE id = $2
+`
+
+type ExtendWindowParams struct {
+	TimeTo pgtype.Timestamp
+	ID     int64
+}
+
+func (q *Queries) ExtendWindow(ctx context.Context, arg ExtendWindowParams) error {
+	_, err := q.db.Exec(ctx, extendWindow, arg.TimeTo, arg.ID)
+	return err
+}
+
+const finishReprocess = `-- name: FinishReprocess :exec
+UPDATE reprocess r
+SET
+  status = CASE
+    WHEN EXISTS (
+      SELECT 1 FROM execution_plan ep
+      WHERE ep.reprocess_id = r.id
+      AND ep.status IN ('failed', 'cancelled')
+    ) THEN 'failed'
+    ELSE 'succeeded'
+  END::execution_status,
+  finished = CURRENT_TIMESTAMP
+WHERE r.id = $1
+AND r.status = 'running'
+AND r.triggered IS NOT NULL
+AND NOT EXISTS (
+  SELECT 1 FROM execution_plan ep
+  WHERE ep.reprocess_id = r.id
+  AND ep.status IN ('pending', 'running')
+)
+`
+
+func (q *Queries) FinishReprocess(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, finishReprocess, id)
+	return err
+}
+
+const fireAlert = `-- name: FireAlert :execrows
+INSERT INTO alert (
+  alert_rule_id,
+  dedupe_key,
+  origin,
+  metadata,
+  windows_id
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+ON CONFLICT (alert_rule_id, dedupe_key) WHERE status = 'firing' DO NOTHING
+`
+
+type FireAlertParams struct {
+	AlertRuleID int64
+	DedupeKey   string
+	Origin      string
+	Metadata    []byte
+	WindowsID   int64
+}
+
+func (q *Queries) FireAlert(ctx context.Context, arg FireAlertParams) (int64, error) {
+	result, err := q.db.Exec(ctx, fireAlert,
+		arg.AlertRuleID,
+		arg.DedupeKey,
+		arg.Origin,
+		arg.Metadata,
+		arg.WindowsID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const linkAnnotationToAlgorithm = `-- name: LinkAnnotationToAlgorithm :exec
+WITH algorithm_id AS (
+  SELECT
+    id
+  FROM algorithm
+  WHERE
+    name = $2
+    AND version = $3
+)
+INSERT INTO 
+  annotation_algorithms (annotation_id, algorithm_id)
+VALUES ($1, (SELECT id FROM algorithm_id))
+`
+
+type LinkAnnotationToAlgorithmParams struct {
+	AnnotationID     int64
+	AlgorithmName    string
+	AlgorithmVersion string
+}
+
+func (q *Queries) LinkAnnotationToAlgorithm(ctx context.Context, arg LinkAnnotationToAlgorithmParams) error {
+	_, err := q.db.Exec(ctx, linkAnnotationToAlgorithm, arg.AnnotationID, arg.AlgorithmName, arg.AlgorithmVersion)
+	return err
+}
+
+const linkAnnotationToWindowType = `-- name: LinkAnnotationToWindowType :exec
+WITH window_type_id AS (
+  SELECT
+    id
+  FROM window_type
+  WHERE
+    name = $2
+    AND version = $3
+)
 INSERT INTO annotation_window_types (annotation_id, window_type_id)
 VALUES ($1, (SELECT id FROM window_type_id))
 `
@@ -348,25 +1497,154 @@ func (q *Queries) LinkAnnotationToWindowType(ctx context.Context, arg LinkAnnota
 	return err
 }
 
-const readAlgorithmExecutionPaths = `-- name: ReadAlgorithmExecutionPaths :many
-SELECT aep.final_algo_id, aep.num_dependencies, aep.algo_id_path, aep.window_type_id_path, aep.proc_id_path FROM algorithm_execution_paths aep WHERE aep.window_type_id_path ~ ('*.' || $1::TEXT || '.*')::lquery
+const linkChildWindows = `-- name: LinkChildWindows :exec
+INSERT INTO window_link (
+  parent_windows_id,
+  child_windows_id
+)
+SELECT
+  parent.id,
+  child_windows_id
+FROM windows parent
+JOIN window_type wt ON parent.window_type_id = wt.id
+CROSS JOIN unnest($1::BIGINT[]) AS child_windows_id
+WHERE wt.name = $2
+AND wt.version = $3
+AND parent.idempotency_key = $4::TEXT
+ON CONFLICT DO NOTHING
 `
 
-func (q *Queries) ReadAlgorithmExecutionPaths(ctx context.Context, windowTypeID string) ([]AlgorithmExecutionPath, error) {
-	rows, err := q.db.Query(ctx, readAlgorithmExecutionPaths, windowTypeID)
+type LinkChildWindowsParams struct {
+	ChildWindowsIds   []int64
+	WindowTypeName    string
+	WindowTypeVersion string
+	IdempotencyKey    string
+}
+
+func (q *Queries) LinkChildWindows(ctx context.Context, arg LinkChildWindowsParams) error {
+	_, err := q.db.Exec(ctx, linkChildWindows,
+		arg.ChildWindowsIds,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+		arg.IdempotencyKey,
+	)
+	return err
+}
+
+const lockResultSequence = `-- name: LockResultSequence :exec
+SELECT pg_advisory_xact_lock(hashtextextended('results_seq', 0))
+`
+
+func (q *Queries) LockResultSequence(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockResultSequence)
+	return err
+}
+
+const lockWindowEmission = `-- name: LockWindowEmission :exec
+SELECT pg_advisory_xact_lock(hashtextextended($1::TEXT, 0))
+`
+
+func (q *Queries) LockWindowEmission(ctx context.Context, dedupeKey string) error {
+	_, err := q.db.Exec(ctx, lockWindowEmission, dedupeKey)
+	return err
+}
+
+const lockWindowEmissions = `-- name: LockWindowEmissions :exec
+SELECT pg_advisory_xact_lock(hashtextextended(dedupe_key, 0))
+FROM (
+  SELECT unnest($1::TEXT[]) AS dedupe_key
+  ORDER BY dedupe_key
+) dedupe_keys
+`
+
+func (q *Queries) LockWindowEmissions(ctx context.Context, dedupeKeys []string) error {
+	_, err := q.db.Exec(ctx, lockWindowEmissions, dedupeKeys)
+	return err
+}
+
+const openWindow = `-- name: OpenWindow :one
+INSERT INTO windows (
+  window_type_id,
+  time_from,
+  time_to,
+  origin,
+  metadata,
+  idempotency_key,
+  state,
+  provisional
+) VALUES (
+  $1,
+  $2,
+  $2,
+  $3,
+  $4,
+  $5::TEXT,
+  'open',
+  $6
+) RETURNING id
+`
+
+type OpenWindowParams struct {
+	WindowTypeID   int64
+	TimeFrom       pgtype.Timestamp
+	Origin         string
+	Metadata       []byte
+	IdempotencyKey string
+	Provisional    bool
+}
+
+func (q *Queries) OpenWindow(ctx context.Context, arg OpenWindowParams) (int64, error) {
+	row := q.db.QueryRow(ctx, openWindow,
+		arg.WindowTypeID,
+		arg.TimeFrom,
+		arg.Origin,
+		arg.Metadata,
+		arg.IdempotencyKey,
+		arg.Provisional,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const readAlertRules = `-- name: ReadAlertRules :many
+SELECT
+  ar.name,
+  ar.expression,
+  ar.description,
+  ar.metadata_key,
+  a.name AS algorithm_name,
+  a.version AS algorithm_version
+FROM alert_rule ar
+JOIN algorithm a ON ar.algorithm_id = a.id
+ORDER BY ar.name
+`
+
+type ReadAlertRulesRow struct {
+	Name             string
+	Expression       string
+	Description      string
+	MetadataKey      string
+	AlgorithmName    string
+	AlgorithmVersion string
+}
+
+func (q *Queries) ReadAlertRules(ctx context.Context) ([]ReadAlertRulesRow, error) {
+	rows, err := q.db.Query(ctx, readAlertRules)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AlgorithmExecutionPath
+	var items []ReadAlertRulesRow
 	for rows.Next() {
-		var i AlgorithmExecutionPath
+		var i ReadAlertRulesRow
 		if err := rows.Scan(
-			&i.FinalAlgoID,
-			&i.NumDependencies,
-			&i.AlgoIDPath,
-			&i.WindowTypeIDPath,
-			&i.ProcIDPath,
+			&i.Name,
+			&i.Expression,
+			&i.Description,
+			&i.MetadataKey,
+			&i.AlgorithmName,
+			&i.AlgorithmVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -378,25 +1656,38 @@ func (q *Queries) ReadAlgorithmExecutionPaths(ctx context.Context, windowTypeID
 	return items, nil
 }
 
-const readAlgorithmExecutionPathsForAlgo = `-- name: ReadAlgorithmExecutionPathsForAlgo :many
-SELECT aep.final_algo_id, aep.num_dependencies, aep.algo_id_path, aep.window_type_id_path, aep.proc_id_path FROM algorithm_execution_paths aep WHERE aep.final_algo_id=$1
+const readAlertRulesForAlgorithm = `-- name: ReadAlertRulesForAlgorithm :many
+SELECT
+  id,
+  name,
+  expression,
+  metadata_key
+FROM alert_rule
+WHERE algorithm_id = $1
+ORDER BY id
 `
 
-func (q *Queries) ReadAlgorithmExecutionPathsForAlgo(ctx context.Context, algoID int64) ([]AlgorithmExecutionPath, error) {
-	rows, err := q.db.Query(ctx, readAlgorithmExecutionPathsForAlgo, algoID)
+type ReadAlertRulesForAlgorithmRow struct {
+	ID          int64
+	Name        string
+	Expression  string
+	MetadataKey string
+}
+
+func (q *Queries) ReadAlertRulesForAlgorithm(ctx context.Context, algorithmID int64) ([]ReadAlertRulesForAlgorithmRow, error) {
+	rows, err := q.db.Query(ctx, readAlertRulesForAlgorithm, algorithmID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AlgorithmExecutionPath
+	var items []ReadAlertRulesForAlgorithmRow
 	for rows.Next() {
-		var i AlgorithmExecutionPath
+		var i ReadAlertRulesForAlgorithmRow
 		if err := rows.Scan(
-			&i.FinalAlgoID,
-			&i.NumDependencies,
-			&i.AlgoIDPath,
-			&i.WindowTypeIDPath,
-			&i.ProcIDPath,
+			&i.ID,
+			&i.Name,
+			&i.Expression,
+			&i.MetadataKey,
 		); err != nil {
 			return nil, err
 		}
@@ -408,51 +1699,273 @@ func (q *Queries) ReadAlgorithmExecutionPathsForAlgo(ctx context.Context, algoID
 	return items, nil
 }
 
-const readAlgorithmId = `-- name: ReadAlgorithmId :one
-WITH processor_id AS (
-  SELECT p.id FROM processor p
-  WHERE p.name = $3
-  AND p.runtime = $4
-)
-SELECT a.id FROM algorithm a
-WHERE a.name = $1
-AND a.version = $2
-AND a.processor_id = (SELECT id from processor_id)
+const readAlerts = `-- name: ReadAlerts :many
+SELECT
+  al.id,
+  ar.name AS alert_rule_name,
+  a.name AS algorithm_name,
+  a.version AS algorithm_version,
+  al.status,
+  al.origin,
+  al.metadata,
+  al.fired,
+  al.resolved,
+  al.acknowledged,
+  al.acknowledged_by,
+  w.time_from,
+  w.time_to
+FROM alert al
+JOIN alert_rule ar ON al.alert_rule_id = ar.id
+JOIN algorithm a ON ar.algorithm_id = a.id
+JOIN windows w ON al.windows_id = w.id
+WHERE al.fired >= $1 AND al.fired <= $2
+AND ($3::TEXT IS NULL OR ar.name = $3)
+AND ($4::alert_status IS NULL OR al.status = $4)
+AND ($5::BOOLEAN OR al.acknowledged IS NULL)
+ORDER BY al.fired, al.id
 `
 
-type ReadAlgorithmIdParams struct {
-	AlgorithmName    string
-	AlgorithmVersion string
-	ProcessorName    string
-	ProcessorRuntime string
-}
-
-func (q *Queries) ReadAlgorithmId(ctx context.Context, arg ReadAlgorithmIdParams) (int64, error) {
-	row := q.db.QueryRow(ctx, readAlgorithmId,
-		arg.AlgorithmName,
-		arg.AlgorithmVersion,
-		arg.ProcessorName,
-		arg.ProcessorRuntime,
-	)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+type ReadAlertsParams struct {
+	FiredFrom           pgtype.Timestamp
+	FiredTo             pgtype.Timestamp
+	AlertRuleName       pgtype.Text
+	Status              NullAlertStatus
+	IncludeAcknowledged bool
 }
 
-const readAlgorithmJsonField = `-- name: ReadAlgorithmJsonField :many
-select w.time_from, w.time_to, (r.result_json::json->>$1) as result from results r
-join algorithm a on r.algorithm_id = a.id
-join windows w on r.windows_id = w.id
-where
-	w.time_from  >= $2 and w.time_to <= $3
-	and a."name" = $4
-	and a."version" = $5
-`
-
-type ReadAlgorithmJsonFieldParams struct {
-	FieldName        []byte
-	TimeFrom         pgtype.Timestamp
-	TimeTo           pgtype.Timestamp
+type ReadAlertsRow struct {
+	ID               int64
+	AlertRuleName    string
+	AlgorithmName    string
+	AlgorithmVersion string
+	Status           AlertStatus
+	Origin           string
+	Metadata         []byte
+	Fired            pgtype.Timestamp
+	Resolved         pgtype.Timestamp
+	Acknowledged     pgtype.Timestamp
+	AcknowledgedBy   pgtype.Text
+	TimeFrom         pgtype.Timestamp
+	TimeTo           pgtype.Timestamp
+}
+
+func (q *Queries) ReadAlerts(ctx context.Context, arg ReadAlertsParams) ([]ReadAlertsRow, error) {
+	rows, err := q.db.Query(ctx, readAlerts,
+		arg.FiredFrom,
+		arg.FiredTo,
+		arg.AlertRuleName,
+		arg.Status,
+		arg.IncludeAcknowledged,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAlertsRow
+	for rows.Next() {
+		var i ReadAlertsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AlertRuleName,
+			&i.AlgorithmName,
+			&i.AlgorithmVersion,
+			&i.Status,
+			&i.Origin,
+			&i.Metadata,
+			&i.Fired,
+			&i.Resolved,
+			&i.Acknowledged,
+			&i.AcknowledgedBy,
+			&i.TimeFrom,
+			&i.TimeTo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAlgorithmExecutionPaths = `-- name: ReadAlgorithmExecutionPaths :many
+SELECT aep.final_algo_id, aep.num_dependencies, aep.algo_id_path, aep.window_type_id_path, aep.proc_id_path FROM algorithm_execution_paths aep WHERE aep.window_type_id_path ~ ('*.' || $1::TEXT || '.*')::lquery
+`
+
+func (q *Queries) ReadAlgorithmExecutionPaths(ctx context.Context, windowTypeID string) ([]AlgorithmExecutionPath, error) {
+	rows, err := q.db.Query(ctx, readAlgorithmExecutionPaths, windowTypeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlgorithmExecutionPath
+	for rows.Next() {
+		var i AlgorithmExecutionPath
+		if err := rows.Scan(
+			&i.FinalAlgoID,
+			&i.NumDependencies,
+			&i.AlgoIDPath,
+			&i.WindowTypeIDPath,
+			&i.ProcIDPath,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAlgorithmExecutionPathsForAlgo = `-- name: ReadAlgorithmExecutionPathsForAlgo :many
+SELECT aep.final_algo_id, aep.num_dependencies, aep.algo_id_path, aep.window_type_id_path, aep.proc_id_path FROM algorithm_execution_paths aep WHERE aep.final_algo_id=$1
+`
+
+func (q *Queries) ReadAlgorithmExecutionPathsForAlgo(ctx context.Context, algoID int64) ([]AlgorithmExecutionPath, error) {
+	rows, err := q.db.Query(ctx, readAlgorithmExecutionPathsForAlgo, algoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlgorithmExecutionPath
+	for rows.Next() {
+		var i AlgorithmExecutionPath
+		if err := rows.Scan(
+			&i.FinalAlgoID,
+			&i.NumDependencies,
+			&i.AlgoIDPath,
+			&i.WindowTypeIDPath,
+			&i.ProcIDPath,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAlgorithmExecutions = `-- name: ReadAlgorithmExecutions :many
+SELECT
+  es.execution_plan_id,
+  en.execution_task_id,
+  a.name,
+  a.version,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version,
+  p.name AS processor_name,
+  p.runtime AS processor_runtime,
+  en.status,
+  en.error_message,
+  en.started,
+  en.finished
+FROM execution_node en
+JOIN execution_task et ON en.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN algorithm a ON en.algorithm_id = a.id
+JOIN window_type wt ON en.window_type_id = wt.id
+JOIN processor p ON et.processor_id = p.id
+WHERE es.execution_plan_id = ANY($1::BIGINT[])
+ORDER BY es.execution_plan_id, es.stage_index, et.task_index, en.node_index
+`
+
+type ReadAlgorithmExecutionsRow struct {
+	ExecutionPlanID   int64
+	ExecutionTaskID   int64
+	Name              string
+	Version           string
+	WindowTypeName    string
+	WindowTypeVersion string
+	ProcessorName     string
+	ProcessorRuntime  string
+	Status            ExecutionStatus
+	ErrorMessage      pgtype.Text
+	Started           pgtype.Timestamp
+	Finished          pgtype.Timestamp
+}
+
+func (q *Queries) ReadAlgorithmExecutions(ctx context.Context, executionPlanIds []int64) ([]ReadAlgorithmExecutionsRow, error) {
+	rows, err := q.db.Query(ctx, readAlgorithmExecutions, executionPlanIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAlgorithmExecutionsRow
+	for rows.Next() {
+		var i ReadAlgorithmExecutionsRow
+		if err := rows.Scan(
+			&i.ExecutionPlanID,
+			&i.ExecutionTaskID,
+			&i.Name,
+			&i.Version,
+			&i.WindowTypeName,
+			&i.WindowTypeVersion,
+			&i.ProcessorName,
+			&i.ProcessorRuntime,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.Started,
+			&i.Finished,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAlgorithmId = `-- name: ReadAlgorithmId :one
+WITH processor_id AS (
+  SELECT p.id FROM processor p
+  WHERE p.name = $3
+  AND p.runtime = $4
+)
+SELECT a.id FROM algorithm a
+WHERE a.name = $1
+AND a.version = $2
+AND a.processor_id = (SELECT id from processor_id)
+`
+
+type ReadAlgorithmIdParams struct {
+	AlgorithmName    string
+	AlgorithmVersion string
+	ProcessorName    string
+	ProcessorRuntime string
+}
+
+func (q *Queries) ReadAlgorithmId(ctx context.Context, arg ReadAlgorithmIdParams) (int64, error) {
+	row := q.db.QueryRow(ctx, readAlgorithmId,
+		arg.AlgorithmName,
+		arg.AlgorithmVersion,
+		arg.ProcessorName,
+		arg.ProcessorRuntime,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const readAlgorithmJsonField = `-- name: ReadAlgorithmJsonField :many
+select w.time_from, w.time_to, (r.result_json::json->>$1) as result from results r
+join algorithm a on r.algorithm_id = a.id
+join windows w on r.windows_id = w.id
+where
+	w.time_from  >= $2 and w.time_to <= $3
+	and a."name" = $4
+	and a."version" = $5
+`
+
+type ReadAlgorithmJsonFieldParams struct {
+	FieldName        []byte
+	TimeFrom         pgtype.Timestamp
+	TimeTo           pgtype.Timestamp
 	AlgorithmName    string
 	AlgorithmVersion string
 }
@@ -475,10 +1988,1266 @@ func (q *Queries) ReadAlgorithmJsonField(ctx context.Context, arg ReadAlgorithmJ
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadAlgorithmJsonFieldRow
+	var items []ReadAlgorithmJsonFieldRow
+	for rows.Next() {
+		var i ReadAlgorithmJsonFieldRow
+		if err := rows.Scan(&i.TimeFrom, &i.TimeTo, &i.Result); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAlgorithms = `-- name: ReadAlgorithms :many
+SELECT
+  a.id,
+  a.name,
+  a.version,
+  a.created,
+  a.result_type,
+  w.name as window_name, 
+  w.version as window_version,
+  p.name as processor_name, 
+  p.runtime as processor_runtime
+FROM algorithm a
+  JOIN window_type w ON a.window_type_id = w.id
+  JOIN processor p ON a.processor_id = p.id
+WHERE a.retired IS NULL
+ORDER BY a.processor_id, a.created DESC
+`
+
+type ReadAlgorithmsRow struct {
+	ID               int64
+	Name             string
+	Version          string
+	Created          pgtype.Timestamp
+	ResultType       ResultType
+	WindowName       string
+	WindowVersion    string
+	ProcessorName    string
+	ProcessorRuntime string
+}
+
+func (q *Queries) ReadAlgorithms(ctx context.Context) ([]ReadAlgorithmsRow, error) {
+	rows, err := q.db.Query(ctx, readAlgorithms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAlgorithmsRow
+	for rows.Next() {
+		var i ReadAlgorithmsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Version,
+			&i.Created,
+			&i.ResultType,
+			&i.WindowName,
+			&i.WindowVersion,
+			&i.ProcessorName,
+			&i.ProcessorRuntime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAlgorithmsByIDs = `-- name: ReadAlgorithmsByIDs :many
+SELECT id, name, version, processor_id, window_type_id, result_type, created, retry_policy, timeout_ms, retired FROM algorithm
+WHERE id = ANY($1::bigint[])
+`
+
+func (q *Queries) ReadAlgorithmsByIDs(ctx context.Context, algorithmIds []int64) ([]Algorithm, error) {
+	rows, err := q.db.Query(ctx, readAlgorithmsByIDs, algorithmIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Algorithm
+	for rows.Next() {
+		var i Algorithm
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Version,
+			&i.ProcessorID,
+			&i.WindowTypeID,
+			&i.ResultType,
+			&i.Created,
+			&i.RetryPolicy,
+			&i.TimeoutMs,
+			&i.Retired,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAlgorithmsForWindow = `-- name: ReadAlgorithmsForWindow :many
+SELECT a.id, a.name, a.version, a.processor_id, a.window_type_id, a.result_type, a.created, a.retry_policy, a.timeout_ms, a.retired FROM algorithm a
+JOIN window_type wt ON a.window_type_id = wt.id
+WHERE wt.name = $1 
+AND wt.version = $2
+`
+
+type ReadAlgorithmsForWindowParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+func (q *Queries) ReadAlgorithmsForWindow(ctx context.Context, arg ReadAlgorithmsForWindowParams) ([]Algorithm, error) {
+	rows, err := q.db.Query(ctx, readAlgorithmsForWindow, arg.WindowTypeName, arg.WindowTypeVersion)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Algorithm
+	for rows.Next() {
+		var i Algorithm
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Version,
+			&i.ProcessorID,
+			&i.WindowTypeID,
+			&i.ResultType,
+			&i.Created,
+			&i.RetryPolicy,
+			&i.TimeoutMs,
+			&i.Retired,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllProcessorInstances = `-- name: ReadAllProcessorInstances :many
+SELECT
+  pi.id,
+  pi.processor_id,
+  pi.connection_string,
+  p.name,
+  p.runtime
+FROM processor_instance pi
+JOIN processor p ON pi.processor_id = p.id
+ORDER BY pi.id
+`
+
+type ReadAllProcessorInstancesRow struct {
+	ID               int64
+	ProcessorID      int64
+	ConnectionString string
+	Name             string
+	Runtime          string
+}
+
+func (q *Queries) ReadAllProcessorInstances(ctx context.Context) ([]ReadAllProcessorInstancesRow, error) {
+	rows, err := q.db.Query(ctx, readAllProcessorInstances)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadAllProcessorInstancesRow
+	for rows.Next() {
+		var i ReadAllProcessorInstancesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProcessorID,
+			&i.ConnectionString,
+			&i.Name,
+			&i.Runtime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readAllProcessors = `-- name: ReadAllProcessors :many
+SELECT 
+  id,
+  name,
+  runtime,
+  connection_string,
+  created,
+  retry_policy,
+  deregistered
+FROM processor
+ORDER BY name, runtime
+`
+
+func (q *Queries) ReadAllProcessors(ctx context.Context) ([]Processor, error) {
+	rows, err := q.db.Query(ctx, readAllProcessors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Processor
+	for rows.Next() {
+		var i Processor
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Runtime,
+			&i.ConnectionString,
+			&i.Created,
+			&i.RetryPolicy,
+			&i.Deregistered,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readChildWindows = `-- name: ReadChildWindows :many
+SELECT
+  child.time_from,
+  child.time_to,
+  child.origin,
+  child.metadata,
+  cwt.name,
+  cwt.version
+FROM windows parent
+JOIN window_type pwt ON parent.window_type_id = pwt.id
+JOIN window_link wl ON wl.parent_windows_id = parent.id
+JOIN windows child ON wl.child_windows_id = child.id
+JOIN window_type cwt ON child.window_type_id = cwt.id
+WHERE pwt.name = $1
+AND pwt.version = $2
+AND parent.time_from = $3
+AND parent.time_to = $4
+AND parent.origin = $5
+AND parent.metadata @> $6::JSONB
+AND NOT parent.superseded
+AND NOT child.superseded
+ORDER BY child.time_from, child.time_to, child.id
+`
+
+type ReadChildWindowsParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	Origin            string
+	Metadata          []byte
+}
+
+type ReadChildWindowsRow struct {
+	TimeFrom pgtype.Timestamp
+	TimeTo   pgtype.Timestamp
+	Origin   string
+	Metadata []byte
+	Name     string
+	Version  string
+}
+
+func (q *Queries) ReadChildWindows(ctx context.Context, arg ReadChildWindowsParams) ([]ReadChildWindowsRow, error) {
+	rows, err := q.db.Query(ctx, readChildWindows,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Origin,
+		arg.Metadata,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadChildWindowsRow
+	for rows.Next() {
+		var i ReadChildWindowsRow
+		if err := rows.Scan(
+			&i.TimeFrom,
+			&i.TimeTo,
+			&i.Origin,
+			&i.Metadata,
+			&i.Name,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readDependencyResults = `-- name: ReadDependencyResults :many
+SELECT
+  r.algorithm_id,
+  a.name,
+  a.version,
+  a.result_type,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  w.time_from,
+  w.time_to
+FROM results r
+JOIN algorithm a ON r.algorithm_id = a.id
+JOIN windows w ON r.windows_id = w.id
+WHERE r.algorithm_id = ANY($1::BIGINT[])
+  AND r.status = 'succeeded'
+  AND NOT r.superseded
+  AND w.time_from >= $2
+  AND w.time_to <= $3
+  -- metadata fields shared between the windows must match
+  AND NOT EXISTS (
+    SELECT 1 FROM jsonb_each(w.metadata) m
+    WHERE $4::JSONB -> m.key <> m.value
+  )
+ORDER BY w.time_from, w.time_to, r.id
+`
+
+type ReadDependencyResultsParams struct {
+	AlgorithmIds []int64
+	TimeFrom     pgtype.Timestamp
+	TimeTo       pgtype.Timestamp
+	Metadata     []byte
+}
+
+type ReadDependencyResultsRow struct {
+	AlgorithmID pgtype.Int8
+	Name        string
+	Version     string
+	ResultType  ResultType
+	ResultValue pgtype.Float8
+	ResultArray []float64
+	ResultJson  []byte
+	TimeFrom    pgtype.Timestamp
+	TimeTo      pgtype.Timestamp
+}
+
+func (q *Queries) ReadDependencyResults(ctx context.Context, arg ReadDependencyResultsParams) ([]ReadDependencyResultsRow, error) {
+	rows, err := q.db.Query(ctx, readDependencyResults,
+		arg.AlgorithmIds,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Metadata,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadDependencyResultsRow
+	for rows.Next() {
+		var i ReadDependencyResultsRow
+		if err := rows.Scan(
+			&i.AlgorithmID,
+			&i.Name,
+			&i.Version,
+			&i.ResultType,
+			&i.ResultValue,
+			&i.ResultArray,
+			&i.ResultJson,
+			&i.TimeFrom,
+			&i.TimeTo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readDistinctJsonResultFieldsForAlgorithm = `-- name: ReadDistinctJsonResultFieldsForAlgorithm :many
+select distinct jsonb_object_keys(r.result_json) as field_names from results r
+join algorithm a on r.algorithm_id = a.id
+join windows w on r.windows_id = w.id
+where
+	w.time_from  >= $1 and w.time_to <= $2
+	and a."name" = $3
+	and a."version" = $4
+	and not r.superseded
+`
+
+type ReadDistinctJsonResultFieldsForAlgorithmParams struct {
+	TimeFrom         pgtype.Timestamp
+	TimeTo           pgtype.Timestamp
+	AlgorithmName    string
+	AlgorithmVersion string
+}
+
+func (q *Queries) ReadDistinctJsonResultFieldsForAlgorithm(ctx context.Context, arg ReadDistinctJsonResultFieldsForAlgorithmParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, readDistinctJsonResultFieldsForAlgorithm,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.AlgorithmName,
+		arg.AlgorithmVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var field_names string
+		if err := rows.Scan(&field_names); err != nil {
+			return nil, err
+		}
+		items = append(items, field_names)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readDistinctWindowMetadata = `-- name: ReadDistinctWindowMetadata :many
+SELECT DISTINCT w.metadata FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id 
+WHERE
+  w.time_from  >= $1
+  AND w.time_to <= $2
+  AND wt.name = $3
+  AND wt.version = $4
+  AND NOT w.superseded
+`
+
+type ReadDistinctWindowMetadataParams struct {
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+func (q *Queries) ReadDistinctWindowMetadata(ctx context.Context, arg ReadDistinctWindowMetadataParams) ([][]byte, error) {
+	rows, err := q.db.Query(ctx, readDistinctWindowMetadata,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var metadata []byte
+		if err := rows.Scan(&metadata); err != nil {
+			return nil, err
+		}
+		items = append(items, metadata)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readDuplicateWindow = `-- name: ReadDuplicateWindow :one
+SELECT
+  w.id,
+  ep.exec_id
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+LEFT JOIN LATERAL (
+  SELECT exec_id FROM execution_plan
+  WHERE windows_id = w.id
+  AND reprocess_id IS NULL
+  AND NOT provisional
+  ORDER BY id
+  LIMIT 1
+) ep ON TRUE
+WHERE wt.name = $1
+AND wt.version = $2
+AND CASE
+  WHEN $3::TEXT IS NOT NULL
+  THEN w.idempotency_key = $3::TEXT
+  ELSE w.time_from = $4
+    AND w.time_to = $5
+    AND w.origin = $6
+    AND w.metadata = $7::JSONB
+END
+ORDER BY w.id
+LIMIT 1
+`
+
+type ReadDuplicateWindowParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+	IdempotencyKey    pgtype.Text
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	Origin            string
+	Metadata          []byte
+}
+
+type ReadDuplicateWindowRow struct {
+	ID     int64
+	ExecID pgtype.Text
+}
+
+func (q *Queries) ReadDuplicateWindow(ctx context.Context, arg ReadDuplicateWindowParams) (ReadDuplicateWindowRow, error) {
+	row := q.db.QueryRow(ctx, readDuplicateWindow,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+		arg.IdempotencyKey,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Origin,
+		arg.Metadata,
+	)
+	var i ReadDuplicateWindowRow
+	err := row.Scan(&i.ID, &i.ExecID)
+	return i, err
+}
+
+const readExecution = `-- name: ReadExecution :one
+SELECT
+  ep.id,
+  ep.exec_id,
+  ep.status,
+  ep.created,
+  ep.started,
+  ep.finished,
+  ep.provisional,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  wt.name,
+  wt.version
+FROM execution_plan ep
+JOIN windows w ON ep.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE ep.exec_id = $1
+`
+
+type ReadExecutionRow struct {
+	ID          int64
+	ExecID      string
+	Status      ExecutionStatus
+	Created     pgtype.Timestamp
+	Started     pgtype.Timestamp
+	Finished    pgtype.Timestamp
+	Provisional bool
+	TimeFrom    pgtype.Timestamp
+	TimeTo      pgtype.Timestamp
+	Origin      string
+	Metadata    []byte
+	Name        string
+	Version     string
+}
+
+func (q *Queries) ReadExecution(ctx context.Context, execID string) (ReadExecutionRow, error) {
+	row := q.db.QueryRow(ctx, readExecution, execID)
+	var i ReadExecutionRow
+	err := row.Scan(
+		&i.ID,
+		&i.ExecID,
+		&i.Status,
+		&i.Created,
+		&i.Started,
+		&i.Finished,
+		&i.Provisional,
+		&i.TimeFrom,
+		&i.TimeTo,
+		&i.Origin,
+		&i.Metadata,
+		&i.Name,
+		&i.Version,
+	)
+	return i, err
+}
+
+const readExecutionAttempts = `-- name: ReadExecutionAttempts :many
+SELECT
+  ea.execution_task_id,
+  ea.attempt,
+  ea.status,
+  ea.error_code,
+  ea.error_message,
+  ea.backoff_ms,
+  ea.started,
+  ea.finished
+FROM execution_attempt ea
+JOIN execution_task et ON ea.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE es.execution_plan_id = ANY($1::BIGINT[])
+ORDER BY ea.execution_task_id, ea.attempt
+`
+
+type ReadExecutionAttemptsRow struct {
+	ExecutionTaskID int64
+	Attempt         int32
+	Status          ExecutionStatus
+	ErrorCode       pgtype.Text
+	ErrorMessage    pgtype.Text
+	BackoffMs       pgtype.Int8
+	Started         pgtype.Timestamp
+	Finished        pgtype.Timestamp
+}
+
+func (q *Queries) ReadExecutionAttempts(ctx context.Context, executionPlanIds []int64) ([]ReadExecutionAttemptsRow, error) {
+	rows, err := q.db.Query(ctx, readExecutionAttempts, executionPlanIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadExecutionAttemptsRow
+	for rows.Next() {
+		var i ReadExecutionAttemptsRow
+		if err := rows.Scan(
+			&i.ExecutionTaskID,
+			&i.Attempt,
+			&i.Status,
+			&i.ErrorCode,
+			&i.ErrorMessage,
+			&i.BackoffMs,
+			&i.Started,
+			&i.Finished,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readExecutionNodes = `-- name: ReadExecutionNodes :many
+SELECT
+  en.execution_task_id,
+  en.algorithm_id,
+  en.window_type_id,
+  en.algorithm_dep_ids
+FROM execution_node en
+JOIN execution_task et ON en.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE es.execution_plan_id = $1
+ORDER BY en.execution_task_id, en.node_index
+`
+
+type ReadExecutionNodesRow struct {
+	ExecutionTaskID int64
+	AlgorithmID     int64
+	WindowTypeID    int64
+	AlgorithmDepIds []int64
+}
+
+func (q *Queries) ReadExecutionNodes(ctx context.Context, executionPlanID int64) ([]ReadExecutionNodesRow, error) {
+	rows, err := q.db.Query(ctx, readExecutionNodes, executionPlanID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadExecutionNodesRow
+	for rows.Next() {
+		var i ReadExecutionNodesRow
+		if err := rows.Scan(
+			&i.ExecutionTaskID,
+			&i.AlgorithmID,
+			&i.WindowTypeID,
+			&i.AlgorithmDepIds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readExecutionPlanWindow = `-- name: ReadExecutionPlanWindow :one
+SELECT
+  w.id,
+  w.window_type_id,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  wt.name,
+  wt.version,
+  ep.exec_id
+FROM execution_plan ep
+JOIN windows w ON ep.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE ep.id = $1
+`
+
+type ReadExecutionPlanWindowRow struct {
+	ID           int64
+	WindowTypeID int64
+	TimeFrom     pgtype.Timestamp
+	TimeTo       pgtype.Timestamp
+	Origin       string
+	Metadata     []byte
+	Name         string
+	Version      string
+	ExecID       string
+}
+
+func (q *Queries) ReadExecutionPlanWindow(ctx context.Context, executionPlanID int64) (ReadExecutionPlanWindowRow, error) {
+	row := q.db.QueryRow(ctx, readExecutionPlanWindow, executionPlanID)
+	var i ReadExecutionPlanWindowRow
+	err := row.Scan(
+		&i.ID,
+		&i.WindowTypeID,
+		&i.TimeFrom,
+		&i.TimeTo,
+		&i.Origin,
+		&i.Metadata,
+		&i.Name,
+		&i.Version,
+		&i.ExecID,
+	)
+	return i, err
+}
+
+const readExecutionTasks = `-- name: ReadExecutionTasks :many
+SELECT
+  es.id AS execution_stage_id,
+  es.stage_index,
+  es.status AS stage_status,
+  et.id,
+  et.task_index,
+  et.processor_id,
+  et.exec_id,
+  et.status
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE es.execution_plan_id = $1
+ORDER BY es.stage_index, et.task_index
+`
+
+type ReadExecutionTasksRow struct {
+	ExecutionStageID int64
+	StageIndex       int32
+	StageStatus      ExecutionStatus
+	ID               int64
+	TaskIndex        int32
+	ProcessorID      int64
+	ExecID           string
+	Status           ExecutionStatus
+}
+
+func (q *Queries) ReadExecutionTasks(ctx context.Context, executionPlanID int64) ([]ReadExecutionTasksRow, error) {
+	rows, err := q.db.Query(ctx, readExecutionTasks, executionPlanID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadExecutionTasksRow
+	for rows.Next() {
+		var i ReadExecutionTasksRow
+		if err := rows.Scan(
+			&i.ExecutionStageID,
+			&i.StageIndex,
+			&i.StageStatus,
+			&i.ID,
+			&i.TaskIndex,
+			&i.ProcessorID,
+			&i.ExecID,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readExecutions = `-- name: ReadExecutions :many
+SELECT
+  ep.id,
+  ep.exec_id,
+  ep.status,
+  ep.created,
+  ep.started,
+  ep.finished,
+  ep.provisional,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  wt.name,
+  wt.version
+FROM execution_plan ep
+JOIN windows w ON ep.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE w.time_from >= $1 AND w.time_to <= $2
+AND ($3::TEXT IS NULL OR wt.name = $3)
+AND ($4::TEXT IS NULL OR wt.version = $4)
+AND ($5::execution_status IS NULL OR ep.status = $5)
+ORDER BY w.time_from, w.time_to, ep.id
+`
+
+type ReadExecutionsParams struct {
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	WindowTypeName    pgtype.Text
+	WindowTypeVersion pgtype.Text
+	Status            NullExecutionStatus
+}
+
+type ReadExecutionsRow struct {
+	ID          int64
+	ExecID      string
+	Status      ExecutionStatus
+	Created     pgtype.Timestamp
+	Started     pgtype.Timestamp
+	Finished    pgtype.Timestamp
+	Provisional bool
+	TimeFrom    pgtype.Timestamp
+	TimeTo      pgtype.Timestamp
+	Origin      string
+	Metadata    []byte
+	Name        string
+	Version     string
+}
+
+func (q *Queries) ReadExecutions(ctx context.Context, arg ReadExecutionsParams) ([]ReadExecutionsRow, error) {
+	rows, err := q.db.Query(ctx, readExecutions,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+		arg.Status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadExecutionsRow
+	for rows.Next() {
+		var i ReadExecutionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExecID,
+			&i.Status,
+			&i.Created,
+			&i.Started,
+			&i.Finished,
+			&i.Provisional,
+			&i.TimeFrom,
+			&i.TimeTo,
+			&i.Origin,
+			&i.Metadata,
+			&i.Name,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readFailedExecutionPlans = `-- name: ReadFailedExecutionPlans :many
+SELECT DISTINCT
+  ep.id,
+  ep.exec_id
+FROM dead_letter dl
+JOIN execution_task et ON dl.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN execution_plan ep ON es.execution_plan_id = ep.id
+JOIN windows w ON ep.windows_id = w.id
+JOIN processor p ON dl.processor_id = p.id
+WHERE dl.requeued IS NULL
+AND ep.status = 'failed'
+AND w.time_from >= $1 AND w.time_to <= $2
+AND ($3::TEXT IS NULL OR p.name = $3)
+AND ($4::TEXT IS NULL OR p.runtime = $4)
+AND ($5::TEXT IS NULL OR EXISTS (
+  SELECT 1 FROM algorithm a
+  WHERE a.id = ANY(dl.algorithm_ids)
+  AND a.name = $5
+  AND ($6::TEXT IS NULL OR a.version = $6)
+))
+ORDER BY ep.id
+`
+
+type ReadFailedExecutionPlansParams struct {
+	TimeFrom         pgtype.Timestamp
+	TimeTo           pgtype.Timestamp
+	ProcessorName    pgtype.Text
+	ProcessorRuntime pgtype.Text
+	AlgorithmName    pgtype.Text
+	AlgorithmVersion pgtype.Text
+}
+
+type ReadFailedExecutionPlansRow struct {
+	ID     int64
+	ExecID string
+}
+
+func (q *Queries) ReadFailedExecutionPlans(ctx context.Context, arg ReadFailedExecutionPlansParams) ([]ReadFailedExecutionPlansRow, error) {
+	rows, err := q.db.Query(ctx, readFailedExecutionPlans,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.ProcessorName,
+		arg.ProcessorRuntime,
+		arg.AlgorithmName,
+		arg.AlgorithmVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadFailedExecutionPlansRow
+	for rows.Next() {
+		var i ReadFailedExecutionPlansRow
+		if err := rows.Scan(&i.ID, &i.ExecID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readFailedExecutions = `-- name: ReadFailedExecutions :many
+SELECT
+  ep.exec_id,
+  et.exec_id AS task_exec_id,
+  p.name AS processor_name,
+  p.runtime AS processor_runtime,
+  dl.request,
+  dl.error_code,
+  dl.error_message,
+  dl.attempts,
+  dl.created,
+  dl.requeued
+FROM dead_letter dl
+JOIN execution_task et ON dl.execution_task_id = et.id
+JOIN execution_stage es ON et.execution_stage_id = es.id
+JOIN execution_plan ep ON es.execution_plan_id = ep.id
+JOIN windows w ON ep.windows_id = w.id
+JOIN processor p ON dl.processor_id = p.id
+WHERE ($1::BOOLEAN OR dl.requeued IS NULL)
+AND w.time_from >= $2 AND w.time_to <= $3
+AND ($4::TEXT IS NULL OR p.name = $4)
+AND ($5::TEXT IS NULL OR p.runtime = $5)
+AND ($6::TEXT IS NULL OR EXISTS (
+  SELECT 1 FROM algorithm a
+  WHERE a.id = ANY(dl.algorithm_ids)
+  AND a.name = $6
+  AND ($7::TEXT IS NULL OR a.version = $7)
+))
+ORDER BY w.time_from, w.time_to, dl.id
+`
+
+type ReadFailedExecutionsParams struct {
+	IncludeRequeued  bool
+	TimeFrom         pgtype.Timestamp
+	TimeTo           pgtype.Timestamp
+	ProcessorName    pgtype.Text
+	ProcessorRuntime pgtype.Text
+	AlgorithmName    pgtype.Text
+	AlgorithmVersion pgtype.Text
+}
+
+type ReadFailedExecutionsRow struct {
+	ExecID           string
+	TaskExecID       string
+	ProcessorName    string
+	ProcessorRuntime string
+	Request          []byte
+	ErrorCode        string
+	ErrorMessage     string
+	Attempts         int32
+	Created          pgtype.Timestamp
+	Requeued         pgtype.Timestamp
+}
+
+func (q *Queries) ReadFailedExecutions(ctx context.Context, arg ReadFailedExecutionsParams) ([]ReadFailedExecutionsRow, error) {
+	rows, err := q.db.Query(ctx, readFailedExecutions,
+		arg.IncludeRequeued,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.ProcessorName,
+		arg.ProcessorRuntime,
+		arg.AlgorithmName,
+		arg.AlgorithmVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadFailedExecutionsRow
+	for rows.Next() {
+		var i ReadFailedExecutionsRow
+		if err := rows.Scan(
+			&i.ExecID,
+			&i.TaskExecID,
+			&i.ProcessorName,
+			&i.ProcessorRuntime,
+			&i.Request,
+			&i.ErrorCode,
+			&i.ErrorMessage,
+			&i.Attempts,
+			&i.Created,
+			&i.Requeued,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readFromAlgorithmDependencies = `-- name: ReadFromAlgorithmDependencies :many
+WITH from_algo AS (
+  SELECT a.id, a.window_type_id, a.processor_id FROM algorithm a
+  JOIN processor p ON a.processor_id = p.id
+  WHERE a.name = $1
+  AND a.version = $2
+  AND p.name = $3
+  AND p.runtime = $4
+)
+SELECT ad.id, ad.from_algorithm_id, ad.to_algorithm_id, ad.from_window_type_id, ad.to_window_type_id, ad.from_processor_id, ad.to_processor_id, ad.created FROM algorithm_dependency ad WHERE ad.from_algorithm_id = from_algo.id
+`
+
+type ReadFromAlgorithmDependenciesParams struct {
+	FromAlgorithmName    string
+	FromAlgorithmVersion string
+	FromProcessorName    string
+	FromProcessorRuntime string
+}
+
+func (q *Queries) ReadFromAlgorithmDependencies(ctx context.Context, arg ReadFromAlgorithmDependenciesParams) ([]AlgorithmDependency, error) {
+	rows, err := q.db.Query(ctx, readFromAlgorithmDependencies,
+		arg.FromAlgorithmName,
+		arg.FromAlgorithmVersion,
+		arg.FromProcessorName,
+		arg.FromProcessorRuntime,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AlgorithmDependency
+	for rows.Next() {
+		var i AlgorithmDependency
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAlgorithmID,
+			&i.ToAlgorithmID,
+			&i.FromWindowTypeID,
+			&i.ToWindowTypeID,
+			&i.FromProcessorID,
+			&i.ToProcessorID,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readLatestResultSeq = `-- name: ReadLatestResultSeq :one
+SELECT COALESCE(MAX(seq), 0)::BIGINT AS seq FROM results
+`
+
+func (q *Queries) ReadLatestResultSeq(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, readLatestResultSeq)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+const readLiveAlgorithmDependents = `-- name: ReadLiveAlgorithmDependents :many
+WITH RECURSIVE dependents AS (
+  SELECT ad.to_algorithm_id AS algorithm_id
+  FROM algorithm_dependency ad
+  WHERE ad.from_algorithm_id = ANY($1::BIGINT[])
+
+  UNION
+
+  SELECT ad.to_algorithm_id
+  FROM algorithm_dependency ad
+  JOIN dependents d ON ad.from_algorithm_id = d.algorithm_id
+)
+SELECT
+  a.id,
+  a.name,
+  a.version
+FROM algorithm a
+JOIN dependents d ON a.id = d.algorithm_id
+WHERE a.retired IS NULL
+AND NOT a.id = ANY($1::BIGINT[])
+ORDER BY a.id
+`
+
+type ReadLiveAlgorithmDependentsRow struct {
+	ID      int64
+	Name    string
+	Version string
+}
+
+func (q *Queries) ReadLiveAlgorithmDependents(ctx context.Context, algorithmIds []int64) ([]ReadLiveAlgorithmDependentsRow, error) {
+	rows, err := q.db.Query(ctx, readLiveAlgorithmDependents, algorithmIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadLiveAlgorithmDependentsRow
+	for rows.Next() {
+		var i ReadLiveAlgorithmDependentsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Version); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readLiveAlgorithmId = `-- name: ReadLiveAlgorithmId :one
+SELECT id FROM algorithm
+WHERE name = $1
+AND version = $2
+AND retired IS NULL
+`
+
+type ReadLiveAlgorithmIdParams struct {
+	Name    string
+	Version string
+}
+
+func (q *Queries) ReadLiveAlgorithmId(ctx context.Context, arg ReadLiveAlgorithmIdParams) (int64, error) {
+	row := q.db.QueryRow(ctx, readLiveAlgorithmId, arg.Name, arg.Version)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const readLiveProcessorAlgorithmIds = `-- name: ReadLiveProcessorAlgorithmIds :many
+SELECT id FROM algorithm
+WHERE processor_id = $1
+AND retired IS NULL
+ORDER BY id
+`
+
+func (q *Queries) ReadLiveProcessorAlgorithmIds(ctx context.Context, processorID int64) ([]int64, error) {
+	rows, err := q.db.Query(ctx, readLiveProcessorAlgorithmIds, processorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readLiveProcessorId = `-- name: ReadLiveProcessorId :one
+SELECT id FROM processor
+WHERE name = $1
+AND runtime = $2
+AND deregistered IS NULL
+`
+
+type ReadLiveProcessorIdParams struct {
+	Name    string
+	Runtime string
+}
+
+func (q *Queries) ReadLiveProcessorId(ctx context.Context, arg ReadLiveProcessorIdParams) (int64, error) {
+	row := q.db.QueryRow(ctx, readLiveProcessorId, arg.Name, arg.Runtime)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const readMetadataFieldsByWindowType = `-- name: ReadMetadataFieldsByWindowType :many
+SELECT 
+    metadata_field_id,
+    metadata_field_name,
+    metadata_field_description
+FROM window_type_metadata_fields
+WHERE window_type_name = $1
+  AND window_type_version = $2
+ORDER BY metadata_field_name
+`
+
+type ReadMetadataFieldsByWindowTypeParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+type ReadMetadataFieldsByWindowTypeRow struct {
+	MetadataFieldID          int64
+	MetadataFieldName        string
+	MetadataFieldDescription string
+}
+
+func (q *Queries) ReadMetadataFieldsByWindowType(ctx context.Context, arg ReadMetadataFieldsByWindowTypeParams) ([]ReadMetadataFieldsByWindowTypeRow, error) {
+	rows, err := q.db.Query(ctx, readMetadataFieldsByWindowType, arg.WindowTypeName, arg.WindowTypeVersion)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadMetadataFieldsByWindowTypeRow
 	for rows.Next() {
-		var i ReadAlgorithmJsonFieldRow
-		if err := rows.Scan(&i.TimeFrom, &i.TimeTo, &i.Result); err != nil {
+		var i ReadMetadataFieldsByWindowTypeRow
+		if err := rows.Scan(&i.MetadataFieldID, &i.MetadataFieldName, &i.MetadataFieldDescription); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -489,54 +3258,53 @@ func (q *Queries) ReadAlgorithmJsonField(ctx context.Context, arg ReadAlgorithmJ
 	return items, nil
 }
 
-const readAlgorithms = `-- name: ReadAlgorithms :many
+const readProcessorInstances = `-- name: ReadProcessorInstances :many
 SELECT
-  a.id,
-  a.name,
-  a.version,
-  a.created,
-  a.result_type,
-  w.name as window_name, 
-  w.version as window_version,
-  p.name as processor_name, 
-  p.runtime as processor_runtime
-FROM algorithm a
-  JOIN window_type w ON a.window_type_id = w.id
-  JOIN processor p ON a.processor_id = p.id
-ORDER BY a.processor_id, a.created DESC
+  id,
+  processor_id,
+  connection_string,
+  created,
+  registered,
+  last_seen,
+  last_checked,
+  health_status,
+  health_message,
+  active_tasks,
+  memory_bytes,
+  cpu_percent,
+  uptime_seconds,
+  available,
+  instance_id
+FROM processor_instance
+WHERE processor_id = $1
+ORDER BY registered DESC, id DESC
 `
 
-type ReadAlgorithmsRow struct {
-	ID               int64
-	Name             string
-	Version          string
-	Created          pgtype.Timestamp
-	ResultType       ResultType
-	WindowName       string
-	WindowVersion    string
-	ProcessorName    string
-	ProcessorRuntime string
-}
-
-func (q *Queries) ReadAlgorithms(ctx context.Context) ([]ReadAlgorithmsRow, error) {
-	rows, err := q.db.Query(ctx, readAlgorithms)
+func (q *Queries) ReadProcessorInstances(ctx context.Context, processorID int64) ([]ProcessorInstance, error) {
+	rows, err := q.db.Query(ctx, readProcessorInstances, processorID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadAlgorithmsRow
+	var items []ProcessorInstance
 	for rows.Next() {
-		var i ReadAlgorithmsRow
+		var i ProcessorInstance
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Version,
+			&i.ProcessorID,
+			&i.ConnectionString,
 			&i.Created,
-			&i.ResultType,
-			&i.WindowName,
-			&i.WindowVersion,
-			&i.ProcessorName,
-			&i.ProcessorRuntime,
+			&i.Registered,
+			&i.LastSeen,
+			&i.LastChecked,
+			&i.HealthStatus,
+			&i.HealthMessage,
+			&i.ActiveTasks,
+			&i.MemoryBytes,
+			&i.CpuPercent,
+			&i.UptimeSeconds,
+			&i.Available,
+			&i.InstanceID,
 		); err != nil {
 			return nil, err
 		}
@@ -548,34 +3316,37 @@ func (q *Queries) ReadAlgorithms(ctx context.Context) ([]ReadAlgorithmsRow, erro
 	return items, nil
 }
 
-const readAlgorithmsForWindow = `-- name: ReadAlgorithmsForWindow :many
-SELECT a.id, a.name, a.version, a.processor_id, a.window_type_id, a.result_type, a.created FROM algorithm a
-JOIN window_type wt ON a.window_type_id = wt.id
-WHERE wt.name = $1 
-AND wt.version = $2
+const readProcessors = `-- name: ReadProcessors :many
+SELECT
+  id,
+  name, 
+  runtime, 
+  created
+FROM processor
+WHERE deregistered IS NULL
+ORDER BY created DESC
 `
 
-type ReadAlgorithmsForWindowParams struct {
-	WindowTypeName    string
-	WindowTypeVersion string
+type ReadProcessorsRow struct {
+	ID      int64
+	Name    string
+	Runtime string
+	Created pgtype.Timestamp
 }
 
-func (q *Queries) ReadAlgorithmsForWindow(ctx context.Context, arg ReadAlgorithmsForWindowParams) ([]Algorithm, error) {
-	rows, err := q.db.Query(ctx, readAlgorithmsForWindow, arg.WindowTypeName, arg.WindowTypeVersion)
+func (q *Queries) ReadProcessors(ctx context.Context) ([]ReadProcessorsRow, error) {
+	rows, err := q.db.Query(ctx, readProcessors)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Algorithm
+	var items []ReadProcessorsRow
 	for rows.Next() {
-		var i Algorithm
+		var i ReadProcessorsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
-			&i.Version,
-			&i.ProcessorID,
-			&i.WindowTypeID,
-			&i.ResultType,
+			&i.Runtime,
 			&i.Created,
 		); err != nil {
 			return nil, err
@@ -588,19 +3359,22 @@ func (q *Queries) ReadAlgorithmsForWindow(ctx context.Context, arg ReadAlgorithm
 	return items, nil
 }
 
-const readAllProcessors = `-- name: ReadAllProcessors :many
+const readProcessorsByIDs = `-- name: ReadProcessorsByIDs :many
 SELECT 
   id,
   name,
   runtime,
   connection_string,
-  created
+  created,
+  retry_policy,
+  deregistered
 FROM processor
+WHERE id = ANY($1::bigint[])
 ORDER BY name, runtime
 `
 
-func (q *Queries) ReadAllProcessors(ctx context.Context) ([]Processor, error) {
-	rows, err := q.db.Query(ctx, readAllProcessors)
+func (q *Queries) ReadProcessorsByIDs(ctx context.Context, processorIds []int64) ([]Processor, error) {
+	rows, err := q.db.Query(ctx, readProcessorsByIDs, processorIds)
 	if err != nil {
 		return nil, err
 	}
@@ -614,6 +3388,8 @@ func (q *Queries) ReadAllProcessors(ctx context.Context) ([]Processor, error) {
 			&i.Runtime,
 			&i.ConnectionString,
 			&i.Created,
+			&i.RetryPolicy,
+			&i.Deregistered,
 		); err != nil {
 			return nil, err
 		}
@@ -625,41 +3401,263 @@ func (q *Queries) ReadAllProcessors(ctx context.Context) ([]Processor, error) {
 	return items, nil
 }
 
-const readDistinctJsonResultFieldsForAlgorithm = `-- name: ReadDistinctJsonResultFieldsForAlgorithm :many
-select distinct jsonb_object_keys(r.result_json) as field_names from results r
+const readReprocess = `-- name: ReadReprocess :one
+SELECT
+  r.id,
+  r.reprocess_id,
+  r.status,
+  r.total_windows,
+  r.triggered_windows,
+  r.error_message,
+  r.created,
+  r.finished,
+  COUNT(ep.id) FILTER (WHERE ep.status = 'succeeded') AS succeeded_windows,
+  COUNT(ep.id) FILTER (WHERE ep.status IN ('failed', 'cancelled')) AS failed_windows
+FROM reprocess r
+LEFT JOIN execution_plan ep ON ep.reprocess_id = r.id
+WHERE r.reprocess_id = $1
+GROUP BY r.id
+`
+
+type ReadReprocessRow struct {
+	ID               int64
+	ReprocessID      string
+	Status           ExecutionStatus
+	TotalWindows     int32
+	TriggeredWindows int32
+	ErrorMessage     pgtype.Text
+	Created          pgtype.Timestamp
+	Finished         pgtype.Timestamp
+	SucceededWindows int64
+	FailedWindows    int64
+}
+
+func (q *Queries) ReadReprocess(ctx context.Context, reprocessID string) (ReadReprocessRow, error) {
+	row := q.db.QueryRow(ctx, readReprocess, reprocessID)
+	var i ReadReprocessRow
+	err := row.Scan(
+		&i.ID,
+		&i.ReprocessID,
+		&i.Status,
+		&i.TotalWindows,
+		&i.TriggeredWindows,
+		&i.ErrorMessage,
+		&i.Created,
+		&i.Finished,
+		&i.SucceededWindows,
+		&i.FailedWindows,
+	)
+	return i, err
+}
+
+const readReprocessByID = `-- name: ReadReprocessByID :one
+SELECT id, reprocess_id, window_type_id, time_from, time_to, metadata, algorithm_ids, windows_per_second, status, total_windows, triggered_windows, last_window_id, error_message, created, finished, triggered, owner, lease_expires FROM reprocess
+WHERE id = $1
+`
+
+func (q *Queries) ReadReprocessByID(ctx context.Context, id int64) (Reprocess, error) {
+	row := q.db.QueryRow(ctx, readReprocessByID, id)
+	var i Reprocess
+	err := row.Scan(
+		&i.ID,
+		&i.ReprocessID,
+		&i.WindowTypeID,
+		&i.TimeFrom,
+		&i.TimeTo,
+		&i.Metadata,
+		&i.AlgorithmIds,
+		&i.WindowsPerSecond,
+		&i.Status,
+		&i.TotalWindows,
+		&i.TriggeredWindows,
+		&i.LastWindowID,
+		&i.ErrorMessage,
+		&i.Created,
+		&i.Finished,
+		&i.Triggered,
+		&i.Owner,
+		&i.LeaseExpires,
+	)
+	return i, err
+}
+
+const readResultsAfter = `-- name: ReadResultsAfter :many
+SELECT
+  r.seq::BIGINT AS seq,
+  a.name AS algorithm_name,
+  a.version AS algorithm_version,
+  a.result_type,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  r.status,
+  r.error_message
+FROM results r
+JOIN algorithm a ON r.algorithm_id = a.id
+JOIN windows w ON r.windows_id = w.id
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE r.seq > $1
+  AND (
+    cardinality($2::TEXT[]) = 0
+    OR (a.name, a.version) IN (
+      SELECT * FROM unnest($2::TEXT[], $3::TEXT[])
+    )
+  )
+  AND (
+    $4::TEXT IS NULL
+    OR (wt.name = $4 AND wt.version = $5)
+  )
+  AND COALESCE(w.metadata, '{}') @> $6::JSONB
+ORDER BY r.seq
+LIMIT $7
+`
+
+type ReadResultsAfterParams struct {
+	After             int64
+	AlgorithmNames    []string
+	AlgorithmVersions []string
+	WindowTypeName    pgtype.Text
+	WindowTypeVersion pgtype.Text
+	Metadata          []byte
+	PageSize          int32
+}
+
+type ReadResultsAfterRow struct {
+	Seq               int64
+	AlgorithmName     string
+	AlgorithmVersion  string
+	ResultType        ResultType
+	WindowTypeName    string
+	WindowTypeVersion string
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	Origin            string
+	Metadata          []byte
+	ResultValue       pgtype.Float8
+	ResultArray       []float64
+	ResultJson        []byte
+	Status            ResultStatus
+	ErrorMessage      pgtype.Text
+}
+
+func (q *Queries) ReadResultsAfter(ctx context.Context, arg ReadResultsAfterParams) ([]ReadResultsAfterRow, error) {
+	rows, err := q.db.Query(ctx, readResultsAfter,
+		arg.After,
+		arg.AlgorithmNames,
+		arg.AlgorithmVersions,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+		arg.Metadata,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReadResultsAfterRow
+	for rows.Next() {
+		var i ReadResultsAfterRow
+		if err := rows.Scan(
+			&i.Seq,
+			&i.AlgorithmName,
+			&i.AlgorithmVersion,
+			&i.ResultType,
+			&i.WindowTypeName,
+			&i.WindowTypeVersion,
+			&i.TimeFrom,
+			&i.TimeTo,
+			&i.Origin,
+			&i.Metadata,
+			&i.ResultValue,
+			&i.ResultArray,
+			&i.ResultJson,
+			&i.Status,
+			&i.ErrorMessage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const readResultsForAlgorithm = `-- name: ReadResultsForAlgorithm :many
+select
+  w.time_from,
+  w.time_to,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  r.status,
+  r.error_message,
+  r.superseded
+from results r
 join algorithm a on r.algorithm_id = a.id
 join windows w on r.windows_id = w.id
 where
 	w.time_from  >= $1 and w.time_to <= $2
 	and a."name" = $3
 	and a."version" = $4
+	and ($5::BOOLEAN or not r.superseded)
+ORDER BY w.time_from, w.time_to ASC
 `
 
-type ReadDistinctJsonResultFieldsForAlgorithmParams struct {
-	TimeFrom         pgtype.Timestamp
-	TimeTo           pgtype.Timestamp
-	AlgorithmName    string
-	AlgorithmVersion string
+type ReadResultsForAlgorithmParams struct {
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	AlgorithmName     string
+	AlgorithmVersion  string
+	IncludeSuperseded bool
 }
 
-func (q *Queries) ReadDistinctJsonResultFieldsForAlgorithm(ctx context.Context, arg ReadDistinctJsonResultFieldsForAlgorithmParams) ([]string, error) {
-	rows, err := q.db.Query(ctx, readDistinctJsonResultFieldsForAlgorithm,
+type ReadResultsForAlgorithmRow struct {
+	TimeFrom     pgtype.Timestamp
+	TimeTo       pgtype.Timestamp
+	ResultValue  pgtype.Float8
+	ResultArray  []float64
+	ResultJson   []byte
+	Status       ResultStatus
+	ErrorMessage pgtype.Text
+	Superseded   bool
+}
+
+func (q *Queries) ReadResultsForAlgorithm(ctx context.Context, arg ReadResultsForAlgorithmParams) ([]ReadResultsForAlgorithmRow, error) {
+	rows, err := q.db.Query(ctx, readResultsForAlgorithm,
 		arg.TimeFrom,
 		arg.TimeTo,
 		arg.AlgorithmName,
 		arg.AlgorithmVersion,
+		arg.IncludeSuperseded,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ReadResultsForAlgorithmRow
 	for rows.Next() {
-		var field_names string
-		if err := rows.Scan(&field_names); err != nil {
+		var i ReadResultsForAlgorithmRow
+		if err := rows.Scan(
+			&i.TimeFrom,
+			&i.TimeTo,
+			&i.ResultValue,
+			&i.ResultArray,
+			&i.ResultJson,
+			&i.Status,
+			&i.ErrorMessage,
+			&i.Superseded,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, field_names)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -667,41 +3665,77 @@ func (q *Queries) ReadDistinctJsonResultFieldsForAlgorithm(ctx context.Context,
 	return items, nil
 }
 
-const readDistinctWindowMetadata = `-- name: ReadDistinctWindowMetadata :many
-SELECT DISTINCT w.metadata FROM windows w
-JOIN window_type wt ON w.window_type_id = wt.id 
+const readResultsForAlgorithmAndMetadata = `-- name: ReadResultsForAlgorithmAndMetadata :many
+WITH algorithmId AS (
+  SELECT id FROM algorithm WHERE name = $4 AND version = $5
+)
+SELECT
+  w.time_from,
+  w.time_to,
+  w.metadata,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  r.status,
+  r.error_message
+FROM results r
+JOIN windows w ON r.windows_id  = w.id
 WHERE
-  w.time_from  >= $1
-  AND w.time_to <= $2
-  AND wt.name = $3
-  AND wt.version = $4
+  w.time_from >= $1 AND
+  w.time_to <= $2 AND
+  w.metadata::jsonb @> $3::jsonb AND
+  r.algorithm_id = (SELECT id FROM algorithmId) AND
+  NOT r.superseded
+ORDER BY w.time_from, w.time_to ASC
 `
 
-type ReadDistinctWindowMetadataParams struct {
-	TimeFrom          pgtype.Timestamp
-	TimeTo            pgtype.Timestamp
-	WindowTypeName    string
-	WindowTypeVersion string
+type ReadResultsForAlgorithmAndMetadataParams struct {
+	TimeFrom         pgtype.Timestamp
+	TimeTo           pgtype.Timestamp
+	MetadataFilter   []byte
+	AlgorithmName    string
+	AlgorithmVersion string
 }
 
-func (q *Queries) ReadDistinctWindowMetadata(ctx context.Context, arg ReadDistinctWindowMetadataParams) ([][]byte, error) {
-	rows, err := q.db.Query(ctx, readDistinctWindowMetadata,
+type ReadResultsForAlgorithmAndMetadataRow struct {
+	TimeFrom     pgtype.Timestamp
+	TimeTo       pgtype.Timestamp
+	Metadata     []byte
+	ResultValue  pgtype.Float8
+	ResultArray  []float64
+	ResultJson   []byte
+	Status       ResultStatus
+	ErrorMessage pgtype.Text
+}
+
+func (q *Queries) ReadResultsForAlgorithmAndMetadata(ctx context.Context, arg ReadResultsForAlgorithmAndMetadataParams) ([]ReadResultsForAlgorithmAndMetadataRow, error) {
+	rows, err := q.db.Query(ctx, readResultsForAlgorithmAndMetadata,
 		arg.TimeFrom,
 		arg.TimeTo,
-		arg.WindowTypeName,
-		arg.WindowTypeVersion,
+		arg.MetadataFilter,
+		arg.AlgorithmName,
+		arg.AlgorithmVersion,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items [][]byte
+	var items []ReadResultsForAlgorithmAndMetadataRow
 	for rows.Next() {
-		var metadata []byte
-		if err := rows.Scan(&metadata); err != nil {
+		var i ReadResultsForAlgorithmAndMetadataRow
+		if err := rows.Scan(
+			&i.TimeFrom,
+			&i.TimeTo,
+			&i.Metadata,
+			&i.ResultValue,
+			&i.ResultArray,
+			&i.ResultJson,
+			&i.Status,
+			&i.ErrorMessage,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, metadata)
+		items = append(items, i)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -709,48 +3743,54 @@ func (q *Queries) ReadDistinctWindowMetadata(ctx context.Context, arg ReadDistin
 	return items, nil
 }
 
-const readFromAlgorithmDependencies = `-- name: ReadFromAlgorithmDependencies :many
-WITH from_algo AS (
-  SELECT a.id, a.window_type_id, a.processor_id FROM algorithm a
-  JOIN processor p ON a.processor_id = p.id
-  WHERE a.name = $1
-  AND a.version = $2
-  AND p.name = $3
-  AND p.runtime = $4
-)
-SELECT ad.id, ad.from_algorithm_id, ad.to_algorithm_id, ad.from_window_type_id, ad.to_window_type_id, ad.from_processor_id, ad.to_processor_id, ad.created FROM algorithm_dependency ad WHERE ad.from_algorithm_id = from_algo.id
+const readResultsForWindow = `-- name: ReadResultsForWindow :many
+SELECT
+  r.algorithm_id,
+  a.name,
+  a.version,
+  a.result_type,
+  r.result_value,
+  r.result_array,
+  r.result_json,
+  r.status,
+  r.error_message
+FROM results r
+JOIN algorithm a ON r.algorithm_id = a.id
+WHERE r.windows_id = $1
+ORDER BY r.id
 `
 
-type ReadFromAlgorithmDependenciesParams struct {
-	FromAlgorithmName    string
-	FromAlgorithmVersion string
-	FromProcessorName    string
-	FromProcessorRuntime string
+type ReadResultsForWindowRow struct {
+	AlgorithmID  pgtype.Int8
+	Name         string
+	Version      string
+	ResultType   ResultType
+	ResultValue  pgtype.Float8
+	ResultArray  []float64
+	ResultJson   []byte
+	Status       ResultStatus
+	ErrorMessage pgtype.Text
 }
 
-func (q *Queries) ReadFromAlgorithmDependencies(ctx context.Context, arg ReadFromAlgorithmDependenciesParams) ([]AlgorithmDependency, error) {
-	rows, err := q.db.Query(ctx, readFromAlgorithmDependencies,
-		arg.FromAlgorithmName,
-		arg.FromAlgorithmVersion,
-		arg.FromProcessorName,
-		arg.FromProcessorRuntime,
-	)
+func (q *Queries) ReadResultsForWindow(ctx context.Context, windowsID pgtype.Int8) ([]ReadResultsForWindowRow, error) {
+	rows, err := q.db.Query(ctx, readResultsForWindow, windowsID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AlgorithmDependency
+	var items []ReadResultsForWindowRow
 	for rows.Next() {
-		var i AlgorithmDependency
+		var i ReadResultsForWindowRow
 		if err := rows.Scan(
-			&i.ID,
-			&i.FromAlgorithmID,
-			&i.ToAlgorithmID,
-			&i.FromWindowTypeID,
-			&i.ToWindowTypeID,
-			&i.FromProcessorID,
-			&i.ToProcessorID,
-			&i.Created,
+			&i.AlgorithmID,
+			&i.Name,
+			&i.Version,
+			&i.ResultType,
+			&i.ResultValue,
+			&i.ResultArray,
+			&i.ResultJson,
+			&i.Status,
+			&i.ErrorMessage,
 		); err != nil {
 			return nil, err
 		}
@@ -762,38 +3802,70 @@ func (q *Queries) ReadFromAlgorithmDependencies(ctx context.Context, arg ReadFro
 	return items, nil
 }
 
-const readMetadataFieldsByWindowType = `-- name: ReadMetadataFieldsByWindowType :many
-SELECT 
-    metadata_field_id,
-    metadata_field_name,
-    metadata_field_description
-FROM window_type_metadata_fields
-WHERE window_type_name = $1
-  AND window_type_version = $2
-ORDER BY metadata_field_name
+const readResultsStats = `-- name: ReadResultsStats :one
+SELECT
+  COUNT(r.id)
+FROM results r
 `
 
-type ReadMetadataFieldsByWindowTypeParams struct {
+func (q *Queries) ReadResultsStats(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, readResultsStats)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const readRollupChildWindows = `-- name: ReadRollupChildWindows :many
+SELECT
+  w.id,
+  w.time_from,
+  w.time_to
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE wt.name = $1
+AND wt.version = $2
+AND w.time_from >= $3
+AND w.time_to <= $4
+AND w.metadata @> $5::JSONB
+AND w.state = 'closed'
+AND NOT w.superseded
+ORDER BY w.time_from, w.time_to
+`
+
+type ReadRollupChildWindowsParams struct {
 	WindowTypeName    string
 	WindowTypeVersion string
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	Metadata          []byte
 }
 
-type ReadMetadataFieldsByWindowTypeRow struct {
-	MetadataFieldID          int64
-	MetadataFieldName        string
-	MetadataFieldDescription string
+type ReadRollupChildWindowsRow struct {
+	ID       int64
+	TimeFrom pgtype.Timestamp
+	TimeTo   pgtype.Timestamp
 }
 
-func (q *Queries) ReadMetadataFieldsByWindowType(ctx context.Context, arg ReadMetadataFieldsByWindowTypeParams) ([]ReadMetadataFieldsByWindowTypeRow, error) {
-	rows, err := q.db.Query(ctx, readMetadataFieldsByWindowType, arg.WindowTypeName, arg.WindowTypeVersion)
+func (q *Queries) ReadRollupChildWindows(ctx context.Context, arg ReadRollupChildWindowsParams) ([]ReadRollupChildWindowsRow, error) {
+	rows, err := q.db.Query(ctx, readRollupChildWindows,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Metadata,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadMetadataFieldsByWindowTypeRow
+	var items []ReadRollupChildWindowsRow
 	for rows.Next() {
-		var i ReadMetadataFieldsByWindowTypeRow
-		if err := rows.Scan(&i.MetadataFieldID, &i.MetadataFieldName, &i.MetadataFieldDescription); err != nil {
+		var i ReadRollupChildWindowsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TimeFrom,
+			&i.TimeTo,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -804,37 +3876,54 @@ func (q *Queries) ReadMetadataFieldsByWindowType(ctx context.Context, arg ReadMe
 	return items, nil
 }
 
-const readProcessors = `-- name: ReadProcessors :many
+const readRollupRules = `-- name: ReadRollupRules :many
 SELECT
-  id,
-  name, 
-  runtime, 
-  created
-FROM processor
-ORDER BY created DESC
+  rr.name,
+  rr.cron,
+  rr.timezone,
+  rr.metadata_keys,
+  rr.origin,
+  child.name AS child_window_type_name,
+  child.version AS child_window_type_version,
+  parent.name AS parent_window_type_name,
+  parent.version AS parent_window_type_version
+FROM rollup_rule rr
+JOIN window_type child ON rr.child_window_type_id = child.id
+JOIN window_type parent ON rr.parent_window_type_id = parent.id
+ORDER BY rr.name
 `
 
-type ReadProcessorsRow struct {
-	ID      int64
-	Name    string
-	Runtime string
-	Created pgtype.Timestamp
+type ReadRollupRulesRow struct {
+	Name                    string
+	Cron                    string
+	Timezone                string
+	MetadataKeys            []string
+	Origin                  string
+	ChildWindowTypeName     string
+	ChildWindowTypeVersion  string
+	ParentWindowTypeName    string
+	ParentWindowTypeVersion string
 }
 
-func (q *Queries) ReadProcessors(ctx context.Context) ([]ReadProcessorsRow, error) {
-	rows, err := q.db.Query(ctx, readProcessors)
+func (q *Queries) ReadRollupRules(ctx context.Context) ([]ReadRollupRulesRow, error) {
+	rows, err := q.db.Query(ctx, readRollupRules)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadProcessorsRow
+	var items []ReadRollupRulesRow
 	for rows.Next() {
-		var i ReadProcessorsRow
+		var i ReadRollupRulesRow
 		if err := rows.Scan(
-			&i.ID,
 			&i.Name,
-			&i.Runtime,
-			&i.Created,
+			&i.Cron,
+			&i.Timezone,
+			&i.MetadataKeys,
+			&i.Origin,
+			&i.ChildWindowTypeName,
+			&i.ChildWindowTypeVersion,
+			&i.ParentWindowTypeName,
+			&i.ParentWindowTypeVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -846,33 +3935,55 @@ func (q *Queries) ReadProcessors(ctx context.Context) ([]ReadProcessorsRow, erro
 	return items, nil
 }
 
-const readProcessorsByIDs = `-- name: ReadProcessorsByIDs :many
-SELECT 
-  id,
-  name,
-  runtime,
-  connection_string,
-  created
-FROM processor
-WHERE id = ANY($1::bigint[])
-ORDER BY name, runtime
+const readRollupRulesForWindowType = `-- name: ReadRollupRulesForWindowType :many
+SELECT
+  rr.name,
+  rr.cron,
+  rr.timezone,
+  rr.metadata_keys,
+  rr.origin,
+  parent.name AS parent_window_type_name,
+  parent.version AS parent_window_type_version
+FROM rollup_rule rr
+JOIN window_type child ON rr.child_window_type_id = child.id
+JOIN window_type parent ON rr.parent_window_type_id = parent.id
+WHERE child.name = $1
+AND child.version = $2
+ORDER BY rr.name
 `
 
-func (q *Queries) ReadProcessorsByIDs(ctx context.Context, processorIds []int64) ([]Processor, error) {
-	rows, err := q.db.Query(ctx, readProcessorsByIDs, processorIds)
+type ReadRollupRulesForWindowTypeParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+type ReadRollupRulesForWindowTypeRow struct {
+	Name                    string
+	Cron                    string
+	Timezone                string
+	MetadataKeys            []string
+	Origin                  string
+	ParentWindowTypeName    string
+	ParentWindowTypeVersion string
+}
+
+func (q *Queries) ReadRollupRulesForWindowType(ctx context.Context, arg ReadRollupRulesForWindowTypeParams) ([]ReadRollupRulesForWindowTypeRow, error) {
+	rows, err := q.db.Query(ctx, readRollupRulesForWindowType, arg.WindowTypeName, arg.WindowTypeVersion)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Processor
+	var items []ReadRollupRulesForWindowTypeRow
 	for rows.Next() {
-		var i Processor
+		var i ReadRollupRulesForWindowTypeRow
 		if err := rows.Scan(
-			&i.ID,
 			&i.Name,
-			&i.Runtime,
-			&i.ConnectionString,
-			&i.Created,
+			&i.Cron,
+			&i.Timezone,
+			&i.MetadataKeys,
+			&i.Origin,
+			&i.ParentWindowTypeName,
+			&i.ParentWindowTypeVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -884,62 +3995,26 @@ func (q *Queries) ReadProcessorsByIDs(ctx context.Context, processorIds []int64)
 	return items, nil
 }
 
-const readResultsForAlgorithm = `-- name: ReadResultsForAlgorithm :many
-select
-  w.time_from,
-  w.time_to,
-  r.result_value,
-  r.result_array,
-  r.result_json
-from results r
-join algorithm a on r.algorithm_id = a.id
-join windows w on r.windows_id = w.id
-where
-	w.time_from  >= $1 and w.time_to <= $2
-	and a."name" = $3
-	and a."version" = $4
-ORDER BY w.time_from, w.time_to ASC
+const readUnfinishedWindowExecutions = `-- name: ReadUnfinishedWindowExecutions :many
+SELECT exec_id FROM execution_plan
+WHERE windows_id = $1
+AND status IN ('pending', 'running')
+ORDER BY id
 `
 
-type ReadResultsForAlgorithmParams struct {
-	TimeFrom         pgtype.Timestamp
-	TimeTo           pgtype.Timestamp
-	AlgorithmName    string
-	AlgorithmVersion string
-}
-
-type ReadResultsForAlgorithmRow struct {
-	TimeFrom    pgtype.Timestamp
-	TimeTo      pgtype.Timestamp
-	ResultValue pgtype.Float8
-	ResultArray []float64
-	ResultJson  []byte
-}
-
-func (q *Queries) ReadResultsForAlgorithm(ctx context.Context, arg ReadResultsForAlgorithmParams) ([]ReadResultsForAlgorithmRow, error) {
-	rows, err := q.db.Query(ctx, readResultsForAlgorithm,
-		arg.TimeFrom,
-		arg.TimeTo,
-		arg.AlgorithmName,
-		arg.AlgorithmVersion,
-	)
+func (q *Queries) ReadUnfinishedWindowExecutions(ctx context.Context, windowsID int64) ([]string, error) {
+	rows, err := q.db.Query(ctx, readUnfinishedWindowExecutions, windowsID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadResultsForAlgorithmRow
+	var items []string
 	for rows.Next() {
-		var i ReadResultsForAlgorithmRow
-		if err := rows.Scan(
-			&i.TimeFrom,
-			&i.TimeTo,
-			&i.ResultValue,
-			&i.ResultArray,
-			&i.ResultJson,
-		); err != nil {
+		var exec_id string
+		if err := rows.Scan(&exec_id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, exec_id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -947,66 +4022,194 @@ func (q *Queries) ReadResultsForAlgorithm(ctx context.Context, arg ReadResultsFo
 	return items, nil
 }
 
-const readResultsForAlgorithmAndMetadata = `-- name: ReadResultsForAlgorithmAndMetadata :many
-WITH algorithmId AS (
-  SELECT id FROM algorithm WHERE name = $4 AND version = $5
-)
-SELECT
-  w.time_from,
-  w.time_to,
-  w.metadata,
-  r.result_value,
-  r.result_array,
-  r.result_json
-FROM results r
-JOIN windows w ON r.windows_id  = w.id
-WHERE
-  w.time_from >= $1 AND
-  w.time_to <= $2 AND
-  w.metadata::jsonb @> $3::jsonb AND
-  r.algorithm_id = (SELECT id FROM algorithmId)
-ORDER BY w.time_from, w.time_to ASC
+const readWindowExecId = `-- name: ReadWindowExecId :one
+SELECT exec_id FROM execution_plan
+WHERE windows_id = $1
+AND reprocess_id IS NULL
+AND NOT provisional
+ORDER BY id
+LIMIT 1
+`
+
+func (q *Queries) ReadWindowExecId(ctx context.Context, windowsID int64) (string, error) {
+	row := q.db.QueryRow(ctx, readWindowExecId, windowsID)
+	var exec_id string
+	err := row.Scan(&exec_id)
+	return exec_id, err
+}
+
+const readWindowForAmendment = `-- name: ReadWindowForAmendment :one
+SELECT
+  w.id,
+  w.window_type_id,
+  w.idempotency_key,
+  w.state
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE wt.name = $1
+AND wt.version = $2
+AND NOT w.superseded
+AND CASE
+  WHEN $3::TEXT IS NOT NULL
+  THEN w.idempotency_key = $3::TEXT
+  ELSE w.time_from = $4
+    AND w.time_to = $5
+    AND w.origin = $6
+    AND w.metadata = $7::JSONB
+END
+ORDER BY w.id
+LIMIT 1
+FOR UPDATE OF w
+`
+
+type ReadWindowForAmendmentParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+	IdempotencyKey    pgtype.Text
+	TimeFrom          pgtype.Timestamp
+	TimeTo            pgtype.Timestamp
+	Origin            string
+	Metadata          []byte
+}
+
+type ReadWindowForAmendmentRow struct {
+	ID             int64
+	WindowTypeID   int64
+	IdempotencyKey pgtype.Text
+	State          WindowState
+}
+
+func (q *Queries) ReadWindowForAmendment(ctx context.Context, arg ReadWindowForAmendmentParams) (ReadWindowForAmendmentRow, error) {
+	row := q.db.QueryRow(ctx, readWindowForAmendment,
+		arg.WindowTypeName,
+		arg.WindowTypeVersion,
+		arg.IdempotencyKey,
+		arg.TimeFrom,
+		arg.TimeTo,
+		arg.Origin,
+		arg.Metadata,
+	)
+	var i ReadWindowForAmendmentRow
+	err := row.Scan(
+		&i.ID,
+		&i.WindowTypeID,
+		&i.IdempotencyKey,
+		&i.State,
+	)
+	return i, err
+}
+
+const readWindowForUpdate = `-- name: ReadWindowForUpdate :one
+SELECT
+  w.id,
+  w.window_type_id,
+  w.time_from,
+  w.time_to,
+  w.origin,
+  w.metadata,
+  w.state,
+  w.provisional
+FROM windows w
+JOIN window_type wt ON w.window_type_id = wt.id
+WHERE wt.name = $1
+AND wt.version = $2
+AND w.idempotency_key = $3::TEXT
+FOR NO KEY UPDATE OF w
+`
+
+type ReadWindowForUpdateParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+	IdempotencyKey    string
+}
+
+type ReadWindowForUpdateRow struct {
+	ID           int64
+	WindowTypeID int64
+	TimeFrom     pgtype.Timestamp
+	TimeTo       pgtype.Timestamp
+	Origin       string
+	Metadata     []byte
+	State        WindowState
+	Provisional  bool
+}
+
+func (q *Queries) ReadWindowForUpdate(ctx context.Context, arg ReadWindowForUpdateParams) (ReadWindowForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, readWindowForUpdate, arg.WindowTypeName, arg.WindowTypeVersion, arg.IdempotencyKey)
+	var i ReadWindowForUpdateRow
+	err := row.Scan(
+		&i.ID,
+		&i.WindowTypeID,
+		&i.TimeFrom,
+		&i.TimeTo,
+		&i.Origin,
+		&i.Metadata,
+		&i.State,
+		&i.Provisional,
+	)
+	return i, err
+}
+
+const readWindowScheduleEmittedUntil = `-- name: ReadWindowScheduleEmittedUntil :one
+SELECT emitted_until FROM window_schedule
+WHERE name = $1
+FOR UPDATE
 `
 
-type ReadResultsForAlgorithmAndMetadataParams struct {
-	TimeFrom         pgtype.Timestamp
-	TimeTo           pgtype.Timestamp
-	MetadataFilter   []byte
-	AlgorithmName    string
-	AlgorithmVersion string
+func (q *Queries) ReadWindowScheduleEmittedUntil(ctx context.Context, name string) (pgtype.Timestamp, error) {
+	row := q.db.QueryRow(ctx, readWindowScheduleEmittedUntil, name)
+	var emitted_until pgtype.Timestamp
+	err := row.Scan(&emitted_until)
+	return emitted_until, err
 }
 
-type ReadResultsForAlgorithmAndMetadataRow struct {
-	TimeFrom    pgtype.Timestamp
-	TimeTo      pgtype.Timestamp
-	Metadata    []byte
-	ResultValue pgtype.Float8
-	ResultArray []float64
-	ResultJson  []byte
+const readWindowSchedules = `-- name: ReadWindowSchedules :many
+SELECT
+  ws.name,
+  ws.cron,
+  ws.timezone,
+  ws.origin,
+  ws.metadata,
+  ws.emitted_until,
+  ws.next_emission,
+  wt.name AS window_type_name,
+  wt.version AS window_type_version
+FROM window_schedule ws
+JOIN window_type wt ON ws.window_type_id = wt.id
+ORDER BY ws.name
+`
+
+type ReadWindowSchedulesRow struct {
+	Name              string
+	Cron              string
+	Timezone          string
+	Origin            string
+	Metadata          []byte
+	EmittedUntil      pgtype.Timestamp
+	NextEmission      pgtype.Timestamp
+	WindowTypeName    string
+	WindowTypeVersion string
 }
 
-func (q *Queries) ReadResultsForAlgorithmAndMetadata(ctx context.Context, arg ReadResultsForAlgorithmAndMetadataParams) ([]ReadResultsForAlgorithmAndMetadataRow, error) {
-	rows, err := q.db.Query(ctx, readResultsForAlgorithmAndMetadata,
-		arg.TimeFrom,
-		arg.TimeTo,
-		arg.MetadataFilter,
-		arg.AlgorithmName,
-		arg.AlgorithmVersion,
-	)
+func (q *Queries) ReadWindowSchedules(ctx context.Context) ([]ReadWindowSchedulesRow, error) {
+	rows, err := q.db.Query(ctx, readWindowSchedules)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ReadResultsForAlgorithmAndMetadataRow
+	var items []ReadWindowSchedulesRow
 	for rows.Next() {
-		var i ReadResultsForAlgorithmAndMetadataRow
+		var i ReadWindowSchedulesRow
 		if err := rows.Scan(
-			&i.TimeFrom,
-			&i.TimeTo,
+			&i.Name,
+			&i.Cron,
+			&i.Timezone,
+			&i.Origin,
 			&i.Metadata,
-			&i.ResultValue,
-			&i.ResultArray,
-			&i.ResultJson,
+			&i.EmittedUntil,
+			&i.NextEmission,
+			&i.WindowTypeName,
+			&i.WindowTypeVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -1018,17 +4221,22 @@ func (q *Queries) ReadResultsForAlgorithmAndMetadata(ctx context.Context, arg Re
 	return items, nil
 }
 
-const readResultsStats = `-- name: ReadResultsStats :one
-SELECT
-  COUNT(r.id)
-FROM results r
+const readWindowTypeId = `-- name: ReadWindowTypeId :one
+SELECT id FROM window_type
+WHERE name = $1
+AND version = $2
 `
 
-func (q *Queries) ReadResultsStats(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, readResultsStats)
-	var count int64
-	err := row.Scan(&count)
-	return count, err
+type ReadWindowTypeIdParams struct {
+	WindowTypeName    string
+	WindowTypeVersion string
+}
+
+func (q *Queries) ReadWindowTypeId(ctx context.Context, arg ReadWindowTypeIdParams) (int64, error) {
+	row := q.db.QueryRow(ctx, readWindowTypeId, arg.WindowTypeName, arg.WindowTypeVersion)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
 }
 
 const readWindowTypes = `-- name: ReadWindowTypes :many
@@ -1090,6 +4298,7 @@ join window_type wt on w.window_type_id =wt.id
 where
 	wt."name" = $1 and wt."version" = $2
 	and w.time_from  >= $3 and w.time_to <= $4
+	and ($5::BOOLEAN or not w.superseded)
 ORDER BY w.time_from, w.time_to ASC
 `
 
@@ -1098,6 +4307,7 @@ type ReadWindowsParams struct {
 	WindowTypeVersion string
 	TimeFrom          pgtype.Timestamp
 	TimeTo            pgtype.Timestamp
+	IncludeSuperseded bool
 }
 
 type ReadWindowsRow struct {
@@ -1115,6 +4325,7 @@ func (q *Queries) ReadWindows(ctx context.Context, arg ReadWindowsParams) ([]Rea
 		arg.WindowTypeVersion,
 		arg.TimeFrom,
 		arg.TimeTo,
+		arg.IncludeSuperseded,
 	)
 	if err != nil {
 		return nil, err
@@ -1155,6 +4366,7 @@ where
 	wt."name" = $1 and wt."version" = $2
 	and w.time_from  >= $3 and w.time_to <= $4
 	and w.metadata::jsonb @> $5::jsonb
+	and not w.superseded
 ORDER BY w.time_from, w.time_to ASC
 `
 
@@ -1208,24 +4420,66 @@ func (q *Queries) ReadWindowsForMetadata(ctx context.Context, arg ReadWindowsFor
 	return items, nil
 }
 
+const readWindowsToReprocess = `-- name: ReadWindowsToReprocess :many
+SELECT w.id FROM windows w
+JOIN reprocess r ON w.window_type_id = r.window_type_id
+WHERE r.id = $1
+AND w.time_from >= r.time_from
+AND w.time_to <= r.time_to
+AND COALESCE(w.metadata, '{}') @> r.metadata
+AND w.created <= r.created
+AND w.id > r.last_window_id
+AND w.state = 'closed'
+AND NOT w.superseded
+ORDER BY w.id
+LIMIT $2
+`
+
+type ReadWindowsToReprocessParams struct {
+	ReprocessID int64
+	Limit       int32
+}
+
+func (q *Queries) ReadWindowsToReprocess(ctx context.Context, arg ReadWindowsToReprocessParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, readWindowsToReprocess, arg.ReprocessID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const registerWindow = `-- name: RegisterWindow :one
 WITH window_type_id AS (
   SELECT id FROM window_type 
-  WHERE name = $5 
-  AND version = $6
+  WHERE name = $6 
+  AND version = $7
 )
 INSERT INTO windows (
   window_type_id,
   time_from, 
   time_to,
   origin, 
-  metadata
+  metadata,
+  idempotency_key
 ) VALUES (
   (SELECT id FROM window_type_id),
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5
 ) RETURNING window_type_id, id
 `
 
@@ -1234,6 +4488,7 @@ type RegisterWindowParams struct {
 	TimeTo            pgtype.Timestamp
 	Origin            string
 	Metadata          []byte
+	IdempotencyKey    pgtype.Text
 	WindowTypeName    string
 	WindowTypeVersion string
 }
@@ -1249,6 +4504,7 @@ func (q *Queries) RegisterWindow(ctx context.Context, arg RegisterWindowParams)
 		arg.TimeTo,
 		arg.Origin,
 		arg.Metadata,
+		arg.IdempotencyKey,
 		arg.WindowTypeName,
 		arg.WindowTypeVersion,
 	)
@@ -1256,3 +4512,461 @@ func (q *Queries) RegisterWindow(ctx context.Context, arg RegisterWindowParams)
 	err := row.Scan(&i.WindowTypeID, &i.ID)
 	return i, err
 }
+
+const renewExecutionLeases = `-- name: RenewExecutionLeases :exec
+UPDATE execution_plan
+SET lease_expires = CURRENT_TIMESTAMP + $1::BIGINT * INTERVAL '1 millisecond'
+WHERE owner = $2::TEXT
+AND status IN ('pending', 'running')
+`
+
+type RenewExecutionLeasesParams struct {
+	LeaseMs int64
+	Owner   string
+}
+
+func (q *Queries) RenewExecutionLeases(ctx context.Context, arg RenewExecutionLeasesParams) error {
+	_, err := q.db.Exec(ctx, renewExecutionLeases, arg.LeaseMs, arg.Owner)
+	return err
+}
+
+const renewReprocessLeases = `-- name: RenewReprocessLeases :exec
+UPDATE reprocess
+SET lease_expires = CURRENT_TIMESTAMP + $1::BIGINT * INTERVAL '1 millisecond'
+WHERE owner = $2::TEXT
+AND status IN ('pending', 'running')
+AND triggered IS NULL
+`
+
+type RenewReprocessLeasesParams struct {
+	LeaseMs int64
+	Owner   string
+}
+
+func (q *Queries) RenewReprocessLeases(ctx context.Context, arg RenewReprocessLeasesParams) error {
+	_, err := q.db.Exec(ctx, renewReprocessLeases, arg.LeaseMs, arg.Owner)
+	return err
+}
+
+const reopenReprocess = `-- name: ReopenReprocess :exec
+UPDATE reprocess r
+SET
+  status = 'running',
+  finished = NULL
+FROM execution_plan ep
+WHERE ep.id = $1
+AND r.id = ep.reprocess_id
+AND r.triggered IS NOT NULL
+`
+
+func (q *Queries) ReopenReprocess(ctx context.Context, executionPlanID int64) error {
+	_, err := q.db.Exec(ctx, reopenReprocess, executionPlanID)
+	return err
+}
+
+const resetFailedExecutionWork = `-- name: ResetFailedExecutionWork :exec
+WITH reset_plan AS (
+  UPDATE execution_plan
+  SET
+    status = 'pending',
+    finished = NULL,
+    updated = CURRENT_TIMESTAMP,
+    owner = $1::TEXT,
+    lease_expires = CURRENT_TIMESTAMP + $2::BIGINT * INTERVAL '1 millisecond'
+  WHERE id = $3
+),
+reset_stages AS (
+  UPDATE execution_stage es
+  SET status = 'pending'
+  WHERE es.execution_plan_id = $3
+  AND es.status != 'succeeded'
+),
+reset_tasks AS (
+  UPDATE execution_task et
+  SET
+    status = 'pending',
+    error_message = NULL,
+    updated = CURRENT_TIMESTAMP
+  FROM execution_stage es
+  WHERE et.execution_stage_id = es.id
+  AND es.execution_plan_id = $3
+  AND et.status != 'succeeded'
+),
+requeued_dead_letters AS (
+  UPDATE dead_letter dl
+  SET requeued = COALESCE(dl.requeued, CURRENT_TIMESTAMP)
+  FROM execution_task et
+  JOIN execution_stage es ON et.execution_stage_id = es.id
+  WHERE dl.execution_task_id = et.id
+  AND es.execution_plan_id = $3
+)
+UPDATE execution_node en
+SET
+  status = 'pending',
+  error_message = NULL,
+  started = NULL,
+  finished = NULL
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE en.execution_task_id = et.id
+AND es.execution_plan_id = $3
+AND en.status != 'succeeded'
+`
+
+type ResetFailedExecutionWorkParams struct {
+	Owner           string
+	LeaseMs         int64
+	ExecutionPlanID int64
+}
+
+func (q *Queries) ResetFailedExecutionWork(ctx context.Context, arg ResetFailedExecutionWorkParams) error {
+	_, err := q.db.Exec(ctx, resetFailedExecutionWork, arg.Owner, arg.LeaseMs, arg.ExecutionPlanID)
+	return err
+}
+
+const resolveAlert = `-- name: ResolveAlert :execrows
+UPDATE alert
+SET
+  status = 'resolved',
+  resolved = CURRENT_TIMESTAMP
+WHERE alert_rule_id = $1
+AND dedupe_key = $2
+AND status = 'firing'
+`
+
+type ResolveAlertParams struct {
+	AlertRuleID int64
+	DedupeKey   string
+}
+
+func (q *Queries) ResolveAlert(ctx context.Context, arg ResolveAlertParams) (int64, error) {
+	result, err := q.db.Exec(ctx, resolveAlert, arg.AlertRuleID, arg.DedupeKey)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const retireAlgorithms = `-- name: RetireAlgorithms :exec
+UPDATE algorithm
+SET retired = CURRENT_TIMESTAMP
+WHERE id = ANY($1::BIGINT[])
+AND retired IS NULL
+`
+
+func (q *Queries) RetireAlgorithms(ctx context.Context, algorithmIds []int64) error {
+	_, err := q.db.Exec(ctx, retireAlgorithms, algorithmIds)
+	return err
+}
+
+const sequenceResults = `-- name: SequenceResults :execrows
+UPDATE results
+SET seq = nextval('results_seq_seq')
+WHERE id IN (
+  SELECT id FROM results
+  WHERE seq IS NULL
+  ORDER BY id
+  FOR UPDATE SKIP LOCKED
+)
+`
+
+func (q *Queries) SequenceResults(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, sequenceResults)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setExecutionPlanProvisional = `-- name: SetExecutionPlanProvisional :exec
+UPDATE execution_plan
+SET provisional = TRUE
+WHERE id = $1
+`
+
+func (q *Queries) SetExecutionPlanProvisional(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, setExecutionPlanProvisional, id)
+	return err
+}
+
+const setExecutionPlanReprocess = `-- name: SetExecutionPlanReprocess :exec
+UPDATE execution_plan
+SET reprocess_id = $1
+WHERE id = $2
+`
+
+type SetExecutionPlanReprocessParams struct {
+	ReprocessID pgtype.Int8
+	ID          int64
+}
+
+func (q *Queries) SetExecutionPlanReprocess(ctx context.Context, arg SetExecutionPlanReprocessParams) error {
+	_, err := q.db.Exec(ctx, setExecutionPlanReprocess, arg.ReprocessID, arg.ID)
+	return err
+}
+
+const setReprocessTriggered = `-- name: SetReprocessTriggered :exec
+UPDATE reprocess
+SET triggered = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+func (q *Queries) SetReprocessTriggered(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, setReprocessTriggered, id)
+	return err
+}
+
+const skipPendingExecutionWork = `-- name: SkipPendingExecutionWork :exec
+WITH skipped_stages AS (
+  UPDATE execution_stage es
+  SET status = 'skipped'
+  WHERE es.execution_plan_id = $1
+  AND es.status = 'pending'
+),
+skipped_tasks AS (
+  UPDATE execution_task et
+  SET
+    status = 'skipped',
+    updated = CURRENT_TIMESTAMP
+  FROM execution_stage es
+  WHERE et.execution_stage_id = es.id
+  AND es.execution_plan_id = $1
+  AND et.status = 'pending'
+)
+UPDATE execution_node en
+SET status = 'skipped'
+FROM execution_task et
+JOIN execution_stage es ON et.execution_stage_id = es.id
+WHERE en.execution_task_id = et.id
+AND es.execution_plan_id = $1
+AND en.status = 'pending'
+`
+
+func (q *Queries) SkipPendingExecutionWork(ctx context.Context, executionPlanID int64) error {
+	_, err := q.db.Exec(ctx, skipPendingExecutionWork, executionPlanID)
+	return err
+}
+
+const supersedeResults = `-- name: SupersedeResults :exec
+UPDATE results
+SET superseded = TRUE
+WHERE windows_id = $1
+`
+
+func (q *Queries) SupersedeResults(ctx context.Context, windowsID int64) error {
+	_, err := q.db.Exec(ctx, supersedeResults, windowsID)
+	return err
+}
+
+const supersedeWindow = `-- name: SupersedeWindow :exec
+UPDATE windows
+SET
+  superseded = TRUE,
+  idempotency_key = NULL
+WHERE id = $1
+`
+
+func (q *Queries) SupersedeWindow(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, supersedeWindow, id)
+	return err
+}
+
+const updateExecutionNodeStatus = `-- name: UpdateExecutionNodeStatus :exec
+UPDATE execution_node
+SET
+  status = $1,
+  error_message = $2,
+  started = CASE WHEN $1 = 'running' THEN COALESCE(started, CURRENT_TIMESTAMP) ELSE started END,
+  finished = CASE WHEN $1 IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END
+WHERE execution_task_id = $3
+AND algorithm_id = $4
+AND status <> 'cancelled'
+`
+
+type UpdateExecutionNodeStatusParams struct {
+	Status          ExecutionStatus
+	ErrorMessage    pgtype.Text
+	ExecutionTaskID int64
+	AlgorithmID     int64
+}
+
+func (q *Queries) UpdateExecutionNodeStatus(ctx context.Context, arg UpdateExecutionNodeStatusParams) error {
+	_, err := q.db.Exec(ctx, updateExecutionNodeStatus,
+		arg.Status,
+		arg.ErrorMessage,
+		arg.ExecutionTaskID,
+		arg.AlgorithmID,
+	)
+	return err
+}
+
+const updateExecutionPlanStatus = `-- name: UpdateExecutionPlanStatus :one
+UPDATE execution_plan
+SET
+  status = $1,
+  started = CASE WHEN $1 = 'running' THEN COALESCE(started, CURRENT_TIMESTAMP) ELSE started END,
+  finished = CASE WHEN $1 IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END,
+  updated = CURRENT_TIMESTAMP
+WHERE id = $2
+AND status NOT IN ('succeeded', 'failed', 'cancelled')
+RETURNING reprocess_id
+`
+
+type UpdateExecutionPlanStatusParams struct {
+	Status ExecutionStatus
+	ID     int64
+}
+
+func (q *Queries) UpdateExecutionPlanStatus(ctx context.Context, arg UpdateExecutionPlanStatusParams) (pgtype.Int8, error) {
+	row := q.db.QueryRow(ctx, updateExecutionPlanStatus, arg.Status, arg.ID)
+	var reprocess_id pgtype.Int8
+	err := row.Scan(&reprocess_id)
+	return reprocess_id, err
+}
+
+const updateExecutionStageStatus = `-- name: UpdateExecutionStageStatus :exec
+UPDATE execution_stage
+SET
+  status = $1
+WHERE id = $2
+AND status <> 'cancelled'
+`
+
+type UpdateExecutionStageStatusParams struct {
+	Status ExecutionStatus
+	ID     int64
+}
+
+func (q *Queries) UpdateExecutionStageStatus(ctx context.Context, arg UpdateExecutionStageStatusParams) error {
+	_, err := q.db.Exec(ctx, updateExecutionStageStatus, arg.Status, arg.ID)
+	return err
+}
+
+const updateExecutionTaskStatus = `-- name: UpdateExecutionTaskStatus :exec
+UPDATE execution_task
+SET
+  status = $1,
+  error_message = $2,
+  updated = CURRENT_TIMESTAMP
+WHERE id = $3
+AND status <> 'cancelled'
+`
+
+type UpdateExecutionTaskStatusParams struct {
+	Status       ExecutionStatus
+	ErrorMessage pgtype.Text
+	ID           int64
+}
+
+func (q *Queries) UpdateExecutionTaskStatus(ctx context.Context, arg UpdateExecutionTaskStatusParams) error {
+	_, err := q.db.Exec(ctx, updateExecutionTaskStatus, arg.Status, arg.ErrorMessage, arg.ID)
+	return err
+}
+
+const updateProcessorInstanceHealth = `-- name: UpdateProcessorInstanceHealth :exec
+UPDATE processor_instance
+SET
+  last_checked = CURRENT_TIMESTAMP,
+  last_seen = CASE WHEN $1::BOOLEAN THEN CURRENT_TIMESTAMP ELSE last_seen END,
+  health_status = $2,
+  health_message = $3,
+  active_tasks = COALESCE($4, active_tasks),
+  memory_bytes = COALESCE($5, memory_bytes),
+  cpu_percent = COALESCE($6, cpu_percent),
+  uptime_seconds = COALESCE($7, uptime_seconds),
+  available = $1::BOOLEAN
+    OR COALESCE(last_seen, registered) > CURRENT_TIMESTAMP - make_interval(secs => $8::DOUBLE PRECISION)
+WHERE id = $9
+`
+
+type UpdateProcessorInstanceHealthParams struct {
+	Seen                    bool
+	HealthStatus            string
+	HealthMessage           pgtype.Text
+	ActiveTasks             pgtype.Int4
+	MemoryBytes             pgtype.Int8
+	CpuPercent              pgtype.Float4
+	UptimeSeconds           pgtype.Int8
+	UnavailableAfterSeconds float64
+	ID                      int64
+}
+
+func (q *Queries) UpdateProcessorInstanceHealth(ctx context.Context, arg UpdateProcessorInstanceHealthParams) error {
+	_, err := q.db.Exec(ctx, updateProcessorInstanceHealth,
+		arg.Seen,
+		arg.HealthStatus,
+		arg.HealthMessage,
+		arg.ActiveTasks,
+		arg.MemoryBytes,
+		arg.CpuPercent,
+		arg.UptimeSeconds,
+		arg.UnavailableAfterSeconds,
+		arg.ID,
+	)
+	return err
+}
+
+const updateReprocessProgress = `-- name: UpdateReprocessProgress :execrows
+UPDATE reprocess
+SET
+  last_window_id = $1,
+  triggered_windows = triggered_windows + 1
+WHERE id = $2
+AND owner = $3::TEXT
+`
+
+type UpdateReprocessProgressParams struct {
+	LastWindowID int64
+	ID           int64
+	Owner        string
+}
+
+func (q *Queries) UpdateReprocessProgress(ctx context.Context, arg UpdateReprocessProgressParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateReprocessProgress, arg.LastWindowID, arg.ID, arg.Owner)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateReprocessStatus = `-- name: UpdateReprocessStatus :exec
+UPDATE reprocess
+SET
+  status = $1,
+  error_message = $2,
+  finished = CASE WHEN $1 IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END
+WHERE id = $3
+`
+
+type UpdateReprocessStatusParams struct {
+	Status       ExecutionStatus
+	ErrorMessage pgtype.Text
+	ID           int64
+}
+
+func (q *Queries) UpdateReprocessStatus(ctx context.Context, arg UpdateReprocessStatusParams) error {
+	_, err := q.db.Exec(ctx, updateReprocessStatus, arg.Status, arg.ErrorMessage, arg.ID)
+	return err
+}
+
+const updateUnfinishedExecutionNodesStatus = `-- name: UpdateUnfinishedExecutionNodesStatus :exec
+UPDATE execution_node
+SET
+  status = $1,
+  error_message = $2,
+  started = CASE WHEN $1 = 'running' THEN COALESCE(started, CURRENT_TIMESTAMP) ELSE started END,
+  finished = CASE WHEN $1 IN ('succeeded', 'failed', 'cancelled') THEN CURRENT_TIMESTAMP END
+WHERE execution_task_id = $3
+AND status IN ('pending', 'running')
+`
+
+type UpdateUnfinishedExecutionNodesStatusParams struct {
+	Status          ExecutionStatus
+	ErrorMessage    pgtype.Text
+	ExecutionTaskID int64
+}
+
+func (q *Queries) UpdateUnfinishedExecutionNodesStatus(ctx context.Context, arg UpdateUnfinishedExecutionNodesStatusParams) error {
+	_, err := q.db.Exec(ctx, updateUnfinishedExecutionNodesStatus, arg.Status, arg.ErrorMessage, arg.ExecutionTaskID)
+	return err
+}
@@ -0,0 +1,216 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/orc-analytics/orca/core/internal/dag"
+)
+
+// reprocessBatchSize is the number of stored windows read at a time by a
+// reprocess
+const reprocessBatchSize = 100
+
+// errReprocessTakenOver is returned when another instance of orca-core has
+// taken over a reprocess whose lease expired
+var errReprocessTakenOver = errors.New("reprocess taken over by another instance")
+
+// runReprocess triggers the execution of each window of a reprocess, at no
+// more than its rate. The last window triggered is recorded along with its
+// execution plan, so that an interrupted reprocess resumes after it. The
+// reprocess finishes once the executions it triggered have
+func (d *Datalayer) runReprocess(reprocessId int64) {
+	ctx := context.Background()
+
+	// the reprocess may be being run by another instance of orca-core
+	claimed, err := d.queries.ClaimReprocess(ctx, ClaimReprocessParams{
+		Owner:   d.leases.owner,
+		LeaseMs: d.leases.duration.Milliseconds(),
+		ID:      reprocessId,
+	})
+	if err != nil {
+		slog.Error("could not claim reprocess", "reprocess_id", reprocessId, "error", err)
+		return
+	}
+	if claimed == 0 {
+		slog.Info("reprocess is leased to another instance", "reprocess_id", reprocessId)
+		return
+	}
+
+	reprocess, err := d.queries.ReadReprocessByID(ctx, reprocessId)
+	if err != nil {
+		slog.Error("could not read reprocess", "reprocess_id", reprocessId, "error", err)
+		return
+	}
+	d.setReprocessStatus(ctx, reprocessId, ExecutionStatusRunning, nil)
+
+	// every window is of the same type, so shares the same plan. Algorithms
+	// that were not selected are left out, and never triggered
+	executionPlan, err := d.buildExecutionPlan(ctx, d.queries, reprocess.WindowTypeID, nil)
+	if err != nil {
+		d.setReprocessStatus(ctx, reprocessId, ExecutionStatusFailed, err)
+		return
+	}
+	executionPlan = dag.FilterPlan(executionPlan, reprocess.AlgorithmIds)
+	if len(executionPlan.Stages) == 0 {
+		slog.Info("no algorithms to reprocess", "reprocess_id", reprocess.ReprocessID)
+		d.setReprocessStatus(ctx, reprocessId, ExecutionStatusSucceeded, nil)
+		return
+	}
+
+	interval := time.Duration(float64(time.Second) / reprocess.WindowsPerSecond)
+	limiter := time.NewTicker(max(interval, time.Millisecond))
+	defer limiter.Stop()
+
+	for {
+		// windows emitted after the reprocess started are left out, as they
+		// have already been triggered by being emitted
+		windowIds, err := d.queries.ReadWindowsToReprocess(ctx, ReadWindowsToReprocessParams{
+			ReprocessID: reprocessId,
+			Limit:       reprocessBatchSize,
+		})
+		if err != nil {
+			d.setReprocessStatus(
+				ctx,
+				reprocessId,
+				ExecutionStatusFailed,
+				fmt.Errorf("could not read windows to reprocess: %v", err),
+			)
+			return
+		}
+		if len(windowIds) == 0 {
+			break
+		}
+
+		for _, windowId := range windowIds {
+			// only idle workers are used, so that a reprocess never fills up
+			// the queue and causes emitted windows to be rejected
+			for {
+				<-limiter.C
+				if d.pool.reserveIdle() {
+					break
+				}
+			}
+
+			executionPlanId, err := d.createReprocessExecutionPlan(ctx, reprocessId, executionPlan, windowId)
+			if errors.Is(err, errReprocessTakenOver) {
+				d.pool.release()
+				slog.Info("reprocess taken over by another instance", "reprocess_id", reprocess.ReprocessID)
+				return
+			}
+			if err != nil {
+				d.pool.release()
+				d.setReprocessStatus(ctx, reprocessId, ExecutionStatusFailed, err)
+				return
+			}
+			d.pool.submit(executionPlanId)
+		}
+	}
+
+	slog.Info("reprocess triggered all windows", "reprocess_id", reprocess.ReprocessID)
+	if err := d.queries.SetReprocessTriggered(ctx, reprocessId); err != nil {
+		d.setReprocessStatus(
+			ctx,
+			reprocessId,
+			ExecutionStatusFailed,
+			fmt.Errorf("could not record that the reprocess triggered all windows: %v", err),
+		)
+		return
+	}
+	// the executions triggered may all have finished already
+	d.finishReprocess(ctx, reprocessId)
+}
+
+// createReprocessExecutionPlan persists the execution plan of a reprocessed
+// window, moving the reprocess on past the window in the same transaction
+func (d *Datalayer) createReprocessExecutionPlan(
+	ctx context.Context,
+	reprocessId int64,
+	executionPlan dag.Plan,
+	windowId int64,
+) (int64, error) {
+	tx, err := d.WithTx(ctx)
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+	if err != nil {
+		return 0, fmt.Errorf("could not start a transaction: %v", err)
+	}
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	executionPlanId, _, err := d.createExecutionPlan(ctx, tx, executionPlan, windowId)
+	if err != nil {
+		return 0, fmt.Errorf("could not persist execution plan for window: %v", err)
+	}
+	err = qtx.SetExecutionPlanReprocess(ctx, SetExecutionPlanReprocessParams{
+		ReprocessID: pgtype.Int8{Int64: reprocessId, Valid: true},
+		ID:          executionPlanId,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not link execution plan to reprocess: %v", err)
+	}
+	updated, err := qtx.UpdateReprocessProgress(ctx, UpdateReprocessProgressParams{
+		LastWindowID: windowId,
+		ID:           reprocessId,
+		Owner:        d.leases.owner,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not update reprocess progress: %v", err)
+	}
+	if updated == 0 {
+		return 0, errReprocessTakenOver
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("could not commit execution plan for window: %v", err)
+	}
+	return executionPlanId, nil
+}
+
+// finishReprocess marks a reprocess that has triggered all its windows as
+// finished, once none of the executions it triggered are left unfinished. It
+// fails if any of them did
+func (d *Datalayer) finishReprocess(ctx context.Context, reprocessId int64) {
+	if err := d.queries.FinishReprocess(ctx, reprocessId); err != nil {
+		slog.Error("could not finish reprocess", "reprocess_id", reprocessId, "error", err)
+	}
+}
+
+// record the status of a reprocess, along with the error that caused it to
+// fail (if any)
+func (d *Datalayer) setReprocessStatus(
+	ctx context.Context,
+	reprocessId int64,
+	status ExecutionStatus,
+	reprocessErr error,
+) {
+	var errorMessage pgtype.Text
+	if reprocessErr != nil {
+		slog.Error("reprocess failed", "reprocess_id", reprocessId, "error", reprocessErr)
+		errorMessage = pgtype.Text{String: reprocessErr.Error(), Valid: true}
+	}
+	err := d.queries.UpdateReprocessStatus(ctx, UpdateReprocessStatusParams{
+		Status:       status,
+		ErrorMessage: errorMessage,
+		ID:           reprocessId,
+	})
+	if err != nil {
+		slog.Error(
+			"could not update reprocess status",
+			"reprocess_id",
+			reprocessId,
+			"status",
+			status,
+			"error",
+			err,
+		)
+	}
+}
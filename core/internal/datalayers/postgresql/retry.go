@@ -0,0 +1,159 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/orc-analytics/orca/core/internal/envs"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// retryPolicy is the policy resolved for a single processor task
+type retryPolicy envs.RetryPolicy
+
+// resolveRetryPolicy resolves the retry policy of a processor task. The global
+// policy is overridden by that of the processor, which is in turn overridden by
+// those of the algorithms in the task. Where the algorithms of a task disagree,
+// the most permissive value of each field is used
+func resolveRetryPolicy(
+	global envs.RetryPolicy,
+	proc Processor,
+	algos []Algorithm,
+) (retryPolicy, error) {
+	policy := retryPolicy(global)
+	policy.RetryableCodes = slices.Clone(global.RetryableCodes)
+
+	procOverride, err := unmarshalRetryPolicy(proc.RetryPolicy)
+	if err != nil {
+		return retryPolicy{}, fmt.Errorf("could not unpack retry policy of processor %v: %v", proc.Name, err)
+	}
+	policy.override(procOverride)
+
+	var algoOverride *pb.RetryPolicy
+	for _, algo := range algos {
+		override, err := unmarshalRetryPolicy(algo.RetryPolicy)
+		if err != nil {
+			return retryPolicy{}, fmt.Errorf("could not unpack retry policy of algorithm %v: %v", algo.Name, err)
+		}
+		algoOverride = combineRetryPolicies(algoOverride, override)
+	}
+	policy.override(algoOverride)
+
+	return policy, nil
+}
+
+// override replaces the fields of the policy that are set in the override
+func (p *retryPolicy) override(override *pb.RetryPolicy) {
+	if override == nil {
+		return
+	}
+	if override.GetMaxAttempts() > 0 {
+		p.MaxAttempts = int(override.GetMaxAttempts())
+	}
+	if override.GetInitialBackoffMs() > 0 {
+		p.InitialBackoff = time.Duration(override.GetInitialBackoffMs()) * time.Millisecond
+	}
+	if override.GetMaxBackoffMs() > 0 {
+		p.MaxBackoff = time.Duration(override.GetMaxBackoffMs()) * time.Millisecond
+	}
+	if override.GetBackoffMultiplier() > 0 {
+		p.BackoffMultiplier = override.GetBackoffMultiplier()
+	}
+	if override.GetJitter() > 0 {
+		p.Jitter = override.GetJitter()
+	}
+	if len(override.GetRetryableCodes()) > 0 {
+		p.RetryableCodes = envs.ParseCodes(override.GetRetryableCodes())
+	}
+}
+
+// combineRetryPolicies combines two overrides, taking the most permissive
+// value of each field
+func combineRetryPolicies(a, b *pb.RetryPolicy) *pb.RetryPolicy {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	combined := &pb.RetryPolicy{
+		MaxAttempts:       max(a.GetMaxAttempts(), b.GetMaxAttempts()),
+		InitialBackoffMs:  max(a.GetInitialBackoffMs(), b.GetInitialBackoffMs()),
+		MaxBackoffMs:      max(a.GetMaxBackoffMs(), b.GetMaxBackoffMs()),
+		BackoffMultiplier: max(a.GetBackoffMultiplier(), b.GetBackoffMultiplier()),
+		Jitter:            max(a.GetJitter(), b.GetJitter()),
+		RetryableCodes:    slices.Clone(a.GetRetryableCodes()),
+	}
+	for _, code := range b.GetRetryableCodes() {
+		if !slices.Contains(combined.RetryableCodes, code) {
+			combined.RetryableCodes = append(combined.RetryableCodes, code)
+		}
+	}
+	return combined
+}
+
+// isRetryable reports whether a failed call should be attempted again
+func (p retryPolicy) isRetryable(err error) bool {
+	return slices.Contains(p.RetryableCodes, status.Code(err))
+}
+
+// backoff returns how long to wait before the given attempt is retried.
+// The backoff grows exponentially from the initial backoff, is capped at the
+// maximum backoff, and is then randomised by the jitter fraction
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.BackoffMultiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		backoff *= 1 - p.Jitter + 2*p.Jitter*rand.Float64()
+	}
+	return time.Duration(backoff)
+}
+
+// wait blocks for the backoff, returning early if the context is done
+func wait(ctx context.Context, backoff time.Duration) error {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// errorCode returns the name of the gRPC status code of an error
+func errorCode(err error) string {
+	if err == nil {
+		return codes.OK.String()
+	}
+	return status.Code(err).String()
+}
+
+// marshalRetryPolicy converts a retry policy into its stored form
+func marshalRetryPolicy(policy *pb.RetryPolicy) ([]byte, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	return protojson.Marshal(policy)
+}
+
+// unmarshalRetryPolicy converts a stored retry policy back into a retry policy
+func unmarshalRetryPolicy(data []byte) (*pb.RetryPolicy, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	policy := &pb.RetryPolicy{}
+	if err := protojson.Unmarshal(data, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
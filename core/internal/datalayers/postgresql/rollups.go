@@ -0,0 +1,204 @@
+package postgresql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// previousScheduleTime returns the latest time of a cron schedule at or
+// before t, or the zero time if there is none within the last two years
+func previousScheduleTime(cronSchedule cron.Schedule, t time.Time) time.Time {
+	for step := time.Minute; step < 2*366*24*time.Hour; step *= 2 {
+		previous := cronSchedule.Next(t.Add(-step))
+		if previous.IsZero() || previous.After(t) {
+			continue
+		}
+		for next := cronSchedule.Next(previous); !next.IsZero() && !next.After(t); next = cronSchedule.Next(next) {
+			previous = next
+		}
+		return previous
+	}
+	return time.Time{}
+}
+
+// rollupParent returns the parent window of a roll-up rule that a window
+// falls within, or nil if the window does not fall within a single parent
+// window or lacks the metadata it is rolled up by
+func rollupParent(rule ReadRollupRulesForWindowTypeRow, window *pb.Window) (*pb.Window, error) {
+	cronSchedule, location, err := parseWindowSchedule(rule.Cron, rule.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	timeFrom := previousScheduleTime(cronSchedule, window.GetTimeFrom().AsTime().In(location))
+	if timeFrom.IsZero() {
+		return nil, nil
+	}
+	timeTo := cronSchedule.Next(timeFrom)
+	if timeTo.IsZero() || window.GetTimeTo().AsTime().After(timeTo) {
+		return nil, nil
+	}
+
+	group := make(map[string]any, len(rule.MetadataKeys))
+	for _, key := range rule.MetadataKeys {
+		value, ok := window.GetMetadata().GetFields()[key]
+		if !ok {
+			return nil, nil
+		}
+		group[key] = value.AsInterface()
+	}
+	metadata, err := structpb.NewStruct(group)
+	if err != nil {
+		return nil, fmt.Errorf("could not build parent window metadata: %v", err)
+	}
+	groupJson, err := json.Marshal(group)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal parent window metadata: %v", err)
+	}
+	groupHash := sha256.Sum256(groupJson)
+
+	return &pb.Window{
+		TimeFrom:          timestamppb.New(timeFrom),
+		TimeTo:            timestamppb.New(timeTo),
+		WindowTypeName:    rule.ParentWindowTypeName,
+		WindowTypeVersion: rule.ParentWindowTypeVersion,
+		Origin:            rule.Origin,
+		Metadata:          metadata,
+		// the same parent window is only ever emitted once, however many
+		// times its children are found to cover it
+		IdempotencyKey: fmt.Sprintf("rollup/%s/%d/%x", rule.Name, timeFrom.Unix(), groupHash[:16]),
+	}, nil
+}
+
+// rollUpWindows rolls newly emitted windows up into the parent windows of the
+// roll-up rules on their window type, emitting those parent windows that are
+// now covered in full by their children. It runs in the background once the
+// windows are stored, so issues are logged rather than returned
+func (d *Datalayer) rollUpWindows(ctx context.Context, windows []*pb.Window) {
+	rulesByWindowType := make(map[string][]ReadRollupRulesForWindowTypeRow)
+	// parent windows are checked once, however many of their children were
+	// emitted together
+	checked := make(map[string]bool)
+
+	for _, window := range windows {
+		windowTypeKey := fmt.Sprintf("%s/%s", window.GetWindowTypeName(), window.GetWindowTypeVersion())
+		rules, ok := rulesByWindowType[windowTypeKey]
+		if !ok {
+			var err error
+			rules, err = d.queries.ReadRollupRulesForWindowType(ctx, ReadRollupRulesForWindowTypeParams{
+				WindowTypeName:    window.GetWindowTypeName(),
+				WindowTypeVersion: window.GetWindowTypeVersion(),
+			})
+			if err != nil {
+				slog.Error("could not read roll-up rules", "window_type", windowTypeKey, "error", err)
+				return
+			}
+			rulesByWindowType[windowTypeKey] = rules
+		}
+
+		for _, rule := range rules {
+			parent, err := rollupParent(rule, window)
+			if err != nil {
+				slog.Warn("could not roll up window", "rollup_rule", rule.Name, "error", err)
+				continue
+			}
+			if parent == nil || checked[parent.GetIdempotencyKey()] {
+				continue
+			}
+			checked[parent.GetIdempotencyKey()] = true
+
+			if err := d.rollUp(ctx, rule, window, parent); err != nil {
+				slog.Error("could not roll up windows", "rollup_rule", rule.Name, "error", err)
+			}
+		}
+	}
+}
+
+// rollUp emits a parent window if its children cover it in full, and links
+// it to them
+func (d *Datalayer) rollUp(
+	ctx context.Context,
+	rule ReadRollupRulesForWindowTypeRow,
+	child *pb.Window,
+	parent *pb.Window,
+) error {
+	metadataBytes, err := parent.GetMetadata().MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("could not marshal parent window metadata: %v", err)
+	}
+	timeFrom := parent.GetTimeFrom().AsTime()
+	timeTo := parent.GetTimeTo().AsTime()
+
+	children, err := d.queries.ReadRollupChildWindows(ctx, ReadRollupChildWindowsParams{
+		WindowTypeName:    child.GetWindowTypeName(),
+		WindowTypeVersion: child.GetWindowTypeVersion(),
+		TimeFrom: pgtype.Timestamp{
+			Time:  timeFrom.UTC(),
+			Valid: true,
+		},
+		TimeTo: pgtype.Timestamp{
+			Time:  timeTo.UTC(),
+			Valid: true,
+		},
+		Metadata: metadataBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("could not read child windows: %v", err)
+	}
+
+	// the children must cover the parent window without any gaps
+	covered := timeFrom
+	childIds := make([]int64, len(children))
+	for ii, childWindow := range children {
+		childIds[ii] = childWindow.ID
+		if childWindow.TimeFrom.Time.After(covered) {
+			break
+		}
+		if childWindow.TimeTo.Time.After(covered) {
+			covered = childWindow.TimeTo.Time
+		}
+	}
+	if covered.Before(timeTo) {
+		return nil
+	}
+
+	emitStatus, err := d.EmitWindow(ctx, parent)
+	if err != nil {
+		return fmt.Errorf("could not emit parent window: %v", err)
+	}
+
+	err = d.queries.LinkChildWindows(ctx, LinkChildWindowsParams{
+		ChildWindowsIds:   childIds,
+		WindowTypeName:    parent.GetWindowTypeName(),
+		WindowTypeVersion: parent.GetWindowTypeVersion(),
+		IdempotencyKey:    parent.GetIdempotencyKey(),
+	})
+	if err != nil {
+		return fmt.Errorf("could not link child windows: %v", err)
+	}
+
+	if !emitStatus.GetDuplicate() {
+		slog.Info(
+			"rolled up windows",
+			"rollup_rule",
+			rule.Name,
+			"time_from",
+			timeFrom,
+			"children",
+			len(children),
+			"exec_id",
+			emitStatus.GetExecId(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+	// schedules can be in any time zone, whether or not the host has the
+	// time zone database installed
+	_ "time/tzdata"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	types "github.com/orc-analytics/orca/core/internal/types"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// windowScheduleBatchSize is the most windows emitted for a schedule at once.
+// A schedule further behind than this, e.g. after downtime, catches up over
+// successive checks
+const windowScheduleBatchSize = 1000
+
+// windowScheduler periodically emits the windows of schedules that have
+// fallen due. Due schedules are claimed with row locks that other instances
+// of orca-core skip over, so that each window is emitted by only one of them
+type windowScheduler struct {
+	interval  time.Duration
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newWindowScheduler(interval time.Duration) *windowScheduler {
+	return &windowScheduler{
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+}
+
+// run emits due windows each interval until the scheduler is closed
+func (s *windowScheduler) run(emitDue func(ctx context.Context)) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			emitDue(context.Background())
+		}
+	}
+}
+
+func (s *windowScheduler) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// parseWindowSchedule parses the cron expression of a schedule, along with
+// the time zone it is evaluated in
+func parseWindowSchedule(cronExpr string, timezone string) (cron.Schedule, *time.Location, error) {
+	cronSchedule, err := cron.ParseStandard(cronExpr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: could not parse cron expression: %v", types.InvalidWindowSchedule, err)
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: unknown time zone: %v", types.InvalidWindowSchedule, err)
+	}
+	return cronSchedule, location, nil
+}
+
+// emitScheduledWindows emits the windows of every schedule that has fallen
+// due
+func (d *Datalayer) emitScheduledWindows(ctx context.Context) {
+	tx, err := d.WithTx(ctx)
+
+	defer func() {
+		if tx != nil {
+			tx.Rollback(ctx)
+		}
+	}()
+
+	if err != nil {
+		slog.Error("could not start a transaction", "error", err)
+		return
+	}
+
+	pgTx := tx.(*PgTx)
+	qtx := d.queries.WithTx(pgTx.tx)
+
+	now := time.Now().UTC()
+	schedules, err := qtx.ClaimDueWindowSchedules(ctx, pgtype.Timestamp{
+		Time:  now,
+		Valid: true,
+	})
+	if err != nil {
+		slog.Error("could not read due window schedules", "error", err)
+		return
+	}
+	if len(schedules) == 0 {
+		return
+	}
+
+	for _, schedule := range schedules {
+		if err := d.emitScheduleWindows(ctx, qtx, schedule, now); err != nil {
+			slog.Error(
+				"could not emit scheduled windows",
+				"window_schedule",
+				schedule.Name,
+				"error",
+				err,
+			)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		slog.Error("could not advance window schedules", "error", err)
+	}
+}
+
+// emitScheduleWindows emits the windows of a schedule that have ended since
+// the last it emitted, and advances the schedule past them. Scheduled windows
+// carry an idempotency key, so that a window emitted again after the schedule
+// failed to advance is not triggered twice
+func (d *Datalayer) emitScheduleWindows(
+	ctx context.Context,
+	queries *Queries,
+	schedule ClaimDueWindowSchedulesRow,
+	now time.Time,
+) error {
+	cronSchedule, location, err := parseWindowSchedule(schedule.Cron, schedule.Timezone)
+	if err != nil {
+		return err
+	}
+	metadata, err := unmarshalToStruct(schedule.Metadata)
+	if err != nil {
+		return fmt.Errorf("could not unmarshal schedule metadata: %v", err)
+	}
+
+	var windows []*pb.Window
+	timeFrom := schedule.EmittedUntil.Time.In(location)
+	for len(windows) < windowScheduleBatchSize {
+		timeTo := cronSchedule.Next(timeFrom)
+		if timeTo.IsZero() || timeTo.After(now) {
+			break
+		}
+		windows = append(windows, &pb.Window{
+			TimeFrom:          timestamppb.New(timeFrom),
+			TimeTo:            timestamppb.New(timeTo),
+			WindowTypeName:    schedule.WindowTypeName,
+			WindowTypeVersion: schedule.WindowTypeVersion,
+			Origin:            schedule.Origin,
+			Metadata:          metadata,
+			IdempotencyKey:    fmt.Sprintf("schedule/%s/%d", schedule.Name, timeTo.Unix()),
+		})
+		timeFrom = timeTo
+	}
+
+	statuses, err := d.EmitWindows(ctx, windows)
+	if err != nil {
+		return err
+	}
+
+	// a window that could not be emitted, e.g. as the execution queue is
+	// full, is tried again at the next check along with those after it
+	emittedUntil := schedule.EmittedUntil.Time
+	for ii, emitStatus := range statuses {
+		if emitStatus.GetStatus() == pb.WindowEmitStatus_TRIGGERING_FAILED {
+			slog.Warn(
+				"could not emit scheduled window",
+				"window_schedule",
+				schedule.Name,
+				"time_from",
+				windows[ii].GetTimeFrom().AsTime(),
+				"error",
+				emitStatus.GetError(),
+			)
+			break
+		}
+		emittedUntil = windows[ii].GetTimeTo().AsTime()
+	}
+	slog.Debug(
+		"emitted scheduled windows",
+		"window_schedule",
+		schedule.Name,
+		"emitted_until",
+		emittedUntil,
+	)
+
+	return queries.AdvanceWindowSchedule(ctx, AdvanceWindowScheduleParams{
+		EmittedUntil: pgtype.Timestamp{
+			Time:  emittedUntil.UTC(),
+			Valid: true,
+		},
+		NextEmission: pgtype.Timestamp{
+			Time:  cronSchedule.Next(emittedUntil.In(location)).UTC(),
+			Valid: true,
+		},
+		ID: schedule.ID,
+	})
+}
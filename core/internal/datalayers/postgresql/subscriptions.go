@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	types "github.com/orc-analytics/orca/core/internal/types"
+	pb "github.com/orc-analytics/orca/core/protobufs/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subscriber is a processor connected in through SubscribeTasks, which tasks
+// are delivered to rather than dialling out to the processor
+type subscriber struct {
+	processorId int64
+	tasks       chan *pb.ProcessingTask
+	done        chan struct{}
+
+	// the number of tasks delivered to the subscriber awaiting results.
+	// Guarded by the subscription manager's lock
+	pending int
+}
+
+// subscriptionManager tracks the processors subscribed for tasks, and routes
+// the results they submit back to the tasks awaiting them
+type subscriptionManager struct {
+	mu          sync.Mutex
+	subscribers map[int64][]*subscriber
+	awaiting    map[string]chan *pb.Result
+}
+
+func newSubscriptionManager() *subscriptionManager {
+	return &subscriptionManager{
+		subscribers: make(map[int64][]*subscriber),
+		awaiting:    make(map[string]chan *pb.Result),
+	}
+}
+
+// subscribe adds a subscriber for a processor. The returned function removes
+// it again
+func (m *subscriptionManager) subscribe(processorId int64) (*subscriber, func()) {
+	s := &subscriber{
+		processorId: processorId,
+		tasks:       make(chan *pb.ProcessingTask),
+		done:        make(chan struct{}),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribers[processorId] = append(m.subscribers[processorId], s)
+
+	return s, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		subscribers := m.subscribers[processorId]
+		for ii, other := range subscribers {
+			if other == s {
+				m.subscribers[processorId] = append(subscribers[:ii:ii], subscribers[ii+1:]...)
+				break
+			}
+		}
+		if len(m.subscribers[processorId]) == 0 {
+			delete(m.subscribers, processorId)
+		}
+		close(s.done)
+	}
+}
+
+// pick returns the subscriber of a processor with the fewest tasks awaiting
+// results, or nil if the processor has no subscribers. The returned release
+// function must be called once the task is done with the subscriber
+func (m *subscriptionManager) pick(processorId int64) (*subscriber, func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var picked *subscriber
+	for _, s := range m.subscribers[processorId] {
+		if picked == nil || s.pending < picked.pending {
+			picked = s
+		}
+	}
+	if picked == nil {
+		return nil, nil
+	}
+	picked.pending++
+	return picked, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		picked.pending--
+	}
+}
+
+// await registers a task as awaiting its result. The returned function must
+// be called once the task no longer awaits it
+func (m *subscriptionManager) await(taskId string) (<-chan *pb.Result, func()) {
+	results := make(chan *pb.Result, 1)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.awaiting[taskId] = results
+
+	return results, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.awaiting, taskId)
+	}
+}
+
+// submit hands the result of a task to the task awaiting it. Each task
+// accepts a single result
+func (m *subscriptionManager) submit(taskId string, result *pb.Result) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	results, ok := m.awaiting[taskId]
+	if !ok {
+		return fmt.Errorf("%w: %v", types.TaskNotFound, taskId)
+	}
+	delete(m.awaiting, taskId)
+	results <- result
+	return nil
+}
+
+// deliver sends a task to a subscriber and waits for its result
+func (m *subscriptionManager) deliver(
+	ctx context.Context,
+	s *subscriber,
+	task *pb.ProcessingTask,
+) (*pb.Result, error) {
+	results, forget := m.await(task.GetTaskId())
+	defer forget()
+
+	select {
+	case s.tasks <- task:
+	case <-s.done:
+		return nil, status.Error(codes.Unavailable, "processor unsubscribed before receiving task")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case result := <-results:
+		return result, nil
+	case <-s.done:
+		return nil, status.Error(codes.Unavailable, "processor unsubscribed before submitting result")
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -2,14 +2,14 @@ package postgresql
 
 import (
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
-	"net"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,257 +19,1252 @@ import (
 	"github.com/orc-analytics/orca/core/internal/envs"
 	pb "github.com/orc-analytics/orca/core/protobufs/go"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// execution holds the state shared between the tasks of an execution plan
+type execution struct {
+	window       *pb.Window
+	windowRow    ReadExecutionPlanWindowRow
+	processorMap map[int64]Processor
+	algorithmMap map[int64]Algorithm
+	resultMap    *resultMap
+
+	// stored results of dependencies on other window types, which are not
+	// produced by the plan itself
+	dependencyResults map[int64][]*pb.AlgorithmResult
+}
+
+// resultMap is a thread-safe map of algorithm IDs to results, shared between
+// the concurrently running tasks of a stage. It also tracks the algorithms
+// that failed or were skipped, so that their dependants can be skipped
+type resultMap struct {
+	mu      sync.RWMutex
+	results map[int64]*pb.ExecutionResult
+	failed  map[int64]bool
+}
+
+func newResultMap(size int) *resultMap {
+	return &resultMap{
+		results: make(map[int64]*pb.ExecutionResult, size),
+		failed:  make(map[int64]bool),
+	}
+}
+
+func (r *resultMap) get(algoId int64) (*pb.ExecutionResult, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result, ok := r.results[algoId]
+	return result, ok
+}
+
+func (r *resultMap) set(algoId int64, result *pb.ExecutionResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results[algoId] = result
+	// an algorithm that failed before, e.g. in an execution that has been
+	// requeued, no longer stops its dependants once it succeeds
+	if result.GetAlgorithmResult().GetResult().GetStatus() == pb.ResultStatus_RESULT_STATUS_SUCEEDED {
+		delete(r.failed, algoId)
+	} else {
+		r.failed[algoId] = true
+	}
+}
+
+// setFailed records that an algorithm failed or was skipped without a result
+func (r *resultMap) setFailed(algoId int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failed[algoId] = true
+}
+
+func (r *resultMap) hasFailed(algoId int64) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.failed[algoId]
+}
+
+// failedDependency returns the first dependency of a node that failed or was
+// skipped, if any
+func (r *resultMap) failedDependency(node dag.Node) (int64, bool) {
+	for _, algoId := range node.AlgoDepIds() {
+		if r.hasFailed(algoId) {
+			return algoId, true
+		}
+	}
+	return 0, false
+}
+
+// processTasks processes a persisted execution plan through to completion.
+// Stages and tasks that have already succeeded, e.g. before orca-core was
+// restarted, are skipped with their stored results used in their place
 func processTasks(
 	d *Datalayer,
-	executionPlan dag.Plan,
-	window *pb.Window,
-	insertedWindow RegisterWindowRow,
+	executionPlanId int64,
 ) error {
 	ctx := context.Background()
+
+	// the plan may have been taken over by another instance of orca-core
+	claimed, err := d.queries.ClaimExecutionPlan(ctx, ClaimExecutionPlanParams{
+		Owner:   d.leases.owner,
+		LeaseMs: d.leases.duration.Milliseconds(),
+		ID:      executionPlanId,
+	})
+	if err != nil {
+		slog.Error("could not claim execution plan", "execution_plan_id", executionPlanId, "error", err)
+		return err
+	}
+	if claimed == 0 {
+		slog.Info("execution plan is leased to another instance", "execution_plan_id", executionPlanId)
+		return nil
+	}
+
+	executionPlan, executionTasks, err := d.readExecutionPlan(ctx, executionPlanId)
+	if err != nil {
+		slog.Error("could not read execution plan", "execution_plan_id", executionPlanId, "error", err)
+		return err
+	}
 	slog.Info("calculated execution paths", "execution_paths", executionPlan)
+
+	windowRow, err := d.queries.ReadExecutionPlanWindow(ctx, executionPlanId)
+	if err != nil {
+		slog.Error("could not read window for execution plan", "execution_plan_id", executionPlanId, "error", err)
+		return err
+	}
+	window, err := windowRowToPb(windowRow)
+	if err != nil {
+		slog.Error("could not unpack window for execution plan", "execution_plan_id", executionPlanId, "error", err)
+		return err
+	}
+
+	// work sent to processors runs under a context that CancelExecution can
+	// cancel, whereas the state of the execution is always recorded
+	execCtx, finish := d.inFlight.start(ctx, windowRow.ExecID)
+	defer finish()
+
+	// the execution may have been cancelled before it was picked up
+	executionRow, err := d.queries.ReadExecution(ctx, windowRow.ExecID)
+	if err != nil {
+		slog.Error("could not read execution", "exec_id", windowRow.ExecID, "error", err)
+		return err
+	}
+	if executionRow.Status == ExecutionStatusCancelled {
+		return errExecutionCancelled
+	}
+
+	d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusRunning)
+
+	exec := execution{
+		window:       window,
+		windowRow:    windowRow,
+		processorMap: make(map[int64]Processor, len(executionPlan.AffectedProcessors)),
+		algorithmMap: make(map[int64]Algorithm),
+	}
+
 	// get map of processors from processor ids
-	processorMap := make(
-		map[int64]Processor,
-		len(executionPlan.AffectedProcessors),
-	)
 	processors, err := d.queries.ReadProcessorsByIDs(ctx, executionPlan.AffectedProcessors)
 	if err != nil {
 		slog.Error("Processors could not be read", "error", err)
+		d.skipPendingExecutionWork(ctx, executionPlanId)
+		d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusFailed)
 		return err
 	}
-
 	for _, proc := range processors {
-		processorMap[proc.ID] = proc
+		exec.processorMap[proc.ID] = proc
 	}
 
-	// get map of algorithms from algorithm ids
-	algorithmMap := make(
-		map[int64]Algorithm,
-	)
-
-	// map of algorithm Ids to results
-	resultMap := make(
-		map[int64]*pb.ExecutionResult,
-	)
+	// get map of algorithms from the ids of the plan's algorithms and their
+	// dependencies, which may be of other window types
+	var algoIds []int64
+	for _, stage := range executionPlan.Stages {
+		for _, task := range stage.Tasks {
+			for _, node := range task.Nodes {
+				algoIds = append(algoIds, node.AlgoId())
+				algoIds = append(algoIds, node.AlgoDepIds()...)
+			}
+		}
+	}
+	algorithms, err := d.queries.ReadAlgorithmsByIDs(ctx, algoIds)
+	if err != nil {
+		slog.Error("Algorithms could not be read", "error", err)
+		d.skipPendingExecutionWork(ctx, executionPlanId)
+		d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusFailed)
+		return err
+	}
+	for _, algo := range algorithms {
+		exec.algorithmMap[algo.ID] = algo
+	}
 
-	// map of execution IDs and the algorithms requested
-	algorithms, err := d.queries.ReadAlgorithmsForWindow(ctx, ReadAlgorithmsForWindowParams{
-		WindowTypeName:    window.WindowTypeName,
-		WindowTypeVersion: window.WindowTypeVersion,
-	})
+	// map of algorithm Ids to results, seeded with results of any work
+	// completed before a restart
+	exec.resultMap, err = d.readResultMap(ctx, windowRow.ID)
+	if err != nil {
+		slog.Error("Existing results could not be read", "error", err)
+		d.skipPendingExecutionWork(ctx, executionPlanId)
+		d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusFailed)
+		return err
+	}
 
-	for _, algo := range algorithms {
-		algorithmMap[algo.ID] = algo
+	exec.dependencyResults, err = d.readDependencyResults(ctx, executionPlan, windowRow, exec.algorithmMap)
+	if err != nil {
+		slog.Error("Dependency results could not be read", "error", err)
+		d.skipPendingExecutionWork(ctx, executionPlanId)
+		d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusFailed)
+		return err
 	}
-	// get the environment
-	config := envs.GetConfig()
 
 	// for each stage, farm off processsings
 	slog.Info("execution plan", "executionPlan", executionPlan)
-	for _, stage := range executionPlan.Stages {
-		for _, task := range stage.Tasks {
-			proc, ok := processorMap[task.ProcId]
-			if !ok {
-				slog.Error("Processor not found for task", "proc_id", task.ProcId)
-				return fmt.Errorf("processor ID %d not found", task.ProcId)
+	planFailed := false
+	var planErrs []error
+	for stageIdx, stage := range executionPlan.Stages {
+		// the execution may have been cancelled through another instance of
+		// orca-core, which only records it
+		if execCtx.Err() != nil || d.checkCancelled(ctx, windowRow.ExecID) {
+			break
+		}
+		stageRow := executionTasks[stageIdx][0]
+		if stageRow.StageStatus == ExecutionStatusSucceeded {
+			continue
+		}
+		d.setExecutionStageStatus(ctx, stageRow.ExecutionStageID, ExecutionStatusRunning)
+
+		// tasks within a stage are independent of one another, so are
+		// dispatched in parallel. All must complete before the next stage
+		var wg sync.WaitGroup
+		taskErrs := make([]error, len(stage.Tasks))
+		for taskIdx, task := range stage.Tasks {
+			taskRow := executionTasks[stageIdx][taskIdx]
+			if taskRow.Status == ExecutionStatusSucceeded {
+				continue
+			}
+			if d.checkCancelled(ctx, windowRow.ExecID) {
+				break
 			}
-			var conn *grpc.ClientConn
 
-			if config.IsProduction {
-				host, _, err := net.SplitHostPort(proc.ConnectionString)
+			// algorithms that depend on a failed algorithm are skipped, rather
+			// than being sent incomplete inputs
+			task = exec.skipFailedDependants(ctx, d, task, taskRow)
+			if len(task.Nodes) == 0 {
+				d.setExecutionTaskStatus(ctx, taskRow.ID, ExecutionStatusSkipped, nil)
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.setExecutionTaskStatus(ctx, taskRow.ID, ExecutionStatusRunning, nil)
+				d.setUnfinishedExecutionNodesStatus(ctx, taskRow.ID, ExecutionStatusRunning, nil)
+
+				err := exec.runTask(execCtx, d, task, taskRow)
+				if err != nil && execCtx.Err() != nil {
+					// recorded as cancelled once the stage completes
+					return
+				}
 				if err != nil {
-					host = proc.ConnectionString
+					d.setExecutionTaskStatus(ctx, taskRow.ID, ExecutionStatusFailed, err)
+					d.setUnfinishedExecutionNodesStatus(ctx, taskRow.ID, ExecutionStatusFailed, err)
+					for _, node := range task.Nodes {
+						if _, ok := exec.resultMap.get(node.AlgoId()); !ok {
+							exec.resultMap.setFailed(node.AlgoId())
+						}
+					}
+					taskErrs[taskIdx] = err
+					return
 				}
-				conn, err = grpc.NewClient(
-					proc.ConnectionString,
-					grpc.WithTransportCredentials(
-						credentials.NewTLS(
-							&tls.Config{
-								ServerName: host,
-							},
-						),
-					),
-				)
-			} else {
+				d.setExecutionTaskStatus(ctx, taskRow.ID, ExecutionStatusSucceeded, nil)
+				d.setUnfinishedExecutionNodesStatus(ctx, taskRow.ID, ExecutionStatusSucceeded, nil)
+			}()
+		}
+		wg.Wait()
+		if execCtx.Err() != nil {
+			break
+		}
 
-				conn, err = grpc.NewClient(
-					proc.ConnectionString,
-					grpc.WithTransportCredentials(insecure.NewCredentials()),
-				)
-			}
-			if err != nil {
-				slog.Error("could not connect to processor", "proc_id", task.ProcId, "error", err)
-				return err
+		// a failed algorithm only stops its dependants, so independent
+		// algorithms in later stages still execute
+		stageFailed := false
+		for _, task := range stage.Tasks {
+			for _, node := range task.Nodes {
+				stageFailed = stageFailed || exec.resultMap.hasFailed(node.AlgoId())
 			}
-			// IMPORTANT: close conn when done (not deferred inside a loop)
-			defer func(conn *grpc.ClientConn) {
-				if err := conn.Close(); err != nil {
-					slog.Warn("error closing gRPC connection", "error", err)
-				}
-			}(conn)
+		}
+		if err := errors.Join(taskErrs...); err != nil {
+			planErrs = append(planErrs, err)
+		}
+		if stageFailed {
+			planFailed = true
+			d.setExecutionStageStatus(ctx, stageRow.ExecutionStageID, ExecutionStatusFailed)
+		} else {
+			d.setExecutionStageStatus(ctx, stageRow.ExecutionStageID, ExecutionStatusSucceeded)
+		}
+	}
+	if cause := context.Cause(execCtx); cause != nil {
+		slog.Info("execution cancelled", "exec_id", windowRow.ExecID, "cause", cause)
+		d.cancelUnfinishedExecutionWork(ctx, executionPlanId, cause)
+		d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusCancelled)
+		return cause
+	}
+	if planFailed {
+		d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusFailed)
+	} else {
+		d.setExecutionPlanStatus(ctx, executionPlanId, ExecutionStatusSucceeded)
+	}
+	return errors.Join(planErrs...)
+}
 
-			client := pb.NewOrcaProcessorClient(conn)
-			healthCheckResponse, err := client.HealthCheck(ctx, &pb.HealthCheckRequest{
-				Timestamp: time.Now().Unix(),
-			})
-			if err != nil {
-				slog.Error(
-					"issue contacting processor",
-					"response",
-					healthCheckResponse,
-					"processor",
-					proc,
-				)
-				return err
+// skipFailedDependants marks the algorithms of a task that depend on a failed
+// algorithm as skipped, returning the task with only the algorithms to execute
+func (e *execution) skipFailedDependants(
+	ctx context.Context,
+	d *Datalayer,
+	task dag.ProcessorTask,
+	taskRow ReadExecutionTasksRow,
+) dag.ProcessorTask {
+	nodes := make([]dag.Node, 0, len(task.Nodes))
+	for _, node := range task.Nodes {
+		failedAlgoId, ok := e.resultMap.failedDependency(node)
+		if !ok {
+			nodes = append(nodes, node)
+			continue
+		}
+
+		failedAlgo := e.algorithmMap[failedAlgoId]
+		skipErr := fmt.Errorf(
+			"dependency %v (%v) did not succeed",
+			failedAlgo.Name,
+			failedAlgo.Version,
+		)
+		slog.Warn(
+			"skipping algorithm with failed dependency",
+			"algo_id",
+			node.AlgoId(),
+			"dependency_algo_id",
+			failedAlgoId,
+		)
+		d.setExecutionNodeStatus(ctx, taskRow.ID, node.AlgoId(), ExecutionStatusSkipped, skipErr)
+		e.resultMap.setFailed(node.AlgoId())
+	}
+	return dag.ProcessorTask{
+		ProcId: task.ProcId,
+		Nodes:  nodes,
+	}
+}
+
+// runTask farms a single processor task off to its processor, storing the
+// results as they are streamed back. Failed attempts are retried as per the
+// retry policy resolved for the processor and its algorithms
+func (e *execution) runTask(
+	ctx context.Context,
+	d *Datalayer,
+	task dag.ProcessorTask,
+	taskRow ReadExecutionTasksRow,
+) error {
+	proc, ok := e.processorMap[task.ProcId]
+	if !ok {
+		slog.Error("Processor not found for task", "proc_id", task.ProcId)
+		return fmt.Errorf("processor ID %d not found", task.ProcId)
+	}
+
+	algos := make([]Algorithm, len(task.Nodes))
+	for ii, node := range task.Nodes {
+		algo, ok := e.algorithmMap[node.AlgoId()]
+		if !ok {
+			slog.Error("algorithm not found", "algo_id", node.AlgoId())
+			return fmt.Errorf("algorithm ID %d not found", node.AlgoId())
+		}
+		algos[ii] = algo
+	}
+
+	// get the environment
+	config := envs.GetConfig()
+
+	policy, err := resolveRetryPolicy(config.RetryPolicy, proc, algos)
+	if err != nil {
+		slog.Error("could not resolve retry policy", "proc_id", task.ProcId, "error", err)
+		return err
+	}
+
+	// algorithms whose results were stored by an earlier attempt, which are
+	// not requested again when retrying
+	completed := make(map[int64]bool, len(task.Nodes))
+
+	// attempts are recorded even once the execution is cancelled
+	dbCtx := context.WithoutCancel(ctx)
+
+	for attempt := 1; ; attempt++ {
+		started := time.Now()
+		err := e.attemptTask(ctx, d, proc, task, taskRow, completed)
+		if err == nil {
+			d.recordExecutionAttempt(dbCtx, taskRow.ID, attempt, started, nil, 0)
+			return nil
+		}
+
+		if attempt >= policy.MaxAttempts || !policy.isRetryable(err) || ctx.Err() != nil {
+			d.recordExecutionAttempt(dbCtx, taskRow.ID, attempt, started, err, 0)
+			if ctx.Err() == nil {
+				e.recordDeadLetter(dbCtx, d, task, taskRow, completed, attempt, err)
 			}
-			if healthCheckResponse.Status != pb.HealthCheckResponse_STATUS_SERVING {
-				slog.Error(
-					"cannot execute stage, processor not serving",
-					"status",
-					healthCheckResponse.Status,
-					"message",
-					healthCheckResponse.Message,
-				)
-				return err
+			if attempt > 1 {
+				return fmt.Errorf("processor task failed after %d attempts: %w", attempt, err)
 			}
+			return err
+		}
 
-			// build list of affected Algorithms
-			var affectedAlgorithms []*pb.Algorithm
+		backoff := policy.backoff(attempt)
+		slog.Warn(
+			"retrying processor task",
+			"proc_id",
+			task.ProcId,
+			"exec_id",
+			taskRow.ExecID,
+			"attempt",
+			attempt,
+			"max_attempts",
+			policy.MaxAttempts,
+			"backoff",
+			backoff,
+			"code",
+			errorCode(err),
+			"error",
+			err,
+		)
+		d.recordExecutionAttempt(dbCtx, taskRow.ID, attempt, started, err, backoff)
 
-			// and their dependency's result
-			algoDepsResults := []*pb.AlgorithmResult{}
+		if err := wait(ctx, backoff); err != nil {
+			return err
+		}
+	}
+}
 
-			// generate an execution id
-			execUuid := uuid.New()
-			execId := strings.ReplaceAll(execUuid.String(), "-", "")
+// recordDeadLetter records a task that failed after exhausting its retries,
+// along with the request for the algorithms it did not complete
+func (e *execution) recordDeadLetter(
+	ctx context.Context,
+	d *Datalayer,
+	task dag.ProcessorTask,
+	taskRow ReadExecutionTasksRow,
+	completed map[int64]bool,
+	attempts int,
+	taskErr error,
+) {
+	request, err := e.executionRequest(task, taskRow, completed)
+	if err != nil {
+		slog.Error("could not build dead letter request", "exec_id", taskRow.ExecID, "error", err)
+		return
+	}
+	algorithmIds := []int64{}
+	for _, node := range task.Nodes {
+		if !completed[node.AlgoId()] {
+			algorithmIds = append(algorithmIds, node.AlgoId())
+		}
+	}
+	d.recordDeadLetter(ctx, taskRow, task.ProcId, algorithmIds, request, attempts, taskErr)
+}
 
-			for _, node := range task.Nodes {
-				algo, ok := algorithmMap[node.AlgoId()]
+// attemptTask makes a single attempt at executing the algorithms of a task
+// that have not already completed. The attempt must complete within the
+// combined timeouts of those algorithms
+func (e *execution) attemptTask(
+	ctx context.Context,
+	d *Datalayer,
+	proc Processor,
+	task dag.ProcessorTask,
+	taskRow ReadExecutionTasksRow,
+	completed map[int64]bool,
+) error {
+	// results are stored even once the execution is cancelled
+	dbCtx := context.WithoutCancel(ctx)
 
-				if !ok {
-					slog.Error("algorithm not found", "algo_id", node.AlgoId())
-					return fmt.Errorf("algorithm ID %d not found", node.AlgoId())
-				}
+	timeout := e.taskTimeout(task, completed)
+	streamCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-				affectedAlgorithms = append(affectedAlgorithms, &pb.Algorithm{
-					Name:    algo.Name,
-					Version: algo.Version,
-				})
+	// processors subscribed for tasks are delivered them, rather than being
+	// dialled out to
+	if sub, release := d.subscriptions.pick(proc.ID); sub != nil {
+		defer release()
+		err := e.deliverTask(streamCtx, d, sub, task, taskRow, completed)
+		if err != nil && errors.Is(streamCtx.Err(), context.DeadlineExceeded) {
+			slog.Warn(
+				"processor did not complete within the timeout",
+				"proc_id",
+				task.ProcId,
+				"timeout",
+				timeout,
+			)
+			return status.Errorf(
+				codes.DeadlineExceeded,
+				"processor %v did not complete within %v",
+				proc.Name,
+				timeout,
+			)
+		}
+		return err
+	}
 
-				// determine which results need to be included
-				for _, algoId := range node.AlgoDepIds() {
-					algoDepsResults = append(algoDepsResults, resultMap[algoId].AlgorithmResult)
-				}
-			}
+	// each attempt is dispatched afresh, so that a retry can be sent to
+	// another instance of the processor
+	instances, err := d.queries.ReadProcessorInstances(dbCtx, proc.ID)
+	if err != nil {
+		return fmt.Errorf("could not read instances of processor %v: %v", proc.Name, err)
+	}
+	client, release, err := d.dispatcher.dispatch(streamCtx, proc, instances)
+	if err != nil {
+		slog.Error(
+			"could not dispatch task to processor",
+			"proc_id",
+			task.ProcId,
+			"error",
+			err,
+		)
+		return err
+	}
+	defer release()
 
-			execReq := &pb.ExecutionRequest{
-				ExecId:           execId,
-				Window:           window,
-				AlgorithmResults: algoDepsResults,
-				Algorithms:       affectedAlgorithms,
+	execReq, err := e.executionRequest(task, taskRow, completed)
+	if err != nil {
+		return err
+	}
+
+	stream, err := client.ExecuteDagPart(streamCtx, execReq)
+	if err != nil {
+		slog.Error(
+			"failed to start DAG part execution",
+			"proc_id",
+			task.ProcId,
+			"error",
+			err,
+		)
+		return err
+	}
+
+	// recieve streamed execution results
+	for {
+		result, err := stream.Recv()
+		// error handling
+		if err != nil {
+			if err == io.EOF {
+				slog.Info("finished receiving execution results", "proc_id", task.ProcId)
+				break
+			}
+			if errors.Is(streamCtx.Err(), context.DeadlineExceeded) {
+				slog.Warn(
+					"processor did not complete within the timeout",
+					"proc_id",
+					task.ProcId,
+					"timeout",
+					timeout,
+				)
+				return status.Errorf(
+					codes.DeadlineExceeded,
+					"processor %v did not complete within %v",
+					proc.Name,
+					timeout,
+				)
 			}
+			slog.Error(
+				"error receiving execution result",
+				"proc_id",
+				task.ProcId,
+				"error",
+				err,
+			)
+			return err
+		}
 
-			stream, err := client.ExecuteDagPart(ctx, execReq)
+		err = e.storeResult(dbCtx, d, task, taskRow, completed, result)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeResult stores a result received for an algorithm of a task, marking
+// the algorithm as completed. Results of algorithms outside the task are
+// dropped
+func (e *execution) storeResult(
+	dbCtx context.Context,
+	d *Datalayer,
+	task dag.ProcessorTask,
+	taskRow ReadExecutionTasksRow,
+	completed map[int64]bool,
+	result *pb.ExecutionResult,
+) error {
+	slog.Info("received execution result",
+		"exec_id", result.GetExecId(),
+	)
+
+	// results are only stored for the algorithms of the task
+	var algoResultId int
+	for _, node := range task.Nodes {
+		algo, ok := e.algorithmMap[node.AlgoId()]
+		if ok &&
+			(algo.Name == result.AlgorithmResult.GetAlgorithm().Name) &&
+			(algo.Version == result.AlgorithmResult.GetAlgorithm().Version) {
+			algoResultId = int(algo.ID)
+			break
+		}
+	}
+	if algoResultId == 0 {
+		slog.Warn(
+			"dropping result of an algorithm that is not part of the task",
+			"exec_id",
+			taskRow.ExecID,
+			"proc_id",
+			task.ProcId,
+			"algorithm",
+			result.AlgorithmResult.GetAlgorithm().GetName(),
+			"version",
+			result.AlgorithmResult.GetAlgorithm().GetVersion(),
+		)
+		return nil
+	}
+
+	// add the result in to the result map
+	e.resultMap.set(int64(algoResultId), result)
+
+	resultParams := CreateResultParams{
+		WindowsID:    pgtype.Int8{Valid: true, Int64: e.windowRow.ID},
+		WindowTypeID: pgtype.Int8{Valid: true, Int64: e.windowRow.WindowTypeID},
+		AlgorithmID:  pgtype.Int8{Valid: true, Int64: int64(algoResultId)},
+		Status:       resultStatusFromPb(result.AlgorithmResult.Result.GetStatus()),
+		KeepRevision: envs.GetConfig().KeepResultRevisions,
+	}
+
+	// failed results only carry the reason they failed
+	var nodeErr error
+	if resultParams.Status != ResultStatusSucceeded {
+		nodeErr = fmt.Errorf(
+			"algorithm reported %v: %v",
+			result.AlgorithmResult.Result.GetStatus(),
+			result.AlgorithmResult.Result.GetErrorMessage(),
+		)
+		resultParams.ErrorMessage = pgtype.Text{
+			Valid:  true,
+			String: result.AlgorithmResult.Result.GetErrorMessage(),
+		}
+	} else {
+		switch resultData := result.AlgorithmResult.Result.GetResultData().(type) {
+		case *pb.Result_SingleValue:
+			resultParams.ResultValue = pgtype.Float8{
+				Valid:   true,
+				Float64: float64(resultData.SingleValue),
+			}
+		case *pb.Result_FloatValues:
+			resultParams.ResultArray = convertFloat32ToFloat64(
+				resultData.FloatValues.GetValues(),
+			)
+		case *pb.Result_StructValue:
+			structResult, err := convertStructToJsonBytes(resultData.StructValue)
 			if err != nil {
 				slog.Error(
-					"failed to start DAG part execution",
-					"proc_id",
-					task.ProcId,
+					"Issue converted algorithm struct result to bytes",
 					"error",
 					err,
+					"struct",
+					resultData.StructValue,
 				)
 				return err
 			}
+			resultParams.ResultJson = structResult
+		}
+	}
 
-			// recieve streamed execution results
-			for {
-				result, err := stream.Recv()
-				// error handling
-				if err != nil {
-					if errors.Is(err, context.Canceled) ||
-						errors.Is(err, context.DeadlineExceeded) {
-						slog.Warn(
-							"context done while receiving execution result",
-							"proc_id",
-							task.ProcId,
-						)
-						break
-					}
-					if err == io.EOF {
-						slog.Info("finished receiving execution results", "proc_id", task.ProcId)
-						break
-					}
-					slog.Error(
-						"error receiving execution result",
-						"proc_id",
-						task.ProcId,
-						"error",
-						err,
-					)
-					return err
-				}
+	resultId, err := d.queries.CreateResult(dbCtx, resultParams)
+	if err != nil {
+		slog.Error("Error inserting result", "error", err)
+		return err
+	}
+	completed[int64(algoResultId)] = true
+	if nodeErr != nil {
+		slog.Warn("algorithm failed", "algo_id", algoResultId, "error", nodeErr)
+		d.setExecutionNodeStatus(dbCtx, taskRow.ID, int64(algoResultId), ExecutionStatusFailed, nodeErr)
+	} else {
+		d.setExecutionNodeStatus(dbCtx, taskRow.ID, int64(algoResultId), ExecutionStatusSucceeded, nil)
+	}
+	slog.Info("Inserted result", "resultId", resultId)
+	d.results.write()
 
-				slog.Info("received execution result",
-					"exec_id", result.GetExecId(),
-				)
+	if nodeErr == nil {
+		d.alerts.evaluate(
+			dbCtx,
+			d.queries,
+			int64(algoResultId),
+			e.windowRow.ID,
+			e.window,
+			result.AlgorithmResult.GetResult(),
+		)
+	}
+	return nil
+}
 
-				var algoResultId int
-				for _, algo := range algorithms {
-					if (algo.Name == result.AlgorithmResult.GetAlgorithm().Name) &&
-						(algo.Version == result.AlgorithmResult.GetAlgorithm().Version) {
-						algoResultId = int(algo.ID)
-						break
-					}
-				}
+// deliverTask delivers the algorithms of a task that have not already
+// completed to a subscribed processor, one at a time in order, storing each
+// result as it is submitted
+func (e *execution) deliverTask(
+	ctx context.Context,
+	d *Datalayer,
+	sub *subscriber,
+	task dag.ProcessorTask,
+	taskRow ReadExecutionTasksRow,
+	completed map[int64]bool,
+) error {
+	// results are stored even once the execution is cancelled
+	dbCtx := context.WithoutCancel(ctx)
 
-				// add the result in to the result map
-				resultMap[int64(algoResultId)] = result
+	for _, node := range task.Nodes {
+		if completed[node.AlgoId()] {
+			continue
+		}
+		algo, ok := e.algorithmMap[node.AlgoId()]
+		if !ok {
+			slog.Error("algorithm not found", "algo_id", node.AlgoId())
+			return fmt.Errorf("algorithm ID %d not found", node.AlgoId())
+		}
+		algorithm := &pb.Algorithm{
+			Name:    algo.Name,
+			Version: algo.Version,
+		}
 
-				structResult, err := convertStructToJsonBytes(
-					result.AlgorithmResult.Result.GetStructValue(),
-				)
-				if err != nil {
-					slog.Error(
-						"Issue converted algorithm struct result to bytes",
-						"error",
-						err,
-						"struct",
-						result.AlgorithmResult.Result.GetStructValue(),
-					)
-					return err
-				}
+		result, err := d.subscriptions.deliver(ctx, sub, &pb.ProcessingTask{
+			TaskId:            newExecId(),
+			Algorithm:         algorithm,
+			Window:            e.window,
+			DependencyResults: e.dependencyResultsFor(node),
+			ExecId:            taskRow.ExecID,
+		})
+		if err != nil {
+			slog.Error(
+				"error delivering task to subscribed processor",
+				"proc_id",
+				task.ProcId,
+				"error",
+				err,
+			)
+			return err
+		}
+
+		err = e.storeResult(dbCtx, d, task, taskRow, completed, &pb.ExecutionResult{
+			ExecId: taskRow.ExecID,
+			AlgorithmResult: &pb.AlgorithmResult{
+				Algorithm: algorithm,
+				Result:    result,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dependencyResultsFor returns the results of the algorithms a node depends on
+func (e *execution) dependencyResultsFor(node dag.Node) []*pb.AlgorithmResult {
+	algoDepsResults := []*pb.AlgorithmResult{}
+	for _, algoId := range node.AlgoDepIds() {
+		if result, ok := e.resultMap.get(algoId); ok {
+			algoDepsResults = append(algoDepsResults, result.GetAlgorithmResult())
+			continue
+		}
+		algoDepsResults = append(algoDepsResults, e.dependencyResults[algoId]...)
+	}
+	return algoDepsResults
+}
+
+// executionRequest builds the request sent to a processor to execute the
+// algorithms of a task that have not already completed, along with the
+// results of the algorithms they depend on
+func (e *execution) executionRequest(
+	task dag.ProcessorTask,
+	taskRow ReadExecutionTasksRow,
+	completed map[int64]bool,
+) (*pb.ExecutionRequest, error) {
+	// build list of affected Algorithms
+	var affectedAlgorithms []*pb.Algorithm
+
+	// and their dependency's result
+	algoDepsResults := []*pb.AlgorithmResult{}
+
+	for _, node := range task.Nodes {
+		if completed[node.AlgoId()] {
+			continue
+		}
+		algo, ok := e.algorithmMap[node.AlgoId()]
+
+		if !ok {
+			slog.Error("algorithm not found", "algo_id", node.AlgoId())
+			return nil, fmt.Errorf("algorithm ID %d not found", node.AlgoId())
+		}
+
+		affectedAlgorithms = append(affectedAlgorithms, &pb.Algorithm{
+			Name:    algo.Name,
+			Version: algo.Version,
+		})
+
+		// determine which results need to be included
+		algoDepsResults = append(algoDepsResults, e.dependencyResultsFor(node)...)
+	}
+
+	return &pb.ExecutionRequest{
+		ExecId:           taskRow.ExecID,
+		Window:           e.window,
+		AlgorithmResults: algoDepsResults,
+		Algorithms:       affectedAlgorithms,
+	}, nil
+}
+
+// taskTimeout returns how long the algorithms of a task that have not already
+// completed may take, being the sum of their timeouts, as processors may
+// execute them one after another
+func (e *execution) taskTimeout(task dag.ProcessorTask, completed map[int64]bool) time.Duration {
+	defaultTimeout := envs.GetConfig().AlgorithmTimeout
+
+	var timeout time.Duration
+	for _, node := range task.Nodes {
+		if completed[node.AlgoId()] {
+			continue
+		}
+		algo := e.algorithmMap[node.AlgoId()]
+		if algo.TimeoutMs.Valid && algo.TimeoutMs.Int64 > 0 {
+			timeout += time.Duration(algo.TimeoutMs.Int64) * time.Millisecond
+		} else {
+			timeout += defaultTimeout
+		}
+	}
+	return timeout
+}
+
+// readResultMap reads the results already stored for a window, keyed by
+// algorithm ID, in the form they are passed on to dependant algorithms
+func (d *Datalayer) readResultMap(
+	ctx context.Context,
+	windowId int64,
+) (*resultMap, error) {
+	results, err := d.queries.ReadResultsForWindow(ctx, pgtype.Int8{Valid: true, Int64: windowId})
+	if err != nil {
+		return nil, fmt.Errorf("could not read results for window: %v", err)
+	}
+
+	storedResults := newResultMap(len(results))
+	for _, res := range results {
+		result := &pb.Result{
+			Status:       resultStatusToPb(res.Status),
+			ErrorMessage: res.ErrorMessage.String,
+		}
+		// failed results carry no result data
+		if res.Status == ResultStatusSucceeded {
+			err := setResultData(result, res.ResultType, res.ResultValue, res.ResultArray, res.ResultJson)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		storedResults.set(res.AlgorithmID.Int64, &pb.ExecutionResult{
+			AlgorithmResult: &pb.AlgorithmResult{
+				Algorithm: &pb.Algorithm{
+					Name:    res.Name,
+					Version: res.Version,
+				},
+				Result: result,
+			},
+		})
+	}
+	return storedResults, nil
+}
+
+// readExecutionsPb builds the state of executions, along with the state of
+// each of their algorithms and the attempts made at executing them
+func (d *Datalayer) readExecutionsPb(
+	ctx context.Context,
+	executionRows []ReadExecutionsRow,
+) ([]*pb.Execution, error) {
+	executionPlanIds := make([]int64, len(executionRows))
+	for ii, executionRow := range executionRows {
+		executionPlanIds[ii] = executionRow.ID
+	}
+
+	attemptRows, err := d.queries.ReadExecutionAttempts(ctx, executionPlanIds)
+	if err != nil {
+		return nil, fmt.Errorf("could not read execution attempts: %v", err)
+	}
+	attempts := make(map[int64][]*pb.ExecutionAttempt)
+	for _, attemptRow := range attemptRows {
+		attempts[attemptRow.ExecutionTaskID] = append(
+			attempts[attemptRow.ExecutionTaskID],
+			&pb.ExecutionAttempt{
+				Attempt:      attemptRow.Attempt,
+				Status:       executionStatusToPb(attemptRow.Status),
+				ErrorCode:    attemptRow.ErrorCode.String,
+				ErrorMessage: attemptRow.ErrorMessage.String,
+				BackoffMs:    attemptRow.BackoffMs.Int64,
+				Started:      timestampToPb(attemptRow.Started),
+				Finished:     timestampToPb(attemptRow.Finished),
+			},
+		)
+	}
 
-				resultId, err := d.queries.CreateResult(ctx, CreateResultParams{
-					WindowsID:    pgtype.Int8{Valid: true, Int64: insertedWindow.ID},
-					WindowTypeID: pgtype.Int8{Valid: true, Int64: insertedWindow.WindowTypeID},
-					AlgorithmID:  pgtype.Int8{Valid: true, Int64: int64(algoResultId)},
-					ResultValue: pgtype.Float8{
-						Valid:   true,
-						Float64: float64(result.AlgorithmResult.Result.GetSingleValue()),
+	algorithmRows, err := d.queries.ReadAlgorithmExecutions(ctx, executionPlanIds)
+	if err != nil {
+		return nil, fmt.Errorf("could not read algorithm executions: %v", err)
+	}
+	algorithms := make(map[int64][]*pb.AlgorithmExecution)
+	for _, algorithmRow := range algorithmRows {
+		algorithms[algorithmRow.ExecutionPlanID] = append(
+			algorithms[algorithmRow.ExecutionPlanID],
+			&pb.AlgorithmExecution{
+				Algorithm: &pb.Algorithm{
+					Name:    algorithmRow.Name,
+					Version: algorithmRow.Version,
+					WindowType: &pb.WindowType{
+						Name:    algorithmRow.WindowTypeName,
+						Version: algorithmRow.WindowTypeVersion,
 					},
-					ResultArray: convertFloat32ToFloat64(
-						result.AlgorithmResult.Result.GetFloatValues().GetValues(),
-					),
-					ResultJson: structResult,
-				})
-				if err != nil {
-					slog.Error("Error inserting result", "error", err)
-					return err
+				},
+				ProcessorName:    algorithmRow.ProcessorName,
+				ProcessorRuntime: algorithmRow.ProcessorRuntime,
+				Status:           executionStatusToPb(algorithmRow.Status),
+				Started:          timestampToPb(algorithmRow.Started),
+				Finished:         timestampToPb(algorithmRow.Finished),
+				ErrorMessage:     algorithmRow.ErrorMessage.String,
+				Attempts:         attempts[algorithmRow.ExecutionTaskID],
+			},
+		)
+	}
+
+	executions := make([]*pb.Execution, len(executionRows))
+	for ii, executionRow := range executionRows {
+		window := &pb.Window{
+			TimeFrom:          timestamppb.New(executionRow.TimeFrom.Time),
+			TimeTo:            timestamppb.New(executionRow.TimeTo.Time),
+			WindowTypeName:    executionRow.Name,
+			WindowTypeVersion: executionRow.Version,
+			Origin:            executionRow.Origin,
+		}
+		if len(executionRow.Metadata) > 0 {
+			metadata, err := unmarshalToStruct(executionRow.Metadata)
+			if err != nil {
+				return nil, fmt.Errorf("could not unpack window metadata: %v", err)
+			}
+			window.Metadata = metadata
+		}
+
+		executions[ii] = &pb.Execution{
+			ExecId:      executionRow.ExecID,
+			Window:      window,
+			Status:      executionStatusToPb(executionRow.Status),
+			Created:     timestampToPb(executionRow.Created),
+			Started:     timestampToPb(executionRow.Started),
+			Finished:    timestampToPb(executionRow.Finished),
+			Algorithms:  algorithms[executionRow.ID],
+			Provisional: executionRow.Provisional,
+		}
+	}
+	return executions, nil
+}
+
+// readDependencyResults reads the stored results of the dependencies of a
+// plan that are of another window type. Only successful results of windows
+// that fall within the plan's window, and whose shared metadata fields match
+// those of the plan's window, are read
+func (d *Datalayer) readDependencyResults(
+	ctx context.Context,
+	plan dag.Plan,
+	windowRow ReadExecutionPlanWindowRow,
+	algorithmMap map[int64]Algorithm,
+) (map[int64][]*pb.AlgorithmResult, error) {
+	dependencyResults := make(map[int64][]*pb.AlgorithmResult)
+
+	// algorithms of the plan's window type are produced by the plan itself
+	var algoIds []int64
+	for _, stage := range plan.Stages {
+		for _, task := range stage.Tasks {
+			for _, node := range task.Nodes {
+				for _, algoId := range node.AlgoDepIds() {
+					if algorithmMap[algoId].WindowTypeID != windowRow.WindowTypeID && !slices.Contains(algoIds, algoId) {
+						algoIds = append(algoIds, algoId)
+					}
 				}
-				slog.Info("Inserted result", "resultId", resultId)
 			}
 		}
 	}
+	if len(algoIds) == 0 {
+		return dependencyResults, nil
+	}
+
+	results, err := d.queries.ReadDependencyResults(ctx, ReadDependencyResultsParams{
+		AlgorithmIds: algoIds,
+		TimeFrom:     windowRow.TimeFrom,
+		TimeTo:       windowRow.TimeTo,
+		Metadata:     windowRow.Metadata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read dependency results: %v", err)
+	}
+
+	for _, res := range results {
+		// the timestamp identifies the window the result belongs to
+		result := &pb.Result{
+			Status:    pb.ResultStatus_RESULT_STATUS_SUCEEDED,
+			Timestamp: res.TimeFrom.Time.Unix(),
+		}
+		err := setResultData(result, res.ResultType, res.ResultValue, res.ResultArray, res.ResultJson)
+		if err != nil {
+			return nil, err
+		}
+		dependencyResults[res.AlgorithmID.Int64] = append(
+			dependencyResults[res.AlgorithmID.Int64],
+			&pb.AlgorithmResult{
+				Algorithm: &pb.Algorithm{
+					Name:    res.Name,
+					Version: res.Version,
+				},
+				Result: result,
+			},
+		)
+	}
+	return dependencyResults, nil
+}
+
+// setResultData fills a result with its stored result data
+func setResultData(
+	result *pb.Result,
+	resultType ResultType,
+	resultValue pgtype.Float8,
+	resultArray []float64,
+	resultJson []byte,
+) error {
+	switch resultType {
+	case ResultTypeValue:
+		result.ResultData = &pb.Result_SingleValue{
+			SingleValue: float32(resultValue.Float64),
+		}
+	case ResultTypeArray:
+		result.ResultData = &pb.Result_FloatValues{
+			FloatValues: &pb.FloatArray{
+				Values: convertFloat64ToFloat32(resultArray),
+			},
+		}
+	case ResultTypeStruct:
+		structResult, err := unmarshalToStruct(resultJson)
+		if err != nil {
+			return fmt.Errorf("could not unpack struct result: %v", err)
+		}
+		result.ResultData = &pb.Result_StructValue{
+			StructValue: structResult,
+		}
+	}
+	return nil
+}
+
+// resultStatusToPb converts a stored result status to its protobuf form
+func resultStatusToPb(status ResultStatus) pb.ResultStatus {
+	switch status {
+	case ResultStatusSucceeded:
+		return pb.ResultStatus_RESULT_STATUS_SUCEEDED
+	case ResultStatusHandledFailed:
+		return pb.ResultStatus_RESULT_STATUS_HANDLED_FAILED
+	default:
+		return pb.ResultStatus_RESULT_STATUS_UNHANDLED_FAILED
+	}
+}
+
+// resultStatusFromPb converts a protobuf result status to its stored form
+func resultStatusFromPb(status pb.ResultStatus) ResultStatus {
+	switch status {
+	case pb.ResultStatus_RESULT_STATUS_SUCEEDED:
+		return ResultStatusSucceeded
+	case pb.ResultStatus_RESULT_STATUS_HANDLED_FAILED:
+		return ResultStatusHandledFailed
+	default:
+		return ResultStatusUnhandledFailed
+	}
+}
+
+// executionStatusToPb converts a stored execution status to its protobuf form
+func executionStatusToPb(status ExecutionStatus) pb.ExecutionStatus {
+	switch status {
+	case ExecutionStatusPending:
+		return pb.ExecutionStatus_EXECUTION_STATUS_PENDING
+	case ExecutionStatusRunning:
+		return pb.ExecutionStatus_EXECUTION_STATUS_RUNNING
+	case ExecutionStatusSucceeded:
+		return pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED
+	case ExecutionStatusFailed:
+		return pb.ExecutionStatus_EXECUTION_STATUS_FAILED
+	case ExecutionStatusSkipped:
+		return pb.ExecutionStatus_EXECUTION_STATUS_SKIPPED
+	case ExecutionStatusCancelled:
+		return pb.ExecutionStatus_EXECUTION_STATUS_CANCELLED
+	default:
+		return pb.ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+	}
+}
+
+// executionStatusFromPb converts a protobuf execution status to its stored form
+func executionStatusFromPb(status pb.ExecutionStatus) (ExecutionStatus, error) {
+	switch status {
+	case pb.ExecutionStatus_EXECUTION_STATUS_PENDING:
+		return ExecutionStatusPending, nil
+	case pb.ExecutionStatus_EXECUTION_STATUS_RUNNING:
+		return ExecutionStatusRunning, nil
+	case pb.ExecutionStatus_EXECUTION_STATUS_SUCCEEDED:
+		return ExecutionStatusSucceeded, nil
+	case pb.ExecutionStatus_EXECUTION_STATUS_FAILED:
+		return ExecutionStatusFailed, nil
+	case pb.ExecutionStatus_EXECUTION_STATUS_SKIPPED:
+		return ExecutionStatusSkipped, nil
+	case pb.ExecutionStatus_EXECUTION_STATUS_CANCELLED:
+		return ExecutionStatusCancelled, nil
+	default:
+		return "", fmt.Errorf("execution status %v not supported", status)
+	}
+}
+
+// reprocessRowToPb converts the progress of a reprocess to its protobuf form
+func reprocessRowToPb(reprocessRow ReadReprocessRow) *pb.Reprocess {
+	return &pb.Reprocess{
+		ReprocessId:      reprocessRow.ReprocessID,
+		Status:           executionStatusToPb(reprocessRow.Status),
+		TotalWindows:     reprocessRow.TotalWindows,
+		TriggeredWindows: reprocessRow.TriggeredWindows,
+		SucceededWindows: int32(reprocessRow.SucceededWindows),
+		FailedWindows:    int32(reprocessRow.FailedWindows),
+		Created:          timestampToPb(reprocessRow.Created),
+		Finished:         timestampToPb(reprocessRow.Finished),
+		ErrorMessage:     reprocessRow.ErrorMessage.String,
+	}
+}
+
+// timestampToPb converts a stored timestamp, which may be null, to a protobuf timestamp
+func timestampToPb(timestamp pgtype.Timestamp) *timestamppb.Timestamp {
+	if !timestamp.Valid {
+		return nil
+	}
+	return timestamppb.New(timestamp.Time)
+}
+
+// windowRowToPb converts a stored window back into the window that was emitted
+func windowRowToPb(windowRow ReadExecutionPlanWindowRow) (*pb.Window, error) {
+	window := &pb.Window{
+		TimeFrom:          timestamppb.New(windowRow.TimeFrom.Time),
+		TimeTo:            timestamppb.New(windowRow.TimeTo.Time),
+		WindowTypeName:    windowRow.Name,
+		WindowTypeVersion: windowRow.Version,
+		Origin:            windowRow.Origin,
+	}
+	if len(windowRow.Metadata) > 0 {
+		metadata, err := unmarshalToStruct(windowRow.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		window.Metadata = metadata
+	}
+	return window, nil
+}
+
+// resultUpdateToPb converts a result read for subscribers to its protobuf form
+func resultUpdateToPb(row ReadResultsAfterRow) (*pb.ResultUpdate, error) {
+	window := &pb.Window{
+		TimeFrom:          timestamppb.New(row.TimeFrom.Time),
+		TimeTo:            timestamppb.New(row.TimeTo.Time),
+		WindowTypeName:    row.WindowTypeName,
+		WindowTypeVersion: row.WindowTypeVersion,
+		Origin:            row.Origin,
+	}
+	if len(row.Metadata) > 0 {
+		metadata, err := unmarshalToStruct(row.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		window.Metadata = metadata
+	}
+
+	result := &pb.Result{
+		Status:       resultStatusToPb(row.Status),
+		ErrorMessage: row.ErrorMessage.String,
+	}
+	if row.Status == ResultStatusSucceeded {
+		err := setResultData(result, row.ResultType, row.ResultValue, row.ResultArray, row.ResultJson)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ResultUpdate{
+		Cursor: row.Seq,
+		Window: window,
+		AlgorithmResult: &pb.AlgorithmResult{
+			Algorithm: &pb.Algorithm{
+				Name:    row.AlgorithmName,
+				Version: row.AlgorithmVersion,
+			},
+			Result: result,
+		},
+	}, nil
+}
+
+// checkRequiredMetadata checks that a window's metadata carries each of the
+// metadata fields of its window type
+func checkRequiredMetadata(
+	metadataBytes []byte,
+	metadataFields []ReadMetadataFieldsByWindowTypeRow,
+) error {
+	if len(metadataFields) == 0 {
+		return nil
+	}
+
+	var metadataMap map[string]any
+	if err := json.Unmarshal(metadataBytes, &metadataMap); err != nil {
+		return fmt.Errorf("could not unmarshal metadata for validation: %v", err)
+	}
+
+	for _, mDataField := range metadataFields {
+		fieldName := mDataField.MetadataFieldName
+		if _, exists := metadataMap[fieldName]; !exists {
+			return fmt.Errorf("required metadata field '%s' is missing", fieldName)
+		}
+	}
 	return nil
 }
 
+// windowDedupeKey identifies emissions of the same window, which are
+// serialised so that concurrent retries cannot both be inserted
+func windowDedupeKey(window *pb.Window) string {
+	if window.GetIdempotencyKey() != "" {
+		return fmt.Sprintf(
+			"%s/%s/%s",
+			window.GetWindowTypeName(),
+			window.GetWindowTypeVersion(),
+			window.GetIdempotencyKey(),
+		)
+	}
+	// metadata is encoded with sorted keys, so that it is compared as the
+	// duplicate lookup compares it. Metadata that cannot be encoded only
+	// makes the key coarser
+	metadata, _ := json.Marshal(window.GetMetadata().AsMap())
+	return fmt.Sprintf(
+		"%s/%s/%d/%d/%s/%s",
+		window.GetWindowTypeName(),
+		window.GetWindowTypeVersion(),
+		window.GetTimeFrom().AsTime().UnixNano(),
+		window.GetTimeTo().AsTime().UnixNano(),
+		window.GetOrigin(),
+		metadata,
+	)
+}
+
+// newExecId generates a unique execution ID
+func newExecId() string {
+	execUuid := uuid.New()
+	return strings.ReplaceAll(execUuid.String(), "-", "")
+}
+
 func convertFloat32ToFloat64(float32Slice []float32) []float64 {
 	float64Slice := make([]float64, len(float32Slice))
 	for i, value := range float32Slice {
@@ -1,10 +1,14 @@
 package envs
 
 import (
+	"log/slog"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
 )
 
 type Config struct {
@@ -13,6 +17,64 @@ type Config struct {
 	Port             int
 	Platform         string
 	LogLevel         string
+	RetryPolicy      RetryPolicy
+
+	// how long a pooled processor connection may go unused before it is closed
+	ProcessorConnIdleTimeout time.Duration
+
+	// the longest a processor may take to execute an algorithm that does not
+	// declare its own timeout
+	AlgorithmTimeout time.Duration
+
+	// how many executions are processed at once, and how many more can wait
+	// for a worker before emitted windows are rejected
+	ExecutionWorkers   int
+	ExecutionQueueSize int
+
+	// the most stored windows a reprocess triggers per second, when the
+	// reprocess does not set its own rate
+	ReprocessRate float64
+
+	// whether a result that is written again for the same window and
+	// algorithm keeps the value it replaces as a revision
+	KeepResultRevisions bool
+
+	// how tasks are spread between the instances of a processor
+	DispatchPolicy DispatchPolicy
+
+	// how often every processor instance is health checked, and how long an
+	// instance can go without responding before it is marked unavailable
+	HeartbeatInterval         time.Duration
+	ProcessorUnavailableAfter time.Duration
+
+	// how often window schedules are checked for windows that are due
+	ScheduleInterval time.Duration
+
+	// how long an unfinished execution plan stays leased to the instance
+	// running it without being renewed, before another instance takes it over
+	ExecutionLease time.Duration
+}
+
+// DispatchPolicy decides which instance of a processor a task is sent to
+type DispatchPolicy string
+
+const (
+	// DispatchLeastLoaded sends tasks to the instance with the fewest active
+	// tasks, then the lowest CPU usage
+	DispatchLeastLoaded DispatchPolicy = "least_loaded"
+
+	// DispatchRoundRobin sends tasks to each instance in turn
+	DispatchRoundRobin DispatchPolicy = "round_robin"
+)
+
+// RetryPolicy defines how failed calls to processors are retried
+type RetryPolicy struct {
+	MaxAttempts       int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+	Jitter            float64
+	RetryableCodes    []codes.Code
 }
 
 var (
@@ -51,9 +113,162 @@ func loadConfig() *Config {
 
 	config.Platform = inferPlatformFromConnectionString(config.ConnectionString)
 
+	config.RetryPolicy = loadRetryPolicy()
+
+	config.ProcessorConnIdleTimeout = 5 * time.Minute
+	if idleTimeoutStr := os.Getenv("ORCA_PROCESSOR_CONN_IDLE_TIMEOUT"); idleTimeoutStr != "" {
+		if parsed, err := time.ParseDuration(idleTimeoutStr); err == nil && parsed > 0 {
+			config.ProcessorConnIdleTimeout = parsed
+		}
+	}
+
+	config.AlgorithmTimeout = 10 * time.Minute
+	if timeoutStr := os.Getenv("ORCA_ALGORITHM_TIMEOUT"); timeoutStr != "" {
+		if parsed, err := time.ParseDuration(timeoutStr); err == nil && parsed > 0 {
+			config.AlgorithmTimeout = parsed
+		}
+	}
+
+	config.ExecutionWorkers = 20
+	if workersStr := os.Getenv("ORCA_EXECUTION_WORKERS"); workersStr != "" {
+		if parsed, err := strconv.Atoi(workersStr); err == nil && parsed > 0 {
+			config.ExecutionWorkers = parsed
+		}
+	}
+
+	config.ExecutionQueueSize = 1000
+	if queueSizeStr := os.Getenv("ORCA_EXECUTION_QUEUE_SIZE"); queueSizeStr != "" {
+		if parsed, err := strconv.Atoi(queueSizeStr); err == nil && parsed >= 0 {
+			config.ExecutionQueueSize = parsed
+		}
+	}
+
+	config.ReprocessRate = 10
+	if rateStr := os.Getenv("ORCA_REPROCESS_RATE"); rateStr != "" {
+		if parsed, err := strconv.ParseFloat(rateStr, 64); err == nil && parsed > 0 {
+			config.ReprocessRate = parsed
+		}
+	}
+
+	switch policy := strings.ToLower(os.Getenv("ORCA_RESULT_WRITE_POLICY")); policy {
+	case "", "overwrite":
+	case "revision":
+		config.KeepResultRevisions = true
+	default:
+		slog.Warn("ignoring unrecognised result write policy", "policy", policy)
+	}
+
+	config.DispatchPolicy = DispatchLeastLoaded
+	switch policy := DispatchPolicy(strings.ToLower(os.Getenv("ORCA_DISPATCH_POLICY"))); policy {
+	case "":
+	case DispatchLeastLoaded, DispatchRoundRobin:
+		config.DispatchPolicy = policy
+	default:
+		slog.Warn("ignoring unrecognised dispatch policy", "policy", policy)
+	}
+
+	config.HeartbeatInterval = 30 * time.Second
+	if intervalStr := os.Getenv("ORCA_HEARTBEAT_INTERVAL"); intervalStr != "" {
+		if parsed, err := time.ParseDuration(intervalStr); err == nil && parsed > 0 {
+			config.HeartbeatInterval = parsed
+		}
+	}
+
+	config.ProcessorUnavailableAfter = 2 * time.Minute
+	if unavailableStr := os.Getenv("ORCA_PROCESSOR_UNAVAILABLE_AFTER"); unavailableStr != "" {
+		if parsed, err := time.ParseDuration(unavailableStr); err == nil && parsed > 0 {
+			config.ProcessorUnavailableAfter = parsed
+		}
+	}
+
+	config.ScheduleInterval = 10 * time.Second
+	if intervalStr := os.Getenv("ORCA_SCHEDULE_INTERVAL"); intervalStr != "" {
+		if parsed, err := time.ParseDuration(intervalStr); err == nil && parsed > 0 {
+			config.ScheduleInterval = parsed
+		}
+	}
+
+	config.ExecutionLease = time.Minute
+	if leaseStr := os.Getenv("ORCA_EXECUTION_LEASE"); leaseStr != "" {
+		if parsed, err := time.ParseDuration(leaseStr); err == nil && parsed > 0 {
+			config.ExecutionLease = parsed
+		}
+	}
+
 	return config
 }
 
+// loadRetryPolicy loads the global processor retry policy from environment variables
+func loadRetryPolicy() RetryPolicy {
+	policy := RetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    500 * time.Millisecond,
+		MaxBackoff:        10 * time.Second,
+		BackoffMultiplier: 2,
+		Jitter:            0.2,
+		RetryableCodes: []codes.Code{
+			codes.Unavailable,
+			codes.ResourceExhausted,
+			codes.Aborted,
+		},
+	}
+
+	if maxAttemptsStr := os.Getenv("ORCA_RETRY_MAX_ATTEMPTS"); maxAttemptsStr != "" {
+		if parsed, err := strconv.Atoi(maxAttemptsStr); err == nil && parsed > 0 {
+			policy.MaxAttempts = parsed
+		}
+	}
+
+	if backoffStr := os.Getenv("ORCA_RETRY_INITIAL_BACKOFF"); backoffStr != "" {
+		if parsed, err := time.ParseDuration(backoffStr); err == nil && parsed >= 0 {
+			policy.InitialBackoff = parsed
+		}
+	}
+
+	if backoffStr := os.Getenv("ORCA_RETRY_MAX_BACKOFF"); backoffStr != "" {
+		if parsed, err := time.ParseDuration(backoffStr); err == nil && parsed >= 0 {
+			policy.MaxBackoff = parsed
+		}
+	}
+
+	if multiplierStr := os.Getenv("ORCA_RETRY_BACKOFF_MULTIPLIER"); multiplierStr != "" {
+		if parsed, err := strconv.ParseFloat(multiplierStr, 64); err == nil && parsed >= 1 {
+			policy.BackoffMultiplier = parsed
+		}
+	}
+
+	if jitterStr := os.Getenv("ORCA_RETRY_JITTER"); jitterStr != "" {
+		if parsed, err := strconv.ParseFloat(jitterStr, 64); err == nil && parsed >= 0 && parsed <= 1 {
+			policy.Jitter = parsed
+		}
+	}
+
+	if codesStr := os.Getenv("ORCA_RETRY_CODES"); codesStr != "" {
+		policy.RetryableCodes = ParseCodes(strings.Split(codesStr, ","))
+	}
+
+	return policy
+}
+
+// ParseCodes converts gRPC status code names, e.g. "UNAVAILABLE", to codes.
+// Unrecognised names are logged and ignored
+func ParseCodes(names []string) []codes.Code {
+	var parsed []codes.Code
+	for _, name := range names {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		var code codes.Code
+		if err := code.UnmarshalJSON([]byte(strconv.Quote(name))); err != nil {
+			slog.Warn("ignoring unrecognised gRPC status code", "code", name)
+			continue
+		}
+		parsed = append(parsed, code)
+	}
+	return parsed
+}
+
 // ReloadConfig forces a reload of the configuration
 func ReloadConfig() *Config {
 	configOnce = sync.Once{}
@@ -2,13 +2,18 @@ package internal
 
 import (
 	"context"
+	"errors"
+	"io"
 	"log/slog"
+	"sync/atomic"
 
 	"github.com/bufbuild/protovalidate-go"
 	dlyr "github.com/orc-analytics/orca/core/internal/datalayers"
 	types "github.com/orc-analytics/orca/core/internal/types"
 	pb "github.com/orc-analytics/orca/core/protobufs/go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -20,11 +25,17 @@ type (
 )
 
 var (
+	// the most processors that can be subscribed for tasks at once.
+	// Executions are bounded separately, by ORCA_EXECUTION_WORKERS
 	MAX_PROCESSORS = 20
-	processors     = make(
-		[]grpc.ServerStreamingServer[pb.ProcessingTask],
-		MAX_PROCESSORS,
-	)
+
+	// the most windows received through EmitWindowStream that are emitted
+	// together in a batch
+	WINDOW_EMIT_BATCH_SIZE = 1000
+
+	// the number of processors subscribed for tasks, of which there can be no
+	// more than MAX_PROCESSORS
+	subscribedProcessors atomic.Int32
 )
 
 // NewServer produces a new ORCA gRPC server
@@ -46,6 +57,13 @@ func NewServer(
 		return nil, err
 	}
 
+	// pick up any processing left unfinished by a previous run
+	err = client.ResumeExecutions(ctx)
+	if err != nil {
+		slog.Error("Could not resume unfinished executions", "error", err)
+		return nil, err
+	}
+
 	s := &OrcaCoreServer{
 		client: client,
 	}
@@ -103,6 +121,283 @@ func (o *OrcaCoreServer) EmitWindow(
 	return &windowEmitStatus, err
 }
 
+func (o *OrcaCoreServer) EmitWindows(
+	ctx context.Context,
+	windowsEmit *pb.WindowsEmit,
+) (*pb.WindowsEmitStatus, error) {
+	slog.Info("emitting windows", "count", len(windowsEmit.GetWindows()))
+	return o.emitWindows(ctx, windowsEmit.GetWindows())
+}
+
+func (o *OrcaCoreServer) EmitWindowStream(
+	stream grpc.ClientStreamingServer[pb.Window, pb.WindowsEmitStatus],
+) error {
+	statuses := []*pb.WindowEmitStatus{}
+	windows := make([]*pb.Window, 0, WINDOW_EMIT_BATCH_SIZE)
+
+	// windows are emitted in batches as they are received, rather than being
+	// held until the stream is closed
+	emit := func() error {
+		if len(windows) == 0 {
+			return nil
+		}
+		slog.Info("emitting streamed windows", "count", len(windows))
+		windowsEmitStatus, err := o.emitWindows(stream.Context(), windows)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, windowsEmitStatus.GetStatuses()...)
+		windows = windows[:0]
+		return nil
+	}
+
+	for {
+		window, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		windows = append(windows, window)
+		if len(windows) == WINDOW_EMIT_BATCH_SIZE {
+			if err := emit(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := emit(); err != nil {
+		return err
+	}
+	return stream.SendAndClose(&pb.WindowsEmitStatus{Statuses: statuses})
+}
+
+func (o *OrcaCoreServer) OpenWindow(
+	ctx context.Context,
+	windowOpen *pb.WindowOpen,
+) (*pb.WindowEmitStatus, error) {
+	err := validate(windowOpen)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info(
+		"opening window",
+		"window_type",
+		windowOpen.GetWindowTypeName(),
+		"idempotency_key",
+		windowOpen.GetIdempotencyKey(),
+	)
+	return o.client.OpenWindow(ctx, windowOpen)
+}
+
+func (o *OrcaCoreServer) ExtendWindow(
+	ctx context.Context,
+	windowExtension *pb.WindowExtension,
+) (*pb.WindowEmitStatus, error) {
+	err := validate(windowExtension)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug(
+		"extending window",
+		"window_type",
+		windowExtension.GetWindowTypeName(),
+		"idempotency_key",
+		windowExtension.GetIdempotencyKey(),
+	)
+	return o.client.ExtendWindow(ctx, windowExtension)
+}
+
+func (o *OrcaCoreServer) CloseWindow(
+	ctx context.Context,
+	windowClosure *pb.WindowClosure,
+) (*pb.WindowEmitStatus, error) {
+	err := validate(windowClosure)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info(
+		"closing window",
+		"window_type",
+		windowClosure.GetWindowTypeName(),
+		"idempotency_key",
+		windowClosure.GetIdempotencyKey(),
+	)
+	return o.client.CloseWindow(ctx, windowClosure)
+}
+
+func (o *OrcaCoreServer) AmendWindow(
+	ctx context.Context,
+	windowAmendment *pb.WindowAmendment,
+) (*pb.WindowEmitStatus, error) {
+	err := validate(windowAmendment)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info(
+		"amending window",
+		"window_type",
+		windowAmendment.GetWindow().GetWindowTypeName(),
+		"time_from",
+		windowAmendment.GetWindow().GetTimeFrom().AsTime(),
+		"time_to",
+		windowAmendment.GetWindow().GetTimeTo().AsTime(),
+	)
+	return o.client.AmendWindow(ctx, windowAmendment)
+}
+
+// emitWindows validates each window on its own, so that an invalid window
+// fails without failing the rest, and emits those that are valid as a batch
+func (o *OrcaCoreServer) emitWindows(
+	ctx context.Context,
+	windows []*pb.Window,
+) (*pb.WindowsEmitStatus, error) {
+	v, err := protovalidate.New()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*pb.WindowEmitStatus, len(windows))
+	validWindows := make([]*pb.Window, 0, len(windows))
+	validIndices := make([]int, 0, len(windows))
+	for index, window := range windows {
+		if err := v.Validate(window); err != nil {
+			statuses[index] = &pb.WindowEmitStatus{
+				Status: pb.WindowEmitStatus_TRIGGERING_FAILED,
+				Error:  err.Error(),
+			}
+			continue
+		}
+		validWindows = append(validWindows, window)
+		validIndices = append(validIndices, index)
+	}
+
+	if len(validWindows) > 0 {
+		emitStatuses, err := o.client.EmitWindows(ctx, validWindows)
+		if err != nil {
+			return nil, err
+		}
+		for ii, emitStatus := range emitStatuses {
+			statuses[validIndices[ii]] = emitStatus
+		}
+	}
+	return &pb.WindowsEmitStatus{Statuses: statuses}, nil
+}
+
+func (o *OrcaCoreServer) DeregisterProcessor(
+	ctx context.Context,
+	processorDeregistration *pb.ProcessorDeregistration,
+) (*pb.Status, error) {
+	err := validate(processorDeregistration)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info(
+		"deregistering processor",
+		"name",
+		processorDeregistration.GetName(),
+		"runtime",
+		processorDeregistration.GetRuntime(),
+	)
+	err = o.client.DeregisterProcessor(ctx, processorDeregistration)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully deregistered processor",
+	}, nil
+}
+
+func (o *OrcaCoreServer) RetireAlgorithm(
+	ctx context.Context,
+	algorithmRetirement *pb.AlgorithmRetirement,
+) (*pb.Status, error) {
+	err := validate(algorithmRetirement)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info(
+		"retiring algorithm",
+		"name",
+		algorithmRetirement.GetName(),
+		"version",
+		algorithmRetirement.GetVersion(),
+	)
+	err = o.client.RetireAlgorithm(ctx, algorithmRetirement)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully retired algorithm",
+	}, nil
+}
+
+func (o *OrcaCoreServer) RemoveAlgorithmDependency(
+	ctx context.Context,
+	algorithmDependencyRemoval *pb.AlgorithmDependencyRemoval,
+) (*pb.Status, error) {
+	err := validate(algorithmDependencyRemoval)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.RemoveAlgorithmDependency(ctx, algorithmDependencyRemoval)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully removed algorithm dependency",
+	}, nil
+}
+
+func (o *OrcaCoreServer) SubscribeTasks(
+	taskSubscription *pb.TaskSubscription,
+	stream grpc.ServerStreamingServer[pb.ProcessingTask],
+) error {
+	err := validate(taskSubscription)
+	if err != nil {
+		return err
+	}
+	if subscribedProcessors.Add(1) > int32(MAX_PROCESSORS) {
+		subscribedProcessors.Add(-1)
+		return status.Errorf(
+			codes.ResourceExhausted,
+			"no more than %v processors can subscribe for tasks",
+			MAX_PROCESSORS,
+		)
+	}
+	defer subscribedProcessors.Add(-1)
+
+	slog.Info(
+		"processor subscribed for tasks",
+		"name",
+		taskSubscription.GetName(),
+		"runtime",
+		taskSubscription.GetRuntime(),
+	)
+	return o.client.SubscribeTasks(stream.Context(), taskSubscription, stream.Send)
+}
+
+func (o *OrcaCoreServer) SubmitResult(
+	ctx context.Context,
+	taskResult *pb.TaskResult,
+) (*pb.Status, error) {
+	err := validate(taskResult)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.SubmitResult(ctx, taskResult)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully submitted result",
+	}, nil
+}
+
 // -------------------------- Data Operations --------------------------
 func (o *OrcaCoreServer) ReadWindowTypes(
 	ctx context.Context,
@@ -174,6 +469,17 @@ func (o *OrcaCoreServer) ReadResultsForAlgorithmAndMetadata(
 	return o.client.ReadResultsForAlgorithmAndMetadata(ctx, resultsForAlgorithmAndMetadata)
 }
 
+func (o *OrcaCoreServer) SubscribeResults(
+	resultsSubscription *pb.ResultsSubscription,
+	stream grpc.ServerStreamingServer[pb.ResultUpdate],
+) error {
+	err := validate(resultsSubscription)
+	if err != nil {
+		return err
+	}
+	return o.client.SubscribeResults(stream.Context(), resultsSubscription, stream.Send)
+}
+
 // ---------------------- Labelling Operations ----------------------
 func (o *OrcaCoreServer) Annotate(
 	ctx context.Context,
@@ -181,3 +487,280 @@ func (o *OrcaCoreServer) Annotate(
 ) (*pb.AnnotateResponse, error) {
 	return o.client.Annotate(ctx, annotateWrite)
 }
+
+// ----------------------- Schedule Operations -----------------------
+func (o *OrcaCoreServer) CreateWindowSchedule(
+	ctx context.Context,
+	windowSchedule *pb.WindowSchedule,
+) (*pb.Status, error) {
+	err := validate(windowSchedule)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info(
+		"creating window schedule",
+		"name",
+		windowSchedule.GetName(),
+		"cron",
+		windowSchedule.GetCron(),
+	)
+	err = o.client.CreateWindowSchedule(ctx, windowSchedule)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully created window schedule",
+	}, nil
+}
+
+func (o *OrcaCoreServer) ReadWindowSchedules(
+	ctx context.Context,
+	windowSchedulesReadStub *pb.WindowSchedulesRead,
+) (*pb.WindowSchedules, error) {
+	return o.client.ReadWindowSchedules(ctx)
+}
+
+func (o *OrcaCoreServer) DeleteWindowSchedule(
+	ctx context.Context,
+	windowScheduleDeletion *pb.WindowScheduleDeletion,
+) (*pb.Status, error) {
+	err := validate(windowScheduleDeletion)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.DeleteWindowSchedule(ctx, windowScheduleDeletion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully deleted window schedule",
+	}, nil
+}
+
+func (o *OrcaCoreServer) CreateRollupRule(
+	ctx context.Context,
+	rollupRule *pb.RollupRule,
+) (*pb.Status, error) {
+	err := validate(rollupRule)
+	if err != nil {
+		return nil, err
+	}
+	slog.Info(
+		"creating roll-up rule",
+		"name",
+		rollupRule.GetName(),
+		"cron",
+		rollupRule.GetCron(),
+	)
+	err = o.client.CreateRollupRule(ctx, rollupRule)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully created roll-up rule",
+	}, nil
+}
+
+func (o *OrcaCoreServer) ReadRollupRules(
+	ctx context.Context,
+	rollupRulesReadStub *pb.RollupRulesRead,
+) (*pb.RollupRules, error) {
+	return o.client.ReadRollupRules(ctx)
+}
+
+func (o *OrcaCoreServer) DeleteRollupRule(
+	ctx context.Context,
+	rollupRuleDeletion *pb.RollupRuleDeletion,
+) (*pb.Status, error) {
+	err := validate(rollupRuleDeletion)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.DeleteRollupRule(ctx, rollupRuleDeletion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully deleted roll-up rule",
+	}, nil
+}
+
+func (o *OrcaCoreServer) ReadChildWindows(
+	ctx context.Context,
+	childWindowsRead *pb.ChildWindowsRead,
+) (*pb.Windows, error) {
+	err := validate(childWindowsRead)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.ReadChildWindows(ctx, childWindowsRead)
+}
+
+// ------------------------ Alert Operations ------------------------
+func (o *OrcaCoreServer) CreateAlertRule(
+	ctx context.Context,
+	alertRule *pb.AlertRule,
+) (*pb.Status, error) {
+	err := validate(alertRule)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.CreateAlertRule(ctx, alertRule)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully created alert rule",
+	}, nil
+}
+
+func (o *OrcaCoreServer) ReadAlertRules(
+	ctx context.Context,
+	alertRulesReadStub *pb.AlertRulesRead,
+) (*pb.AlertRules, error) {
+	return o.client.ReadAlertRules(ctx)
+}
+
+func (o *OrcaCoreServer) DeleteAlertRule(
+	ctx context.Context,
+	alertRuleDeletion *pb.AlertRuleDeletion,
+) (*pb.Status, error) {
+	err := validate(alertRuleDeletion)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.DeleteAlertRule(ctx, alertRuleDeletion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully deleted alert rule",
+	}, nil
+}
+
+func (o *OrcaCoreServer) ReadAlerts(
+	ctx context.Context,
+	alertsRead *pb.AlertsRead,
+) (*pb.Alerts, error) {
+	err := validate(alertsRead)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.ReadAlerts(ctx, alertsRead)
+}
+
+func (o *OrcaCoreServer) AcknowledgeAlert(
+	ctx context.Context,
+	alertAcknowledgement *pb.AlertAcknowledgement,
+) (*pb.Status, error) {
+	err := validate(alertAcknowledgement)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.AcknowledgeAlert(ctx, alertAcknowledgement)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully acknowledged alert",
+	}, nil
+}
+
+// ---------------------- Execution Operations ----------------------
+func (o *OrcaCoreServer) ReadExecution(
+	ctx context.Context,
+	executionRead *pb.ExecutionRead,
+) (*pb.Execution, error) {
+	err := validate(executionRead)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.ReadExecution(ctx, executionRead)
+}
+
+func (o *OrcaCoreServer) ReadExecutions(
+	ctx context.Context,
+	executionsRead *pb.ExecutionsRead,
+) (*pb.Executions, error) {
+	err := validate(executionsRead)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.ReadExecutions(ctx, executionsRead)
+}
+
+func (o *OrcaCoreServer) CancelExecution(
+	ctx context.Context,
+	executionCancel *pb.ExecutionCancel,
+) (*pb.Status, error) {
+	err := validate(executionCancel)
+	if err != nil {
+		return nil, err
+	}
+	err = o.client.CancelExecution(ctx, executionCancel)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{
+		Received: true,
+		Message:  "Successfully cancelled execution",
+	}, nil
+}
+
+func (o *OrcaCoreServer) ReadExecutionQueue(
+	ctx context.Context,
+	executionQueueReadStub *pb.ExecutionQueueRead,
+) (*pb.ExecutionQueue, error) {
+	return o.client.ReadExecutionQueue(ctx)
+}
+
+func (o *OrcaCoreServer) ReprocessWindows(
+	ctx context.Context,
+	windowsReprocess *pb.WindowsReprocess,
+) (*pb.Reprocess, error) {
+	err := validate(windowsReprocess)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.ReprocessWindows(ctx, windowsReprocess)
+}
+
+func (o *OrcaCoreServer) ReadReprocess(
+	ctx context.Context,
+	reprocessRead *pb.ReprocessRead,
+) (*pb.Reprocess, error) {
+	err := validate(reprocessRead)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.ReadReprocess(ctx, reprocessRead)
+}
+
+func (o *OrcaCoreServer) ReadFailedExecutions(
+	ctx context.Context,
+	failedExecutionsRead *pb.FailedExecutionsRead,
+) (*pb.FailedExecutions, error) {
+	err := validate(failedExecutionsRead)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.ReadFailedExecutions(ctx, failedExecutionsRead)
+}
+
+func (o *OrcaCoreServer) RequeueFailedExecutions(
+	ctx context.Context,
+	failedExecutionsRequeue *pb.FailedExecutionsRequeue,
+) (*pb.RequeuedExecutions, error) {
+	err := validate(failedExecutionsRequeue)
+	if err != nil {
+		return nil, err
+	}
+	return o.client.RequeueFailedExecutions(ctx, failedExecutionsRequeue)
+}
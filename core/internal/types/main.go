@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	pb "github.com/orc-analytics/orca/core/protobufs/go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // the interface that all datalayers must implement to be compatible with Orca
@@ -19,6 +21,21 @@ type (
 		// Core level operations
 		RegisterProcessor(ctx context.Context, proc *pb.ProcessorRegistration) error
 		EmitWindow(ctx context.Context, window *pb.Window) (pb.WindowEmitStatus, error)
+		EmitWindows(ctx context.Context, windows []*pb.Window) ([]*pb.WindowEmitStatus, error)
+		OpenWindow(ctx context.Context, windowOpen *pb.WindowOpen) (*pb.WindowEmitStatus, error)
+		ExtendWindow(ctx context.Context, windowExtension *pb.WindowExtension) (*pb.WindowEmitStatus, error)
+		CloseWindow(ctx context.Context, windowClosure *pb.WindowClosure) (*pb.WindowEmitStatus, error)
+		AmendWindow(ctx context.Context, windowAmendment *pb.WindowAmendment) (*pb.WindowEmitStatus, error)
+		ResumeExecutions(ctx context.Context) error
+		DeregisterProcessor(ctx context.Context, processorDeregistration *pb.ProcessorDeregistration) error
+		RetireAlgorithm(ctx context.Context, algorithmRetirement *pb.AlgorithmRetirement) error
+		RemoveAlgorithmDependency(ctx context.Context, algorithmDependencyRemoval *pb.AlgorithmDependencyRemoval) error
+		SubscribeTasks(
+			ctx context.Context,
+			taskSubscription *pb.TaskSubscription,
+			send func(*pb.ProcessingTask) error,
+		) error
+		SubmitResult(ctx context.Context, taskResult *pb.TaskResult) error
 
 		// Data level operations
 		ReadWindowTypes(ctx context.Context) (*pb.WindowTypes, error)
@@ -46,7 +63,38 @@ type (
 			ctx context.Context,
 			windowsForMetadataRead *pb.WindowsForMetadataRead,
 		) (*pb.WindowsForMetadata, error)
+		SubscribeResults(
+			ctx context.Context,
+			resultsSubscription *pb.ResultsSubscription,
+			send func(*pb.ResultUpdate) error,
+		) error
 		Annotate(ctx context.Context, annotateWrite *pb.AnnotateWrite) (*pb.AnnotateResponse, error)
+
+		// Schedule level operations
+		CreateWindowSchedule(ctx context.Context, windowSchedule *pb.WindowSchedule) error
+		ReadWindowSchedules(ctx context.Context) (*pb.WindowSchedules, error)
+		DeleteWindowSchedule(ctx context.Context, windowScheduleDeletion *pb.WindowScheduleDeletion) error
+		CreateRollupRule(ctx context.Context, rollupRule *pb.RollupRule) error
+		ReadRollupRules(ctx context.Context) (*pb.RollupRules, error)
+		DeleteRollupRule(ctx context.Context, rollupRuleDeletion *pb.RollupRuleDeletion) error
+		ReadChildWindows(ctx context.Context, childWindowsRead *pb.ChildWindowsRead) (*pb.Windows, error)
+
+		// Alert level operations
+		CreateAlertRule(ctx context.Context, alertRule *pb.AlertRule) error
+		ReadAlertRules(ctx context.Context) (*pb.AlertRules, error)
+		DeleteAlertRule(ctx context.Context, alertRuleDeletion *pb.AlertRuleDeletion) error
+		ReadAlerts(ctx context.Context, alertsRead *pb.AlertsRead) (*pb.Alerts, error)
+		AcknowledgeAlert(ctx context.Context, alertAcknowledgement *pb.AlertAcknowledgement) error
+
+		// Execution level operations
+		ReadExecution(ctx context.Context, executionRead *pb.ExecutionRead) (*pb.Execution, error)
+		ReadExecutions(ctx context.Context, executionsRead *pb.ExecutionsRead) (*pb.Executions, error)
+		CancelExecution(ctx context.Context, executionCancel *pb.ExecutionCancel) error
+		ReadExecutionQueue(ctx context.Context) (*pb.ExecutionQueue, error)
+		ReprocessWindows(ctx context.Context, windowsReprocess *pb.WindowsReprocess) (*pb.Reprocess, error)
+		ReadReprocess(ctx context.Context, reprocessRead *pb.ReprocessRead) (*pb.Reprocess, error)
+		ReadFailedExecutions(ctx context.Context, failedExecutionsRead *pb.FailedExecutionsRead) (*pb.FailedExecutions, error)
+		RequeueFailedExecutions(ctx context.Context, failedExecutionsRequeue *pb.FailedExecutionsRequeue) (*pb.RequeuedExecutions, error)
 	}
 )
 
@@ -55,6 +103,73 @@ var (
 	AlgorithmExistsUnderDifferentProcessor = fmt.Errorf(
 		"algorithm exists under a different processor",
 	)
+	ExecutionNotFound = fmt.Errorf(
+		"execution not found",
+	)
+	ExecutionFinished = fmt.Errorf(
+		"execution has already finished",
+	)
+	ReprocessNotFound = fmt.Errorf(
+		"reprocess not found",
+	)
+	AlgorithmNotFound = fmt.Errorf(
+		"algorithm not found",
+	)
+	ProcessorNotFound = fmt.Errorf(
+		"processor not found",
+	)
+	AlgorithmDependencyNotFound = fmt.Errorf(
+		"algorithm dependency not found",
+	)
+	AlgorithmHasDependents = fmt.Errorf(
+		"algorithm has live dependents",
+	)
+	AlgorithmExecutionsInProgress = fmt.Errorf(
+		"executions of algorithm are in progress",
+	)
+	AlertRuleNotFound = fmt.Errorf(
+		"alert rule not found",
+	)
+	InvalidAlertRule = fmt.Errorf(
+		"invalid alert rule",
+	)
+	AlertNotFound = fmt.Errorf(
+		"alert not found",
+	)
+	TaskNotFound = fmt.Errorf(
+		"task not found",
+	)
+	WindowTypeNotFound = fmt.Errorf(
+		"window type not found",
+	)
+	WindowScheduleNotFound = fmt.Errorf(
+		"window schedule not found",
+	)
+	InvalidWindowSchedule = fmt.Errorf(
+		"invalid window schedule",
+	)
+	RollupRuleNotFound = fmt.Errorf(
+		"roll-up rule not found",
+	)
+	InvalidRollupRule = fmt.Errorf(
+		"invalid roll-up rule",
+	)
+	WindowNotFound = fmt.Errorf(
+		"window not found",
+	)
+	WindowNotOpen = fmt.Errorf(
+		"window is not open",
+	)
+	InvalidWindowEnd = fmt.Errorf(
+		"invalid window end",
+	)
+	InvalidWindowAmendment = fmt.Errorf(
+		"invalid window amendment",
+	)
+	ExecutionQueueFull = status.Error(
+		codes.ResourceExhausted,
+		"execution queue is full",
+	)
 )
 
 type CircularDependencyError struct {
@@ -140,6 +140,196 @@ func (ResultStatus) EnumDescriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{1}
 }
 
+// ConnectionState is the state of orca-core's pooled connection to a processor
+type ConnectionState int32
+
+const (
+	// placeholder sentinel to make explicit that nothing was provided
+	ConnectionState_CONNECTION_STATE_UNSPECIFIED ConnectionState = 0
+	// No connection is open, e.g. it has not been used or was evicted as idle
+	ConnectionState_CONNECTION_STATE_DISCONNECTED ConnectionState = 1
+	// The connection is open but has no active transport
+	ConnectionState_CONNECTION_STATE_IDLE ConnectionState = 2
+	// The connection is being established
+	ConnectionState_CONNECTION_STATE_CONNECTING ConnectionState = 3
+	// The connection is ready for tasks
+	ConnectionState_CONNECTION_STATE_READY ConnectionState = 4
+	// The connection failed and is waiting to reconnect
+	ConnectionState_CONNECTION_STATE_TRANSIENT_FAILURE ConnectionState = 5
+	// The connection is shutting down
+	ConnectionState_CONNECTION_STATE_SHUTDOWN ConnectionState = 6
+)
+
+// Enum value maps for ConnectionState.
+var (
+	ConnectionState_name = map[int32]string{
+		0: "CONNECTION_STATE_UNSPECIFIED",
+		1: "CONNECTION_STATE_DISCONNECTED",
+		2: "CONNECTION_STATE_IDLE",
+		3: "CONNECTION_STATE_CONNECTING",
+		4: "CONNECTION_STATE_READY",
+		5: "CONNECTION_STATE_TRANSIENT_FAILURE",
+		6: "CONNECTION_STATE_SHUTDOWN",
+	}
+	ConnectionState_value = map[string]int32{
+		"CONNECTION_STATE_UNSPECIFIED":       0,
+		"CONNECTION_STATE_DISCONNECTED":      1,
+		"CONNECTION_STATE_IDLE":              2,
+		"CONNECTION_STATE_CONNECTING":        3,
+		"CONNECTION_STATE_READY":             4,
+		"CONNECTION_STATE_TRANSIENT_FAILURE": 5,
+		"CONNECTION_STATE_SHUTDOWN":          6,
+	}
+)
+
+func (x ConnectionState) Enum() *ConnectionState {
+	p := new(ConnectionState)
+	*p = x
+	return p
+}
+
+func (x ConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ConnectionState) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[2]
+}
+
+func (x ConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectionState.Descriptor instead.
+func (ConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{2}
+}
+
+// ExecutionStatus is the state of an execution, or of an algorithm within one
+type ExecutionStatus int32
+
+const (
+	// placeholder sentinel to make explicit that nothing was provided
+	ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED ExecutionStatus = 0
+	// Waiting to be executed
+	ExecutionStatus_EXECUTION_STATUS_PENDING ExecutionStatus = 1
+	// Currently being executed
+	ExecutionStatus_EXECUTION_STATUS_RUNNING ExecutionStatus = 2
+	// Executed successfully
+	ExecutionStatus_EXECUTION_STATUS_SUCCEEDED ExecutionStatus = 3
+	// Execution failed - see the error message
+	ExecutionStatus_EXECUTION_STATUS_FAILED ExecutionStatus = 4
+	// Not executed, as an upstream part of the execution failed
+	ExecutionStatus_EXECUTION_STATUS_SKIPPED ExecutionStatus = 5
+	// Cancelled before it could complete
+	ExecutionStatus_EXECUTION_STATUS_CANCELLED ExecutionStatus = 6
+)
+
+// Enum value maps for ExecutionStatus.
+var (
+	ExecutionStatus_name = map[int32]string{
+		0: "EXECUTION_STATUS_UNSPECIFIED",
+		1: "EXECUTION_STATUS_PENDING",
+		2: "EXECUTION_STATUS_RUNNING",
+		3: "EXECUTION_STATUS_SUCCEEDED",
+		4: "EXECUTION_STATUS_FAILED",
+		5: "EXECUTION_STATUS_SKIPPED",
+		6: "EXECUTION_STATUS_CANCELLED",
+	}
+	ExecutionStatus_value = map[string]int32{
+		"EXECUTION_STATUS_UNSPECIFIED": 0,
+		"EXECUTION_STATUS_PENDING":     1,
+		"EXECUTION_STATUS_RUNNING":     2,
+		"EXECUTION_STATUS_SUCCEEDED":   3,
+		"EXECUTION_STATUS_FAILED":      4,
+		"EXECUTION_STATUS_SKIPPED":     5,
+		"EXECUTION_STATUS_CANCELLED":   6,
+	}
+)
+
+func (x ExecutionStatus) Enum() *ExecutionStatus {
+	p := new(ExecutionStatus)
+	*p = x
+	return p
+}
+
+func (x ExecutionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ExecutionStatus) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[3]
+}
+
+func (x ExecutionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionStatus.Descriptor instead.
+func (ExecutionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{3}
+}
+
+// AlertStatus is the state of an alert
+type AlertStatus int32
+
+const (
+	AlertStatus_ALERT_STATUS_UNSPECIFIED AlertStatus = 0
+	// the rule holds for the latest result
+	AlertStatus_ALERT_STATUS_FIRING AlertStatus = 1
+	// the rule has since stopped holding
+	AlertStatus_ALERT_STATUS_RESOLVED AlertStatus = 2
+)
+
+// Enum value maps for AlertStatus.
+var (
+	AlertStatus_name = map[int32]string{
+		0: "ALERT_STATUS_UNSPECIFIED",
+		1: "ALERT_STATUS_FIRING",
+		2: "ALERT_STATUS_RESOLVED",
+	}
+	AlertStatus_value = map[string]int32{
+		"ALERT_STATUS_UNSPECIFIED": 0,
+		"ALERT_STATUS_FIRING":      1,
+		"ALERT_STATUS_RESOLVED":    2,
+	}
+)
+
+func (x AlertStatus) Enum() *AlertStatus {
+	p := new(AlertStatus)
+	*p = x
+	return p
+}
+
+func (x AlertStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[4].Descriptor()
+}
+
+func (AlertStatus) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[4]
+}
+
+func (x AlertStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertStatus.Descriptor instead.
+func (AlertStatus) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{4}
+}
+
 // A status enum that captures scenarios regarding a window being emmited
 type WindowEmitStatus_StatusEnum int32
 
@@ -150,6 +340,9 @@ const (
 	WindowEmitStatus_NO_TRIGGERED_ALGORITHMS WindowEmitStatus_StatusEnum = 1
 	// When processing has successfully been triggered
 	WindowEmitStatus_PROCESSING_TRIGGERED WindowEmitStatus_StatusEnum = 2
+	// When processing has been triggered, but is queued behind other
+	// executions as all execution workers are busy
+	WindowEmitStatus_QUEUED WindowEmitStatus_StatusEnum = 3
 )
 
 // Enum value maps for WindowEmitStatus_StatusEnum.
@@ -158,11 +351,13 @@ var (
 		0: "TRIGGERING_FAILED",
 		1: "NO_TRIGGERED_ALGORITHMS",
 		2: "PROCESSING_TRIGGERED",
+		3: "QUEUED",
 	}
 	WindowEmitStatus_StatusEnum_value = map[string]int32{
 		"TRIGGERING_FAILED":       0,
 		"NO_TRIGGERED_ALGORITHMS": 1,
 		"PROCESSING_TRIGGERED":    2,
+		"QUEUED":                  3,
 	}
 )
 
@@ -177,11 +372,11 @@ func (x WindowEmitStatus_StatusEnum) String() string {
 }
 
 func (WindowEmitStatus_StatusEnum) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[2].Descriptor()
+	return file_service_proto_enumTypes[5].Descriptor()
 }
 
 func (WindowEmitStatus_StatusEnum) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[2]
+	return &file_service_proto_enumTypes[5]
 }
 
 func (x WindowEmitStatus_StatusEnum) Number() protoreflect.EnumNumber {
@@ -234,11 +429,11 @@ func (x HealthCheckResponse_Status) String() string {
 }
 
 func (HealthCheckResponse_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[3].Descriptor()
+	return file_service_proto_enumTypes[6].Descriptor()
 }
 
 func (HealthCheckResponse_Status) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[3]
+	return &file_service_proto_enumTypes[6]
 }
 
 func (x HealthCheckResponse_Status) Number() protoreflect.EnumNumber {
@@ -247,7 +442,7 @@ func (x HealthCheckResponse_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HealthCheckResponse_Status.Descriptor instead.
 func (HealthCheckResponse_Status) EnumDescriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{15, 0}
+	return file_service_proto_rawDescGZIP(), []int{27, 0}
 }
 
 // Window represents a time-bounded processing context that triggers algorithm execution. Windows are the primary input that start DAG processing flows.
@@ -270,9 +465,18 @@ type Window struct {
 	// window_type_name and window_type_version. Each window type version
 	// defines its own required and optional metadata fields, as defined
 	// at the time of registration.
-	Metadata      *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Metadata *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Only trigger these algorithms of the window type, along with the
+	// algorithms they depend on. All algorithms are triggered when none are
+	// given
+	TargetAlgorithms []*Algorithm `protobuf:"bytes,7,rep,name=target_algorithms,json=targetAlgorithms,proto3" json:"target_algorithms,omitempty"`
+	// Identifies the window across retries by its emitter, scoped to the
+	// window type. A window emitted again with a key that has already been
+	// seen is not re-triggered. Without a key, windows are deduplicated on
+	// their type, time range, origin and metadata
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Window) Reset() {
@@ -347,6 +551,20 @@ func (x *Window) GetMetadata() *structpb.Struct {
 	return nil
 }
 
+func (x *Window) GetTargetAlgorithms() []*Algorithm {
+	if x != nil {
+		return x.TargetAlgorithms
+	}
+	return nil
+}
+
+func (x *Window) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // MetadataField describes the metadata that can be carried along with windows
 type MetadataField struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -483,8 +701,17 @@ func (x *WindowType) GetMetadataFields() []*MetadataField {
 
 // WindowEmitStatus status message returned after emitting a window
 type WindowEmitStatus struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Status        WindowEmitStatus_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=WindowEmitStatus_StatusEnum" json:"status,omitempty"`
+	state  protoimpl.MessageState      `protogen:"open.v1"`
+	Status WindowEmitStatus_StatusEnum `protobuf:"varint,1,opt,name=status,proto3,enum=WindowEmitStatus_StatusEnum" json:"status,omitempty"`
+	// ID of the execution triggered by the window, used to read its progress
+	// Empty when no processing was triggered
+	ExecId string `protobuf:"bytes,2,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// Whether the window had already been emitted, in which case the status
+	// and exec_id are those of the original window and nothing was triggered
+	Duplicate bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	// Why the window could not be emitted, for windows emitted in a batch
+	// Empty unless triggering failed
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -526,38 +753,51 @@ func (x *WindowEmitStatus) GetStatus() WindowEmitStatus_StatusEnum {
 	return WindowEmitStatus_TRIGGERING_FAILED
 }
 
-// AlgorithmDependency defines a requirement that one algorithm has on another's results.
-// These dependencies form the edges in the processing DAG.
-type AlgorithmDependency struct {
+func (x *WindowEmitStatus) GetExecId() string {
+	if x != nil {
+		return x.ExecId
+	}
+	return ""
+}
+
+func (x *WindowEmitStatus) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+func (x *WindowEmitStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// WindowsEmit is a batch of windows to be emitted together
+type WindowsEmit struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Name of the required algorithm
-	// Must reference an existing algorithm name in the system
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Version of the required algorithm
-	// Must follow semantic versioning (e.g., "1.0.0")
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// Name of the processor that the algorithm is associated with
-	ProcessorName string `protobuf:"bytes,3,opt,name=processor_name,json=processorName,proto3" json:"processor_name,omitempty"`
-	// Runtime of the processor that the algorithm is associated with
-	ProcessorRuntime string `protobuf:"bytes,4,opt,name=processor_runtime,json=processorRuntime,proto3" json:"processor_runtime,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// The windows to emit. Each window is validated on its own, so that an
+	// invalid window fails without failing the rest of the batch
+	Windows       []*Window `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlgorithmDependency) Reset() {
-	*x = AlgorithmDependency{}
+func (x *WindowsEmit) Reset() {
+	*x = WindowsEmit{}
 	mi := &file_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlgorithmDependency) String() string {
+func (x *WindowsEmit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlgorithmDependency) ProtoMessage() {}
+func (*WindowsEmit) ProtoMessage() {}
 
-func (x *AlgorithmDependency) ProtoReflect() protoreflect.Message {
+func (x *WindowsEmit) ProtoReflect() protoreflect.Message {
 	mi := &file_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -569,78 +809,106 @@ func (x *AlgorithmDependency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlgorithmDependency.ProtoReflect.Descriptor instead.
-func (*AlgorithmDependency) Descriptor() ([]byte, []int) {
+// Deprecated: Use WindowsEmit.ProtoReflect.Descriptor instead.
+func (*WindowsEmit) Descriptor() ([]byte, []int) {
 	return file_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AlgorithmDependency) GetName() string {
+func (x *WindowsEmit) GetWindows() []*Window {
 	if x != nil {
-		return x.Name
+		return x.Windows
 	}
-	return ""
+	return nil
 }
 
-func (x *AlgorithmDependency) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+// WindowsEmitStatus holds the status of each window emitted in a batch
+type WindowsEmitStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The status of each window, in the order that the windows were emitted
+	Statuses      []*WindowEmitStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlgorithmDependency) GetProcessorName() string {
+func (x *WindowsEmitStatus) Reset() {
+	*x = WindowsEmitStatus{}
+	mi := &file_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowsEmitStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsEmitStatus) ProtoMessage() {}
+
+func (x *WindowsEmitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[5]
 	if x != nil {
-		return x.ProcessorName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AlgorithmDependency) GetProcessorRuntime() string {
+// Deprecated: Use WindowsEmitStatus.ProtoReflect.Descriptor instead.
+func (*WindowsEmitStatus) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WindowsEmitStatus) GetStatuses() []*WindowEmitStatus {
 	if x != nil {
-		return x.ProcessorRuntime
+		return x.Statuses
 	}
-	return ""
+	return nil
 }
 
-// Algorithm defines a processing unit that can be executed by processors.
-// Algorithms form the nodes in the processing DAG and are triggered by specific window types.
-type Algorithm struct {
+// WindowOpen opens a window whose end is not yet known, e.g. a machine fault
+// that is still ongoing. The window is extended as time passes and closed
+// once its end is known
+type WindowOpen struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Name of the algorithm - must be globally unique
-	// This identifies the algorithm across the system
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Version of the algorithm - must follow semantic versioning
-	// Allows for algorithm evolution while maintaining compatibility
-	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	// Type of window that triggers this algorithm
-	// References a WindowType that will cause this algorithm to execute
-	WindowType *WindowType `protobuf:"bytes,3,opt,name=window_type,json=windowType,proto3" json:"window_type,omitempty"`
-	// Other algorithms that this algorithm depends on
-	// The algorithm won't execute until all dependencies have completed
-	// Dependencies must not form cycles - this is statically checked on processor registration
-	Dependencies []*AlgorithmDependency `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
-	// The type of result that the algorithm produces. This is specified upfront
-	// rather than introspected, to allow for validation
-	ResultType    ResultType `protobuf:"varint,5,opt,name=result_type,json=resultType,proto3,enum=ResultType" json:"result_type,omitempty"`
+	// Time that the window starts
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// The name of the window type
+	WindowTypeName string `protobuf:"bytes,2,opt,name=window_type_name,json=windowTypeName,proto3" json:"window_type_name,omitempty"`
+	// The version of the window type
+	WindowTypeVersion string `protobuf:"bytes,3,opt,name=window_type_version,json=windowTypeVersion,proto3" json:"window_type_version,omitempty"`
+	// A unique identifier that defines where the window came from
+	Origin string `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	// Additional metadata to attach to this window, as for emitted windows
+	Metadata *structpb.Struct `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Identifies the window when it is extended and closed, scoped to the
+	// window type. Opening a window again with a key that has already been
+	// seen has no effect
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Execute the window each time it is extended, rather than only once it is
+	// closed. The results of these provisional executions are replaced by
+	// those of the window once it is closed
+	Provisional   bool `protobuf:"varint,7,opt,name=provisional,proto3" json:"provisional,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Algorithm) Reset() {
-	*x = Algorithm{}
-	mi := &file_service_proto_msgTypes[5]
+func (x *WindowOpen) Reset() {
+	*x = WindowOpen{}
+	mi := &file_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Algorithm) String() string {
+func (x *WindowOpen) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Algorithm) ProtoMessage() {}
+func (*WindowOpen) ProtoMessage() {}
 
-func (x *Algorithm) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[5]
+func (x *WindowOpen) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,69 +919,3094 @@ func (x *Algorithm) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Algorithm.ProtoReflect.Descriptor instead.
-func (*Algorithm) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WindowOpen.ProtoReflect.Descriptor instead.
+func (*WindowOpen) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Algorithm) GetName() string {
+func (x *WindowOpen) GetTimeFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *WindowOpen) GetWindowTypeName() string {
+	if x != nil {
+		return x.WindowTypeName
 	}
 	return ""
 }
 
-func (x *Algorithm) GetVersion() string {
+func (x *WindowOpen) GetWindowTypeVersion() string {
 	if x != nil {
-		return x.Version
+		return x.WindowTypeVersion
 	}
 	return ""
 }
 
-func (x *Algorithm) GetWindowType() *WindowType {
+func (x *WindowOpen) GetOrigin() string {
 	if x != nil {
-		return x.WindowType
+		return x.Origin
 	}
-	return nil
+	return ""
 }
 
-func (x *Algorithm) GetDependencies() []*AlgorithmDependency {
+func (x *WindowOpen) GetMetadata() *structpb.Struct {
 	if x != nil {
-		return x.Dependencies
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *Algorithm) GetResultType() ResultType {
+func (x *WindowOpen) GetIdempotencyKey() string {
 	if x != nil {
-		return x.ResultType
+		return x.IdempotencyKey
 	}
-	return ResultType_NOT_SPECIFIED
+	return ""
 }
 
-// Container for array of float values
-type FloatArray struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Values        []float32              `protobuf:"fixed32,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+func (x *WindowOpen) GetProvisional() bool {
+	if x != nil {
+		return x.Provisional
+	}
+	return false
+}
+
+// WindowExtension extends an open window up to a later time
+type WindowExtension struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The window type of the open window
+	WindowTypeName    string `protobuf:"bytes,1,opt,name=window_type_name,json=windowTypeName,proto3" json:"window_type_name,omitempty"`
+	WindowTypeVersion string `protobuf:"bytes,2,opt,name=window_type_version,json=windowTypeVersion,proto3" json:"window_type_version,omitempty"`
+	// The idempotency key the window was opened with
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// The time the window now extends to. Must be after the time it was last
+	// extended to
+	TimeTo        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FloatArray) Reset() {
-	*x = FloatArray{}
-	mi := &file_service_proto_msgTypes[6]
+func (x *WindowExtension) Reset() {
+	*x = WindowExtension{}
+	mi := &file_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FloatArray) String() string {
+func (x *WindowExtension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FloatArray) ProtoMessage() {}
+func (*WindowExtension) ProtoMessage() {}
 
-func (x *FloatArray) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[6]
+func (x *WindowExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowExtension.ProtoReflect.Descriptor instead.
+func (*WindowExtension) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WindowExtension) GetWindowTypeName() string {
+	if x != nil {
+		return x.WindowTypeName
+	}
+	return ""
+}
+
+func (x *WindowExtension) GetWindowTypeVersion() string {
+	if x != nil {
+		return x.WindowTypeVersion
+	}
+	return ""
+}
+
+func (x *WindowExtension) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *WindowExtension) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+// WindowClosure closes an open window at its end
+type WindowClosure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The window type of the open window
+	WindowTypeName    string `protobuf:"bytes,1,opt,name=window_type_name,json=windowTypeName,proto3" json:"window_type_name,omitempty"`
+	WindowTypeVersion string `protobuf:"bytes,2,opt,name=window_type_version,json=windowTypeVersion,proto3" json:"window_type_version,omitempty"`
+	// The idempotency key the window was opened with
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// The time that the window ends. Must be after the time it starts and no
+	// earlier than the time it was last extended to
+	TimeTo        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowClosure) Reset() {
+	*x = WindowClosure{}
+	mi := &file_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowClosure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowClosure) ProtoMessage() {}
+
+func (x *WindowClosure) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowClosure.ProtoReflect.Descriptor instead.
+func (*WindowClosure) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WindowClosure) GetWindowTypeName() string {
+	if x != nil {
+		return x.WindowTypeName
+	}
+	return ""
+}
+
+func (x *WindowClosure) GetWindowTypeVersion() string {
+	if x != nil {
+		return x.WindowTypeVersion
+	}
+	return ""
+}
+
+func (x *WindowClosure) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *WindowClosure) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+// WindowAmendment supersedes a window that has already been emitted with a
+// corrected one, e.g. with the right time range or metadata
+type WindowAmendment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The window as it was emitted. Matched on its idempotency key if it has
+	// one, or else on its window type, time range, origin and metadata
+	Window *Window `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// The corrected window, of the same window type. It takes over the
+	// idempotency key of the window it supersedes unless given its own. All
+	// of its algorithms are triggered
+	AmendedWindow *Window `protobuf:"bytes,2,opt,name=amended_window,json=amendedWindow,proto3" json:"amended_window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowAmendment) Reset() {
+	*x = WindowAmendment{}
+	mi := &file_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowAmendment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowAmendment) ProtoMessage() {}
+
+func (x *WindowAmendment) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowAmendment.ProtoReflect.Descriptor instead.
+func (*WindowAmendment) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WindowAmendment) GetWindow() *Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *WindowAmendment) GetAmendedWindow() *Window {
+	if x != nil {
+		return x.AmendedWindow
+	}
+	return nil
+}
+
+// AlgorithmDependency defines a requirement that one algorithm has on another's results.
+// These dependencies form the edges in the processing DAG.
+type AlgorithmDependency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the required algorithm
+	// Must reference an existing algorithm name in the system
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version of the required algorithm
+	// Must follow semantic versioning (e.g., "1.0.0")
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Name of the processor that the algorithm is associated with
+	ProcessorName string `protobuf:"bytes,3,opt,name=processor_name,json=processorName,proto3" json:"processor_name,omitempty"`
+	// Runtime of the processor that the algorithm is associated with
+	ProcessorRuntime string `protobuf:"bytes,4,opt,name=processor_runtime,json=processorRuntime,proto3" json:"processor_runtime,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AlgorithmDependency) Reset() {
+	*x = AlgorithmDependency{}
+	mi := &file_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmDependency) ProtoMessage() {}
+
+func (x *AlgorithmDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgorithmDependency.ProtoReflect.Descriptor instead.
+func (*AlgorithmDependency) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AlgorithmDependency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlgorithmDependency) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AlgorithmDependency) GetProcessorName() string {
+	if x != nil {
+		return x.ProcessorName
+	}
+	return ""
+}
+
+func (x *AlgorithmDependency) GetProcessorRuntime() string {
+	if x != nil {
+		return x.ProcessorRuntime
+	}
+	return ""
+}
+
+// Algorithm defines a processing unit that can be executed by processors.
+// Algorithms form the nodes in the processing DAG and are triggered by specific window types.
+type Algorithm struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the algorithm - must be globally unique
+	// This identifies the algorithm across the system
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Version of the algorithm - must follow semantic versioning
+	// Allows for algorithm evolution while maintaining compatibility
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Type of window that triggers this algorithm
+	// References a WindowType that will cause this algorithm to execute
+	WindowType *WindowType `protobuf:"bytes,3,opt,name=window_type,json=windowType,proto3" json:"window_type,omitempty"`
+	// Other algorithms that this algorithm depends on
+	// The algorithm won't execute until all dependencies have completed
+	// Dependencies must not form cycles - this is statically checked on processor registration
+	Dependencies []*AlgorithmDependency `protobuf:"bytes,4,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// The type of result that the algorithm produces. This is specified upfront
+	// rather than introspected, to allow for validation
+	ResultType ResultType `protobuf:"varint,5,opt,name=result_type,json=resultType,proto3,enum=ResultType" json:"result_type,omitempty"`
+	// Overrides the retry policy of the processor when executing this algorithm
+	RetryPolicy *RetryPolicy `protobuf:"bytes,6,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// The longest a processor may take to execute the algorithm, in milliseconds.
+	// If not set, the global default timeout applies
+	TimeoutMs     int64 `protobuf:"varint,7,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithm) Reset() {
+	*x = Algorithm{}
+	mi := &file_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithm) ProtoMessage() {}
+
+func (x *Algorithm) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithm.ProtoReflect.Descriptor instead.
+func (*Algorithm) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Algorithm) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Algorithm) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Algorithm) GetWindowType() *WindowType {
+	if x != nil {
+		return x.WindowType
+	}
+	return nil
+}
+
+func (x *Algorithm) GetDependencies() []*AlgorithmDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *Algorithm) GetResultType() ResultType {
+	if x != nil {
+		return x.ResultType
+	}
+	return ResultType_NOT_SPECIFIED
+}
+
+func (x *Algorithm) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Algorithm) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// Container for array of float values
+type FloatArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []float32              `protobuf:"fixed32,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloatArray) Reset() {
+	*x = FloatArray{}
+	mi := &file_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloatArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatArray) ProtoMessage() {}
+
+func (x *FloatArray) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatArray.ProtoReflect.Descriptor instead.
+func (*FloatArray) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FloatArray) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Result of an algorithm execution
+type Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Status of the result execution
+	Status ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ResultStatus" json:"status,omitempty"`
+	// The actual result data - can be either an array of floats or a structured object
+	//
+	// Types that are valid to be assigned to ResultData:
+	//
+	//	*Result_SingleValue
+	//	*Result_FloatValues
+	//	*Result_StructValue
+	ResultData isResult_ResultData `protobuf_oneof:"result_data"`
+	// Timestamp when the result was produced
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Why the algorithm failed, when the status is not RESULT_STATUS_SUCEEDED
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	mi := &file_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Result) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_HANDLED_FAILED
+}
+
+func (x *Result) GetResultData() isResult_ResultData {
+	if x != nil {
+		return x.ResultData
+	}
+	return nil
+}
+
+func (x *Result) GetSingleValue() float32 {
+	if x != nil {
+		if x, ok := x.ResultData.(*Result_SingleValue); ok {
+			return x.SingleValue
+		}
+	}
+	return 0
+}
+
+func (x *Result) GetFloatValues() *FloatArray {
+	if x != nil {
+		if x, ok := x.ResultData.(*Result_FloatValues); ok {
+			return x.FloatValues
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetStructValue() *structpb.Struct {
+	if x != nil {
+		if x, ok := x.ResultData.(*Result_StructValue); ok {
+			return x.StructValue
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Result) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type isResult_ResultData interface {
+	isResult_ResultData()
+}
+
+type Result_SingleValue struct {
+	// for single number results
+	SingleValue float32 `protobuf:"fixed32,2,opt,name=single_value,json=singleValue,proto3,oneof"`
+}
+
+type Result_FloatValues struct {
+	// For numeric array results
+	FloatValues *FloatArray `protobuf:"bytes,3,opt,name=float_values,json=floatValues,proto3,oneof"`
+}
+
+type Result_StructValue struct {
+	// For structured data results (JSON-like)
+	// Must follow a map<string, value> schema where value corresponds to https://protobuf.dev/reference/protobuf/google.protobuf/#value
+	StructValue *structpb.Struct `protobuf:"bytes,4,opt,name=struct_value,json=structValue,proto3,oneof"`
+}
+
+func (*Result_SingleValue) isResult_ResultData() {}
+
+func (*Result_FloatValues) isResult_ResultData() {}
+
+func (*Result_StructValue) isResult_ResultData() {}
+
+// ProcessorRegistration is sent by processors when they start up to announce their capabilities
+// to the orchestrator. This establishes a long-lived connection for receiving tasks.
+type ProcessorRegistration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique name of the runtime
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Language/runtime of the processor
+	// Examples: "python3.9", "go1.19", "Rust4.1"
+	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// The connection string of the processor
+	// e.g. grpc://localhost:5433
+	// Left empty by processors that instead receive their tasks through
+	// SubscribeTasks
+	ConnectionStr string `protobuf:"bytes,3,opt,name=connection_str,json=connectionStr,proto3" json:"connection_str,omitempty"`
+	// Algorithms this processor can execute
+	// The processor must implement all listed algorithms
+	SupportedAlgorithms []*Algorithm `protobuf:"bytes,4,rep,name=supported_algorithms,json=supportedAlgorithms,proto3" json:"supported_algorithms,omitempty"`
+	// Overrides the retry policy configured on Orca Core for calls to this processor
+	RetryPolicy *RetryPolicy `protobuf:"bytes,5,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Identifies the instance of the processor registering, e.g. its host or
+	// pod name, when the processor is scaled horizontally. An instance that
+	// registers again from a new connection string replaces its previous
+	// registration. Processors registered without one have a single instance
+	InstanceId    string `protobuf:"bytes,6,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessorRegistration) Reset() {
+	*x = ProcessorRegistration{}
+	mi := &file_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorRegistration) ProtoMessage() {}
+
+func (x *ProcessorRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorRegistration.ProtoReflect.Descriptor instead.
+func (*ProcessorRegistration) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProcessorRegistration) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessorRegistration) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *ProcessorRegistration) GetConnectionStr() string {
+	if x != nil {
+		return x.ConnectionStr
+	}
+	return ""
+}
+
+func (x *ProcessorRegistration) GetSupportedAlgorithms() []*Algorithm {
+	if x != nil {
+		return x.SupportedAlgorithms
+	}
+	return nil
+}
+
+func (x *ProcessorRegistration) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *ProcessorRegistration) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+// ProcessorDeregistration removes a processor, retiring every algorithm it
+// supports
+type ProcessorDeregistration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the processor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// runtime of the processor
+	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// also retire the live algorithms of other processors that depend on the
+	// algorithms of this processor. Without it, deregistration fails if any exist
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessorDeregistration) Reset() {
+	*x = ProcessorDeregistration{}
+	mi := &file_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorDeregistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorDeregistration) ProtoMessage() {}
+
+func (x *ProcessorDeregistration) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorDeregistration.ProtoReflect.Descriptor instead.
+func (*ProcessorDeregistration) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProcessorDeregistration) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessorDeregistration) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *ProcessorDeregistration) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// AlgorithmRetirement stops an algorithm from being executed
+type AlgorithmRetirement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the algorithm
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version of the algorithm
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// also retire the live algorithms that depend on this algorithm. Without it,
+	// retirement fails if any exist
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgorithmRetirement) Reset() {
+	*x = AlgorithmRetirement{}
+	mi := &file_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmRetirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmRetirement) ProtoMessage() {}
+
+func (x *AlgorithmRetirement) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgorithmRetirement.ProtoReflect.Descriptor instead.
+func (*AlgorithmRetirement) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AlgorithmRetirement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlgorithmRetirement) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AlgorithmRetirement) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// AlgorithmDependencyRemoval removes the dependency of an algorithm on another
+type AlgorithmDependencyRemoval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the dependent algorithm
+	AlgorithmName string `protobuf:"bytes,1,opt,name=algorithm_name,json=algorithmName,proto3" json:"algorithm_name,omitempty"`
+	// version of the dependent algorithm
+	AlgorithmVersion string `protobuf:"bytes,2,opt,name=algorithm_version,json=algorithmVersion,proto3" json:"algorithm_version,omitempty"`
+	// name of the algorithm depended upon
+	DependencyName string `protobuf:"bytes,3,opt,name=dependency_name,json=dependencyName,proto3" json:"dependency_name,omitempty"`
+	// version of the algorithm depended upon
+	DependencyVersion string `protobuf:"bytes,4,opt,name=dependency_version,json=dependencyVersion,proto3" json:"dependency_version,omitempty"`
+	// remove the dependency even while executions of the dependent algorithm
+	// are in progress. Without it, removal fails if any are
+	Force         bool `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgorithmDependencyRemoval) Reset() {
+	*x = AlgorithmDependencyRemoval{}
+	mi := &file_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmDependencyRemoval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmDependencyRemoval) ProtoMessage() {}
+
+func (x *AlgorithmDependencyRemoval) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgorithmDependencyRemoval.ProtoReflect.Descriptor instead.
+func (*AlgorithmDependencyRemoval) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AlgorithmDependencyRemoval) GetAlgorithmName() string {
+	if x != nil {
+		return x.AlgorithmName
+	}
+	return ""
+}
+
+func (x *AlgorithmDependencyRemoval) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *AlgorithmDependencyRemoval) GetDependencyName() string {
+	if x != nil {
+		return x.DependencyName
+	}
+	return ""
+}
+
+func (x *AlgorithmDependencyRemoval) GetDependencyVersion() string {
+	if x != nil {
+		return x.DependencyVersion
+	}
+	return ""
+}
+
+func (x *AlgorithmDependencyRemoval) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// RetryPolicy defines how failed calls to a processor are retried.
+// Fields left unset inherit from the policy they override.
+type RetryPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of attempts, including the first. 1 disables retries
+	MaxAttempts int32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Backoff before the first retry, in milliseconds
+	InitialBackoffMs int64 `protobuf:"varint,2,opt,name=initial_backoff_ms,json=initialBackoffMs,proto3" json:"initial_backoff_ms,omitempty"`
+	// Upper bound on the backoff between attempts, in milliseconds
+	MaxBackoffMs int64 `protobuf:"varint,3,opt,name=max_backoff_ms,json=maxBackoffMs,proto3" json:"max_backoff_ms,omitempty"`
+	// Factor the backoff grows by after each attempt
+	BackoffMultiplier float64 `protobuf:"fixed64,4,opt,name=backoff_multiplier,json=backoffMultiplier,proto3" json:"backoff_multiplier,omitempty"`
+	// Fraction of the backoff that is randomised, between 0 and 1
+	Jitter float64 `protobuf:"fixed64,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// gRPC status codes that are retried
+	// Examples: "UNAVAILABLE", "RESOURCE_EXHAUSTED"
+	RetryableCodes []string `protobuf:"bytes,6,rep,name=retryable_codes,json=retryableCodes,proto3" json:"retryable_codes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialBackoffMs() int64 {
+	if x != nil {
+		return x.InitialBackoffMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxBackoffMs() int64 {
+	if x != nil {
+		return x.MaxBackoffMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffMultiplier() float64 {
+	if x != nil {
+		return x.BackoffMultiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryableCodes() []string {
+	if x != nil {
+		return x.RetryableCodes
+	}
+	return nil
+}
+
+// ProcessingTask represents a single algorithm execution request sent to a processor.
+// Tasks are streamed to processors as their dependencies are satisfied.
+type ProcessingTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique ID for this specific task execution
+	// Used to correlate results and track execution state
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Algorithm to execute
+	// Must be one of the algorithms the processor registered support for
+	Algorithm *Algorithm `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// Window that triggered this task
+	// Provides the time context for the algorithm execution
+	Window *Window `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// Results from dependent algorithms
+	// Contains all results that this algorithm declared dependencies on
+	// All dependencies will be present when task is sent
+	DependencyResults []*AlgorithmResult `protobuf:"bytes,4,rep,name=dependency_results,json=dependencyResults,proto3" json:"dependency_results,omitempty"`
+	// The exec_id of the execution the task is part of
+	ExecId        string `protobuf:"bytes,5,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessingTask) Reset() {
+	*x = ProcessingTask{}
+	mi := &file_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessingTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessingTask) ProtoMessage() {}
+
+func (x *ProcessingTask) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessingTask.ProtoReflect.Descriptor instead.
+func (*ProcessingTask) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProcessingTask) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ProcessingTask) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *ProcessingTask) GetWindow() *Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *ProcessingTask) GetDependencyResults() []*AlgorithmResult {
+	if x != nil {
+		return x.DependencyResults
+	}
+	return nil
+}
+
+func (x *ProcessingTask) GetExecId() string {
+	if x != nil {
+		return x.ExecId
+	}
+	return ""
+}
+
+// TaskSubscription is sent by a registered processor to receive its tasks
+type TaskSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the processor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// runtime of the processor
+	Runtime       string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSubscription) Reset() {
+	*x = TaskSubscription{}
+	mi := &file_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSubscription) ProtoMessage() {}
+
+func (x *TaskSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSubscription.ProtoReflect.Descriptor instead.
+func (*TaskSubscription) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TaskSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskSubscription) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+// TaskResult is the result of a ProcessingTask, submitted by the processor
+// that received it
+type TaskResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the task_id of the ProcessingTask
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// the result of the algorithm
+	Result        *Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskResult) Reset() {
+	*x = TaskResult{}
+	mi := &file_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskResult) ProtoMessage() {}
+
+func (x *TaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskResult.ProtoReflect.Descriptor instead.
+func (*TaskResult) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TaskResult) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskResult) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// ExecutionRequest provides a complete view of a processing DAG's execution
+// status for a specific window. Used for monitoring and debugging.
+type ExecutionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exec_id
+	ExecId string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// The window that triggered the algorithm
+	Window *Window `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// Results from dependant algorithms
+	AlgorithmResults []*AlgorithmResult `protobuf:"bytes,3,rep,name=algorithm_results,json=algorithmResults,proto3" json:"algorithm_results,omitempty"`
+	// The algorithms to execute
+	Algorithms    []*Algorithm `protobuf:"bytes,4,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionRequest) Reset() {
+	*x = ExecutionRequest{}
+	mi := &file_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionRequest) ProtoMessage() {}
+
+func (x *ExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionRequest.ProtoReflect.Descriptor instead.
+func (*ExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExecutionRequest) GetExecId() string {
+	if x != nil {
+		return x.ExecId
+	}
+	return ""
+}
+
+func (x *ExecutionRequest) GetWindow() *Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *ExecutionRequest) GetAlgorithmResults() []*AlgorithmResult {
+	if x != nil {
+		return x.AlgorithmResults
+	}
+	return nil
+}
+
+func (x *ExecutionRequest) GetAlgorithms() []*Algorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+type ExecutionResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exec ID
+	ExecId string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// The algorithn result
+	AlgorithmResult *AlgorithmResult `protobuf:"bytes,3,opt,name=algorithm_result,json=algorithmResult,proto3" json:"algorithm_result,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExecutionResult) Reset() {
+	*x = ExecutionResult{}
+	mi := &file_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionResult) ProtoMessage() {}
+
+func (x *ExecutionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionResult.ProtoReflect.Descriptor instead.
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExecutionResult) GetExecId() string {
+	if x != nil {
+		return x.ExecId
+	}
+	return ""
+}
+
+func (x *ExecutionResult) GetAlgorithmResult() *AlgorithmResult {
+	if x != nil {
+		return x.AlgorithmResult
+	}
+	return nil
+}
+
+// AlgorithmWindowResult Packaged algorithm and result to a window
+type AlgorithmResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     *Algorithm             `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgorithmResult) Reset() {
+	*x = AlgorithmResult{}
+	mi := &file_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmResult) ProtoMessage() {}
+
+func (x *AlgorithmResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgorithmResult.ProtoReflect.Descriptor instead.
+func (*AlgorithmResult) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AlgorithmResult) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *AlgorithmResult) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type Status struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Received      bool                   `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	mi := &file_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Status) GetReceived() bool {
+	if x != nil {
+		return x.Received
+	}
+	return false
+}
+
+func (x *Status) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// HealthCheckRequest is sent to processors to verify they are functioning
+type HealthCheckRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timestamp of the request in unix epoch milliseconds
+	// Used to measure response latency
+	Timestamp     int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HealthCheckRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// HealthCheckResponse indicates the health status of a processor
+type HealthCheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current health status
+	Status HealthCheckResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=HealthCheckResponse_Status" json:"status,omitempty"`
+	// Optional message providing more detail about the health status
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// System metrics about the processor
+	Metrics       *ProcessorMetrics `protobuf:"bytes,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return HealthCheckResponse_STATUS_UNKNOWN
+}
+
+func (x *HealthCheckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetMetrics() *ProcessorMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// ProcessorMetrics provides runtime information about a processor
+type ProcessorMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of algorithms currently being executed
+	ActiveTasks int32 `protobuf:"varint,1,opt,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
+	// Memory usage in bytes
+	MemoryBytes int64 `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	// CPU usage percentage (0-100)
+	CpuPercent float32 `protobuf:"fixed32,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	// Time since processor started in seconds
+	UptimeSeconds int64 `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessorMetrics) Reset() {
+	*x = ProcessorMetrics{}
+	mi := &file_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorMetrics) ProtoMessage() {}
+
+func (x *ProcessorMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorMetrics.ProtoReflect.Descriptor instead.
+func (*ProcessorMetrics) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProcessorMetrics) GetActiveTasks() int32 {
+	if x != nil {
+		return x.ActiveTasks
+	}
+	return 0
+}
+
+func (x *ProcessorMetrics) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ProcessorMetrics) GetCpuPercent() float32 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ProcessorMetrics) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+// ---------------------------- Data Messages ----------------------------
+type WindowTypeRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowTypeRead) Reset() {
+	*x = WindowTypeRead{}
+	mi := &file_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowTypeRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowTypeRead) ProtoMessage() {}
+
+func (x *WindowTypeRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowTypeRead.ProtoReflect.Descriptor instead.
+func (*WindowTypeRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{29}
+}
+
+type WindowTypes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Windows       []*WindowType          `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowTypes) Reset() {
+	*x = WindowTypes{}
+	mi := &file_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowTypes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowTypes) ProtoMessage() {}
+
+func (x *WindowTypes) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowTypes.ProtoReflect.Descriptor instead.
+func (*WindowTypes) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WindowTypes) GetWindows() []*WindowType {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type AlgorithmsRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgorithmsRead) Reset() {
+	*x = AlgorithmsRead{}
+	mi := &file_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmsRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmsRead) ProtoMessage() {}
+
+func (x *AlgorithmsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgorithmsRead.ProtoReflect.Descriptor instead.
+func (*AlgorithmsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{31}
+}
+
+type Algorithms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     []*Algorithm           `protobuf:"bytes,1,rep,name=algorithm,proto3" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Algorithms) Reset() {
+	*x = Algorithms{}
+	mi := &file_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Algorithms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Algorithms) ProtoMessage() {}
+
+func (x *Algorithms) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Algorithms.ProtoReflect.Descriptor instead.
+func (*Algorithms) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Algorithms) GetAlgorithm() []*Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+type ProcessorsRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessorsRead) Reset() {
+	*x = ProcessorsRead{}
+	mi := &file_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessorsRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessorsRead) ProtoMessage() {}
+
+func (x *ProcessorsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessorsRead.ProtoReflect.Descriptor instead.
+func (*ProcessorsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{33}
+}
+
+type Processors struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Processor     []*Processors_Processor `protobuf:"bytes,1,rep,name=processor,proto3" json:"processor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Processors) Reset() {
+	*x = Processors{}
+	mi := &file_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Processors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Processors) ProtoMessage() {}
+
+func (x *Processors) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Processors.ProtoReflect.Descriptor instead.
+func (*Processors) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Processors) GetProcessor() []*Processors_Processor {
+	if x != nil {
+		return x.Processor
+	}
+	return nil
+}
+
+type ResultsStatsRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultsStatsRead) Reset() {
+	*x = ResultsStatsRead{}
+	mi := &file_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsStatsRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsStatsRead) ProtoMessage() {}
+
+func (x *ResultsStatsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsStatsRead.ProtoReflect.Descriptor instead.
+func (*ResultsStatsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{35}
+}
+
+type ResultsStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultsStats) Reset() {
+	*x = ResultsStats{}
+	mi := &file_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsStats) ProtoMessage() {}
+
+func (x *ResultsStats) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsStats.ProtoReflect.Descriptor instead.
+func (*ResultsStats) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResultsStats) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AlgorithmFieldsRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeFrom      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	TimeTo        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	Algorithm     *Algorithm             `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgorithmFieldsRead) Reset() {
+	*x = AlgorithmFieldsRead{}
+	mi := &file_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmFieldsRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmFieldsRead) ProtoMessage() {}
+
+func (x *AlgorithmFieldsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgorithmFieldsRead.ProtoReflect.Descriptor instead.
+func (*AlgorithmFieldsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AlgorithmFieldsRead) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *AlgorithmFieldsRead) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *AlgorithmFieldsRead) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+type AlgorithmFields struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         []string               `protobuf:"bytes,1,rep,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlgorithmFields) Reset() {
+	*x = AlgorithmFields{}
+	mi := &file_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgorithmFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgorithmFields) ProtoMessage() {}
+
+func (x *AlgorithmFields) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlgorithmFields.ProtoReflect.Descriptor instead.
+func (*AlgorithmFields) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AlgorithmFields) GetField() []string {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type ResultsForAlgorithmRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the time to read results from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read results to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// the algorithm to read results for
+	Algorithm *Algorithm `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// also read the results of windows that have been amended
+	IncludeSuperseded bool `protobuf:"varint,4,opt,name=include_superseded,json=includeSuperseded,proto3" json:"include_superseded,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResultsForAlgorithmRead) Reset() {
+	*x = ResultsForAlgorithmRead{}
+	mi := &file_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsForAlgorithmRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsForAlgorithmRead) ProtoMessage() {}
+
+func (x *ResultsForAlgorithmRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsForAlgorithmRead.ProtoReflect.Descriptor instead.
+func (*ResultsForAlgorithmRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResultsForAlgorithmRead) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *ResultsForAlgorithmRead) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *ResultsForAlgorithmRead) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *ResultsForAlgorithmRead) GetIncludeSuperseded() bool {
+	if x != nil {
+		return x.IncludeSuperseded
+	}
+	return false
+}
+
+type ResultsForAlgorithm struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Results       []*ResultsForAlgorithm_ResultsRow `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultsForAlgorithm) Reset() {
+	*x = ResultsForAlgorithm{}
+	mi := &file_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsForAlgorithm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsForAlgorithm) ProtoMessage() {}
+
+func (x *ResultsForAlgorithm) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsForAlgorithm.ProtoReflect.Descriptor instead.
+func (*ResultsForAlgorithm) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResultsForAlgorithm) GetResults() []*ResultsForAlgorithm_ResultsRow {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WindowsRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the time to read windows from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read windows to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// the window
+	Window *WindowType `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// also read windows that have been amended
+	IncludeSuperseded bool `protobuf:"varint,4,opt,name=include_superseded,json=includeSuperseded,proto3" json:"include_superseded,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WindowsRead) Reset() {
+	*x = WindowsRead{}
+	mi := &file_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowsRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsRead) ProtoMessage() {}
+
+func (x *WindowsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsRead.ProtoReflect.Descriptor instead.
+func (*WindowsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WindowsRead) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *WindowsRead) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *WindowsRead) GetWindow() *WindowType {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *WindowsRead) GetIncludeSuperseded() bool {
+	if x != nil {
+		return x.IncludeSuperseded
+	}
+	return false
+}
+
+type Windows struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the windows
+	Window        []*Window `protobuf:"bytes,1,rep,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Windows) Reset() {
+	*x = Windows{}
+	mi := &file_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Windows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Windows) ProtoMessage() {}
+
+func (x *Windows) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Windows.ProtoReflect.Descriptor instead.
+func (*Windows) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Windows) GetWindow() []*Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type DistinctMetadataForWindowTypeRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the time to read windows from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read windows to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// the window type to read
+	WindowType    *WindowType `protobuf:"bytes,3,opt,name=window_type,json=windowType,proto3" json:"window_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistinctMetadataForWindowTypeRead) Reset() {
+	*x = DistinctMetadataForWindowTypeRead{}
+	mi := &file_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistinctMetadataForWindowTypeRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistinctMetadataForWindowTypeRead) ProtoMessage() {}
+
+func (x *DistinctMetadataForWindowTypeRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistinctMetadataForWindowTypeRead.ProtoReflect.Descriptor instead.
+func (*DistinctMetadataForWindowTypeRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DistinctMetadataForWindowTypeRead) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *DistinctMetadataForWindowTypeRead) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *DistinctMetadataForWindowTypeRead) GetWindowType() *WindowType {
+	if x != nil {
+		return x.WindowType
+	}
+	return nil
+}
+
+type DistinctMetadataForWindowType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *structpb.ListValue    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DistinctMetadataForWindowType) Reset() {
+	*x = DistinctMetadataForWindowType{}
+	mi := &file_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DistinctMetadataForWindowType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DistinctMetadataForWindowType) ProtoMessage() {}
+
+func (x *DistinctMetadataForWindowType) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DistinctMetadataForWindowType.ProtoReflect.Descriptor instead.
+func (*DistinctMetadataForWindowType) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DistinctMetadataForWindowType) GetMetadata() *structpb.ListValue {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type WindowsForMetadataRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the time to read windows from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read windows to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// the window
+	Window        *WindowType                        `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	Metadata      []*WindowsForMetadataRead_Metadata `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowsForMetadataRead) Reset() {
+	*x = WindowsForMetadataRead{}
+	mi := &file_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowsForMetadataRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsForMetadataRead) ProtoMessage() {}
+
+func (x *WindowsForMetadataRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsForMetadataRead.ProtoReflect.Descriptor instead.
+func (*WindowsForMetadataRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WindowsForMetadataRead) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *WindowsForMetadataRead) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *WindowsForMetadataRead) GetWindow() *WindowType {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *WindowsForMetadataRead) GetMetadata() []*WindowsForMetadataRead_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type WindowsForMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the windows
+	Window        []*Window `protobuf:"bytes,1,rep,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WindowsForMetadata) Reset() {
+	*x = WindowsForMetadata{}
+	mi := &file_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowsForMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowsForMetadata) ProtoMessage() {}
+
+func (x *WindowsForMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowsForMetadata.ProtoReflect.Descriptor instead.
+func (*WindowsForMetadata) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WindowsForMetadata) GetWindow() []*Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ResultsSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only stream results of these algorithms. All algorithms when empty
+	Algorithms []*Algorithm `protobuf:"bytes,1,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	// only stream results of windows of this type
+	WindowType *WindowType                     `protobuf:"bytes,2,opt,name=window_type,json=windowType,proto3" json:"window_type,omitempty"`
+	Metadata   []*ResultsSubscription_Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// the cursor of the last result received, to resume streaming after it.
+	// Only results written after subscribing are streamed when not set
+	ResumeAfter   int64 `protobuf:"varint,4,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultsSubscription) Reset() {
+	*x = ResultsSubscription{}
+	mi := &file_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsSubscription) ProtoMessage() {}
+
+func (x *ResultsSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsSubscription.ProtoReflect.Descriptor instead.
+func (*ResultsSubscription) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ResultsSubscription) GetAlgorithms() []*Algorithm {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *ResultsSubscription) GetWindowType() *WindowType {
+	if x != nil {
+		return x.WindowType
+	}
+	return nil
+}
+
+func (x *ResultsSubscription) GetMetadata() []*ResultsSubscription_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ResultsSubscription) GetResumeAfter() int64 {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return 0
+}
+
+type ResultUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the position of the result in the stream, which can be resumed after
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// the window the result is for
+	Window *Window `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// the algorithm and its result
+	AlgorithmResult *AlgorithmResult `protobuf:"bytes,3,opt,name=algorithm_result,json=algorithmResult,proto3" json:"algorithm_result,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResultUpdate) Reset() {
+	*x = ResultUpdate{}
+	mi := &file_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultUpdate) ProtoMessage() {}
+
+func (x *ResultUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultUpdate.ProtoReflect.Descriptor instead.
+func (*ResultUpdate) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ResultUpdate) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ResultUpdate) GetWindow() *Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *ResultUpdate) GetAlgorithmResult() *AlgorithmResult {
+	if x != nil {
+		return x.AlgorithmResult
+	}
+	return nil
+}
+
+type ResultsForAlgorithmAndMetadataRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the time to read results from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read results to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// the algorithm to read results for
+	Algorithm     *Algorithm                                     `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Metadata      []*ResultsForAlgorithmAndMetadataRead_Metadata `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultsForAlgorithmAndMetadataRead) Reset() {
+	*x = ResultsForAlgorithmAndMetadataRead{}
+	mi := &file_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsForAlgorithmAndMetadataRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsForAlgorithmAndMetadataRead) ProtoMessage() {}
+
+func (x *ResultsForAlgorithmAndMetadataRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsForAlgorithmAndMetadataRead.ProtoReflect.Descriptor instead.
+func (*ResultsForAlgorithmAndMetadataRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ResultsForAlgorithmAndMetadataRead) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *ResultsForAlgorithmAndMetadataRead) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *ResultsForAlgorithmAndMetadataRead) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *ResultsForAlgorithmAndMetadataRead) GetMetadata() []*ResultsForAlgorithmAndMetadataRead_Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ResultsForAlgorithmAndMetadata struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Results       []*ResultsForAlgorithmAndMetadata_ResultsRow `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultsForAlgorithmAndMetadata) Reset() {
+	*x = ResultsForAlgorithmAndMetadata{}
+	mi := &file_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsForAlgorithmAndMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsForAlgorithmAndMetadata) ProtoMessage() {}
+
+func (x *ResultsForAlgorithmAndMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsForAlgorithmAndMetadata.ProtoReflect.Descriptor instead.
+func (*ResultsForAlgorithmAndMetadata) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ResultsForAlgorithmAndMetadata) GetResults() []*ResultsForAlgorithmAndMetadata_ResultsRow {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ------------------------ Annotation Messages ------------------------
+type AnnotateWrite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the time to annotate from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to annotate to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// the algorithms within the annotation
+	CapturedAlgorithms []*Algorithm `protobuf:"bytes,3,rep,name=captured_algorithms,json=capturedAlgorithms,proto3" json:"captured_algorithms,omitempty"`
+	// the windows within the annotation
+	CapturedWindows []*WindowType `protobuf:"bytes,4,rep,name=captured_windows,json=capturedWindows,proto3" json:"captured_windows,omitempty"`
+	// the description of the annotation
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// metadata to be attached to the annotation
+	Metadata      *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnotateWrite) Reset() {
+	*x = AnnotateWrite{}
+	mi := &file_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnotateWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotateWrite) ProtoMessage() {}
+
+func (x *AnnotateWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotateWrite.ProtoReflect.Descriptor instead.
+func (*AnnotateWrite) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AnnotateWrite) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *AnnotateWrite) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *AnnotateWrite) GetCapturedAlgorithms() []*Algorithm {
+	if x != nil {
+		return x.CapturedAlgorithms
+	}
+	return nil
+}
+
+func (x *AnnotateWrite) GetCapturedWindows() []*WindowType {
+	if x != nil {
+		return x.CapturedWindows
+	}
+	return nil
+}
+
+func (x *AnnotateWrite) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AnnotateWrite) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type AnnotateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnotateResponse) Reset() {
+	*x = AnnotateResponse{}
+	mi := &file_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnotateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotateResponse) ProtoMessage() {}
+
+func (x *AnnotateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotateResponse.ProtoReflect.Descriptor instead.
+func (*AnnotateResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{52}
+}
+
+type ExecutionRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the exec_id returned when the window was emitted
+	ExecId        string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionRead) Reset() {
+	*x = ExecutionRead{}
+	mi := &file_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionRead) ProtoMessage() {}
+
+func (x *ExecutionRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionRead.ProtoReflect.Descriptor instead.
+func (*ExecutionRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExecutionRead) GetExecId() string {
+	if x != nil {
+		return x.ExecId
+	}
+	return ""
+}
+
+type ExecutionQueueRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionQueueRead) Reset() {
+	*x = ExecutionQueueRead{}
+	mi := &file_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionQueueRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionQueueRead) ProtoMessage() {}
+
+func (x *ExecutionQueueRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionQueueRead.ProtoReflect.Descriptor instead.
+func (*ExecutionQueueRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{54}
+}
+
+type ExecutionQueue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the number of executions that can be processed at once
+	Workers int32 `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	// the number of executions being processed
+	Active int32 `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	// the number of executions waiting for a worker
+	Queued int32 `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	// the number of executions that can wait for a worker before emitted
+	// windows are rejected with RESOURCE_EXHAUSTED
+	QueueSize int32 `protobuf:"varint,4,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// the fraction of the capacity of the workers and queue that is in use,
+	// from 0 to 1
+	Saturation    float64 `protobuf:"fixed64,5,opt,name=saturation,proto3" json:"saturation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionQueue) Reset() {
+	*x = ExecutionQueue{}
+	mi := &file_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionQueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionQueue) ProtoMessage() {}
+
+func (x *ExecutionQueue) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionQueue.ProtoReflect.Descriptor instead.
+func (*ExecutionQueue) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExecutionQueue) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *ExecutionQueue) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *ExecutionQueue) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *ExecutionQueue) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *ExecutionQueue) GetSaturation() float64 {
+	if x != nil {
+		return x.Saturation
+	}
+	return 0
+}
+
+type ExecutionCancel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the exec_id returned when the window was emitted
+	ExecId        string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionCancel) Reset() {
+	*x = ExecutionCancel{}
+	mi := &file_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionCancel) ProtoMessage() {}
+
+func (x *ExecutionCancel) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,52 +4017,47 @@ func (x *FloatArray) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FloatArray.ProtoReflect.Descriptor instead.
-func (*FloatArray) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExecutionCancel.ProtoReflect.Descriptor instead.
+func (*ExecutionCancel) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *FloatArray) GetValues() []float32 {
+func (x *ExecutionCancel) GetExecId() string {
 	if x != nil {
-		return x.Values
+		return x.ExecId
 	}
-	return nil
+	return ""
 }
 
-// Result of an algorithm execution
-type Result struct {
+type ExecutionsRead struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Status of the result execution
-	Status ResultStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ResultStatus" json:"status,omitempty"`
-	// The actual result data - can be either an array of floats or a structured object
-	//
-	// Types that are valid to be assigned to ResultData:
-	//
-	//	*Result_SingleValue
-	//	*Result_FloatValues
-	//	*Result_StructValue
-	ResultData isResult_ResultData `protobuf_oneof:"result_data"`
-	// Timestamp when the result was produced
-	Timestamp     int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// the time to read executions of windows from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read executions of windows to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// only read executions triggered by this type of window
+	Window *WindowType `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// only read executions in this state
+	Status        ExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ExecutionStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Result) Reset() {
-	*x = Result{}
-	mi := &file_service_proto_msgTypes[7]
+func (x *ExecutionsRead) Reset() {
+	*x = ExecutionsRead{}
+	mi := &file_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Result) String() string {
+func (x *ExecutionsRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Result) ProtoMessage() {}
+func (*ExecutionsRead) ProtoMessage() {}
 
-func (x *Result) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[7]
+func (x *ExecutionsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,119 +4068,177 @@ func (x *Result) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Result.ProtoReflect.Descriptor instead.
-func (*Result) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExecutionsRead.ProtoReflect.Descriptor instead.
+func (*ExecutionsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Result) GetStatus() ResultStatus {
+func (x *ExecutionsRead) GetTimeFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.TimeFrom
 	}
-	return ResultStatus_RESULT_STATUS_HANDLED_FAILED
+	return nil
 }
 
-func (x *Result) GetResultData() isResult_ResultData {
+func (x *ExecutionsRead) GetTimeTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ResultData
+		return x.TimeTo
 	}
 	return nil
 }
 
-func (x *Result) GetSingleValue() float32 {
+func (x *ExecutionsRead) GetWindow() *WindowType {
 	if x != nil {
-		if x, ok := x.ResultData.(*Result_SingleValue); ok {
-			return x.SingleValue
-		}
+		return x.Window
 	}
-	return 0
+	return nil
 }
 
-func (x *Result) GetFloatValues() *FloatArray {
+func (x *ExecutionsRead) GetStatus() ExecutionStatus {
 	if x != nil {
-		if x, ok := x.ResultData.(*Result_FloatValues); ok {
-			return x.FloatValues
-		}
+		return x.Status
 	}
-	return nil
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
 }
 
-func (x *Result) GetStructValue() *structpb.Struct {
+// ExecutionAttempt is a single attempt made at executing an algorithm
+type ExecutionAttempt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the attempt number, starting at 1
+	Attempt int32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// the outcome of the attempt
+	Status ExecutionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ExecutionStatus" json:"status,omitempty"`
+	// the gRPC status code of a failed attempt
+	ErrorCode string `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// the error of a failed attempt
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// the backoff waited before the next attempt, in milliseconds
+	BackoffMs int64 `protobuf:"varint,5,opt,name=backoff_ms,json=backoffMs,proto3" json:"backoff_ms,omitempty"`
+	// when the attempt started
+	Started *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`
+	// when the attempt finished
+	Finished      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished,proto3" json:"finished,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionAttempt) Reset() {
+	*x = ExecutionAttempt{}
+	mi := &file_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionAttempt) ProtoMessage() {}
+
+func (x *ExecutionAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[58]
 	if x != nil {
-		if x, ok := x.ResultData.(*Result_StructValue); ok {
-			return x.StructValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Result) GetTimestamp() int64 {
+// Deprecated: Use ExecutionAttempt.ProtoReflect.Descriptor instead.
+func (*ExecutionAttempt) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExecutionAttempt) GetAttempt() int32 {
 	if x != nil {
-		return x.Timestamp
+		return x.Attempt
 	}
 	return 0
 }
 
-type isResult_ResultData interface {
-	isResult_ResultData()
+func (x *ExecutionAttempt) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
 }
 
-type Result_SingleValue struct {
-	// for single number results
-	SingleValue float32 `protobuf:"fixed32,2,opt,name=single_value,json=singleValue,proto3,oneof"`
+func (x *ExecutionAttempt) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
 }
 
-type Result_FloatValues struct {
-	// For numeric array results
-	FloatValues *FloatArray `protobuf:"bytes,3,opt,name=float_values,json=floatValues,proto3,oneof"`
+func (x *ExecutionAttempt) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
 }
 
-type Result_StructValue struct {
-	// For structured data results (JSON-like)
-	// Must follow a map<string, value> schema where value corresponds to https://protobuf.dev/reference/protobuf/google.protobuf/#value
-	StructValue *structpb.Struct `protobuf:"bytes,4,opt,name=struct_value,json=structValue,proto3,oneof"`
+func (x *ExecutionAttempt) GetBackoffMs() int64 {
+	if x != nil {
+		return x.BackoffMs
+	}
+	return 0
 }
 
-func (*Result_SingleValue) isResult_ResultData() {}
-
-func (*Result_FloatValues) isResult_ResultData() {}
+func (x *ExecutionAttempt) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
 
-func (*Result_StructValue) isResult_ResultData() {}
+func (x *ExecutionAttempt) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
 
-// ProcessorRegistration is sent by processors when they start up to announce their capabilities
-// to the orchestrator. This establishes a long-lived connection for receiving tasks.
-type ProcessorRegistration struct {
+// AlgorithmExecution is the state of a single algorithm within an execution
+type AlgorithmExecution struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique name of the runtime
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Language/runtime of the processor
-	// Examples: "python3.9", "go1.19", "Rust4.1"
-	Runtime string `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	// The connection string of the processor
-	// e.g. grpc://localhost:5433
-	ConnectionStr string `protobuf:"bytes,3,opt,name=connection_str,json=connectionStr,proto3" json:"connection_str,omitempty"`
-	// Algorithms this processor can execute
-	// The processor must implement all listed algorithms
-	SupportedAlgorithms []*Algorithm `protobuf:"bytes,4,rep,name=supported_algorithms,json=supportedAlgorithms,proto3" json:"supported_algorithms,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// the algorithm being executed
+	Algorithm *Algorithm `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// the name of the processor executing the algorithm
+	ProcessorName string `protobuf:"bytes,2,opt,name=processor_name,json=processorName,proto3" json:"processor_name,omitempty"`
+	// the runtime of the processor executing the algorithm
+	ProcessorRuntime string `protobuf:"bytes,3,opt,name=processor_runtime,json=processorRuntime,proto3" json:"processor_runtime,omitempty"`
+	// the state of the algorithm
+	Status ExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ExecutionStatus" json:"status,omitempty"`
+	// when the algorithm started executing
+	Started *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started,proto3" json:"started,omitempty"`
+	// when the algorithm finished executing
+	Finished *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished,proto3" json:"finished,omitempty"`
+	// the error the algorithm failed with
+	ErrorMessage string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// attempts made at executing the algorithm, including any retries
+	Attempts      []*ExecutionAttempt `protobuf:"bytes,8,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessorRegistration) Reset() {
-	*x = ProcessorRegistration{}
-	mi := &file_service_proto_msgTypes[8]
+func (x *AlgorithmExecution) Reset() {
+	*x = AlgorithmExecution{}
+	mi := &file_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessorRegistration) String() string {
+func (x *AlgorithmExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessorRegistration) ProtoMessage() {}
+func (*AlgorithmExecution) ProtoMessage() {}
 
-func (x *ProcessorRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[8]
+func (x *AlgorithmExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,75 +4249,106 @@ func (x *ProcessorRegistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessorRegistration.ProtoReflect.Descriptor instead.
-func (*ProcessorRegistration) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AlgorithmExecution.ProtoReflect.Descriptor instead.
+func (*AlgorithmExecution) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ProcessorRegistration) GetName() string {
+func (x *AlgorithmExecution) GetAlgorithm() *Algorithm {
 	if x != nil {
-		return x.Name
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *AlgorithmExecution) GetProcessorName() string {
+	if x != nil {
+		return x.ProcessorName
 	}
 	return ""
 }
 
-func (x *ProcessorRegistration) GetRuntime() string {
+func (x *AlgorithmExecution) GetProcessorRuntime() string {
 	if x != nil {
-		return x.Runtime
+		return x.ProcessorRuntime
 	}
 	return ""
 }
 
-func (x *ProcessorRegistration) GetConnectionStr() string {
+func (x *AlgorithmExecution) GetStatus() ExecutionStatus {
 	if x != nil {
-		return x.ConnectionStr
+		return x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (x *AlgorithmExecution) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *AlgorithmExecution) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *AlgorithmExecution) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-func (x *ProcessorRegistration) GetSupportedAlgorithms() []*Algorithm {
+func (x *AlgorithmExecution) GetAttempts() []*ExecutionAttempt {
 	if x != nil {
-		return x.SupportedAlgorithms
+		return x.Attempts
 	}
 	return nil
 }
 
-// ProcessingTask represents a single algorithm execution request sent to a processor.
-// Tasks are streamed to processors as their dependencies are satisfied.
-type ProcessingTask struct {
+// Execution is the state of the processing triggered by an emitted window
+type Execution struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique ID for this specific task execution
-	// Used to correlate results and track execution state
-	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	// Algorithm to execute
-	// Must be one of the algorithms the processor registered support for
-	Algorithm *Algorithm `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	// Window that triggered this task
-	// Provides the time context for the algorithm execution
-	Window *Window `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
-	// Results from dependent algorithms
-	// Contains all results that this algorithm declared dependencies on
-	// All dependencies will be present when task is sent
-	DependencyResults []*Result `protobuf:"bytes,4,rep,name=dependency_results,json=dependencyResults,proto3" json:"dependency_results,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// the exec_id returned when the window was emitted
+	ExecId string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// the window that triggered the execution
+	Window *Window `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// the overall state of the execution
+	Status ExecutionStatus `protobuf:"varint,3,opt,name=status,proto3,enum=ExecutionStatus" json:"status,omitempty"`
+	// when the window was emitted
+	Created *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	// when the execution started
+	Started *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started,proto3" json:"started,omitempty"`
+	// when the execution finished
+	Finished *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished,proto3" json:"finished,omitempty"`
+	// the state of each triggered algorithm, in execution order
+	Algorithms []*AlgorithmExecution `protobuf:"bytes,7,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	// whether the execution is of an open window, whose results are replaced
+	// once the window closes
+	Provisional   bool `protobuf:"varint,8,opt,name=provisional,proto3" json:"provisional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessingTask) Reset() {
-	*x = ProcessingTask{}
-	mi := &file_service_proto_msgTypes[9]
+func (x *Execution) Reset() {
+	*x = Execution{}
+	mi := &file_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessingTask) String() string {
+func (x *Execution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessingTask) ProtoMessage() {}
+func (*Execution) ProtoMessage() {}
 
-func (x *ProcessingTask) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[9]
+func (x *Execution) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,70 +4359,147 @@ func (x *ProcessingTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessingTask.ProtoReflect.Descriptor instead.
-func (*ProcessingTask) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Execution.ProtoReflect.Descriptor instead.
+func (*Execution) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ProcessingTask) GetTaskId() string {
+func (x *Execution) GetExecId() string {
 	if x != nil {
-		return x.TaskId
+		return x.ExecId
 	}
 	return ""
 }
 
-func (x *ProcessingTask) GetAlgorithm() *Algorithm {
-	if x != nil {
-		return x.Algorithm
-	}
-	return nil
+func (x *Execution) GetWindow() *Window {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Execution) GetStatus() ExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (x *Execution) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Execution) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *Execution) GetFinished() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *Execution) GetAlgorithms() []*AlgorithmExecution {
+	if x != nil {
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *Execution) GetProvisional() bool {
+	if x != nil {
+		return x.Provisional
+	}
+	return false
+}
+
+type Executions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the executions
+	Executions    []*Execution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Executions) Reset() {
+	*x = Executions{}
+	mi := &file_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Executions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProcessingTask) GetWindow() *Window {
+func (*Executions) ProtoMessage() {}
+
+func (x *Executions) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[61]
 	if x != nil {
-		return x.Window
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Executions.ProtoReflect.Descriptor instead.
+func (*Executions) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ProcessingTask) GetDependencyResults() []*Result {
+func (x *Executions) GetExecutions() []*Execution {
 	if x != nil {
-		return x.DependencyResults
+		return x.Executions
 	}
 	return nil
 }
 
-// ExecutionRequest provides a complete view of a processing DAG's execution
-// status for a specific window. Used for monitoring and debugging.
-type ExecutionRequest struct {
+type WindowsReprocess struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The exec_id
-	ExecId string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
-	// The window that triggered the algorithm
-	Window *Window `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
-	// Results from dependant algorithms
-	AlgorithmResults []*AlgorithmResult `protobuf:"bytes,3,rep,name=algorithm_results,json=algorithmResults,proto3" json:"algorithm_results,omitempty"`
-	// The algorithms to execute
-	Algorithms    []*Algorithm `protobuf:"bytes,4,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// the time to reprocess windows from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to reprocess windows to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// the type of window to reprocess
+	Window *WindowType `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// only reprocess windows whose metadata contains these fields and values
+	Metadata *structpb.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// only execute these algorithms of the window type. Algorithms that are not
+	// selected are never executed, with their stored results used as the inputs
+	// of selected algorithms. All algorithms are executed when none are given
+	Algorithms []*Algorithm `protobuf:"bytes,5,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	// the most windows to trigger per second. Defaults to ORCA_REPROCESS_RATE
+	WindowsPerSecond float64 `protobuf:"fixed64,6,opt,name=windows_per_second,json=windowsPerSecond,proto3" json:"windows_per_second,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ExecutionRequest) Reset() {
-	*x = ExecutionRequest{}
-	mi := &file_service_proto_msgTypes[10]
+func (x *WindowsReprocess) Reset() {
+	*x = WindowsReprocess{}
+	mi := &file_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutionRequest) String() string {
+func (x *WindowsReprocess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionRequest) ProtoMessage() {}
+func (*WindowsReprocess) ProtoMessage() {}
 
-func (x *ExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[10]
+func (x *WindowsReprocess) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1056,64 +4510,76 @@ func (x *ExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionRequest.ProtoReflect.Descriptor instead.
-func (*ExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use WindowsReprocess.ProtoReflect.Descriptor instead.
+func (*WindowsReprocess) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ExecutionRequest) GetExecId() string {
+func (x *WindowsReprocess) GetTimeFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExecId
+		return x.TimeFrom
 	}
-	return ""
+	return nil
 }
 
-func (x *ExecutionRequest) GetWindow() *Window {
+func (x *WindowsReprocess) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *WindowsReprocess) GetWindow() *WindowType {
 	if x != nil {
 		return x.Window
 	}
 	return nil
 }
 
-func (x *ExecutionRequest) GetAlgorithmResults() []*AlgorithmResult {
+func (x *WindowsReprocess) GetMetadata() *structpb.Struct {
 	if x != nil {
-		return x.AlgorithmResults
+		return x.Metadata
 	}
 	return nil
 }
 
-func (x *ExecutionRequest) GetAlgorithms() []*Algorithm {
+func (x *WindowsReprocess) GetAlgorithms() []*Algorithm {
 	if x != nil {
 		return x.Algorithms
 	}
 	return nil
 }
 
-type ExecutionResult struct {
+func (x *WindowsReprocess) GetWindowsPerSecond() float64 {
+	if x != nil {
+		return x.WindowsPerSecond
+	}
+	return 0
+}
+
+type ReprocessRead struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Exec ID
-	ExecId string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
-	// The algorithn result
-	AlgorithmResult *AlgorithmResult `protobuf:"bytes,3,opt,name=algorithm_result,json=algorithmResult,proto3" json:"algorithm_result,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// the reprocess_id returned when the reprocess was started
+	ReprocessId   string `protobuf:"bytes,1,opt,name=reprocess_id,json=reprocessId,proto3" json:"reprocess_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecutionResult) Reset() {
-	*x = ExecutionResult{}
-	mi := &file_service_proto_msgTypes[11]
+func (x *ReprocessRead) Reset() {
+	*x = ReprocessRead{}
+	mi := &file_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutionResult) String() string {
+func (x *ReprocessRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionResult) ProtoMessage() {}
+func (*ReprocessRead) ProtoMessage() {}
 
-func (x *ExecutionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[11]
+func (x *ReprocessRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,49 +4590,59 @@ func (x *ExecutionResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionResult.ProtoReflect.Descriptor instead.
-func (*ExecutionResult) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ReprocessRead.ProtoReflect.Descriptor instead.
+func (*ReprocessRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ExecutionResult) GetExecId() string {
+func (x *ReprocessRead) GetReprocessId() string {
 	if x != nil {
-		return x.ExecId
+		return x.ReprocessId
 	}
 	return ""
 }
 
-func (x *ExecutionResult) GetAlgorithmResult() *AlgorithmResult {
-	if x != nil {
-		return x.AlgorithmResult
-	}
-	return nil
-}
-
-// AlgorithmWindowResult Packaged algorithm and result to a window
-type AlgorithmResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Algorithm     *Algorithm             `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+// Reprocess is the progress of reprocessing stored windows
+type Reprocess struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifies the reprocess, to read its progress
+	ReprocessId string `protobuf:"bytes,1,opt,name=reprocess_id,json=reprocessId,proto3" json:"reprocess_id,omitempty"`
+	// the state of the reprocess, which finishes once every window has been
+	// triggered and its execution has finished
+	Status ExecutionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=ExecutionStatus" json:"status,omitempty"`
+	// the number of windows to reprocess
+	TotalWindows int32 `protobuf:"varint,3,opt,name=total_windows,json=totalWindows,proto3" json:"total_windows,omitempty"`
+	// the number of windows whose execution has been triggered
+	TriggeredWindows int32 `protobuf:"varint,4,opt,name=triggered_windows,json=triggeredWindows,proto3" json:"triggered_windows,omitempty"`
+	// the number of windows whose execution succeeded
+	SucceededWindows int32 `protobuf:"varint,5,opt,name=succeeded_windows,json=succeededWindows,proto3" json:"succeeded_windows,omitempty"`
+	// the number of windows whose execution failed or was cancelled
+	FailedWindows int32 `protobuf:"varint,6,opt,name=failed_windows,json=failedWindows,proto3" json:"failed_windows,omitempty"`
+	// when the reprocess was started
+	Created *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`
+	// when the reprocess finished
+	Finished *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished,proto3" json:"finished,omitempty"`
+	// the error the reprocess failed with
+	ErrorMessage  string `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlgorithmResult) Reset() {
-	*x = AlgorithmResult{}
-	mi := &file_service_proto_msgTypes[12]
+func (x *Reprocess) Reset() {
+	*x = Reprocess{}
+	mi := &file_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlgorithmResult) String() string {
+func (x *Reprocess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlgorithmResult) ProtoMessage() {}
+func (*Reprocess) ProtoMessage() {}
 
-func (x *AlgorithmResult) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[12]
+func (x *Reprocess) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,102 +4653,108 @@ func (x *AlgorithmResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlgorithmResult.ProtoReflect.Descriptor instead.
-func (*AlgorithmResult) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Reprocess.ProtoReflect.Descriptor instead.
+func (*Reprocess) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *AlgorithmResult) GetAlgorithm() *Algorithm {
+func (x *Reprocess) GetReprocessId() string {
 	if x != nil {
-		return x.Algorithm
+		return x.ReprocessId
 	}
-	return nil
+	return ""
 }
 
-func (x *AlgorithmResult) GetResult() *Result {
+func (x *Reprocess) GetStatus() ExecutionStatus {
 	if x != nil {
-		return x.Result
+		return x.Status
 	}
-	return nil
+	return ExecutionStatus_EXECUTION_STATUS_UNSPECIFIED
 }
 
-type Status struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Received      bool                   `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Reprocess) GetTotalWindows() int32 {
+	if x != nil {
+		return x.TotalWindows
+	}
+	return 0
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
-	mi := &file_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Reprocess) GetTriggeredWindows() int32 {
+	if x != nil {
+		return x.TriggeredWindows
+	}
+	return 0
 }
 
-func (x *Status) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Reprocess) GetSucceededWindows() int32 {
+	if x != nil {
+		return x.SucceededWindows
+	}
+	return 0
 }
 
-func (*Status) ProtoMessage() {}
-
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[13]
+func (x *Reprocess) GetFailedWindows() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FailedWindows
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{13}
+func (x *Reprocess) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
 }
 
-func (x *Status) GetReceived() bool {
+func (x *Reprocess) GetFinished() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Received
+		return x.Finished
 	}
-	return false
+	return nil
 }
 
-func (x *Status) GetMessage() string {
+func (x *Reprocess) GetErrorMessage() string {
 	if x != nil {
-		return x.Message
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-// HealthCheckRequest is sent to processors to verify they are functioning
-type HealthCheckRequest struct {
+type FailedExecutionsRead struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Timestamp of the request in unix epoch milliseconds
-	// Used to measure response latency
-	Timestamp     int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// the time to read failures of windows from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read failures of windows to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// only read failures of tasks sent to processors with this name
+	ProcessorName string `protobuf:"bytes,3,opt,name=processor_name,json=processorName,proto3" json:"processor_name,omitempty"`
+	// only read failures of tasks sent to processors with this runtime
+	ProcessorRuntime string `protobuf:"bytes,4,opt,name=processor_runtime,json=processorRuntime,proto3" json:"processor_runtime,omitempty"`
+	// only read failures of tasks executing this algorithm. The version may be
+	// left out to match every version
+	Algorithm *Algorithm `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// also read failures that have already been requeued
+	IncludeRequeued bool `protobuf:"varint,6,opt,name=include_requeued,json=includeRequeued,proto3" json:"include_requeued,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
-	mi := &file_service_proto_msgTypes[14]
+func (x *FailedExecutionsRead) Reset() {
+	*x = FailedExecutionsRead{}
+	mi := &file_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *FailedExecutionsRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*FailedExecutionsRead) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[14]
+func (x *FailedExecutionsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1283,46 +4765,85 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use FailedExecutionsRead.ProtoReflect.Descriptor instead.
+func (*FailedExecutionsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *HealthCheckRequest) GetTimestamp() int64 {
+func (x *FailedExecutionsRead) GetTimeFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.TimeFrom
 	}
-	return 0
+	return nil
 }
 
-// HealthCheckResponse indicates the health status of a processor
-type HealthCheckResponse struct {
+func (x *FailedExecutionsRead) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
+}
+
+func (x *FailedExecutionsRead) GetProcessorName() string {
+	if x != nil {
+		return x.ProcessorName
+	}
+	return ""
+}
+
+func (x *FailedExecutionsRead) GetProcessorRuntime() string {
+	if x != nil {
+		return x.ProcessorRuntime
+	}
+	return ""
+}
+
+func (x *FailedExecutionsRead) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *FailedExecutionsRead) GetIncludeRequeued() bool {
+	if x != nil {
+		return x.IncludeRequeued
+	}
+	return false
+}
+
+type FailedExecutionsRequeue struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Current health status
-	Status HealthCheckResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=HealthCheckResponse_Status" json:"status,omitempty"`
-	// Optional message providing more detail about the health status
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// System metrics about the processor
-	Metrics       *ProcessorMetrics `protobuf:"bytes,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// the time to requeue failures of windows from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to requeue failures of windows to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// only requeue failures of tasks sent to processors with this name
+	ProcessorName string `protobuf:"bytes,3,opt,name=processor_name,json=processorName,proto3" json:"processor_name,omitempty"`
+	// only requeue failures of tasks sent to processors with this runtime
+	ProcessorRuntime string `protobuf:"bytes,4,opt,name=processor_runtime,json=processorRuntime,proto3" json:"processor_runtime,omitempty"`
+	// only requeue failures of tasks executing this algorithm. The version may
+	// be left out to match every version
+	Algorithm     *Algorithm `protobuf:"bytes,5,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
-	mi := &file_service_proto_msgTypes[15]
+func (x *FailedExecutionsRequeue) Reset() {
+	*x = FailedExecutionsRequeue{}
+	mi := &file_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *FailedExecutionsRequeue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*FailedExecutionsRequeue) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[15]
+func (x *FailedExecutionsRequeue) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,62 +4854,89 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use FailedExecutionsRequeue.ProtoReflect.Descriptor instead.
+func (*FailedExecutionsRequeue) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *FailedExecutionsRequeue) GetTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return nil
+}
+
+func (x *FailedExecutionsRequeue) GetTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeTo
+	}
+	return nil
 }
 
-func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_Status {
+func (x *FailedExecutionsRequeue) GetProcessorName() string {
 	if x != nil {
-		return x.Status
+		return x.ProcessorName
 	}
-	return HealthCheckResponse_STATUS_UNKNOWN
+	return ""
 }
 
-func (x *HealthCheckResponse) GetMessage() string {
+func (x *FailedExecutionsRequeue) GetProcessorRuntime() string {
 	if x != nil {
-		return x.Message
+		return x.ProcessorRuntime
 	}
 	return ""
 }
 
-func (x *HealthCheckResponse) GetMetrics() *ProcessorMetrics {
+func (x *FailedExecutionsRequeue) GetAlgorithm() *Algorithm {
 	if x != nil {
-		return x.Metrics
+		return x.Algorithm
 	}
 	return nil
 }
 
-// ProcessorMetrics provides runtime information about a processor
-type ProcessorMetrics struct {
+// FailedExecution is a processor task that failed after exhausting its retries
+type FailedExecution struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Number of algorithms currently being executed
-	ActiveTasks int32 `protobuf:"varint,1,opt,name=active_tasks,json=activeTasks,proto3" json:"active_tasks,omitempty"`
-	// Memory usage in bytes
-	MemoryBytes int64 `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	// CPU usage percentage (0-100)
-	CpuPercent float32 `protobuf:"fixed32,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
-	// Time since processor started in seconds
-	UptimeSeconds int64 `protobuf:"varint,4,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// the exec_id of the execution the task belongs to
+	ExecId string `protobuf:"bytes,1,opt,name=exec_id,json=execId,proto3" json:"exec_id,omitempty"`
+	// the exec_id of the request sent to the processor
+	TaskExecId string `protobuf:"bytes,2,opt,name=task_exec_id,json=taskExecId,proto3" json:"task_exec_id,omitempty"`
+	// the name of the processor the task was sent to
+	ProcessorName string `protobuf:"bytes,3,opt,name=processor_name,json=processorName,proto3" json:"processor_name,omitempty"`
+	// the runtime of the processor the task was sent to
+	ProcessorRuntime string `protobuf:"bytes,4,opt,name=processor_runtime,json=processorRuntime,proto3" json:"processor_runtime,omitempty"`
+	// the request made in the last attempt, including the window, algorithms
+	// and dependency results
+	Request *ExecutionRequest `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	// the gRPC status code the task failed with
+	ErrorCode string `protobuf:"bytes,6,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// the error the task failed with
+	ErrorMessage string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// the attempts made at executing the task, including any requeues
+	Attempts int32 `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// when the task failed
+	Failed *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=failed,proto3" json:"failed,omitempty"`
+	// when the failure was requeued, if it has been
+	Requeued      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=requeued,proto3" json:"requeued,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessorMetrics) Reset() {
-	*x = ProcessorMetrics{}
-	mi := &file_service_proto_msgTypes[16]
+func (x *FailedExecution) Reset() {
+	*x = FailedExecution{}
+	mi := &file_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessorMetrics) String() string {
+func (x *FailedExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessorMetrics) ProtoMessage() {}
+func (*FailedExecution) ProtoMessage() {}
 
-func (x *ProcessorMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[16]
+func (x *FailedExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,98 +4947,104 @@ func (x *ProcessorMetrics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessorMetrics.ProtoReflect.Descriptor instead.
-func (*ProcessorMetrics) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use FailedExecution.ProtoReflect.Descriptor instead.
+func (*FailedExecution) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ProcessorMetrics) GetActiveTasks() int32 {
+func (x *FailedExecution) GetExecId() string {
 	if x != nil {
-		return x.ActiveTasks
+		return x.ExecId
 	}
-	return 0
+	return ""
 }
 
-func (x *ProcessorMetrics) GetMemoryBytes() int64 {
+func (x *FailedExecution) GetTaskExecId() string {
 	if x != nil {
-		return x.MemoryBytes
+		return x.TaskExecId
 	}
-	return 0
+	return ""
 }
 
-func (x *ProcessorMetrics) GetCpuPercent() float32 {
+func (x *FailedExecution) GetProcessorName() string {
 	if x != nil {
-		return x.CpuPercent
+		return x.ProcessorName
 	}
-	return 0
+	return ""
 }
 
-func (x *ProcessorMetrics) GetUptimeSeconds() int64 {
+func (x *FailedExecution) GetProcessorRuntime() string {
 	if x != nil {
-		return x.UptimeSeconds
+		return x.ProcessorRuntime
 	}
-	return 0
+	return ""
 }
 
-// ---------------------------- Data Messages ----------------------------
-type WindowTypeRead struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FailedExecution) GetRequest() *ExecutionRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
 }
 
-func (x *WindowTypeRead) Reset() {
-	*x = WindowTypeRead{}
-	mi := &file_service_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FailedExecution) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
 }
 
-func (x *WindowTypeRead) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FailedExecution) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
 }
 
-func (*WindowTypeRead) ProtoMessage() {}
+func (x *FailedExecution) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
 
-func (x *WindowTypeRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[17]
+func (x *FailedExecution) GetFailed() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Failed
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use WindowTypeRead.ProtoReflect.Descriptor instead.
-func (*WindowTypeRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{17}
+func (x *FailedExecution) GetRequeued() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Requeued
+	}
+	return nil
 }
 
-type WindowTypes struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Windows       []*WindowType          `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type FailedExecutions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the failures
+	FailedExecutions []*FailedExecution `protobuf:"bytes,1,rep,name=failed_executions,json=failedExecutions,proto3" json:"failed_executions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *WindowTypes) Reset() {
-	*x = WindowTypes{}
-	mi := &file_service_proto_msgTypes[18]
+func (x *FailedExecutions) Reset() {
+	*x = FailedExecutions{}
+	mi := &file_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WindowTypes) String() string {
+func (x *FailedExecutions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowTypes) ProtoMessage() {}
+func (*FailedExecutions) ProtoMessage() {}
 
-func (x *WindowTypes) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[18]
+func (x *FailedExecutions) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,39 +5055,41 @@ func (x *WindowTypes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowTypes.ProtoReflect.Descriptor instead.
-func (*WindowTypes) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use FailedExecutions.ProtoReflect.Descriptor instead.
+func (*FailedExecutions) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *WindowTypes) GetWindows() []*WindowType {
+func (x *FailedExecutions) GetFailedExecutions() []*FailedExecution {
 	if x != nil {
-		return x.Windows
+		return x.FailedExecutions
 	}
 	return nil
 }
 
-type AlgorithmsRead struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type RequeuedExecutions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the exec_ids of the executions that were requeued
+	ExecIds       []string `protobuf:"bytes,1,rep,name=exec_ids,json=execIds,proto3" json:"exec_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlgorithmsRead) Reset() {
-	*x = AlgorithmsRead{}
-	mi := &file_service_proto_msgTypes[19]
+func (x *RequeuedExecutions) Reset() {
+	*x = RequeuedExecutions{}
+	mi := &file_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlgorithmsRead) String() string {
+func (x *RequeuedExecutions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlgorithmsRead) ProtoMessage() {}
+func (*RequeuedExecutions) ProtoMessage() {}
 
-func (x *AlgorithmsRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[19]
+func (x *RequeuedExecutions) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1544,33 +5100,56 @@ func (x *AlgorithmsRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlgorithmsRead.ProtoReflect.Descriptor instead.
-func (*AlgorithmsRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RequeuedExecutions.ProtoReflect.Descriptor instead.
+func (*RequeuedExecutions) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{69}
 }
 
-type Algorithms struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Algorithm     []*Algorithm           `protobuf:"bytes,1,rep,name=algorithm,proto3" json:"algorithm,omitempty"`
+func (x *RequeuedExecutions) GetExecIds() []string {
+	if x != nil {
+		return x.ExecIds
+	}
+	return nil
+}
+
+// AlertRule is checked against the results of an algorithm as they are
+// written, firing an alert when its expression holds and resolving it once
+// the expression no longer holds
+type AlertRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unique name of the rule
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the algorithm whose results are checked
+	Algorithm *Algorithm `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// a CEL expression evaluating to a bool. It is evaluated over each
+	// succeeded result as `result`, along with the `origin` and `metadata` of
+	// its window. E.g. `result.single_value > 10.0` or
+	// `result.struct_value.status == "fault" && metadata.site == "north"`
+	Expression string `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	// description of the rule
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// alerts are deduplicated per window origin and the value of this metadata
+	// field. Per origin alone when not set
+	MetadataKey   string `protobuf:"bytes,5,opt,name=metadata_key,json=metadataKey,proto3" json:"metadata_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Algorithms) Reset() {
-	*x = Algorithms{}
-	mi := &file_service_proto_msgTypes[20]
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Algorithms) String() string {
+func (x *AlertRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Algorithms) ProtoMessage() {}
+func (*AlertRule) ProtoMessage() {}
 
-func (x *Algorithms) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[20]
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1581,76 +5160,67 @@ func (x *Algorithms) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Algorithms.ProtoReflect.Descriptor instead.
-func (*Algorithms) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Algorithms) GetAlgorithm() []*Algorithm {
+func (x *AlertRule) GetName() string {
 	if x != nil {
-		return x.Algorithm
+		return x.Name
 	}
-	return nil
-}
-
-type ProcessorsRead struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *ProcessorsRead) Reset() {
-	*x = ProcessorsRead{}
-	mi := &file_service_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AlertRule) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
 }
 
-func (x *ProcessorsRead) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AlertRule) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
 }
 
-func (*ProcessorsRead) ProtoMessage() {}
-
-func (x *ProcessorsRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[21]
+func (x *AlertRule) GetDescription() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ProcessorsRead.ProtoReflect.Descriptor instead.
-func (*ProcessorsRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{21}
+func (x *AlertRule) GetMetadataKey() string {
+	if x != nil {
+		return x.MetadataKey
+	}
+	return ""
 }
 
-type Processors struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Processor     []*Processors_Processor `protobuf:"bytes,1,rep,name=processor,proto3" json:"processor,omitempty"`
+type AlertRulesRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Processors) Reset() {
-	*x = Processors{}
-	mi := &file_service_proto_msgTypes[22]
+func (x *AlertRulesRead) Reset() {
+	*x = AlertRulesRead{}
+	mi := &file_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Processors) String() string {
+func (x *AlertRulesRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Processors) ProtoMessage() {}
+func (*AlertRulesRead) ProtoMessage() {}
 
-func (x *Processors) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[22]
+func (x *AlertRulesRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,39 +5231,33 @@ func (x *Processors) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Processors.ProtoReflect.Descriptor instead.
-func (*Processors) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *Processors) GetProcessor() []*Processors_Processor {
-	if x != nil {
-		return x.Processor
-	}
-	return nil
+// Deprecated: Use AlertRulesRead.ProtoReflect.Descriptor instead.
+func (*AlertRulesRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{71}
 }
 
-type ResultsStatsRead struct {
+type AlertRules struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertRules    []*AlertRule           `protobuf:"bytes,1,rep,name=alert_rules,json=alertRules,proto3" json:"alert_rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResultsStatsRead) Reset() {
-	*x = ResultsStatsRead{}
-	mi := &file_service_proto_msgTypes[23]
+func (x *AlertRules) Reset() {
+	*x = AlertRules{}
+	mi := &file_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResultsStatsRead) String() string {
+func (x *AlertRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultsStatsRead) ProtoMessage() {}
+func (*AlertRules) ProtoMessage() {}
 
-func (x *ResultsStatsRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[23]
+func (x *AlertRules) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1704,33 +5268,41 @@ func (x *ResultsStatsRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultsStatsRead.ProtoReflect.Descriptor instead.
-func (*ResultsStatsRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AlertRules.ProtoReflect.Descriptor instead.
+func (*AlertRules) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{72}
 }
 
-type ResultsStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int64                  `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+func (x *AlertRules) GetAlertRules() []*AlertRule {
+	if x != nil {
+		return x.AlertRules
+	}
+	return nil
+}
+
+type AlertRuleDeletion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the rule
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResultsStats) Reset() {
-	*x = ResultsStats{}
-	mi := &file_service_proto_msgTypes[24]
+func (x *AlertRuleDeletion) Reset() {
+	*x = AlertRuleDeletion{}
+	mi := &file_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResultsStats) String() string {
+func (x *AlertRuleDeletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultsStats) ProtoMessage() {}
+func (*AlertRuleDeletion) ProtoMessage() {}
 
-func (x *ResultsStats) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[24]
+func (x *AlertRuleDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,42 +5313,49 @@ func (x *ResultsStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultsStats.ProtoReflect.Descriptor instead.
-func (*ResultsStats) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *ResultsStats) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+// Deprecated: Use AlertRuleDeletion.ProtoReflect.Descriptor instead.
+func (*AlertRuleDeletion) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{73}
 }
 
-type AlgorithmFieldsRead struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeFrom      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
-	TimeTo        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
-	Algorithm     *Algorithm             `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AlertRuleDeletion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *AlgorithmFieldsRead) Reset() {
-	*x = AlgorithmFieldsRead{}
-	mi := &file_service_proto_msgTypes[25]
+type AlertsRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the time to read alerts fired from
+	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	// the time to read alerts fired to
+	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	// only read alerts of this rule
+	AlertRuleName string `protobuf:"bytes,3,opt,name=alert_rule_name,json=alertRuleName,proto3" json:"alert_rule_name,omitempty"`
+	// only read alerts in this state. Alerts in any state when not set
+	Status AlertStatus `protobuf:"varint,4,opt,name=status,proto3,enum=AlertStatus" json:"status,omitempty"`
+	// also read alerts that have been acknowledged
+	IncludeAcknowledged bool `protobuf:"varint,5,opt,name=include_acknowledged,json=includeAcknowledged,proto3" json:"include_acknowledged,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AlertsRead) Reset() {
+	*x = AlertsRead{}
+	mi := &file_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlgorithmFieldsRead) String() string {
+func (x *AlertsRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlgorithmFieldsRead) ProtoMessage() {}
+func (*AlertsRead) ProtoMessage() {}
 
-func (x *AlgorithmFieldsRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[25]
+func (x *AlertsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,54 +5366,68 @@ func (x *AlgorithmFieldsRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlgorithmFieldsRead.ProtoReflect.Descriptor instead.
-func (*AlgorithmFieldsRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AlertsRead.ProtoReflect.Descriptor instead.
+func (*AlertsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *AlgorithmFieldsRead) GetTimeFrom() *timestamppb.Timestamp {
+func (x *AlertsRead) GetTimeFrom() *timestamppb.Timestamp {
 	if x != nil {
 		return x.TimeFrom
 	}
 	return nil
 }
 
-func (x *AlgorithmFieldsRead) GetTimeTo() *timestamppb.Timestamp {
+func (x *AlertsRead) GetTimeTo() *timestamppb.Timestamp {
 	if x != nil {
 		return x.TimeTo
 	}
 	return nil
 }
 
-func (x *AlgorithmFieldsRead) GetAlgorithm() *Algorithm {
+func (x *AlertsRead) GetAlertRuleName() string {
 	if x != nil {
-		return x.Algorithm
+		return x.AlertRuleName
 	}
-	return nil
+	return ""
 }
 
-type AlgorithmFields struct {
+func (x *AlertsRead) GetStatus() AlertStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AlertStatus_ALERT_STATUS_UNSPECIFIED
+}
+
+func (x *AlertsRead) GetIncludeAcknowledged() bool {
+	if x != nil {
+		return x.IncludeAcknowledged
+	}
+	return false
+}
+
+type Alerts struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         []string               `protobuf:"bytes,1,rep,name=field,proto3" json:"field,omitempty"`
+	Alerts        []*Alerts_Alert        `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AlgorithmFields) Reset() {
-	*x = AlgorithmFields{}
-	mi := &file_service_proto_msgTypes[26]
+func (x *Alerts) Reset() {
+	*x = Alerts{}
+	mi := &file_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AlgorithmFields) String() string {
+func (x *Alerts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlgorithmFields) ProtoMessage() {}
+func (*Alerts) ProtoMessage() {}
 
-func (x *AlgorithmFields) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[26]
+func (x *Alerts) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1845,45 +5438,43 @@ func (x *AlgorithmFields) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlgorithmFields.ProtoReflect.Descriptor instead.
-func (*AlgorithmFields) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use Alerts.ProtoReflect.Descriptor instead.
+func (*Alerts) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AlgorithmFields) GetField() []string {
+func (x *Alerts) GetAlerts() []*Alerts_Alert {
 	if x != nil {
-		return x.Field
+		return x.Alerts
 	}
 	return nil
 }
 
-type ResultsForAlgorithmRead struct {
+type AlertAcknowledgement struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the time to read results from
-	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
-	// the time to read results to
-	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
-	// the algorithm to read results for
-	Algorithm     *Algorithm `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// the ID of the alert
+	AlertId int64 `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	// who is acknowledging the alert
+	AcknowledgedBy string `protobuf:"bytes,2,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ResultsForAlgorithmRead) Reset() {
-	*x = ResultsForAlgorithmRead{}
-	mi := &file_service_proto_msgTypes[27]
+func (x *AlertAcknowledgement) Reset() {
+	*x = AlertAcknowledgement{}
+	mi := &file_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResultsForAlgorithmRead) String() string {
+func (x *AlertAcknowledgement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultsForAlgorithmRead) ProtoMessage() {}
+func (*AlertAcknowledgement) ProtoMessage() {}
 
-func (x *ResultsForAlgorithmRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[27]
+func (x *AlertAcknowledgement) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1894,54 +5485,74 @@ func (x *ResultsForAlgorithmRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultsForAlgorithmRead.ProtoReflect.Descriptor instead.
-func (*ResultsForAlgorithmRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *ResultsForAlgorithmRead) GetTimeFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TimeFrom
-	}
-	return nil
+// Deprecated: Use AlertAcknowledgement.ProtoReflect.Descriptor instead.
+func (*AlertAcknowledgement) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ResultsForAlgorithmRead) GetTimeTo() *timestamppb.Timestamp {
+func (x *AlertAcknowledgement) GetAlertId() int64 {
 	if x != nil {
-		return x.TimeTo
+		return x.AlertId
 	}
-	return nil
+	return 0
 }
 
-func (x *ResultsForAlgorithmRead) GetAlgorithm() *Algorithm {
+func (x *AlertAcknowledgement) GetAcknowledgedBy() string {
 	if x != nil {
-		return x.Algorithm
+		return x.AcknowledgedBy
 	}
-	return nil
+	return ""
 }
 
-type ResultsForAlgorithm struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Results       []*ResultsForAlgorithm_ResultsRow `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+// WindowSchedule emits windows of a type periodically. Each window runs from
+// one time of the cron expression to the next, and is emitted once it ends.
+// Windows missed while orca-core was down are emitted once it is back up
+type WindowSchedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// unique name of the schedule
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the type of the windows emitted
+	WindowTypeName    string `protobuf:"bytes,2,opt,name=window_type_name,json=windowTypeName,proto3" json:"window_type_name,omitempty"`
+	WindowTypeVersion string `protobuf:"bytes,3,opt,name=window_type_version,json=windowTypeVersion,proto3" json:"window_type_version,omitempty"`
+	// a standard five field cron expression, or a descriptor such as `@hourly`,
+	// that the windows are aligned to. E.g. `0 6,14,22 * * *` for shifts
+	Cron string `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	// the IANA time zone that the cron expression is evaluated in. UTC when
+	// not set
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// the origin of the windows emitted
+	Origin string `protobuf:"bytes,6,opt,name=origin,proto3" json:"origin,omitempty"`
+	// metadata attached to every window emitted, which must carry the
+	// metadata fields of the window type
+	Metadata *structpb.Struct `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// windows are emitted from the first time of the cron expression at or
+	// after this, including any that have already ended. When not set, a new
+	// schedule starts from now, and a replaced schedule carries on from the
+	// last window it emitted
+	Start *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	// the end of the last window emitted. Set when read
+	EmittedUntil *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=emitted_until,json=emittedUntil,proto3" json:"emitted_until,omitempty"`
+	// when the next window is due to be emitted. Set when read
+	NextEmission  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_emission,json=nextEmission,proto3" json:"next_emission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResultsForAlgorithm) Reset() {
-	*x = ResultsForAlgorithm{}
-	mi := &file_service_proto_msgTypes[28]
+func (x *WindowSchedule) Reset() {
+	*x = WindowSchedule{}
+	mi := &file_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResultsForAlgorithm) String() string {
+func (x *WindowSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultsForAlgorithm) ProtoMessage() {}
+func (*WindowSchedule) ProtoMessage() {}
 
-func (x *ResultsForAlgorithm) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[28]
+func (x *WindowSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,104 +5563,102 @@ func (x *ResultsForAlgorithm) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultsForAlgorithm.ProtoReflect.Descriptor instead.
-func (*ResultsForAlgorithm) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use WindowSchedule.ProtoReflect.Descriptor instead.
+func (*WindowSchedule) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ResultsForAlgorithm) GetResults() []*ResultsForAlgorithm_ResultsRow {
+func (x *WindowSchedule) GetName() string {
 	if x != nil {
-		return x.Results
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type WindowsRead struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the time to read windows from
-	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
-	// the time to read windows to
-	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
-	// the window
-	Window        *WindowType `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WindowSchedule) GetWindowTypeName() string {
+	if x != nil {
+		return x.WindowTypeName
+	}
+	return ""
 }
 
-func (x *WindowsRead) Reset() {
-	*x = WindowsRead{}
-	mi := &file_service_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WindowSchedule) GetWindowTypeVersion() string {
+	if x != nil {
+		return x.WindowTypeVersion
+	}
+	return ""
 }
 
-func (x *WindowsRead) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WindowSchedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
 }
 
-func (*WindowsRead) ProtoMessage() {}
+func (x *WindowSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
 
-func (x *WindowsRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[29]
+func (x *WindowSchedule) GetOrigin() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Origin
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WindowsRead.ProtoReflect.Descriptor instead.
-func (*WindowsRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{29}
+func (x *WindowSchedule) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-func (x *WindowsRead) GetTimeFrom() *timestamppb.Timestamp {
+func (x *WindowSchedule) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TimeFrom
+		return x.Start
 	}
 	return nil
 }
 
-func (x *WindowsRead) GetTimeTo() *timestamppb.Timestamp {
+func (x *WindowSchedule) GetEmittedUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TimeTo
+		return x.EmittedUntil
 	}
 	return nil
 }
 
-func (x *WindowsRead) GetWindow() *WindowType {
+func (x *WindowSchedule) GetNextEmission() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Window
+		return x.NextEmission
 	}
 	return nil
 }
 
-type Windows struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the windows
-	Window        []*Window `protobuf:"bytes,1,rep,name=window,proto3" json:"window,omitempty"`
+type WindowSchedulesRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Windows) Reset() {
-	*x = Windows{}
-	mi := &file_service_proto_msgTypes[30]
+func (x *WindowSchedulesRead) Reset() {
+	*x = WindowSchedulesRead{}
+	mi := &file_service_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Windows) String() string {
+func (x *WindowSchedulesRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Windows) ProtoMessage() {}
+func (*WindowSchedulesRead) ProtoMessage() {}
 
-func (x *Windows) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[30]
+func (x *WindowSchedulesRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2060,45 +5669,33 @@ func (x *Windows) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Windows.ProtoReflect.Descriptor instead.
-func (*Windows) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *Windows) GetWindow() []*Window {
-	if x != nil {
-		return x.Window
-	}
-	return nil
+// Deprecated: Use WindowSchedulesRead.ProtoReflect.Descriptor instead.
+func (*WindowSchedulesRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{78}
 }
 
-type DistinctMetadataForWindowTypeRead struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the time to read windows from
-	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
-	// the time to read windows to
-	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
-	// the window type to read
-	WindowType    *WindowType `protobuf:"bytes,3,opt,name=window_type,json=windowType,proto3" json:"window_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WindowSchedules struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WindowSchedules []*WindowSchedule      `protobuf:"bytes,1,rep,name=window_schedules,json=windowSchedules,proto3" json:"window_schedules,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DistinctMetadataForWindowTypeRead) Reset() {
-	*x = DistinctMetadataForWindowTypeRead{}
-	mi := &file_service_proto_msgTypes[31]
+func (x *WindowSchedules) Reset() {
+	*x = WindowSchedules{}
+	mi := &file_service_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DistinctMetadataForWindowTypeRead) String() string {
+func (x *WindowSchedules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DistinctMetadataForWindowTypeRead) ProtoMessage() {}
+func (*WindowSchedules) ProtoMessage() {}
 
-func (x *DistinctMetadataForWindowTypeRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[31]
+func (x *WindowSchedules) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2109,54 +5706,41 @@ func (x *DistinctMetadataForWindowTypeRead) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DistinctMetadataForWindowTypeRead.ProtoReflect.Descriptor instead.
-func (*DistinctMetadataForWindowTypeRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *DistinctMetadataForWindowTypeRead) GetTimeFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TimeFrom
-	}
-	return nil
-}
-
-func (x *DistinctMetadataForWindowTypeRead) GetTimeTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TimeTo
-	}
-	return nil
+// Deprecated: Use WindowSchedules.ProtoReflect.Descriptor instead.
+func (*WindowSchedules) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *DistinctMetadataForWindowTypeRead) GetWindowType() *WindowType {
+func (x *WindowSchedules) GetWindowSchedules() []*WindowSchedule {
 	if x != nil {
-		return x.WindowType
+		return x.WindowSchedules
 	}
 	return nil
 }
 
-type DistinctMetadataForWindowType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Metadata      *structpb.ListValue    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+type WindowScheduleDeletion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the schedule
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DistinctMetadataForWindowType) Reset() {
-	*x = DistinctMetadataForWindowType{}
-	mi := &file_service_proto_msgTypes[32]
+func (x *WindowScheduleDeletion) Reset() {
+	*x = WindowScheduleDeletion{}
+	mi := &file_service_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DistinctMetadataForWindowType) String() string {
+func (x *WindowScheduleDeletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DistinctMetadataForWindowType) ProtoMessage() {}
+func (*WindowScheduleDeletion) ProtoMessage() {}
 
-func (x *DistinctMetadataForWindowType) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[32]
+func (x *WindowScheduleDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,46 +5751,65 @@ func (x *DistinctMetadataForWindowType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DistinctMetadataForWindowType.ProtoReflect.Descriptor instead.
-func (*DistinctMetadataForWindowType) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use WindowScheduleDeletion.ProtoReflect.Descriptor instead.
+func (*WindowScheduleDeletion) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *DistinctMetadataForWindowType) GetMetadata() *structpb.ListValue {
+func (x *WindowScheduleDeletion) GetName() string {
 	if x != nil {
-		return x.Metadata
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type WindowsForMetadataRead struct {
+// RollupRule rolls windows of a child type up into windows of a parent type.
+// Parent windows run from one time of the cron expression to the next. Once
+// child windows with the same values of the metadata keys cover the whole of
+// a parent window, the parent window is emitted, linked to its children, and
+// triggers the algorithms of its own window type
+type RollupRule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the time to read windows from
-	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
-	// the time to read windows to
-	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
-	// the window
-	Window        *WindowType                        `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
-	Metadata      []*WindowsForMetadataRead_Metadata `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// unique name of the rule
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the type of the windows rolled up
+	ChildWindowTypeName    string `protobuf:"bytes,2,opt,name=child_window_type_name,json=childWindowTypeName,proto3" json:"child_window_type_name,omitempty"`
+	ChildWindowTypeVersion string `protobuf:"bytes,3,opt,name=child_window_type_version,json=childWindowTypeVersion,proto3" json:"child_window_type_version,omitempty"`
+	// the type of the windows emitted
+	ParentWindowTypeName    string `protobuf:"bytes,4,opt,name=parent_window_type_name,json=parentWindowTypeName,proto3" json:"parent_window_type_name,omitempty"`
+	ParentWindowTypeVersion string `protobuf:"bytes,5,opt,name=parent_window_type_version,json=parentWindowTypeVersion,proto3" json:"parent_window_type_version,omitempty"`
+	// a standard five field cron expression, or a descriptor such as `@daily`,
+	// that parent windows are aligned to
+	Cron string `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`
+	// the IANA time zone that the cron expression is evaluated in. UTC when
+	// not set
+	Timezone string `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// child windows are rolled up together when they have the same values of
+	// these metadata fields, which are carried over to the parent window.
+	// E.g. `asset` rolls up the windows of each asset separately. They must
+	// include the metadata fields of the parent window type
+	MetadataKeys []string `protobuf:"bytes,8,rep,name=metadata_keys,json=metadataKeys,proto3" json:"metadata_keys,omitempty"`
+	// the origin of the parent windows. The name of the rule when not set
+	Origin        string `protobuf:"bytes,9,opt,name=origin,proto3" json:"origin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WindowsForMetadataRead) Reset() {
-	*x = WindowsForMetadataRead{}
-	mi := &file_service_proto_msgTypes[33]
+func (x *RollupRule) Reset() {
+	*x = RollupRule{}
+	mi := &file_service_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WindowsForMetadataRead) String() string {
+func (x *RollupRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowsForMetadataRead) ProtoMessage() {}
+func (*RollupRule) ProtoMessage() {}
 
-func (x *WindowsForMetadataRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[33]
+func (x *RollupRule) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2217,62 +5820,95 @@ func (x *WindowsForMetadataRead) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowsForMetadataRead.ProtoReflect.Descriptor instead.
-func (*WindowsForMetadataRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use RollupRule.ProtoReflect.Descriptor instead.
+func (*RollupRule) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *WindowsForMetadataRead) GetTimeFrom() *timestamppb.Timestamp {
+func (x *RollupRule) GetName() string {
 	if x != nil {
-		return x.TimeFrom
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *WindowsForMetadataRead) GetTimeTo() *timestamppb.Timestamp {
+func (x *RollupRule) GetChildWindowTypeName() string {
 	if x != nil {
-		return x.TimeTo
+		return x.ChildWindowTypeName
 	}
-	return nil
+	return ""
 }
 
-func (x *WindowsForMetadataRead) GetWindow() *WindowType {
+func (x *RollupRule) GetChildWindowTypeVersion() string {
 	if x != nil {
-		return x.Window
+		return x.ChildWindowTypeVersion
 	}
-	return nil
+	return ""
 }
 
-func (x *WindowsForMetadataRead) GetMetadata() []*WindowsForMetadataRead_Metadata {
+func (x *RollupRule) GetParentWindowTypeName() string {
 	if x != nil {
-		return x.Metadata
+		return x.ParentWindowTypeName
+	}
+	return ""
+}
+
+func (x *RollupRule) GetParentWindowTypeVersion() string {
+	if x != nil {
+		return x.ParentWindowTypeVersion
+	}
+	return ""
+}
+
+func (x *RollupRule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *RollupRule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RollupRule) GetMetadataKeys() []string {
+	if x != nil {
+		return x.MetadataKeys
 	}
 	return nil
 }
 
-type WindowsForMetadata struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the windows
-	Window        []*Window `protobuf:"bytes,1,rep,name=window,proto3" json:"window,omitempty"`
+func (x *RollupRule) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+type RollupRulesRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WindowsForMetadata) Reset() {
-	*x = WindowsForMetadata{}
-	mi := &file_service_proto_msgTypes[34]
+func (x *RollupRulesRead) Reset() {
+	*x = RollupRulesRead{}
+	mi := &file_service_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WindowsForMetadata) String() string {
+func (x *RollupRulesRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindowsForMetadata) ProtoMessage() {}
+func (*RollupRulesRead) ProtoMessage() {}
 
-func (x *WindowsForMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[34]
+func (x *RollupRulesRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2283,46 +5919,33 @@ func (x *WindowsForMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindowsForMetadata.ProtoReflect.Descriptor instead.
-func (*WindowsForMetadata) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *WindowsForMetadata) GetWindow() []*Window {
-	if x != nil {
-		return x.Window
-	}
-	return nil
+// Deprecated: Use RollupRulesRead.ProtoReflect.Descriptor instead.
+func (*RollupRulesRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{82}
 }
 
-type ResultsForAlgorithmAndMetadataRead struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the time to read results from
-	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
-	// the time to read results to
-	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
-	// the algorithm to read results for
-	Algorithm     *Algorithm                                     `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
-	Metadata      []*ResultsForAlgorithmAndMetadataRead_Metadata `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty"`
+type RollupRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RollupRules   []*RollupRule          `protobuf:"bytes,1,rep,name=rollup_rules,json=rollupRules,proto3" json:"rollup_rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResultsForAlgorithmAndMetadataRead) Reset() {
-	*x = ResultsForAlgorithmAndMetadataRead{}
-	mi := &file_service_proto_msgTypes[35]
+func (x *RollupRules) Reset() {
+	*x = RollupRules{}
+	mi := &file_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResultsForAlgorithmAndMetadataRead) String() string {
+func (x *RollupRules) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultsForAlgorithmAndMetadataRead) ProtoMessage() {}
+func (*RollupRules) ProtoMessage() {}
 
-func (x *ResultsForAlgorithmAndMetadataRead) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[35]
+func (x *RollupRules) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2333,61 +5956,87 @@ func (x *ResultsForAlgorithmAndMetadataRead) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultsForAlgorithmAndMetadataRead.ProtoReflect.Descriptor instead.
-func (*ResultsForAlgorithmAndMetadataRead) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use RollupRules.ProtoReflect.Descriptor instead.
+func (*RollupRules) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ResultsForAlgorithmAndMetadataRead) GetTimeFrom() *timestamppb.Timestamp {
+func (x *RollupRules) GetRollupRules() []*RollupRule {
 	if x != nil {
-		return x.TimeFrom
+		return x.RollupRules
 	}
 	return nil
 }
 
-func (x *ResultsForAlgorithmAndMetadataRead) GetTimeTo() *timestamppb.Timestamp {
-	if x != nil {
-		return x.TimeTo
-	}
-	return nil
+type RollupRuleDeletion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the rule
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResultsForAlgorithmAndMetadataRead) GetAlgorithm() *Algorithm {
+func (x *RollupRuleDeletion) Reset() {
+	*x = RollupRuleDeletion{}
+	mi := &file_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollupRuleDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupRuleDeletion) ProtoMessage() {}
+
+func (x *RollupRuleDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[84]
 	if x != nil {
-		return x.Algorithm
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ResultsForAlgorithmAndMetadataRead) GetMetadata() []*ResultsForAlgorithmAndMetadataRead_Metadata {
+// Deprecated: Use RollupRuleDeletion.ProtoReflect.Descriptor instead.
+func (*RollupRuleDeletion) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RollupRuleDeletion) GetName() string {
 	if x != nil {
-		return x.Metadata
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type ResultsForAlgorithmAndMetadata struct {
-	state         protoimpl.MessageState                       `protogen:"open.v1"`
-	Results       []*ResultsForAlgorithmAndMetadata_ResultsRow `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+type ChildWindowsRead struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the parent window, matched on its window type, time range and origin,
+	// along with any metadata given
+	Window        *Window `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResultsForAlgorithmAndMetadata) Reset() {
-	*x = ResultsForAlgorithmAndMetadata{}
-	mi := &file_service_proto_msgTypes[36]
+func (x *ChildWindowsRead) Reset() {
+	*x = ChildWindowsRead{}
+	mi := &file_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResultsForAlgorithmAndMetadata) String() string {
+func (x *ChildWindowsRead) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultsForAlgorithmAndMetadata) ProtoMessage() {}
+func (*ChildWindowsRead) ProtoMessage() {}
 
-func (x *ResultsForAlgorithmAndMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[36]
+func (x *ChildWindowsRead) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,52 +6047,55 @@ func (x *ResultsForAlgorithmAndMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultsForAlgorithmAndMetadata.ProtoReflect.Descriptor instead.
-func (*ResultsForAlgorithmAndMetadata) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ChildWindowsRead.ProtoReflect.Descriptor instead.
+func (*ChildWindowsRead) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ResultsForAlgorithmAndMetadata) GetResults() []*ResultsForAlgorithmAndMetadata_ResultsRow {
+func (x *ChildWindowsRead) GetWindow() *Window {
 	if x != nil {
-		return x.Results
+		return x.Window
 	}
 	return nil
 }
 
-// ------------------------ Annotation Messages ------------------------
-type AnnotateWrite struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the time to annotate from
-	TimeFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
-	// the time to annotate to
-	TimeTo *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
-	// the algorithms within the annotation
-	CapturedAlgorithms []*Algorithm `protobuf:"bytes,3,rep,name=captured_algorithms,json=capturedAlgorithms,proto3" json:"captured_algorithms,omitempty"`
-	// the windows within the annotation
-	CapturedWindows []*WindowType `protobuf:"bytes,4,rep,name=captured_windows,json=capturedWindows,proto3" json:"captured_windows,omitempty"`
-	// the description of the annotation
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	// metadata to be attached to the annotation
-	Metadata      *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+type Processors_Processor struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Runtime string                 `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	// the state of the connection to the most recently registered instance
+	// of the processor
+	ConnectionState ConnectionState `protobuf:"varint,3,opt,name=connection_state,json=connectionState,proto3,enum=ConnectionState" json:"connection_state,omitempty"`
+	// the instances registered under the processor, which tasks are
+	// dispatched between
+	Instances []*Processors_Instance `protobuf:"bytes,4,rep,name=instances,proto3" json:"instances,omitempty"`
+	// when an instance of the processor last responded to a heartbeat
+	LastSeen *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// the health reported by the most recently seen instance
+	Status        HealthCheckResponse_Status `protobuf:"varint,6,opt,name=status,proto3,enum=HealthCheckResponse_Status" json:"status,omitempty"`
+	StatusMessage string                     `protobuf:"bytes,7,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	Metrics       *ProcessorMetrics          `protobuf:"bytes,8,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// whether any instance of the processor is available to be dispatched to
+	Available     bool `protobuf:"varint,9,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnnotateWrite) Reset() {
-	*x = AnnotateWrite{}
-	mi := &file_service_proto_msgTypes[37]
+func (x *Processors_Processor) Reset() {
+	*x = Processors_Processor{}
+	mi := &file_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnnotateWrite) String() string {
+func (x *Processors_Processor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnnotateWrite) ProtoMessage() {}
+func (*Processors_Processor) ProtoMessage() {}
 
-func (x *AnnotateWrite) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[37]
+func (x *Processors_Processor) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2454,74 +6106,115 @@ func (x *AnnotateWrite) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnnotateWrite.ProtoReflect.Descriptor instead.
-func (*AnnotateWrite) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use Processors_Processor.ProtoReflect.Descriptor instead.
+func (*Processors_Processor) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{34, 0}
 }
 
-func (x *AnnotateWrite) GetTimeFrom() *timestamppb.Timestamp {
+func (x *Processors_Processor) GetName() string {
 	if x != nil {
-		return x.TimeFrom
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *AnnotateWrite) GetTimeTo() *timestamppb.Timestamp {
+func (x *Processors_Processor) GetRuntime() string {
 	if x != nil {
-		return x.TimeTo
+		return x.Runtime
 	}
-	return nil
+	return ""
 }
 
-func (x *AnnotateWrite) GetCapturedAlgorithms() []*Algorithm {
+func (x *Processors_Processor) GetConnectionState() ConnectionState {
 	if x != nil {
-		return x.CapturedAlgorithms
+		return x.ConnectionState
+	}
+	return ConnectionState_CONNECTION_STATE_UNSPECIFIED
+}
+
+func (x *Processors_Processor) GetInstances() []*Processors_Instance {
+	if x != nil {
+		return x.Instances
 	}
 	return nil
 }
 
-func (x *AnnotateWrite) GetCapturedWindows() []*WindowType {
+func (x *Processors_Processor) GetLastSeen() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CapturedWindows
+		return x.LastSeen
 	}
 	return nil
 }
 
-func (x *AnnotateWrite) GetDescription() string {
+func (x *Processors_Processor) GetStatus() HealthCheckResponse_Status {
 	if x != nil {
-		return x.Description
+		return x.Status
+	}
+	return HealthCheckResponse_STATUS_UNKNOWN
+}
+
+func (x *Processors_Processor) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
 	}
 	return ""
 }
 
-func (x *AnnotateWrite) GetMetadata() *structpb.Struct {
+func (x *Processors_Processor) GetMetrics() *ProcessorMetrics {
 	if x != nil {
-		return x.Metadata
+		return x.Metrics
 	}
 	return nil
 }
 
-type AnnotateResponse struct {
+func (x *Processors_Processor) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+// Instance is a replica of a processor, registered with its own
+// connection string
+type Processors_Instance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionStr string                 `protobuf:"bytes,1,opt,name=connection_str,json=connectionStr,proto3" json:"connection_str,omitempty"`
+	// the identity the instance registered with
+	InstanceId string `protobuf:"bytes,9,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// the state of the connection to the instance
+	ConnectionState ConnectionState `protobuf:"varint,2,opt,name=connection_state,json=connectionState,proto3,enum=ConnectionState" json:"connection_state,omitempty"`
+	// when the instance last registered
+	Registered *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=registered,proto3" json:"registered,omitempty"`
+	// when the instance last responded to a heartbeat
+	LastSeen *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// the health the instance last reported, or the reason it could not be
+	// reached
+	Status        HealthCheckResponse_Status `protobuf:"varint,5,opt,name=status,proto3,enum=HealthCheckResponse_Status" json:"status,omitempty"`
+	StatusMessage string                     `protobuf:"bytes,6,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	// the metrics the instance last reported
+	Metrics *ProcessorMetrics `protobuf:"bytes,7,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// instances unseen for longer than ORCA_PROCESSOR_UNAVAILABLE_AFTER are
+	// unavailable, and are not dispatched to until they are seen again
+	Available     bool `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AnnotateResponse) Reset() {
-	*x = AnnotateResponse{}
-	mi := &file_service_proto_msgTypes[38]
+func (x *Processors_Instance) Reset() {
+	*x = Processors_Instance{}
+	mi := &file_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AnnotateResponse) String() string {
+func (x *Processors_Instance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnnotateResponse) ProtoMessage() {}
+func (*Processors_Instance) ProtoMessage() {}
 
-func (x *AnnotateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[38]
+func (x *Processors_Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,61 +6225,72 @@ func (x *AnnotateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnnotateResponse.ProtoReflect.Descriptor instead.
-func (*AnnotateResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Processors_Instance.ProtoReflect.Descriptor instead.
+func (*Processors_Instance) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{34, 1}
 }
 
-type Processors_Processor struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Runtime       string                 `protobuf:"bytes,2,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Processors_Instance) GetConnectionStr() string {
+	if x != nil {
+		return x.ConnectionStr
+	}
+	return ""
 }
 
-func (x *Processors_Processor) Reset() {
-	*x = Processors_Processor{}
-	mi := &file_service_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Processors_Instance) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
 }
 
-func (x *Processors_Processor) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Processors_Instance) GetConnectionState() ConnectionState {
+	if x != nil {
+		return x.ConnectionState
+	}
+	return ConnectionState_CONNECTION_STATE_UNSPECIFIED
 }
 
-func (*Processors_Processor) ProtoMessage() {}
+func (x *Processors_Instance) GetRegistered() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Registered
+	}
+	return nil
+}
 
-func (x *Processors_Processor) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[39]
+func (x *Processors_Instance) GetLastSeen() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastSeen
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Processors_Processor.ProtoReflect.Descriptor instead.
-func (*Processors_Processor) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{22, 0}
+func (x *Processors_Instance) GetStatus() HealthCheckResponse_Status {
+	if x != nil {
+		return x.Status
+	}
+	return HealthCheckResponse_STATUS_UNKNOWN
 }
 
-func (x *Processors_Processor) GetName() string {
+func (x *Processors_Instance) GetStatusMessage() string {
 	if x != nil {
-		return x.Name
+		return x.StatusMessage
 	}
 	return ""
 }
 
-func (x *Processors_Processor) GetRuntime() string {
+func (x *Processors_Instance) GetMetrics() *ProcessorMetrics {
 	if x != nil {
-		return x.Runtime
+		return x.Metrics
 	}
-	return ""
+	return nil
+}
+
+func (x *Processors_Instance) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
 }
 
 type ResultsForAlgorithm_ResultsRow struct {
@@ -2600,14 +6304,21 @@ type ResultsForAlgorithm_ResultsRow struct {
 	//	*ResultsForAlgorithm_ResultsRow_SingleValue
 	//	*ResultsForAlgorithm_ResultsRow_ArrayValues
 	//	*ResultsForAlgorithm_ResultsRow_StructValue
-	ResultData    isResultsForAlgorithm_ResultsRow_ResultData `protobuf_oneof:"result_data"`
+	ResultData isResultsForAlgorithm_ResultsRow_ResultData `protobuf_oneof:"result_data"`
+	// the status of the result - failed results carry no result data
+	Status ResultStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ResultStatus" json:"status,omitempty"`
+	// why the algorithm failed, if it did
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// whether the window of the result has been amended, so that the result
+	// is out of date
+	Superseded    bool `protobuf:"varint,7,opt,name=superseded,proto3" json:"superseded,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResultsForAlgorithm_ResultsRow) Reset() {
 	*x = ResultsForAlgorithm_ResultsRow{}
-	mi := &file_service_proto_msgTypes[40]
+	mi := &file_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2619,7 +6330,7 @@ func (x *ResultsForAlgorithm_ResultsRow) String() string {
 func (*ResultsForAlgorithm_ResultsRow) ProtoMessage() {}
 
 func (x *ResultsForAlgorithm_ResultsRow) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[40]
+	mi := &file_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2632,7 +6343,7 @@ func (x *ResultsForAlgorithm_ResultsRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultsForAlgorithm_ResultsRow.ProtoReflect.Descriptor instead.
 func (*ResultsForAlgorithm_ResultsRow) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{28, 0}
+	return file_service_proto_rawDescGZIP(), []int{40, 0}
 }
 
 func (x *ResultsForAlgorithm_ResultsRow) GetTime() *timestamppb.Timestamp {
@@ -2676,6 +6387,27 @@ func (x *ResultsForAlgorithm_ResultsRow) GetStructValue() *structpb.Struct {
 	return nil
 }
 
+func (x *ResultsForAlgorithm_ResultsRow) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_HANDLED_FAILED
+}
+
+func (x *ResultsForAlgorithm_ResultsRow) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ResultsForAlgorithm_ResultsRow) GetSuperseded() bool {
+	if x != nil {
+		return x.Superseded
+	}
+	return false
+}
+
 type isResultsForAlgorithm_ResultsRow_ResultData interface {
 	isResultsForAlgorithm_ResultsRow_ResultData()
 }
@@ -2713,7 +6445,7 @@ type WindowsForMetadataRead_Metadata struct {
 
 func (x *WindowsForMetadataRead_Metadata) Reset() {
 	*x = WindowsForMetadataRead_Metadata{}
-	mi := &file_service_proto_msgTypes[41]
+	mi := &file_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2725,7 +6457,7 @@ func (x *WindowsForMetadataRead_Metadata) String() string {
 func (*WindowsForMetadataRead_Metadata) ProtoMessage() {}
 
 func (x *WindowsForMetadataRead_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[41]
+	mi := &file_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2738,7 +6470,7 @@ func (x *WindowsForMetadataRead_Metadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WindowsForMetadataRead_Metadata.ProtoReflect.Descriptor instead.
 func (*WindowsForMetadataRead_Metadata) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{33, 0}
+	return file_service_proto_rawDescGZIP(), []int{45, 0}
 }
 
 func (x *WindowsForMetadataRead_Metadata) GetField() string {
@@ -2755,6 +6487,59 @@ func (x *WindowsForMetadataRead_Metadata) GetValue() *structpb.Value {
 	return nil
 }
 
+// only stream results of windows whose metadata contains these fields
+type ResultsSubscription_Metadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultsSubscription_Metadata) Reset() {
+	*x = ResultsSubscription_Metadata{}
+	mi := &file_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultsSubscription_Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultsSubscription_Metadata) ProtoMessage() {}
+
+func (x *ResultsSubscription_Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultsSubscription_Metadata.ProtoReflect.Descriptor instead.
+func (*ResultsSubscription_Metadata) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *ResultsSubscription_Metadata) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ResultsSubscription_Metadata) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 type ResultsForAlgorithmAndMetadataRead_Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
@@ -2765,7 +6550,7 @@ type ResultsForAlgorithmAndMetadataRead_Metadata struct {
 
 func (x *ResultsForAlgorithmAndMetadataRead_Metadata) Reset() {
 	*x = ResultsForAlgorithmAndMetadataRead_Metadata{}
-	mi := &file_service_proto_msgTypes[42]
+	mi := &file_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2777,7 +6562,7 @@ func (x *ResultsForAlgorithmAndMetadataRead_Metadata) String() string {
 func (*ResultsForAlgorithmAndMetadataRead_Metadata) ProtoMessage() {}
 
 func (x *ResultsForAlgorithmAndMetadataRead_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[42]
+	mi := &file_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,7 +6575,7 @@ func (x *ResultsForAlgorithmAndMetadataRead_Metadata) ProtoReflect() protoreflec
 
 // Deprecated: Use ResultsForAlgorithmAndMetadataRead_Metadata.ProtoReflect.Descriptor instead.
 func (*ResultsForAlgorithmAndMetadataRead_Metadata) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{35, 0}
+	return file_service_proto_rawDescGZIP(), []int{49, 0}
 }
 
 func (x *ResultsForAlgorithmAndMetadataRead_Metadata) GetField() string {
@@ -2818,14 +6603,18 @@ type ResultsForAlgorithmAndMetadata_ResultsRow struct {
 	//	*ResultsForAlgorithmAndMetadata_ResultsRow_SingleValue
 	//	*ResultsForAlgorithmAndMetadata_ResultsRow_ArrayValues
 	//	*ResultsForAlgorithmAndMetadata_ResultsRow_StructValue
-	ResultData    isResultsForAlgorithmAndMetadata_ResultsRow_ResultData `protobuf_oneof:"result_data"`
+	ResultData isResultsForAlgorithmAndMetadata_ResultsRow_ResultData `protobuf_oneof:"result_data"`
+	// the status of the result - failed results carry no result data
+	Status ResultStatus `protobuf:"varint,5,opt,name=status,proto3,enum=ResultStatus" json:"status,omitempty"`
+	// why the algorithm failed, if it did
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ResultsForAlgorithmAndMetadata_ResultsRow) Reset() {
 	*x = ResultsForAlgorithmAndMetadata_ResultsRow{}
-	mi := &file_service_proto_msgTypes[43]
+	mi := &file_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2837,7 +6626,7 @@ func (x *ResultsForAlgorithmAndMetadata_ResultsRow) String() string {
 func (*ResultsForAlgorithmAndMetadata_ResultsRow) ProtoMessage() {}
 
 func (x *ResultsForAlgorithmAndMetadata_ResultsRow) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[43]
+	mi := &file_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2850,7 +6639,7 @@ func (x *ResultsForAlgorithmAndMetadata_ResultsRow) ProtoReflect() protoreflect.
 
 // Deprecated: Use ResultsForAlgorithmAndMetadata_ResultsRow.ProtoReflect.Descriptor instead.
 func (*ResultsForAlgorithmAndMetadata_ResultsRow) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{36, 0}
+	return file_service_proto_rawDescGZIP(), []int{50, 0}
 }
 
 func (x *ResultsForAlgorithmAndMetadata_ResultsRow) GetTime() *timestamppb.Timestamp {
@@ -2894,6 +6683,20 @@ func (x *ResultsForAlgorithmAndMetadata_ResultsRow) GetStructValue() *structpb.S
 	return nil
 }
 
+func (x *ResultsForAlgorithmAndMetadata_ResultsRow) GetStatus() ResultStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ResultStatus_RESULT_STATUS_HANDLED_FAILED
+}
+
+func (x *ResultsForAlgorithmAndMetadata_ResultsRow) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
 type isResultsForAlgorithmAndMetadata_ResultsRow_ResultData interface {
 	isResultsForAlgorithmAndMetadata_ResultsRow_ResultData()
 }
@@ -2923,6 +6726,150 @@ func (*ResultsForAlgorithmAndMetadata_ResultsRow_ArrayValues) isResultsForAlgori
 func (*ResultsForAlgorithmAndMetadata_ResultsRow_StructValue) isResultsForAlgorithmAndMetadata_ResultsRow_ResultData() {
 }
 
+type Alerts_Alert struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the ID of the alert, used to acknowledge it
+	AlertId int64 `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	// the rule that fired the alert
+	AlertRuleName string `protobuf:"bytes,2,opt,name=alert_rule_name,json=alertRuleName,proto3" json:"alert_rule_name,omitempty"`
+	// the algorithm whose result fired the alert
+	Algorithm *Algorithm `protobuf:"bytes,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// the state of the alert
+	Status AlertStatus `protobuf:"varint,4,opt,name=status,proto3,enum=AlertStatus" json:"status,omitempty"`
+	// the origin of the window whose result fired the alert
+	Origin string `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	// the metadata of the window whose result fired the alert
+	Metadata *structpb.Struct `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// the time the window whose result fired the alert starts
+	WindowTimeFrom *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=window_time_from,json=windowTimeFrom,proto3" json:"window_time_from,omitempty"`
+	// the time the window whose result fired the alert ends
+	WindowTimeTo *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=window_time_to,json=windowTimeTo,proto3" json:"window_time_to,omitempty"`
+	// when the alert fired
+	Fired *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=fired,proto3" json:"fired,omitempty"`
+	// when the alert resolved, if it has
+	Resolved *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	// when the alert was acknowledged, if it has been
+	Acknowledged *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	// who acknowledged the alert
+	AcknowledgedBy string `protobuf:"bytes,12,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Alerts_Alert) Reset() {
+	*x = Alerts_Alert{}
+	mi := &file_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alerts_Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alerts_Alert) ProtoMessage() {}
+
+func (x *Alerts_Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alerts_Alert.ProtoReflect.Descriptor instead.
+func (*Alerts_Alert) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{75, 0}
+}
+
+func (x *Alerts_Alert) GetAlertId() int64 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+func (x *Alerts_Alert) GetAlertRuleName() string {
+	if x != nil {
+		return x.AlertRuleName
+	}
+	return ""
+}
+
+func (x *Alerts_Alert) GetAlgorithm() *Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return nil
+}
+
+func (x *Alerts_Alert) GetStatus() AlertStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AlertStatus_ALERT_STATUS_UNSPECIFIED
+}
+
+func (x *Alerts_Alert) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Alerts_Alert) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Alerts_Alert) GetWindowTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowTimeFrom
+	}
+	return nil
+}
+
+func (x *Alerts_Alert) GetWindowTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowTimeTo
+	}
+	return nil
+}
+
+func (x *Alerts_Alert) GetFired() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Fired
+	}
+	return nil
+}
+
+func (x *Alerts_Alert) GetResolved() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Resolved
+	}
+	return nil
+}
+
+func (x *Alerts_Alert) GetAcknowledged() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return nil
+}
+
+func (x *Alerts_Alert) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
 var File_service_proto protoreflect.FileDescriptor
 
 var file_service_proto_rawDesc = string([]byte{
@@ -2932,7 +6879,7 @@ var file_service_proto_rawDesc = string([]byte{
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
 	0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x03, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x04, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
 	0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
@@ -2954,69 +6901,157 @@ var file_service_proto_rawDesc = string([]byte{
 	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x3a, 0x62, 0xba, 0x48, 0x5f, 0x1a, 0x5d, 0x0a, 0x14, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x26, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62,
-	0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x1a, 0x1d, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x3e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x55, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac,
-	0x01, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03,
-	0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0e, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xac, 0x01,
-	0x0a, 0x10, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d,
-	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x5a, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x15,
-	0x0a, 0x11, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x4f, 0x5f, 0x54, 0x52, 0x49, 0x47,
-	0x47, 0x45, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x53,
-	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
-	0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x22, 0xb7, 0x01, 0x0a,
-	0x13, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x61, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x3a,
+	0x62, 0xba, 0x48, 0x5f, 0x1a, 0x5d, 0x0a, 0x14, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2e, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x1a, 0x1d, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x6f, 0x20, 0x3e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x22, 0x55, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x33, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x77, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
-	0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x24, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61,
+	0x12, 0x28, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x0a, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x36, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x10, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x49, 0x47, 0x47,
+	0x45, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x4e, 0x4f, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x45, 0x44, 0x5f, 0x41,
+	0x4c, 0x47, 0x4f, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x53, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50,
+	0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10,
+	0x03, 0x22, 0x30, 0x0a, 0x0b, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x6d, 0x69, 0x74,
+	0x12, 0x21, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x22, 0x42, 0x0a, 0x11, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x6d,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xf7, 0x02, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02,
+	0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x34, 0x0a, 0x10,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x13, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x11, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x22, 0xfd, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x13, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54,
+	0x6f, 0x22, 0xfb, 0x01, 0x0a, 0x0d, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x6c, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x13, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8,
+	0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x22,
+	0x72, 0x0a, 0x0f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x36, 0x0a, 0x0e, 0x61,
+	0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x61, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x10, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc8, 0x02,
+	0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1a, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x38, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2f, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x26, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x22, 0x24, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61,
 	0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x81,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xa6,
 	0x02, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75,
 	0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
@@ -3032,133 +7067,312 @@ var file_service_proto_rawDesc = string([]byte{
 	0x52, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a,
 	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
 	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x0d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0xcb, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x0e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x12, 0x45, 0x0a, 0x14, 0x73, 0x75, 0x70,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x13, 0x73, 0x75, 0x70,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73,
-	0x22, 0xc4, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x74, 0x61,
-	0x73, 0x6b, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x27, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42,
-	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
-	0x36, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07,
-	0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba,
-	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x27, 0x0a,
-	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3d, 0x0a, 0x11, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x10, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x73, 0x22, 0x77, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x65,
-	0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0f, 0x61, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6c, 0x0a, 0x0f, 0x41, 0x6c,
-	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a,
-	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x95, 0x02, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x12, 0x45, 0x0a, 0x14, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x13, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x2f, 0x0a,
+	0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22,
+	0x6d, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x69,
+	0x0a, 0x13, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xfe, 0x01, 0x0a, 0x1a, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x0e, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x11, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x10, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0f,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0e, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x12, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x0b, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x10, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x12, 0x2d, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x12, 0x3d, 0x0a, 0x12,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x11, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14,
+	0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x27, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3f, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x11, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x22, 0x50,
+	0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x22, 0x56, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f,
+	0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
 	0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x07, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
-	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x0a, 0x12, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x22, 0x62, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47,
-	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52, 0x41,
-	0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49,
-	0x4e, 0x47, 0x10, 0x03, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x22, 0x34, 0x0a, 0x0b, 0x57, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22,
-	0x10, 0x0a, 0x0e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x22, 0x36, 0x0a, 0x0a, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12,
-	0x28, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09,
-	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x22, 0x7c, 0x0a, 0x0a, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x6f, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x1a, 0x39,
-	0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x61, 0x64, 0x22, 0x24, 0x0a,
-	0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a,
-	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
-	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3d, 0x0a, 0x11, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x10, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x73, 0x22, 0x77, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06,
+	0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0f, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x6c, 0x0a, 0x0f, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x30,
+	0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x27, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x0a, 0x12, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x22, 0x62, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x22, 0x34, 0x0a, 0x0b, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x07, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x22, 0x10, 0x0a, 0x0e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x22, 0x36, 0x0a, 0x0a, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73,
+	0x12, 0x28, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x22, 0xfc, 0x06, 0x0a,
+	0x0a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x1a, 0x8a, 0x03, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x37, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0xab, 0x03,
+	0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x3b, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8,
-	0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f,
-	0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b,
-	0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d,
-	0x65, 0x54, 0x6f, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xd3,
-	0x01, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x61, 0x64, 0x22,
+	0x24, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74,
+	0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x27, 0x0a, 0x0f, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x82, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x6f, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x64, 0x65, 0x64, 0x22, 0xac, 0x03, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x39, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x6f, 0x77, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xd9, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x52, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x61, 0x72, 0x72,
+	0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b,
+	0x61, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65,
+	0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x64, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xf1, 0x01, 0x0a, 0x0b, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2,
+	0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x2b, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x75,
+	0x70, 0x65, 0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x07, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x22, 0xd9, 0x01, 0x0a, 0x21, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69,
 	0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01,
@@ -3167,31 +7381,169 @@ var file_service_proto_rawDesc = string([]byte{
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba,
 	0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65,
-	0x54, 0x6f, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
-	0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x22, 0xc0, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x39, 0x0a, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x6f, 0x77, 0x52, 0x07,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xed, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x52, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x57, 0x0a, 0x1d, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe3, 0x02, 0x0a, 0x16, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2,
+	0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x2b, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x44, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x61, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x4e, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x35, 0x0a, 0x12, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xa6, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
+	0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x0a,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x0b, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x1a,
+	0x4e, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x84, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x10, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x80, 0x03, 0x0a, 0x22, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e,
+	0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x61,
-	0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x61,
-	0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x01, 0x0a, 0x0b, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74,
+	0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x50, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41,
+	0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4e, 0x0a, 0x08, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa2, 0x03, 0x0a, 0x1e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x6f, 0x77, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x1a, 0xb9, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x6f,
+	0x77, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x61, 0x79, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf,
+	0x03, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00,
+	0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x43, 0x0a, 0x13, 0x63, 0x61, 0x70, 0x74,
+	0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x12, 0x63, 0x61, 0x70, 0x74, 0x75,
+	0x72, 0x65, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x3e, 0x0a,
+	0x10, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0f, 0x63, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x28, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x62, 0xba, 0x48,
+	0x5f, 0x1a, 0x5d, 0x0a, 0x14, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2e, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x74, 0x6f, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x1a, 0x1d, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x20,
+	0x3e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x22, 0x12, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x22, 0x99, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x0f,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12,
+	0x23, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x65, 0x78,
+	0x65, 0x63, 0x49, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f,
 	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01,
@@ -3200,210 +7552,581 @@ var file_service_proto_rawDesc = string([]byte{
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8,
 	0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12,
-	0x2b, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x2a, 0x0a, 0x07,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xd9, 0x01, 0x0a, 0x21, 0x44, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba,
-	0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
-	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06,
-	0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x57, 0x0a, 0x1d, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe3, 0x02,
-	0x0a, 0x16, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x23, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa7,
+	0x02, 0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x28, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2,
-	0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40,
-	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22, 0xfe, 0x02, 0x0a, 0x12, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08,
-	0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f,
-	0x12, 0x2b, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba,
-	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x44, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x1a, 0x4e, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x12, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f,
-	0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x06, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x80, 0x03, 0x0a, 0x22, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61,
-	0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74,
-	0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x09, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12,
+	0x33, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x38, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xe6, 0x02, 0x0a, 0x10, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
 	0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a,
-	0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
-	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x50, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x61, 0x64, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x06, 0xba, 0x48, 0x03,
-	0xc8, 0x01, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x4e, 0x0a,
-	0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd6, 0x02,
-	0x0a, 0x1e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f,
-	0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c,
-	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x6f, 0x77, 0x52, 0x07, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xed, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x52, 0x6f, 0x77, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x61, 0x79,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72,
-	0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdf, 0x03, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01,
+	0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x2b, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x2a, 0x0a, 0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52,
+	0x0a, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x12, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x12, 0x09, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2d, 0x0a, 0x0c, 0x72, 0x65,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x72, 0x65,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x22, 0x91, 0x03, 0x0a, 0x09, 0x52, 0x65,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2,
-	0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40,
-	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x36, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc7, 0x02,
+	0x0a, 0x14, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02,
+	0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01,
+	0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x29, 0x0a, 0x10,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0x9f, 0x02, 0x0a, 0x17, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01,
+	0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x99, 0x03, 0x0a, 0x0f, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x65,
+	0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61,
+	0x73, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08,
-	0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f,
-	0x12, 0x43, 0x0a, 0x13, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x12, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72,
-	0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x10, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65,
-	0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x0f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0x51, 0x0a, 0x10, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x11, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x78, 0x65, 0x63, 0x49, 0x64, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x09, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x26, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4b,
+	0x65, 0x79, 0x22, 0x10, 0x0a, 0x0e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x22, 0x39, 0x0a, 0x0a, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x0b, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x0a, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0x2f, 0x0a, 0x11, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x95, 0x02, 0x0a, 0x0a, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x44, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b,
+	0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0xb2, 0x01, 0x02, 0x2a, 0x00, 0x52,
+	0x06, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0c, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x22, 0xf4, 0x04, 0x0a, 0x06, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x2e, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x1a, 0xc2, 0x04, 0x0a, 0x05, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x12, 0x24, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
 	0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x62, 0xba, 0x48, 0x5f, 0x1a, 0x5d, 0x0a, 0x14, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x12, 0x26, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20,
-	0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x1a, 0x1d, 0x74, 0x68, 0x69, 0x73, 0x2e,
-	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x3e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x12, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4b, 0x0a, 0x0a,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f,
-	0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x56, 0x41, 0x4c,
-	0x55, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x03, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x04, 0x2a, 0x70, 0x0a, 0x0c, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x53,
-	0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x4c,
-	0x45, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x52,
-	0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x48,
-	0x41, 0x4e, 0x44, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x1a, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x53, 0x55, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x32, 0xb8, 0x06, 0x0a, 0x08,
-	0x4f, 0x72, 0x63, 0x61, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x16, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28,
-	0x0a, 0x0a, 0x45, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x07, 0x2e, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a, 0x11, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d,
-	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x57, 0x69,
-	0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x0c, 0x2e, 0x57,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0e, 0x52, 0x65,
-	0x61, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x0f, 0x2e, 0x41,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x0b, 0x2e,
-	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x0e, 0x52, 0x65,
-	0x61, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x0b, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x10, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x11,
-	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x1a, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x12, 0x46, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x12, 0x14, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
-	0x68, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x12, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72,
-	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x14, 0x2e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x12, 0x25, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x73, 0x12, 0x0c, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x61, 0x64,
-	0x1a, 0x08, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x67, 0x0a, 0x21, 0x52, 0x65,
-	0x61, 0x64, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x22, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x65, 0x61, 0x64, 0x1a, 0x1e, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x2e,
-	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x13, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
-	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6a, 0x0a, 0x22, 0x52,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x0e, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x12, 0x30, 0x0a, 0x05,
+	0x66, 0x69, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x66, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x42, 0x79, 0x22,
+	0x63, 0x0a, 0x14, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x08, 0x61, 0x6c, 0x65, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x07, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x64, 0x42, 0x79, 0x22, 0xd7, 0x03, 0x0a, 0x0e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x13, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0d,
+	0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x3f, 0x0a,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15,
+	0x0a, 0x13, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x22, 0x4d, 0x0a, 0x0f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x10, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x0f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x16, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa1, 0x03, 0x0a, 0x0a, 0x52,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x16, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x13, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x41, 0x0a, 0x19, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x16, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x17, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x14,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x1a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x17, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79,
+	0x70, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x63, 0x72, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0x11,
+	0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x22, 0x3d, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52,
+	0x75, 0x6c, 0x65, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x30, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x10, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2a,
+	0x4b, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a,
+	0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x52, 0x41, 0x59,
+	0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x04, 0x2a, 0x70, 0x0a, 0x0c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48, 0x41,
+	0x4e, 0x44, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
+	0x0a, 0x1e, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x55, 0x4e, 0x48, 0x41, 0x4e, 0x44, 0x4c, 0x45, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xf5,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x44, 0x4c, 0x45,
+	0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x04, 0x12,
+	0x26, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x48, 0x55, 0x54,
+	0x44, 0x4f, 0x57, 0x4e, 0x10, 0x06, 0x2a, 0xea, 0x01, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43,
+	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x06, 0x2a, 0x5f, 0x0a, 0x0b, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x49, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x4c, 0x45,
+	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56,
+	0x45, 0x44, 0x10, 0x02, 0x32, 0xab, 0x13, 0x0a, 0x08, 0x4f, 0x72, 0x63, 0x61, 0x43, 0x6f, 0x72,
+	0x65, 0x12, 0x34, 0x0a, 0x11, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x07,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0a, 0x45, 0x6d, 0x69, 0x74, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a, 0x11,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x45, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x12, 0x0c, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x6d, 0x69, 0x74, 0x1a, 0x12,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x31, 0x0a, 0x10, 0x45, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x07, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a,
+	0x12, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x28, 0x01, 0x12, 0x2c, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x6e, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4f, 0x70, 0x65, 0x6e,
+	0x1a, 0x11, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x10, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0e, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x43, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x1a, 0x11, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x41, 0x6d,
+	0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x10, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x41, 0x6d, 0x65, 0x6e, 0x64, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x45, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38,
+	0x0a, 0x13, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x14, 0x2e, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x19, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x61, 0x6c, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a,
+	0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12,
+	0x11, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54,
+	0x61, 0x73, 0x6b, 0x30, 0x01, 0x12, 0x24, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0b, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x52,
+	0x65, 0x61, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0f,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x1a,
+	0x0c, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x0e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12,
+	0x0f, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x1a, 0x0b, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x2e, 0x0a,
+	0x0e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12,
+	0x0f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x1a, 0x0b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x34, 0x0a,
+	0x10, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x11, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x1a, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x14, 0x2e, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x10, 0x2e, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x17, 0x52,
 	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67,
-	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x23, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c,
-	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x1f, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x1a, 0x11, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x82, 0x01, 0x0a, 0x0d, 0x4f, 0x72, 0x63, 0x61, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x44, 0x61, 0x67, 0x50, 0x61, 0x72, 0x74, 0x12, 0x11, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30,
-	0x01, 0x12, 0x38, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x12, 0x13, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x72, 0x63, 0x2d, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x6f, 0x72, 0x63, 0x61, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x73, 0x2f, 0x67, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x18, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x61, 0x64,
+	0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x25, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x0c, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x1a, 0x08, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x67, 0x0a,
+	0x21, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x22, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x1e, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x17, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x13, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6a,
+	0x0a, 0x22, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x46, 0x6f,
+	0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x1f, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x41,
+	0x6e, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x14,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x2d, 0x0a, 0x08, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x0e, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x1a, 0x11, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x07, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x1a, 0x10, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x17, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x28, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x0b, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65,
+	0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x0f, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x0c,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x13, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f,
+	0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x12, 0x11, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x08, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x0a, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x07,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x0b, 0x2e, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x07,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x0b, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x1a, 0x07, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x10, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12,
+	0x15, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2b, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x64,
+	0x1a, 0x0a, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0e,
+	0x52, 0x65, 0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a,
+	0x0b, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x0f,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x1a, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x12, 0x52, 0x65,
+	0x61, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x13, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x0f, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x11, 0x2e, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x0a, 0x2e,
+	0x52, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x0d, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x2e, 0x52, 0x65, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x0a, 0x2e, 0x52, 0x65, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x1a, 0x11, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x17, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x1a, 0x13, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x32, 0x82, 0x01, 0x0a, 0x0d, 0x4f, 0x72, 0x63, 0x61, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x44,
+	0x61, 0x67, 0x50, 0x61, 0x72, 0x74, 0x12, 0x11, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x38, 0x0a,
+	0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x13, 0x2e, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x72, 0x63, 0x2d, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2f, 0x6f, 0x72, 0x63, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x73, 0x2f, 0x67, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -3418,162 +8141,367 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_service_proto_goTypes = []any{
 	(ResultType)(0),                                     // 0: ResultType
 	(ResultStatus)(0),                                   // 1: ResultStatus
-	(WindowEmitStatus_StatusEnum)(0),                    // 2: WindowEmitStatus.StatusEnum
-	(HealthCheckResponse_Status)(0),                     // 3: HealthCheckResponse.Status
-	(*Window)(nil),                                      // 4: Window
-	(*MetadataField)(nil),                               // 5: MetadataField
-	(*WindowType)(nil),                                  // 6: WindowType
-	(*WindowEmitStatus)(nil),                            // 7: WindowEmitStatus
-	(*AlgorithmDependency)(nil),                         // 8: AlgorithmDependency
-	(*Algorithm)(nil),                                   // 9: Algorithm
-	(*FloatArray)(nil),                                  // 10: FloatArray
-	(*Result)(nil),                                      // 11: Result
-	(*ProcessorRegistration)(nil),                       // 12: ProcessorRegistration
-	(*ProcessingTask)(nil),                              // 13: ProcessingTask
-	(*ExecutionRequest)(nil),                            // 14: ExecutionRequest
-	(*ExecutionResult)(nil),                             // 15: ExecutionResult
-	(*AlgorithmResult)(nil),                             // 16: AlgorithmResult
-	(*Status)(nil),                                      // 17: Status
-	(*HealthCheckRequest)(nil),                          // 18: HealthCheckRequest
-	(*HealthCheckResponse)(nil),                         // 19: HealthCheckResponse
-	(*ProcessorMetrics)(nil),                            // 20: ProcessorMetrics
-	(*WindowTypeRead)(nil),                              // 21: WindowTypeRead
-	(*WindowTypes)(nil),                                 // 22: WindowTypes
-	(*AlgorithmsRead)(nil),                              // 23: AlgorithmsRead
-	(*Algorithms)(nil),                                  // 24: Algorithms
-	(*ProcessorsRead)(nil),                              // 25: ProcessorsRead
-	(*Processors)(nil),                                  // 26: Processors
-	(*ResultsStatsRead)(nil),                            // 27: ResultsStatsRead
-	(*ResultsStats)(nil),                                // 28: ResultsStats
-	(*AlgorithmFieldsRead)(nil),                         // 29: AlgorithmFieldsRead
-	(*AlgorithmFields)(nil),                             // 30: AlgorithmFields
-	(*ResultsForAlgorithmRead)(nil),                     // 31: ResultsForAlgorithmRead
-	(*ResultsForAlgorithm)(nil),                         // 32: ResultsForAlgorithm
-	(*WindowsRead)(nil),                                 // 33: WindowsRead
-	(*Windows)(nil),                                     // 34: Windows
-	(*DistinctMetadataForWindowTypeRead)(nil),           // 35: DistinctMetadataForWindowTypeRead
-	(*DistinctMetadataForWindowType)(nil),               // 36: DistinctMetadataForWindowType
-	(*WindowsForMetadataRead)(nil),                      // 37: WindowsForMetadataRead
-	(*WindowsForMetadata)(nil),                          // 38: WindowsForMetadata
-	(*ResultsForAlgorithmAndMetadataRead)(nil),          // 39: ResultsForAlgorithmAndMetadataRead
-	(*ResultsForAlgorithmAndMetadata)(nil),              // 40: ResultsForAlgorithmAndMetadata
-	(*AnnotateWrite)(nil),                               // 41: AnnotateWrite
-	(*AnnotateResponse)(nil),                            // 42: AnnotateResponse
-	(*Processors_Processor)(nil),                        // 43: Processors.Processor
-	(*ResultsForAlgorithm_ResultsRow)(nil),              // 44: ResultsForAlgorithm.ResultsRow
-	(*WindowsForMetadataRead_Metadata)(nil),             // 45: WindowsForMetadataRead.Metadata
-	(*ResultsForAlgorithmAndMetadataRead_Metadata)(nil), // 46: ResultsForAlgorithmAndMetadataRead.Metadata
-	(*ResultsForAlgorithmAndMetadata_ResultsRow)(nil),   // 47: ResultsForAlgorithmAndMetadata.ResultsRow
-	(*timestamppb.Timestamp)(nil),                       // 48: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                             // 49: google.protobuf.Struct
-	(*structpb.ListValue)(nil),                          // 50: google.protobuf.ListValue
-	(*structpb.Value)(nil),                              // 51: google.protobuf.Value
+	(ConnectionState)(0),                                // 2: ConnectionState
+	(ExecutionStatus)(0),                                // 3: ExecutionStatus
+	(AlertStatus)(0),                                    // 4: AlertStatus
+	(WindowEmitStatus_StatusEnum)(0),                    // 5: WindowEmitStatus.StatusEnum
+	(HealthCheckResponse_Status)(0),                     // 6: HealthCheckResponse.Status
+	(*Window)(nil),                                      // 7: Window
+	(*MetadataField)(nil),                               // 8: MetadataField
+	(*WindowType)(nil),                                  // 9: WindowType
+	(*WindowEmitStatus)(nil),                            // 10: WindowEmitStatus
+	(*WindowsEmit)(nil),                                 // 11: WindowsEmit
+	(*WindowsEmitStatus)(nil),                           // 12: WindowsEmitStatus
+	(*WindowOpen)(nil),                                  // 13: WindowOpen
+	(*WindowExtension)(nil),                             // 14: WindowExtension
+	(*WindowClosure)(nil),                               // 15: WindowClosure
+	(*WindowAmendment)(nil),                             // 16: WindowAmendment
+	(*AlgorithmDependency)(nil),                         // 17: AlgorithmDependency
+	(*Algorithm)(nil),                                   // 18: Algorithm
+	(*FloatArray)(nil),                                  // 19: FloatArray
+	(*Result)(nil),                                      // 20: Result
+	(*ProcessorRegistration)(nil),                       // 21: ProcessorRegistration
+	(*ProcessorDeregistration)(nil),                     // 22: ProcessorDeregistration
+	(*AlgorithmRetirement)(nil),                         // 23: AlgorithmRetirement
+	(*AlgorithmDependencyRemoval)(nil),                  // 24: AlgorithmDependencyRemoval
+	(*RetryPolicy)(nil),                                 // 25: RetryPolicy
+	(*ProcessingTask)(nil),                              // 26: ProcessingTask
+	(*TaskSubscription)(nil),                            // 27: TaskSubscription
+	(*TaskResult)(nil),                                  // 28: TaskResult
+	(*ExecutionRequest)(nil),                            // 29: ExecutionRequest
+	(*ExecutionResult)(nil),                             // 30: ExecutionResult
+	(*AlgorithmResult)(nil),                             // 31: AlgorithmResult
+	(*Status)(nil),                                      // 32: Status
+	(*HealthCheckRequest)(nil),                          // 33: HealthCheckRequest
+	(*HealthCheckResponse)(nil),                         // 34: HealthCheckResponse
+	(*ProcessorMetrics)(nil),                            // 35: ProcessorMetrics
+	(*WindowTypeRead)(nil),                              // 36: WindowTypeRead
+	(*WindowTypes)(nil),                                 // 37: WindowTypes
+	(*AlgorithmsRead)(nil),                              // 38: AlgorithmsRead
+	(*Algorithms)(nil),                                  // 39: Algorithms
+	(*ProcessorsRead)(nil),                              // 40: ProcessorsRead
+	(*Processors)(nil),                                  // 41: Processors
+	(*ResultsStatsRead)(nil),                            // 42: ResultsStatsRead
+	(*ResultsStats)(nil),                                // 43: ResultsStats
+	(*AlgorithmFieldsRead)(nil),                         // 44: AlgorithmFieldsRead
+	(*AlgorithmFields)(nil),                             // 45: AlgorithmFields
+	(*ResultsForAlgorithmRead)(nil),                     // 46: ResultsForAlgorithmRead
+	(*ResultsForAlgorithm)(nil),                         // 47: ResultsForAlgorithm
+	(*WindowsRead)(nil),                                 // 48: WindowsRead
+	(*Windows)(nil),                                     // 49: Windows
+	(*DistinctMetadataForWindowTypeRead)(nil),           // 50: DistinctMetadataForWindowTypeRead
+	(*DistinctMetadataForWindowType)(nil),               // 51: DistinctMetadataForWindowType
+	(*WindowsForMetadataRead)(nil),                      // 52: WindowsForMetadataRead
+	(*WindowsForMetadata)(nil),                          // 53: WindowsForMetadata
+	(*ResultsSubscription)(nil),                         // 54: ResultsSubscription
+	(*ResultUpdate)(nil),                                // 55: ResultUpdate
+	(*ResultsForAlgorithmAndMetadataRead)(nil),          // 56: ResultsForAlgorithmAndMetadataRead
+	(*ResultsForAlgorithmAndMetadata)(nil),              // 57: ResultsForAlgorithmAndMetadata
+	(*AnnotateWrite)(nil),                               // 58: AnnotateWrite
+	(*AnnotateResponse)(nil),                            // 59: AnnotateResponse
+	(*ExecutionRead)(nil),                               // 60: ExecutionRead
+	(*ExecutionQueueRead)(nil),                          // 61: ExecutionQueueRead
+	(*ExecutionQueue)(nil),                              // 62: ExecutionQueue
+	(*ExecutionCancel)(nil),                             // 63: ExecutionCancel
+	(*ExecutionsRead)(nil),                              // 64: ExecutionsRead
+	(*ExecutionAttempt)(nil),                            // 65: ExecutionAttempt
+	(*AlgorithmExecution)(nil),                          // 66: AlgorithmExecution
+	(*Execution)(nil),                                   // 67: Execution
+	(*Executions)(nil),                                  // 68: Executions
+	(*WindowsReprocess)(nil),                            // 69: WindowsReprocess
+	(*ReprocessRead)(nil),                               // 70: ReprocessRead
+	(*Reprocess)(nil),                                   // 71: Reprocess
+	(*FailedExecutionsRead)(nil),                        // 72: FailedExecutionsRead
+	(*FailedExecutionsRequeue)(nil),                     // 73: FailedExecutionsRequeue
+	(*FailedExecution)(nil),                             // 74: FailedExecution
+	(*FailedExecutions)(nil),                            // 75: FailedExecutions
+	(*RequeuedExecutions)(nil),                          // 76: RequeuedExecutions
+	(*AlertRule)(nil),                                   // 77: AlertRule
+	(*AlertRulesRead)(nil),                              // 78: AlertRulesRead
+	(*AlertRules)(nil),                                  // 79: AlertRules
+	(*AlertRuleDeletion)(nil),                           // 80: AlertRuleDeletion
+	(*AlertsRead)(nil),                                  // 81: AlertsRead
+	(*Alerts)(nil),                                      // 82: Alerts
+	(*AlertAcknowledgement)(nil),                        // 83: AlertAcknowledgement
+	(*WindowSchedule)(nil),                              // 84: WindowSchedule
+	(*WindowSchedulesRead)(nil),                         // 85: WindowSchedulesRead
+	(*WindowSchedules)(nil),                             // 86: WindowSchedules
+	(*WindowScheduleDeletion)(nil),                      // 87: WindowScheduleDeletion
+	(*RollupRule)(nil),                                  // 88: RollupRule
+	(*RollupRulesRead)(nil),                             // 89: RollupRulesRead
+	(*RollupRules)(nil),                                 // 90: RollupRules
+	(*RollupRuleDeletion)(nil),                          // 91: RollupRuleDeletion
+	(*ChildWindowsRead)(nil),                            // 92: ChildWindowsRead
+	(*Processors_Processor)(nil),                        // 93: Processors.Processor
+	(*Processors_Instance)(nil),                         // 94: Processors.Instance
+	(*ResultsForAlgorithm_ResultsRow)(nil),              // 95: ResultsForAlgorithm.ResultsRow
+	(*WindowsForMetadataRead_Metadata)(nil),             // 96: WindowsForMetadataRead.Metadata
+	(*ResultsSubscription_Metadata)(nil),                // 97: ResultsSubscription.Metadata
+	(*ResultsForAlgorithmAndMetadataRead_Metadata)(nil), // 98: ResultsForAlgorithmAndMetadataRead.Metadata
+	(*ResultsForAlgorithmAndMetadata_ResultsRow)(nil),   // 99: ResultsForAlgorithmAndMetadata.ResultsRow
+	(*Alerts_Alert)(nil),                                // 100: Alerts.Alert
+	(*timestamppb.Timestamp)(nil),                       // 101: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                             // 102: google.protobuf.Struct
+	(*structpb.ListValue)(nil),                          // 103: google.protobuf.ListValue
+	(*structpb.Value)(nil),                              // 104: google.protobuf.Value
 }
 var file_service_proto_depIdxs = []int32{
-	48, // 0: Window.time_from:type_name -> google.protobuf.Timestamp
-	48, // 1: Window.time_to:type_name -> google.protobuf.Timestamp
-	49, // 2: Window.metadata:type_name -> google.protobuf.Struct
-	5,  // 3: WindowType.metadataFields:type_name -> MetadataField
-	2,  // 4: WindowEmitStatus.status:type_name -> WindowEmitStatus.StatusEnum
-	6,  // 5: Algorithm.window_type:type_name -> WindowType
-	8,  // 6: Algorithm.dependencies:type_name -> AlgorithmDependency
-	0,  // 7: Algorithm.result_type:type_name -> ResultType
-	1,  // 8: Result.status:type_name -> ResultStatus
-	10, // 9: Result.float_values:type_name -> FloatArray
-	49, // 10: Result.struct_value:type_name -> google.protobuf.Struct
-	9,  // 11: ProcessorRegistration.supported_algorithms:type_name -> Algorithm
-	9,  // 12: ProcessingTask.algorithm:type_name -> Algorithm
-	4,  // 13: ProcessingTask.window:type_name -> Window
-	11, // 14: ProcessingTask.dependency_results:type_name -> Result
-	4,  // 15: ExecutionRequest.window:type_name -> Window
-	16, // 16: ExecutionRequest.algorithm_results:type_name -> AlgorithmResult
-	9,  // 17: ExecutionRequest.algorithms:type_name -> Algorithm
-	16, // 18: ExecutionResult.algorithm_result:type_name -> AlgorithmResult
-	9,  // 19: AlgorithmResult.algorithm:type_name -> Algorithm
-	11, // 20: AlgorithmResult.result:type_name -> Result
-	3,  // 21: HealthCheckResponse.status:type_name -> HealthCheckResponse.Status
-	20, // 22: HealthCheckResponse.metrics:type_name -> ProcessorMetrics
-	6,  // 23: WindowTypes.windows:type_name -> WindowType
-	9,  // 24: Algorithms.algorithm:type_name -> Algorithm
-	43, // 25: Processors.processor:type_name -> Processors.Processor
-	48, // 26: AlgorithmFieldsRead.time_from:type_name -> google.protobuf.Timestamp
-	48, // 27: AlgorithmFieldsRead.time_to:type_name -> google.protobuf.Timestamp
-	9,  // 28: AlgorithmFieldsRead.algorithm:type_name -> Algorithm
-	48, // 29: ResultsForAlgorithmRead.time_from:type_name -> google.protobuf.Timestamp
-	48, // 30: ResultsForAlgorithmRead.time_to:type_name -> google.protobuf.Timestamp
-	9,  // 31: ResultsForAlgorithmRead.algorithm:type_name -> Algorithm
-	44, // 32: ResultsForAlgorithm.results:type_name -> ResultsForAlgorithm.ResultsRow
-	48, // 33: WindowsRead.time_from:type_name -> google.protobuf.Timestamp
-	48, // 34: WindowsRead.time_to:type_name -> google.protobuf.Timestamp
-	6,  // 35: WindowsRead.window:type_name -> WindowType
-	4,  // 36: Windows.window:type_name -> Window
-	48, // 37: DistinctMetadataForWindowTypeRead.time_from:type_name -> google.protobuf.Timestamp
-	48, // 38: DistinctMetadataForWindowTypeRead.time_to:type_name -> google.protobuf.Timestamp
-	6,  // 39: DistinctMetadataForWindowTypeRead.window_type:type_name -> WindowType
-	50, // 40: DistinctMetadataForWindowType.metadata:type_name -> google.protobuf.ListValue
-	48, // 41: WindowsForMetadataRead.time_from:type_name -> google.protobuf.Timestamp
-	48, // 42: WindowsForMetadataRead.time_to:type_name -> google.protobuf.Timestamp
-	6,  // 43: WindowsForMetadataRead.window:type_name -> WindowType
-	45, // 44: WindowsForMetadataRead.metadata:type_name -> WindowsForMetadataRead.Metadata
-	4,  // 45: WindowsForMetadata.window:type_name -> Window
-	48, // 46: ResultsForAlgorithmAndMetadataRead.time_from:type_name -> google.protobuf.Timestamp
-	48, // 47: ResultsForAlgorithmAndMetadataRead.time_to:type_name -> google.protobuf.Timestamp
-	9,  // 48: ResultsForAlgorithmAndMetadataRead.algorithm:type_name -> Algorithm
-	46, // 49: ResultsForAlgorithmAndMetadataRead.metadata:type_name -> ResultsForAlgorithmAndMetadataRead.Metadata
-	47, // 50: ResultsForAlgorithmAndMetadata.results:type_name -> ResultsForAlgorithmAndMetadata.ResultsRow
-	48, // 51: AnnotateWrite.time_from:type_name -> google.protobuf.Timestamp
-	48, // 52: AnnotateWrite.time_to:type_name -> google.protobuf.Timestamp
-	9,  // 53: AnnotateWrite.captured_algorithms:type_name -> Algorithm
-	6,  // 54: AnnotateWrite.captured_windows:type_name -> WindowType
-	49, // 55: AnnotateWrite.metadata:type_name -> google.protobuf.Struct
-	48, // 56: ResultsForAlgorithm.ResultsRow.time:type_name -> google.protobuf.Timestamp
-	10, // 57: ResultsForAlgorithm.ResultsRow.array_values:type_name -> FloatArray
-	49, // 58: ResultsForAlgorithm.ResultsRow.struct_value:type_name -> google.protobuf.Struct
-	51, // 59: WindowsForMetadataRead.Metadata.value:type_name -> google.protobuf.Value
-	51, // 60: ResultsForAlgorithmAndMetadataRead.Metadata.value:type_name -> google.protobuf.Value
-	48, // 61: ResultsForAlgorithmAndMetadata.ResultsRow.time:type_name -> google.protobuf.Timestamp
-	10, // 62: ResultsForAlgorithmAndMetadata.ResultsRow.array_values:type_name -> FloatArray
-	49, // 63: ResultsForAlgorithmAndMetadata.ResultsRow.struct_value:type_name -> google.protobuf.Struct
-	12, // 64: OrcaCore.RegisterProcessor:input_type -> ProcessorRegistration
-	4,  // 65: OrcaCore.EmitWindow:input_type -> Window
-	21, // 66: OrcaCore.ReadWindowTypes:input_type -> WindowTypeRead
-	23, // 67: OrcaCore.ReadAlgorithms:input_type -> AlgorithmsRead
-	25, // 68: OrcaCore.ReadProcessors:input_type -> ProcessorsRead
-	27, // 69: OrcaCore.ReadResultsStats:input_type -> ResultsStatsRead
-	29, // 70: OrcaCore.ReadResultFieldsForAlgorithm:input_type -> AlgorithmFieldsRead
-	31, // 71: OrcaCore.ReadResultsForAlgorithm:input_type -> ResultsForAlgorithmRead
-	33, // 72: OrcaCore.ReadWindows:input_type -> WindowsRead
-	35, // 73: OrcaCore.ReadDistinctMetadataForWindowType:input_type -> DistinctMetadataForWindowTypeRead
-	37, // 74: OrcaCore.ReadWindowsForMetadata:input_type -> WindowsForMetadataRead
-	39, // 75: OrcaCore.ReadResultsForAlgorithmAndMetadata:input_type -> ResultsForAlgorithmAndMetadataRead
-	41, // 76: OrcaCore.Annotate:input_type -> AnnotateWrite
-	14, // 77: OrcaProcessor.ExecuteDagPart:input_type -> ExecutionRequest
-	18, // 78: OrcaProcessor.HealthCheck:input_type -> HealthCheckRequest
-	17, // 79: OrcaCore.RegisterProcessor:output_type -> Status
-	7,  // 80: OrcaCore.EmitWindow:output_type -> WindowEmitStatus
-	22, // 81: OrcaCore.ReadWindowTypes:output_type -> WindowTypes
-	24, // 82: OrcaCore.ReadAlgorithms:output_type -> Algorithms
-	26, // 83: OrcaCore.ReadProcessors:output_type -> Processors
-	28, // 84: OrcaCore.ReadResultsStats:output_type -> ResultsStats
-	30, // 85: OrcaCore.ReadResultFieldsForAlgorithm:output_type -> AlgorithmFields
-	32, // 86: OrcaCore.ReadResultsForAlgorithm:output_type -> ResultsForAlgorithm
-	34, // 87: OrcaCore.ReadWindows:output_type -> Windows
-	36, // 88: OrcaCore.ReadDistinctMetadataForWindowType:output_type -> DistinctMetadataForWindowType
-	38, // 89: OrcaCore.ReadWindowsForMetadata:output_type -> WindowsForMetadata
-	40, // 90: OrcaCore.ReadResultsForAlgorithmAndMetadata:output_type -> ResultsForAlgorithmAndMetadata
-	42, // 91: OrcaCore.Annotate:output_type -> AnnotateResponse
-	15, // 92: OrcaProcessor.ExecuteDagPart:output_type -> ExecutionResult
-	19, // 93: OrcaProcessor.HealthCheck:output_type -> HealthCheckResponse
-	79, // [79:94] is the sub-list for method output_type
-	64, // [64:79] is the sub-list for method input_type
-	64, // [64:64] is the sub-list for extension type_name
-	64, // [64:64] is the sub-list for extension extendee
-	0,  // [0:64] is the sub-list for field type_name
+	101, // 0: Window.time_from:type_name -> google.protobuf.Timestamp
+	101, // 1: Window.time_to:type_name -> google.protobuf.Timestamp
+	102, // 2: Window.metadata:type_name -> google.protobuf.Struct
+	18,  // 3: Window.target_algorithms:type_name -> Algorithm
+	8,   // 4: WindowType.metadataFields:type_name -> MetadataField
+	5,   // 5: WindowEmitStatus.status:type_name -> WindowEmitStatus.StatusEnum
+	7,   // 6: WindowsEmit.windows:type_name -> Window
+	10,  // 7: WindowsEmitStatus.statuses:type_name -> WindowEmitStatus
+	101, // 8: WindowOpen.time_from:type_name -> google.protobuf.Timestamp
+	102, // 9: WindowOpen.metadata:type_name -> google.protobuf.Struct
+	101, // 10: WindowExtension.time_to:type_name -> google.protobuf.Timestamp
+	101, // 11: WindowClosure.time_to:type_name -> google.protobuf.Timestamp
+	7,   // 12: WindowAmendment.window:type_name -> Window
+	7,   // 13: WindowAmendment.amended_window:type_name -> Window
+	9,   // 14: Algorithm.window_type:type_name -> WindowType
+	17,  // 15: Algorithm.dependencies:type_name -> AlgorithmDependency
+	0,   // 16: Algorithm.result_type:type_name -> ResultType
+	25,  // 17: Algorithm.retry_policy:type_name -> RetryPolicy
+	1,   // 18: Result.status:type_name -> ResultStatus
+	19,  // 19: Result.float_values:type_name -> FloatArray
+	102, // 20: Result.struct_value:type_name -> google.protobuf.Struct
+	18,  // 21: ProcessorRegistration.supported_algorithms:type_name -> Algorithm
+	25,  // 22: ProcessorRegistration.retry_policy:type_name -> RetryPolicy
+	18,  // 23: ProcessingTask.algorithm:type_name -> Algorithm
+	7,   // 24: ProcessingTask.window:type_name -> Window
+	31,  // 25: ProcessingTask.dependency_results:type_name -> AlgorithmResult
+	20,  // 26: TaskResult.result:type_name -> Result
+	7,   // 27: ExecutionRequest.window:type_name -> Window
+	31,  // 28: ExecutionRequest.algorithm_results:type_name -> AlgorithmResult
+	18,  // 29: ExecutionRequest.algorithms:type_name -> Algorithm
+	31,  // 30: ExecutionResult.algorithm_result:type_name -> AlgorithmResult
+	18,  // 31: AlgorithmResult.algorithm:type_name -> Algorithm
+	20,  // 32: AlgorithmResult.result:type_name -> Result
+	6,   // 33: HealthCheckResponse.status:type_name -> HealthCheckResponse.Status
+	35,  // 34: HealthCheckResponse.metrics:type_name -> ProcessorMetrics
+	9,   // 35: WindowTypes.windows:type_name -> WindowType
+	18,  // 36: Algorithms.algorithm:type_name -> Algorithm
+	93,  // 37: Processors.processor:type_name -> Processors.Processor
+	101, // 38: AlgorithmFieldsRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 39: AlgorithmFieldsRead.time_to:type_name -> google.protobuf.Timestamp
+	18,  // 40: AlgorithmFieldsRead.algorithm:type_name -> Algorithm
+	101, // 41: ResultsForAlgorithmRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 42: ResultsForAlgorithmRead.time_to:type_name -> google.protobuf.Timestamp
+	18,  // 43: ResultsForAlgorithmRead.algorithm:type_name -> Algorithm
+	95,  // 44: ResultsForAlgorithm.results:type_name -> ResultsForAlgorithm.ResultsRow
+	101, // 45: WindowsRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 46: WindowsRead.time_to:type_name -> google.protobuf.Timestamp
+	9,   // 47: WindowsRead.window:type_name -> WindowType
+	7,   // 48: Windows.window:type_name -> Window
+	101, // 49: DistinctMetadataForWindowTypeRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 50: DistinctMetadataForWindowTypeRead.time_to:type_name -> google.protobuf.Timestamp
+	9,   // 51: DistinctMetadataForWindowTypeRead.window_type:type_name -> WindowType
+	103, // 52: DistinctMetadataForWindowType.metadata:type_name -> google.protobuf.ListValue
+	101, // 53: WindowsForMetadataRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 54: WindowsForMetadataRead.time_to:type_name -> google.protobuf.Timestamp
+	9,   // 55: WindowsForMetadataRead.window:type_name -> WindowType
+	96,  // 56: WindowsForMetadataRead.metadata:type_name -> WindowsForMetadataRead.Metadata
+	7,   // 57: WindowsForMetadata.window:type_name -> Window
+	18,  // 58: ResultsSubscription.algorithms:type_name -> Algorithm
+	9,   // 59: ResultsSubscription.window_type:type_name -> WindowType
+	97,  // 60: ResultsSubscription.metadata:type_name -> ResultsSubscription.Metadata
+	7,   // 61: ResultUpdate.window:type_name -> Window
+	31,  // 62: ResultUpdate.algorithm_result:type_name -> AlgorithmResult
+	101, // 63: ResultsForAlgorithmAndMetadataRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 64: ResultsForAlgorithmAndMetadataRead.time_to:type_name -> google.protobuf.Timestamp
+	18,  // 65: ResultsForAlgorithmAndMetadataRead.algorithm:type_name -> Algorithm
+	98,  // 66: ResultsForAlgorithmAndMetadataRead.metadata:type_name -> ResultsForAlgorithmAndMetadataRead.Metadata
+	99,  // 67: ResultsForAlgorithmAndMetadata.results:type_name -> ResultsForAlgorithmAndMetadata.ResultsRow
+	101, // 68: AnnotateWrite.time_from:type_name -> google.protobuf.Timestamp
+	101, // 69: AnnotateWrite.time_to:type_name -> google.protobuf.Timestamp
+	18,  // 70: AnnotateWrite.captured_algorithms:type_name -> Algorithm
+	9,   // 71: AnnotateWrite.captured_windows:type_name -> WindowType
+	102, // 72: AnnotateWrite.metadata:type_name -> google.protobuf.Struct
+	101, // 73: ExecutionsRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 74: ExecutionsRead.time_to:type_name -> google.protobuf.Timestamp
+	9,   // 75: ExecutionsRead.window:type_name -> WindowType
+	3,   // 76: ExecutionsRead.status:type_name -> ExecutionStatus
+	3,   // 77: ExecutionAttempt.status:type_name -> ExecutionStatus
+	101, // 78: ExecutionAttempt.started:type_name -> google.protobuf.Timestamp
+	101, // 79: ExecutionAttempt.finished:type_name -> google.protobuf.Timestamp
+	18,  // 80: AlgorithmExecution.algorithm:type_name -> Algorithm
+	3,   // 81: AlgorithmExecution.status:type_name -> ExecutionStatus
+	101, // 82: AlgorithmExecution.started:type_name -> google.protobuf.Timestamp
+	101, // 83: AlgorithmExecution.finished:type_name -> google.protobuf.Timestamp
+	65,  // 84: AlgorithmExecution.attempts:type_name -> ExecutionAttempt
+	7,   // 85: Execution.window:type_name -> Window
+	3,   // 86: Execution.status:type_name -> ExecutionStatus
+	101, // 87: Execution.created:type_name -> google.protobuf.Timestamp
+	101, // 88: Execution.started:type_name -> google.protobuf.Timestamp
+	101, // 89: Execution.finished:type_name -> google.protobuf.Timestamp
+	66,  // 90: Execution.algorithms:type_name -> AlgorithmExecution
+	67,  // 91: Executions.executions:type_name -> Execution
+	101, // 92: WindowsReprocess.time_from:type_name -> google.protobuf.Timestamp
+	101, // 93: WindowsReprocess.time_to:type_name -> google.protobuf.Timestamp
+	9,   // 94: WindowsReprocess.window:type_name -> WindowType
+	102, // 95: WindowsReprocess.metadata:type_name -> google.protobuf.Struct
+	18,  // 96: WindowsReprocess.algorithms:type_name -> Algorithm
+	3,   // 97: Reprocess.status:type_name -> ExecutionStatus
+	101, // 98: Reprocess.created:type_name -> google.protobuf.Timestamp
+	101, // 99: Reprocess.finished:type_name -> google.protobuf.Timestamp
+	101, // 100: FailedExecutionsRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 101: FailedExecutionsRead.time_to:type_name -> google.protobuf.Timestamp
+	18,  // 102: FailedExecutionsRead.algorithm:type_name -> Algorithm
+	101, // 103: FailedExecutionsRequeue.time_from:type_name -> google.protobuf.Timestamp
+	101, // 104: FailedExecutionsRequeue.time_to:type_name -> google.protobuf.Timestamp
+	18,  // 105: FailedExecutionsRequeue.algorithm:type_name -> Algorithm
+	29,  // 106: FailedExecution.request:type_name -> ExecutionRequest
+	101, // 107: FailedExecution.failed:type_name -> google.protobuf.Timestamp
+	101, // 108: FailedExecution.requeued:type_name -> google.protobuf.Timestamp
+	74,  // 109: FailedExecutions.failed_executions:type_name -> FailedExecution
+	18,  // 110: AlertRule.algorithm:type_name -> Algorithm
+	77,  // 111: AlertRules.alert_rules:type_name -> AlertRule
+	101, // 112: AlertsRead.time_from:type_name -> google.protobuf.Timestamp
+	101, // 113: AlertsRead.time_to:type_name -> google.protobuf.Timestamp
+	4,   // 114: AlertsRead.status:type_name -> AlertStatus
+	100, // 115: Alerts.alerts:type_name -> Alerts.Alert
+	102, // 116: WindowSchedule.metadata:type_name -> google.protobuf.Struct
+	101, // 117: WindowSchedule.start:type_name -> google.protobuf.Timestamp
+	101, // 118: WindowSchedule.emitted_until:type_name -> google.protobuf.Timestamp
+	101, // 119: WindowSchedule.next_emission:type_name -> google.protobuf.Timestamp
+	84,  // 120: WindowSchedules.window_schedules:type_name -> WindowSchedule
+	88,  // 121: RollupRules.rollup_rules:type_name -> RollupRule
+	7,   // 122: ChildWindowsRead.window:type_name -> Window
+	2,   // 123: Processors.Processor.connection_state:type_name -> ConnectionState
+	94,  // 124: Processors.Processor.instances:type_name -> Processors.Instance
+	101, // 125: Processors.Processor.last_seen:type_name -> google.protobuf.Timestamp
+	6,   // 126: Processors.Processor.status:type_name -> HealthCheckResponse.Status
+	35,  // 127: Processors.Processor.metrics:type_name -> ProcessorMetrics
+	2,   // 128: Processors.Instance.connection_state:type_name -> ConnectionState
+	101, // 129: Processors.Instance.registered:type_name -> google.protobuf.Timestamp
+	101, // 130: Processors.Instance.last_seen:type_name -> google.protobuf.Timestamp
+	6,   // 131: Processors.Instance.status:type_name -> HealthCheckResponse.Status
+	35,  // 132: Processors.Instance.metrics:type_name -> ProcessorMetrics
+	101, // 133: ResultsForAlgorithm.ResultsRow.time:type_name -> google.protobuf.Timestamp
+	19,  // 134: ResultsForAlgorithm.ResultsRow.array_values:type_name -> FloatArray
+	102, // 135: ResultsForAlgorithm.ResultsRow.struct_value:type_name -> google.protobuf.Struct
+	1,   // 136: ResultsForAlgorithm.ResultsRow.status:type_name -> ResultStatus
+	104, // 137: WindowsForMetadataRead.Metadata.value:type_name -> google.protobuf.Value
+	104, // 138: ResultsSubscription.Metadata.value:type_name -> google.protobuf.Value
+	104, // 139: ResultsForAlgorithmAndMetadataRead.Metadata.value:type_name -> google.protobuf.Value
+	101, // 140: ResultsForAlgorithmAndMetadata.ResultsRow.time:type_name -> google.protobuf.Timestamp
+	19,  // 141: ResultsForAlgorithmAndMetadata.ResultsRow.array_values:type_name -> FloatArray
+	102, // 142: ResultsForAlgorithmAndMetadata.ResultsRow.struct_value:type_name -> google.protobuf.Struct
+	1,   // 143: ResultsForAlgorithmAndMetadata.ResultsRow.status:type_name -> ResultStatus
+	18,  // 144: Alerts.Alert.algorithm:type_name -> Algorithm
+	4,   // 145: Alerts.Alert.status:type_name -> AlertStatus
+	102, // 146: Alerts.Alert.metadata:type_name -> google.protobuf.Struct
+	101, // 147: Alerts.Alert.window_time_from:type_name -> google.protobuf.Timestamp
+	101, // 148: Alerts.Alert.window_time_to:type_name -> google.protobuf.Timestamp
+	101, // 149: Alerts.Alert.fired:type_name -> google.protobuf.Timestamp
+	101, // 150: Alerts.Alert.resolved:type_name -> google.protobuf.Timestamp
+	101, // 151: Alerts.Alert.acknowledged:type_name -> google.protobuf.Timestamp
+	21,  // 152: OrcaCore.RegisterProcessor:input_type -> ProcessorRegistration
+	7,   // 153: OrcaCore.EmitWindow:input_type -> Window
+	11,  // 154: OrcaCore.EmitWindows:input_type -> WindowsEmit
+	7,   // 155: OrcaCore.EmitWindowStream:input_type -> Window
+	13,  // 156: OrcaCore.OpenWindow:input_type -> WindowOpen
+	14,  // 157: OrcaCore.ExtendWindow:input_type -> WindowExtension
+	15,  // 158: OrcaCore.CloseWindow:input_type -> WindowClosure
+	16,  // 159: OrcaCore.AmendWindow:input_type -> WindowAmendment
+	22,  // 160: OrcaCore.DeregisterProcessor:input_type -> ProcessorDeregistration
+	23,  // 161: OrcaCore.RetireAlgorithm:input_type -> AlgorithmRetirement
+	24,  // 162: OrcaCore.RemoveAlgorithmDependency:input_type -> AlgorithmDependencyRemoval
+	27,  // 163: OrcaCore.SubscribeTasks:input_type -> TaskSubscription
+	28,  // 164: OrcaCore.SubmitResult:input_type -> TaskResult
+	36,  // 165: OrcaCore.ReadWindowTypes:input_type -> WindowTypeRead
+	38,  // 166: OrcaCore.ReadAlgorithms:input_type -> AlgorithmsRead
+	40,  // 167: OrcaCore.ReadProcessors:input_type -> ProcessorsRead
+	42,  // 168: OrcaCore.ReadResultsStats:input_type -> ResultsStatsRead
+	44,  // 169: OrcaCore.ReadResultFieldsForAlgorithm:input_type -> AlgorithmFieldsRead
+	46,  // 170: OrcaCore.ReadResultsForAlgorithm:input_type -> ResultsForAlgorithmRead
+	48,  // 171: OrcaCore.ReadWindows:input_type -> WindowsRead
+	50,  // 172: OrcaCore.ReadDistinctMetadataForWindowType:input_type -> DistinctMetadataForWindowTypeRead
+	52,  // 173: OrcaCore.ReadWindowsForMetadata:input_type -> WindowsForMetadataRead
+	56,  // 174: OrcaCore.ReadResultsForAlgorithmAndMetadata:input_type -> ResultsForAlgorithmAndMetadataRead
+	54,  // 175: OrcaCore.SubscribeResults:input_type -> ResultsSubscription
+	58,  // 176: OrcaCore.Annotate:input_type -> AnnotateWrite
+	84,  // 177: OrcaCore.CreateWindowSchedule:input_type -> WindowSchedule
+	85,  // 178: OrcaCore.ReadWindowSchedules:input_type -> WindowSchedulesRead
+	87,  // 179: OrcaCore.DeleteWindowSchedule:input_type -> WindowScheduleDeletion
+	88,  // 180: OrcaCore.CreateRollupRule:input_type -> RollupRule
+	89,  // 181: OrcaCore.ReadRollupRules:input_type -> RollupRulesRead
+	91,  // 182: OrcaCore.DeleteRollupRule:input_type -> RollupRuleDeletion
+	92,  // 183: OrcaCore.ReadChildWindows:input_type -> ChildWindowsRead
+	77,  // 184: OrcaCore.CreateAlertRule:input_type -> AlertRule
+	78,  // 185: OrcaCore.ReadAlertRules:input_type -> AlertRulesRead
+	80,  // 186: OrcaCore.DeleteAlertRule:input_type -> AlertRuleDeletion
+	81,  // 187: OrcaCore.ReadAlerts:input_type -> AlertsRead
+	83,  // 188: OrcaCore.AcknowledgeAlert:input_type -> AlertAcknowledgement
+	60,  // 189: OrcaCore.ReadExecution:input_type -> ExecutionRead
+	64,  // 190: OrcaCore.ReadExecutions:input_type -> ExecutionsRead
+	63,  // 191: OrcaCore.CancelExecution:input_type -> ExecutionCancel
+	61,  // 192: OrcaCore.ReadExecutionQueue:input_type -> ExecutionQueueRead
+	69,  // 193: OrcaCore.ReprocessWindows:input_type -> WindowsReprocess
+	70,  // 194: OrcaCore.ReadReprocess:input_type -> ReprocessRead
+	72,  // 195: OrcaCore.ReadFailedExecutions:input_type -> FailedExecutionsRead
+	73,  // 196: OrcaCore.RequeueFailedExecutions:input_type -> FailedExecutionsRequeue
+	29,  // 197: OrcaProcessor.ExecuteDagPart:input_type -> ExecutionRequest
+	33,  // 198: OrcaProcessor.HealthCheck:input_type -> HealthCheckRequest
+	32,  // 199: OrcaCore.RegisterProcessor:output_type -> Status
+	10,  // 200: OrcaCore.EmitWindow:output_type -> WindowEmitStatus
+	12,  // 201: OrcaCore.EmitWindows:output_type -> WindowsEmitStatus
+	12,  // 202: OrcaCore.EmitWindowStream:output_type -> WindowsEmitStatus
+	10,  // 203: OrcaCore.OpenWindow:output_type -> WindowEmitStatus
+	10,  // 204: OrcaCore.ExtendWindow:output_type -> WindowEmitStatus
+	10,  // 205: OrcaCore.CloseWindow:output_type -> WindowEmitStatus
+	10,  // 206: OrcaCore.AmendWindow:output_type -> WindowEmitStatus
+	32,  // 207: OrcaCore.DeregisterProcessor:output_type -> Status
+	32,  // 208: OrcaCore.RetireAlgorithm:output_type -> Status
+	32,  // 209: OrcaCore.RemoveAlgorithmDependency:output_type -> Status
+	26,  // 210: OrcaCore.SubscribeTasks:output_type -> ProcessingTask
+	32,  // 211: OrcaCore.SubmitResult:output_type -> Status
+	37,  // 212: OrcaCore.ReadWindowTypes:output_type -> WindowTypes
+	39,  // 213: OrcaCore.ReadAlgorithms:output_type -> Algorithms
+	41,  // 214: OrcaCore.ReadProcessors:output_type -> Processors
+	43,  // 215: OrcaCore.ReadResultsStats:output_type -> ResultsStats
+	45,  // 216: OrcaCore.ReadResultFieldsForAlgorithm:output_type -> AlgorithmFields
+	47,  // 217: OrcaCore.ReadResultsForAlgorithm:output_type -> ResultsForAlgorithm
+	49,  // 218: OrcaCore.ReadWindows:output_type -> Windows
+	51,  // 219: OrcaCore.ReadDistinctMetadataForWindowType:output_type -> DistinctMetadataForWindowType
+	53,  // 220: OrcaCore.ReadWindowsForMetadata:output_type -> WindowsForMetadata
+	57,  // 221: OrcaCore.ReadResultsForAlgorithmAndMetadata:output_type -> ResultsForAlgorithmAndMetadata
+	55,  // 222: OrcaCore.SubscribeResults:output_type -> ResultUpdate
+	59,  // 223: OrcaCore.Annotate:output_type -> AnnotateResponse
+	32,  // 224: OrcaCore.CreateWindowSchedule:output_type -> Status
+	86,  // 225: OrcaCore.ReadWindowSchedules:output_type -> WindowSchedules
+	32,  // 226: OrcaCore.DeleteWindowSchedule:output_type -> Status
+	32,  // 227: OrcaCore.CreateRollupRule:output_type -> Status
+	90,  // 228: OrcaCore.ReadRollupRules:output_type -> RollupRules
+	32,  // 229: OrcaCore.DeleteRollupRule:output_type -> Status
+	49,  // 230: OrcaCore.ReadChildWindows:output_type -> Windows
+	32,  // 231: OrcaCore.CreateAlertRule:output_type -> Status
+	79,  // 232: OrcaCore.ReadAlertRules:output_type -> AlertRules
+	32,  // 233: OrcaCore.DeleteAlertRule:output_type -> Status
+	82,  // 234: OrcaCore.ReadAlerts:output_type -> Alerts
+	32,  // 235: OrcaCore.AcknowledgeAlert:output_type -> Status
+	67,  // 236: OrcaCore.ReadExecution:output_type -> Execution
+	68,  // 237: OrcaCore.ReadExecutions:output_type -> Executions
+	32,  // 238: OrcaCore.CancelExecution:output_type -> Status
+	62,  // 239: OrcaCore.ReadExecutionQueue:output_type -> ExecutionQueue
+	71,  // 240: OrcaCore.ReprocessWindows:output_type -> Reprocess
+	71,  // 241: OrcaCore.ReadReprocess:output_type -> Reprocess
+	75,  // 242: OrcaCore.ReadFailedExecutions:output_type -> FailedExecutions
+	76,  // 243: OrcaCore.RequeueFailedExecutions:output_type -> RequeuedExecutions
+	30,  // 244: OrcaProcessor.ExecuteDagPart:output_type -> ExecutionResult
+	34,  // 245: OrcaProcessor.HealthCheck:output_type -> HealthCheckResponse
+	199, // [199:246] is the sub-list for method output_type
+	152, // [152:199] is the sub-list for method input_type
+	152, // [152:152] is the sub-list for extension type_name
+	152, // [152:152] is the sub-list for extension extendee
+	0,   // [0:152] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -3581,17 +8509,17 @@ func file_service_proto_init() {
 	if File_service_proto != nil {
 		return
 	}
-	file_service_proto_msgTypes[7].OneofWrappers = []any{
+	file_service_proto_msgTypes[13].OneofWrappers = []any{
 		(*Result_SingleValue)(nil),
 		(*Result_FloatValues)(nil),
 		(*Result_StructValue)(nil),
 	}
-	file_service_proto_msgTypes[40].OneofWrappers = []any{
+	file_service_proto_msgTypes[88].OneofWrappers = []any{
 		(*ResultsForAlgorithm_ResultsRow_SingleValue)(nil),
 		(*ResultsForAlgorithm_ResultsRow_ArrayValues)(nil),
 		(*ResultsForAlgorithm_ResultsRow_StructValue)(nil),
 	}
-	file_service_proto_msgTypes[43].OneofWrappers = []any{
+	file_service_proto_msgTypes[92].OneofWrappers = []any{
 		(*ResultsForAlgorithmAndMetadata_ResultsRow_SingleValue)(nil),
 		(*ResultsForAlgorithmAndMetadata_ResultsRow_ArrayValues)(nil),
 		(*ResultsForAlgorithmAndMetadata_ResultsRow_StructValue)(nil),
@@ -3601,8 +8529,8 @@ func file_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   44,
+			NumEnums:      7,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
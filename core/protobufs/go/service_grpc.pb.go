@@ -21,6 +21,17 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	OrcaCore_RegisterProcessor_FullMethodName                  = "/OrcaCore/RegisterProcessor"
 	OrcaCore_EmitWindow_FullMethodName                         = "/OrcaCore/EmitWindow"
+	OrcaCore_EmitWindows_FullMethodName                        = "/OrcaCore/EmitWindows"
+	OrcaCore_EmitWindowStream_FullMethodName                   = "/OrcaCore/EmitWindowStream"
+	OrcaCore_OpenWindow_FullMethodName                         = "/OrcaCore/OpenWindow"
+	OrcaCore_ExtendWindow_FullMethodName                       = "/OrcaCore/ExtendWindow"
+	OrcaCore_CloseWindow_FullMethodName                        = "/OrcaCore/CloseWindow"
+	OrcaCore_AmendWindow_FullMethodName                        = "/OrcaCore/AmendWindow"
+	OrcaCore_DeregisterProcessor_FullMethodName                = "/OrcaCore/DeregisterProcessor"
+	OrcaCore_RetireAlgorithm_FullMethodName                    = "/OrcaCore/RetireAlgorithm"
+	OrcaCore_RemoveAlgorithmDependency_FullMethodName          = "/OrcaCore/RemoveAlgorithmDependency"
+	OrcaCore_SubscribeTasks_FullMethodName                     = "/OrcaCore/SubscribeTasks"
+	OrcaCore_SubmitResult_FullMethodName                       = "/OrcaCore/SubmitResult"
 	OrcaCore_ReadWindowTypes_FullMethodName                    = "/OrcaCore/ReadWindowTypes"
 	OrcaCore_ReadAlgorithms_FullMethodName                     = "/OrcaCore/ReadAlgorithms"
 	OrcaCore_ReadProcessors_FullMethodName                     = "/OrcaCore/ReadProcessors"
@@ -31,7 +42,28 @@ const (
 	OrcaCore_ReadDistinctMetadataForWindowType_FullMethodName  = "/OrcaCore/ReadDistinctMetadataForWindowType"
 	OrcaCore_ReadWindowsForMetadata_FullMethodName             = "/OrcaCore/ReadWindowsForMetadata"
 	OrcaCore_ReadResultsForAlgorithmAndMetadata_FullMethodName = "/OrcaCore/ReadResultsForAlgorithmAndMetadata"
+	OrcaCore_SubscribeResults_FullMethodName                   = "/OrcaCore/SubscribeResults"
 	OrcaCore_Annotate_FullMethodName                           = "/OrcaCore/Annotate"
+	OrcaCore_CreateWindowSchedule_FullMethodName               = "/OrcaCore/CreateWindowSchedule"
+	OrcaCore_ReadWindowSchedules_FullMethodName                = "/OrcaCore/ReadWindowSchedules"
+	OrcaCore_DeleteWindowSchedule_FullMethodName               = "/OrcaCore/DeleteWindowSchedule"
+	OrcaCore_CreateRollupRule_FullMethodName                   = "/OrcaCore/CreateRollupRule"
+	OrcaCore_ReadRollupRules_FullMethodName                    = "/OrcaCore/ReadRollupRules"
+	OrcaCore_DeleteRollupRule_FullMethodName                   = "/OrcaCore/DeleteRollupRule"
+	OrcaCore_ReadChildWindows_FullMethodName                   = "/OrcaCore/ReadChildWindows"
+	OrcaCore_CreateAlertRule_FullMethodName                    = "/OrcaCore/CreateAlertRule"
+	OrcaCore_ReadAlertRules_FullMethodName                     = "/OrcaCore/ReadAlertRules"
+	OrcaCore_DeleteAlertRule_FullMethodName                    = "/OrcaCore/DeleteAlertRule"
+	OrcaCore_ReadAlerts_FullMethodName                         = "/OrcaCore/ReadAlerts"
+	OrcaCore_AcknowledgeAlert_FullMethodName                   = "/OrcaCore/AcknowledgeAlert"
+	OrcaCore_ReadExecution_FullMethodName                      = "/OrcaCore/ReadExecution"
+	OrcaCore_ReadExecutions_FullMethodName                     = "/OrcaCore/ReadExecutions"
+	OrcaCore_CancelExecution_FullMethodName                    = "/OrcaCore/CancelExecution"
+	OrcaCore_ReadExecutionQueue_FullMethodName                 = "/OrcaCore/ReadExecutionQueue"
+	OrcaCore_ReprocessWindows_FullMethodName                   = "/OrcaCore/ReprocessWindows"
+	OrcaCore_ReadReprocess_FullMethodName                      = "/OrcaCore/ReadReprocess"
+	OrcaCore_ReadFailedExecutions_FullMethodName               = "/OrcaCore/ReadFailedExecutions"
+	OrcaCore_RequeueFailedExecutions_FullMethodName            = "/OrcaCore/RequeueFailedExecutions"
 )
 
 // OrcaCoreClient is the client API for OrcaCore service.
@@ -48,6 +80,35 @@ type OrcaCoreClient interface {
 	RegisterProcessor(ctx context.Context, in *ProcessorRegistration, opts ...grpc.CallOption) (*Status, error)
 	// Submit a window for processing
 	EmitWindow(ctx context.Context, in *Window, opts ...grpc.CallOption) (*WindowEmitStatus, error)
+	// Submit a batch of windows for processing, returning the status of each
+	EmitWindows(ctx context.Context, in *WindowsEmit, opts ...grpc.CallOption) (*WindowsEmitStatus, error)
+	// Stream windows for processing, returning the status of each once the
+	// stream is closed
+	EmitWindowStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Window, WindowsEmitStatus], error)
+	// Open a window whose end is not yet known. Its algorithms are not
+	// triggered until it is closed, unless it is provisional
+	OpenWindow(ctx context.Context, in *WindowOpen, opts ...grpc.CallOption) (*WindowEmitStatus, error)
+	// Extend an open window up to a later time
+	ExtendWindow(ctx context.Context, in *WindowExtension, opts ...grpc.CallOption) (*WindowEmitStatus, error)
+	// Close an open window at its end, triggering its algorithms
+	CloseWindow(ctx context.Context, in *WindowClosure, opts ...grpc.CallOption) (*WindowEmitStatus, error)
+	// Supersede an emitted window with a corrected one, triggering its
+	// algorithms again. The superseded window and its results are hidden from
+	// reads by default
+	AmendWindow(ctx context.Context, in *WindowAmendment, opts ...grpc.CallOption) (*WindowEmitStatus, error)
+	// Deregister a processor, retiring the algorithms it supports
+	DeregisterProcessor(ctx context.Context, in *ProcessorDeregistration, opts ...grpc.CallOption) (*Status, error)
+	// Retire an algorithm so that it is no longer executed. Results it has
+	// already produced remain readable
+	RetireAlgorithm(ctx context.Context, in *AlgorithmRetirement, opts ...grpc.CallOption) (*Status, error)
+	// Remove the dependency of one algorithm on another
+	RemoveAlgorithmDependency(ctx context.Context, in *AlgorithmDependencyRemoval, opts ...grpc.CallOption) (*Status, error)
+	// Subscribe a registered processor for the tasks it is to execute. This is
+	// an alternative to orca-core dialling out to the processor, for
+	// processors that cannot be reached, e.g. behind NAT or a firewall
+	SubscribeTasks(ctx context.Context, in *TaskSubscription, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessingTask], error)
+	// Submit the result of a task received through SubscribeTasks
+	SubmitResult(ctx context.Context, in *TaskResult, opts ...grpc.CallOption) (*Status, error)
 	// ------------------- Data operations -------------------
 	ReadWindowTypes(ctx context.Context, in *WindowTypeRead, opts ...grpc.CallOption) (*WindowTypes, error)
 	ReadAlgorithms(ctx context.Context, in *AlgorithmsRead, opts ...grpc.CallOption) (*Algorithms, error)
@@ -59,8 +120,55 @@ type OrcaCoreClient interface {
 	ReadDistinctMetadataForWindowType(ctx context.Context, in *DistinctMetadataForWindowTypeRead, opts ...grpc.CallOption) (*DistinctMetadataForWindowType, error)
 	ReadWindowsForMetadata(ctx context.Context, in *WindowsForMetadataRead, opts ...grpc.CallOption) (*WindowsForMetadata, error)
 	ReadResultsForAlgorithmAndMetadata(ctx context.Context, in *ResultsForAlgorithmAndMetadataRead, opts ...grpc.CallOption) (*ResultsForAlgorithmAndMetadata, error)
+	// Stream results as they are written, optionally filtered by algorithm,
+	// window type and window metadata
+	SubscribeResults(ctx context.Context, in *ResultsSubscription, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultUpdate], error)
 	// ------------------ Annotation operations -----------------
 	Annotate(ctx context.Context, in *AnnotateWrite, opts ...grpc.CallOption) (*AnnotateResponse, error)
+	// Create a schedule that windows of a type are emitted on, or replace the
+	// schedule of the same name
+	CreateWindowSchedule(ctx context.Context, in *WindowSchedule, opts ...grpc.CallOption) (*Status, error)
+	// Read the window schedules
+	ReadWindowSchedules(ctx context.Context, in *WindowSchedulesRead, opts ...grpc.CallOption) (*WindowSchedules, error)
+	// Delete a window schedule. Windows it has already emitted are kept
+	DeleteWindowSchedule(ctx context.Context, in *WindowScheduleDeletion, opts ...grpc.CallOption) (*Status, error)
+	// Create a rule that rolls windows of one type up into windows of
+	// another, or replace the rule of the same name
+	CreateRollupRule(ctx context.Context, in *RollupRule, opts ...grpc.CallOption) (*Status, error)
+	// Read the roll-up rules
+	ReadRollupRules(ctx context.Context, in *RollupRulesRead, opts ...grpc.CallOption) (*RollupRules, error)
+	// Delete a roll-up rule. Windows it has already rolled up are kept
+	DeleteRollupRule(ctx context.Context, in *RollupRuleDeletion, opts ...grpc.CallOption) (*Status, error)
+	// Read the child windows that a window was rolled up from
+	ReadChildWindows(ctx context.Context, in *ChildWindowsRead, opts ...grpc.CallOption) (*Windows, error)
+	// Create an alert rule, or replace the rule of the same name
+	CreateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*Status, error)
+	// Read the alert rules
+	ReadAlertRules(ctx context.Context, in *AlertRulesRead, opts ...grpc.CallOption) (*AlertRules, error)
+	// Delete an alert rule along with its alerts
+	DeleteAlertRule(ctx context.Context, in *AlertRuleDeletion, opts ...grpc.CallOption) (*Status, error)
+	// Read the alerts fired within a time range
+	ReadAlerts(ctx context.Context, in *AlertsRead, opts ...grpc.CallOption) (*Alerts, error)
+	// Acknowledge an alert
+	AcknowledgeAlert(ctx context.Context, in *AlertAcknowledgement, opts ...grpc.CallOption) (*Status, error)
+	// Read the state of the execution triggered by an emitted window
+	ReadExecution(ctx context.Context, in *ExecutionRead, opts ...grpc.CallOption) (*Execution, error)
+	// Read the state of the executions triggered by windows in a time range
+	ReadExecutions(ctx context.Context, in *ExecutionsRead, opts ...grpc.CallOption) (*Executions, error)
+	// Cancel an in-flight execution, including the work sent to processors
+	CancelExecution(ctx context.Context, in *ExecutionCancel, opts ...grpc.CallOption) (*Status, error)
+	// Read the depth and saturation of the queue of executions
+	ReadExecutionQueue(ctx context.Context, in *ExecutionQueueRead, opts ...grpc.CallOption) (*ExecutionQueue, error)
+	// Execute algorithms over windows that have already been emitted, e.g. to
+	// backfill the results of a newly registered algorithm
+	ReprocessWindows(ctx context.Context, in *WindowsReprocess, opts ...grpc.CallOption) (*Reprocess, error)
+	// Read the progress of a reprocess
+	ReadReprocess(ctx context.Context, in *ReprocessRead, opts ...grpc.CallOption) (*Reprocess, error)
+	// Read the processor tasks that failed after exhausting their retries
+	ReadFailedExecutions(ctx context.Context, in *FailedExecutionsRead, opts ...grpc.CallOption) (*FailedExecutions, error)
+	// Re-execute the executions of failed processor tasks, along with the
+	// algorithms that were skipped because of them
+	RequeueFailedExecutions(ctx context.Context, in *FailedExecutionsRequeue, opts ...grpc.CallOption) (*RequeuedExecutions, error)
 }
 
 type orcaCoreClient struct {
@@ -91,6 +199,128 @@ func (c *orcaCoreClient) EmitWindow(ctx context.Context, in *Window, opts ...grp
 	return out, nil
 }
 
+func (c *orcaCoreClient) EmitWindows(ctx context.Context, in *WindowsEmit, opts ...grpc.CallOption) (*WindowsEmitStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WindowsEmitStatus)
+	err := c.cc.Invoke(ctx, OrcaCore_EmitWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) EmitWindowStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Window, WindowsEmitStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrcaCore_ServiceDesc.Streams[0], OrcaCore_EmitWindowStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Window, WindowsEmitStatus]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrcaCore_EmitWindowStreamClient = grpc.ClientStreamingClient[Window, WindowsEmitStatus]
+
+func (c *orcaCoreClient) OpenWindow(ctx context.Context, in *WindowOpen, opts ...grpc.CallOption) (*WindowEmitStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WindowEmitStatus)
+	err := c.cc.Invoke(ctx, OrcaCore_OpenWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ExtendWindow(ctx context.Context, in *WindowExtension, opts ...grpc.CallOption) (*WindowEmitStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WindowEmitStatus)
+	err := c.cc.Invoke(ctx, OrcaCore_ExtendWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) CloseWindow(ctx context.Context, in *WindowClosure, opts ...grpc.CallOption) (*WindowEmitStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WindowEmitStatus)
+	err := c.cc.Invoke(ctx, OrcaCore_CloseWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) AmendWindow(ctx context.Context, in *WindowAmendment, opts ...grpc.CallOption) (*WindowEmitStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WindowEmitStatus)
+	err := c.cc.Invoke(ctx, OrcaCore_AmendWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) DeregisterProcessor(ctx context.Context, in *ProcessorDeregistration, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_DeregisterProcessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) RetireAlgorithm(ctx context.Context, in *AlgorithmRetirement, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_RetireAlgorithm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) RemoveAlgorithmDependency(ctx context.Context, in *AlgorithmDependencyRemoval, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_RemoveAlgorithmDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) SubscribeTasks(ctx context.Context, in *TaskSubscription, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProcessingTask], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrcaCore_ServiceDesc.Streams[1], OrcaCore_SubscribeTasks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TaskSubscription, ProcessingTask]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrcaCore_SubscribeTasksClient = grpc.ServerStreamingClient[ProcessingTask]
+
+func (c *orcaCoreClient) SubmitResult(ctx context.Context, in *TaskResult, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_SubmitResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orcaCoreClient) ReadWindowTypes(ctx context.Context, in *WindowTypeRead, opts ...grpc.CallOption) (*WindowTypes, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WindowTypes)
@@ -191,6 +421,25 @@ func (c *orcaCoreClient) ReadResultsForAlgorithmAndMetadata(ctx context.Context,
 	return out, nil
 }
 
+func (c *orcaCoreClient) SubscribeResults(ctx context.Context, in *ResultsSubscription, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ResultUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrcaCore_ServiceDesc.Streams[2], OrcaCore_SubscribeResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ResultsSubscription, ResultUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrcaCore_SubscribeResultsClient = grpc.ServerStreamingClient[ResultUpdate]
+
 func (c *orcaCoreClient) Annotate(ctx context.Context, in *AnnotateWrite, opts ...grpc.CallOption) (*AnnotateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AnnotateResponse)
@@ -201,333 +450,1256 @@ func (c *orcaCoreClient) Annotate(ctx context.Context, in *AnnotateWrite, opts .
 	return out, nil
 }
 
-// OrcaCoreServer is the server API for OrcaCore service.
-// All implementations must embed UnimplementedOrcaCoreServer
-// for forward compatibility.
-//
-// OrcaCore is the central orchestration service that:
-// - Manages the lifecycle of processing windows
-// - Coordinates algorithm execution across distributed processors
-// - Tracks DAG dependencies and execution state
-// - Routes results between dependent algorithms
-type OrcaCoreServer interface {
-	// Register a processor node and its supported algorithms
-	RegisterProcessor(context.Context, *ProcessorRegistration) (*Status, error)
-	// Submit a window for processing
-	EmitWindow(context.Context, *Window) (*WindowEmitStatus, error)
-	// ------------------- Data operations -------------------
-	ReadWindowTypes(context.Context, *WindowTypeRead) (*WindowTypes, error)
-	ReadAlgorithms(context.Context, *AlgorithmsRead) (*Algorithms, error)
-	ReadProcessors(context.Context, *ProcessorsRead) (*Processors, error)
-	ReadResultsStats(context.Context, *ResultsStatsRead) (*ResultsStats, error)
-	ReadResultFieldsForAlgorithm(context.Context, *AlgorithmFieldsRead) (*AlgorithmFields, error)
-	ReadResultsForAlgorithm(context.Context, *ResultsForAlgorithmRead) (*ResultsForAlgorithm, error)
-	ReadWindows(context.Context, *WindowsRead) (*Windows, error)
-	ReadDistinctMetadataForWindowType(context.Context, *DistinctMetadataForWindowTypeRead) (*DistinctMetadataForWindowType, error)
-	ReadWindowsForMetadata(context.Context, *WindowsForMetadataRead) (*WindowsForMetadata, error)
-	ReadResultsForAlgorithmAndMetadata(context.Context, *ResultsForAlgorithmAndMetadataRead) (*ResultsForAlgorithmAndMetadata, error)
-	// ------------------ Annotation operations -----------------
-	Annotate(context.Context, *AnnotateWrite) (*AnnotateResponse, error)
-	mustEmbedUnimplementedOrcaCoreServer()
+func (c *orcaCoreClient) CreateWindowSchedule(ctx context.Context, in *WindowSchedule, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_CreateWindowSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedOrcaCoreServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedOrcaCoreServer struct{}
+func (c *orcaCoreClient) ReadWindowSchedules(ctx context.Context, in *WindowSchedulesRead, opts ...grpc.CallOption) (*WindowSchedules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WindowSchedules)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadWindowSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedOrcaCoreServer) RegisterProcessor(context.Context, *ProcessorRegistration) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterProcessor not implemented")
+func (c *orcaCoreClient) DeleteWindowSchedule(ctx context.Context, in *WindowScheduleDeletion, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_DeleteWindowSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrcaCoreServer) EmitWindow(context.Context, *Window) (*WindowEmitStatus, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EmitWindow not implemented")
+
+func (c *orcaCoreClient) CreateRollupRule(ctx context.Context, in *RollupRule, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_CreateRollupRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrcaCoreServer) ReadWindowTypes(context.Context, *WindowTypeRead) (*WindowTypes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadWindowTypes not implemented")
+
+func (c *orcaCoreClient) ReadRollupRules(ctx context.Context, in *RollupRulesRead, opts ...grpc.CallOption) (*RollupRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollupRules)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadRollupRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrcaCoreServer) ReadAlgorithms(context.Context, *AlgorithmsRead) (*Algorithms, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadAlgorithms not implemented")
+
+func (c *orcaCoreClient) DeleteRollupRule(ctx context.Context, in *RollupRuleDeletion, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_DeleteRollupRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrcaCoreServer) ReadProcessors(context.Context, *ProcessorsRead) (*Processors, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadProcessors not implemented")
+
+func (c *orcaCoreClient) ReadChildWindows(ctx context.Context, in *ChildWindowsRead, opts ...grpc.CallOption) (*Windows, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Windows)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadChildWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrcaCoreServer) ReadResultsStats(context.Context, *ResultsStatsRead) (*ResultsStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadResultsStats not implemented")
+
+func (c *orcaCoreClient) CreateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrcaCoreServer) ReadResultFieldsForAlgorithm(context.Context, *AlgorithmFieldsRead) (*AlgorithmFields, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadResultFieldsForAlgorithm not implemented")
+
+func (c *orcaCoreClient) ReadAlertRules(ctx context.Context, in *AlertRulesRead, opts ...grpc.CallOption) (*AlertRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRules)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOrcaCoreServer) ReadResultsForAlgorithm(context.Context, *ResultsForAlgorithmRead) (*ResultsForAlgorithm, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadResultsForAlgorithm not implemented")
+
+func (c *orcaCoreClient) DeleteAlertRule(ctx context.Context, in *AlertRuleDeletion, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ReadAlerts(ctx context.Context, in *AlertsRead, opts ...grpc.CallOption) (*Alerts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Alerts)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) AcknowledgeAlert(ctx context.Context, in *AlertAcknowledgement, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_AcknowledgeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ReadExecution(ctx context.Context, in *ExecutionRead, opts ...grpc.CallOption) (*Execution, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Execution)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ReadExecutions(ctx context.Context, in *ExecutionsRead, opts ...grpc.CallOption) (*Executions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Executions)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) CancelExecution(ctx context.Context, in *ExecutionCancel, opts ...grpc.CallOption) (*Status, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Status)
+	err := c.cc.Invoke(ctx, OrcaCore_CancelExecution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ReadExecutionQueue(ctx context.Context, in *ExecutionQueueRead, opts ...grpc.CallOption) (*ExecutionQueue, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecutionQueue)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadExecutionQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ReprocessWindows(ctx context.Context, in *WindowsReprocess, opts ...grpc.CallOption) (*Reprocess, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reprocess)
+	err := c.cc.Invoke(ctx, OrcaCore_ReprocessWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ReadReprocess(ctx context.Context, in *ReprocessRead, opts ...grpc.CallOption) (*Reprocess, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reprocess)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadReprocess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) ReadFailedExecutions(ctx context.Context, in *FailedExecutionsRead, opts ...grpc.CallOption) (*FailedExecutions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FailedExecutions)
+	err := c.cc.Invoke(ctx, OrcaCore_ReadFailedExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orcaCoreClient) RequeueFailedExecutions(ctx context.Context, in *FailedExecutionsRequeue, opts ...grpc.CallOption) (*RequeuedExecutions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequeuedExecutions)
+	err := c.cc.Invoke(ctx, OrcaCore_RequeueFailedExecutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrcaCoreServer is the server API for OrcaCore service.
+// All implementations must embed UnimplementedOrcaCoreServer
+// for forward compatibility.
+//
+// OrcaCore is the central orchestration service that:
+// - Manages the lifecycle of processing windows
+// - Coordinates algorithm execution across distributed processors
+// - Tracks DAG dependencies and execution state
+// - Routes results between dependent algorithms
+type OrcaCoreServer interface {
+	// Register a processor node and its supported algorithms
+	RegisterProcessor(context.Context, *ProcessorRegistration) (*Status, error)
+	// Submit a window for processing
+	EmitWindow(context.Context, *Window) (*WindowEmitStatus, error)
+	// Submit a batch of windows for processing, returning the status of each
+	EmitWindows(context.Context, *WindowsEmit) (*WindowsEmitStatus, error)
+	// Stream windows for processing, returning the status of each once the
+	// stream is closed
+	EmitWindowStream(grpc.ClientStreamingServer[Window, WindowsEmitStatus]) error
+	// Open a window whose end is not yet known. Its algorithms are not
+	// triggered until it is closed, unless it is provisional
+	OpenWindow(context.Context, *WindowOpen) (*WindowEmitStatus, error)
+	// Extend an open window up to a later time
+	ExtendWindow(context.Context, *WindowExtension) (*WindowEmitStatus, error)
+	// Close an open window at its end, triggering its algorithms
+	CloseWindow(context.Context, *WindowClosure) (*WindowEmitStatus, error)
+	// Supersede an emitted window with a corrected one, triggering its
+	// algorithms again. The superseded window and its results are hidden from
+	// reads by default
+	AmendWindow(context.Context, *WindowAmendment) (*WindowEmitStatus, error)
+	// Deregister a processor, retiring the algorithms it supports
+	DeregisterProcessor(context.Context, *ProcessorDeregistration) (*Status, error)
+	// Retire an algorithm so that it is no longer executed. Results it has
+	// already produced remain readable
+	RetireAlgorithm(context.Context, *AlgorithmRetirement) (*Status, error)
+	// Remove the dependency of one algorithm on another
+	RemoveAlgorithmDependency(context.Context, *AlgorithmDependencyRemoval) (*Status, error)
+	// Subscribe a registered processor for the tasks it is to execute. This is
+	// an alternative to orca-core dialling out to the processor, for
+	// processors that cannot be reached, e.g. behind NAT or a firewall
+	SubscribeTasks(*TaskSubscription, grpc.ServerStreamingServer[ProcessingTask]) error
+	// Submit the result of a task received through SubscribeTasks
+	SubmitResult(context.Context, *TaskResult) (*Status, error)
+	// ------------------- Data operations -------------------
+	ReadWindowTypes(context.Context, *WindowTypeRead) (*WindowTypes, error)
+	ReadAlgorithms(context.Context, *AlgorithmsRead) (*Algorithms, error)
+	ReadProcessors(context.Context, *ProcessorsRead) (*Processors, error)
+	ReadResultsStats(context.Context, *ResultsStatsRead) (*ResultsStats, error)
+	ReadResultFieldsForAlgorithm(context.Context, *AlgorithmFieldsRead) (*AlgorithmFields, error)
+	ReadResultsForAlgorithm(context.Context, *ResultsForAlgorithmRead) (*ResultsForAlgorithm, error)
+	ReadWindows(context.Context, *WindowsRead) (*Windows, error)
+	ReadDistinctMetadataForWindowType(context.Context, *DistinctMetadataForWindowTypeRead) (*DistinctMetadataForWindowType, error)
+	ReadWindowsForMetadata(context.Context, *WindowsForMetadataRead) (*WindowsForMetadata, error)
+	ReadResultsForAlgorithmAndMetadata(context.Context, *ResultsForAlgorithmAndMetadataRead) (*ResultsForAlgorithmAndMetadata, error)
+	// Stream results as they are written, optionally filtered by algorithm,
+	// window type and window metadata
+	SubscribeResults(*ResultsSubscription, grpc.ServerStreamingServer[ResultUpdate]) error
+	// ------------------ Annotation operations -----------------
+	Annotate(context.Context, *AnnotateWrite) (*AnnotateResponse, error)
+	// Create a schedule that windows of a type are emitted on, or replace the
+	// schedule of the same name
+	CreateWindowSchedule(context.Context, *WindowSchedule) (*Status, error)
+	// Read the window schedules
+	ReadWindowSchedules(context.Context, *WindowSchedulesRead) (*WindowSchedules, error)
+	// Delete a window schedule. Windows it has already emitted are kept
+	DeleteWindowSchedule(context.Context, *WindowScheduleDeletion) (*Status, error)
+	// Create a rule that rolls windows of one type up into windows of
+	// another, or replace the rule of the same name
+	CreateRollupRule(context.Context, *RollupRule) (*Status, error)
+	// Read the roll-up rules
+	ReadRollupRules(context.Context, *RollupRulesRead) (*RollupRules, error)
+	// Delete a roll-up rule. Windows it has already rolled up are kept
+	DeleteRollupRule(context.Context, *RollupRuleDeletion) (*Status, error)
+	// Read the child windows that a window was rolled up from
+	ReadChildWindows(context.Context, *ChildWindowsRead) (*Windows, error)
+	// Create an alert rule, or replace the rule of the same name
+	CreateAlertRule(context.Context, *AlertRule) (*Status, error)
+	// Read the alert rules
+	ReadAlertRules(context.Context, *AlertRulesRead) (*AlertRules, error)
+	// Delete an alert rule along with its alerts
+	DeleteAlertRule(context.Context, *AlertRuleDeletion) (*Status, error)
+	// Read the alerts fired within a time range
+	ReadAlerts(context.Context, *AlertsRead) (*Alerts, error)
+	// Acknowledge an alert
+	AcknowledgeAlert(context.Context, *AlertAcknowledgement) (*Status, error)
+	// Read the state of the execution triggered by an emitted window
+	ReadExecution(context.Context, *ExecutionRead) (*Execution, error)
+	// Read the state of the executions triggered by windows in a time range
+	ReadExecutions(context.Context, *ExecutionsRead) (*Executions, error)
+	// Cancel an in-flight execution, including the work sent to processors
+	CancelExecution(context.Context, *ExecutionCancel) (*Status, error)
+	// Read the depth and saturation of the queue of executions
+	ReadExecutionQueue(context.Context, *ExecutionQueueRead) (*ExecutionQueue, error)
+	// Execute algorithms over windows that have already been emitted, e.g. to
+	// backfill the results of a newly registered algorithm
+	ReprocessWindows(context.Context, *WindowsReprocess) (*Reprocess, error)
+	// Read the progress of a reprocess
+	ReadReprocess(context.Context, *ReprocessRead) (*Reprocess, error)
+	// Read the processor tasks that failed after exhausting their retries
+	ReadFailedExecutions(context.Context, *FailedExecutionsRead) (*FailedExecutions, error)
+	// Re-execute the executions of failed processor tasks, along with the
+	// algorithms that were skipped because of them
+	RequeueFailedExecutions(context.Context, *FailedExecutionsRequeue) (*RequeuedExecutions, error)
+	mustEmbedUnimplementedOrcaCoreServer()
+}
+
+// UnimplementedOrcaCoreServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrcaCoreServer struct{}
+
+func (UnimplementedOrcaCoreServer) RegisterProcessor(context.Context, *ProcessorRegistration) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterProcessor not implemented")
+}
+func (UnimplementedOrcaCoreServer) EmitWindow(context.Context, *Window) (*WindowEmitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmitWindow not implemented")
+}
+func (UnimplementedOrcaCoreServer) EmitWindows(context.Context, *WindowsEmit) (*WindowsEmitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmitWindows not implemented")
+}
+func (UnimplementedOrcaCoreServer) EmitWindowStream(grpc.ClientStreamingServer[Window, WindowsEmitStatus]) error {
+	return status.Errorf(codes.Unimplemented, "method EmitWindowStream not implemented")
+}
+func (UnimplementedOrcaCoreServer) OpenWindow(context.Context, *WindowOpen) (*WindowEmitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenWindow not implemented")
+}
+func (UnimplementedOrcaCoreServer) ExtendWindow(context.Context, *WindowExtension) (*WindowEmitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendWindow not implemented")
+}
+func (UnimplementedOrcaCoreServer) CloseWindow(context.Context, *WindowClosure) (*WindowEmitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseWindow not implemented")
+}
+func (UnimplementedOrcaCoreServer) AmendWindow(context.Context, *WindowAmendment) (*WindowEmitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendWindow not implemented")
+}
+func (UnimplementedOrcaCoreServer) DeregisterProcessor(context.Context, *ProcessorDeregistration) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterProcessor not implemented")
+}
+func (UnimplementedOrcaCoreServer) RetireAlgorithm(context.Context, *AlgorithmRetirement) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireAlgorithm not implemented")
+}
+func (UnimplementedOrcaCoreServer) RemoveAlgorithmDependency(context.Context, *AlgorithmDependencyRemoval) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAlgorithmDependency not implemented")
+}
+func (UnimplementedOrcaCoreServer) SubscribeTasks(*TaskSubscription, grpc.ServerStreamingServer[ProcessingTask]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTasks not implemented")
+}
+func (UnimplementedOrcaCoreServer) SubmitResult(context.Context, *TaskResult) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitResult not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadWindowTypes(context.Context, *WindowTypeRead) (*WindowTypes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWindowTypes not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadAlgorithms(context.Context, *AlgorithmsRead) (*Algorithms, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAlgorithms not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadProcessors(context.Context, *ProcessorsRead) (*Processors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadProcessors not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadResultsStats(context.Context, *ResultsStatsRead) (*ResultsStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadResultsStats not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadResultFieldsForAlgorithm(context.Context, *AlgorithmFieldsRead) (*AlgorithmFields, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadResultFieldsForAlgorithm not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadResultsForAlgorithm(context.Context, *ResultsForAlgorithmRead) (*ResultsForAlgorithm, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadResultsForAlgorithm not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadWindows(context.Context, *WindowsRead) (*Windows, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWindows not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadDistinctMetadataForWindowType(context.Context, *DistinctMetadataForWindowTypeRead) (*DistinctMetadataForWindowType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDistinctMetadataForWindowType not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadWindowsForMetadata(context.Context, *WindowsForMetadataRead) (*WindowsForMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWindowsForMetadata not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadResultsForAlgorithmAndMetadata(context.Context, *ResultsForAlgorithmAndMetadataRead) (*ResultsForAlgorithmAndMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadResultsForAlgorithmAndMetadata not implemented")
+}
+func (UnimplementedOrcaCoreServer) SubscribeResults(*ResultsSubscription, grpc.ServerStreamingServer[ResultUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeResults not implemented")
+}
+func (UnimplementedOrcaCoreServer) Annotate(context.Context, *AnnotateWrite) (*AnnotateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Annotate not implemented")
+}
+func (UnimplementedOrcaCoreServer) CreateWindowSchedule(context.Context, *WindowSchedule) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWindowSchedule not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadWindowSchedules(context.Context, *WindowSchedulesRead) (*WindowSchedules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWindowSchedules not implemented")
+}
+func (UnimplementedOrcaCoreServer) DeleteWindowSchedule(context.Context, *WindowScheduleDeletion) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWindowSchedule not implemented")
+}
+func (UnimplementedOrcaCoreServer) CreateRollupRule(context.Context, *RollupRule) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRollupRule not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadRollupRules(context.Context, *RollupRulesRead) (*RollupRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadRollupRules not implemented")
+}
+func (UnimplementedOrcaCoreServer) DeleteRollupRule(context.Context, *RollupRuleDeletion) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRollupRule not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadChildWindows(context.Context, *ChildWindowsRead) (*Windows, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadChildWindows not implemented")
+}
+func (UnimplementedOrcaCoreServer) CreateAlertRule(context.Context, *AlertRule) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadAlertRules(context.Context, *AlertRulesRead) (*AlertRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAlertRules not implemented")
+}
+func (UnimplementedOrcaCoreServer) DeleteAlertRule(context.Context, *AlertRuleDeletion) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadAlerts(context.Context, *AlertsRead) (*Alerts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAlerts not implemented")
+}
+func (UnimplementedOrcaCoreServer) AcknowledgeAlert(context.Context, *AlertAcknowledgement) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadExecution(context.Context, *ExecutionRead) (*Execution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadExecution not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadExecutions(context.Context, *ExecutionsRead) (*Executions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadExecutions not implemented")
+}
+func (UnimplementedOrcaCoreServer) CancelExecution(context.Context, *ExecutionCancel) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecution not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadExecutionQueue(context.Context, *ExecutionQueueRead) (*ExecutionQueue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadExecutionQueue not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReprocessWindows(context.Context, *WindowsReprocess) (*Reprocess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReprocessWindows not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadReprocess(context.Context, *ReprocessRead) (*Reprocess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadReprocess not implemented")
+}
+func (UnimplementedOrcaCoreServer) ReadFailedExecutions(context.Context, *FailedExecutionsRead) (*FailedExecutions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadFailedExecutions not implemented")
+}
+func (UnimplementedOrcaCoreServer) RequeueFailedExecutions(context.Context, *FailedExecutionsRequeue) (*RequeuedExecutions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueFailedExecutions not implemented")
+}
+func (UnimplementedOrcaCoreServer) mustEmbedUnimplementedOrcaCoreServer() {}
+func (UnimplementedOrcaCoreServer) testEmbeddedByValue()                  {}
+
+// UnsafeOrcaCoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrcaCoreServer will
+// result in compilation errors.
+type UnsafeOrcaCoreServer interface {
+	mustEmbedUnimplementedOrcaCoreServer()
+}
+
+func RegisterOrcaCoreServer(s grpc.ServiceRegistrar, srv OrcaCoreServer) {
+	// If the following call pancis, it indicates UnimplementedOrcaCoreServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OrcaCore_ServiceDesc, srv)
+}
+
+func _OrcaCore_RegisterProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessorRegistration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).RegisterProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_RegisterProcessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).RegisterProcessor(ctx, req.(*ProcessorRegistration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_EmitWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Window)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).EmitWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_EmitWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).EmitWindow(ctx, req.(*Window))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_EmitWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowsEmit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).EmitWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_EmitWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).EmitWindows(ctx, req.(*WindowsEmit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_EmitWindowStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OrcaCoreServer).EmitWindowStream(&grpc.GenericServerStream[Window, WindowsEmitStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrcaCore_EmitWindowStreamServer = grpc.ClientStreamingServer[Window, WindowsEmitStatus]
+
+func _OrcaCore_OpenWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowOpen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).OpenWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_OpenWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).OpenWindow(ctx, req.(*WindowOpen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ExtendWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowExtension)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ExtendWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ExtendWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ExtendWindow(ctx, req.(*WindowExtension))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_CloseWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowClosure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).CloseWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_CloseWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).CloseWindow(ctx, req.(*WindowClosure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_AmendWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).AmendWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_AmendWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).AmendWindow(ctx, req.(*WindowAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_DeregisterProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessorDeregistration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).DeregisterProcessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_DeregisterProcessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).DeregisterProcessor(ctx, req.(*ProcessorDeregistration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_RetireAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgorithmRetirement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).RetireAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_RetireAlgorithm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).RetireAlgorithm(ctx, req.(*AlgorithmRetirement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_RemoveAlgorithmDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgorithmDependencyRemoval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).RemoveAlgorithmDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_RemoveAlgorithmDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).RemoveAlgorithmDependency(ctx, req.(*AlgorithmDependencyRemoval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_SubscribeTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TaskSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrcaCoreServer).SubscribeTasks(m, &grpc.GenericServerStream[TaskSubscription, ProcessingTask]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrcaCore_SubscribeTasksServer = grpc.ServerStreamingServer[ProcessingTask]
+
+func _OrcaCore_SubmitResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).SubmitResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_SubmitResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).SubmitResult(ctx, req.(*TaskResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadWindowTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowTypeRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadWindowTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadWindowTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadWindowTypes(ctx, req.(*WindowTypeRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadAlgorithms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgorithmsRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadAlgorithms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadAlgorithms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadAlgorithms(ctx, req.(*AlgorithmsRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadProcessors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessorsRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadProcessors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadProcessors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadProcessors(ctx, req.(*ProcessorsRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadResultsStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultsStatsRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadResultsStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadResultsStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadResultsStats(ctx, req.(*ResultsStatsRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadResultFieldsForAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlgorithmFieldsRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadResultFieldsForAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadResultFieldsForAlgorithm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadResultFieldsForAlgorithm(ctx, req.(*AlgorithmFieldsRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadResultsForAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultsForAlgorithmRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadResultsForAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadResultsForAlgorithm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadResultsForAlgorithm(ctx, req.(*ResultsForAlgorithmRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowsRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadWindows(ctx, req.(*WindowsRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadDistinctMetadataForWindowType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DistinctMetadataForWindowTypeRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadDistinctMetadataForWindowType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadDistinctMetadataForWindowType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadDistinctMetadataForWindowType(ctx, req.(*DistinctMetadataForWindowTypeRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadWindowsForMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowsForMetadataRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadWindowsForMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadWindowsForMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadWindowsForMetadata(ctx, req.(*WindowsForMetadataRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_ReadResultsForAlgorithmAndMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultsForAlgorithmAndMetadataRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadResultsForAlgorithmAndMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadResultsForAlgorithmAndMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadResultsForAlgorithmAndMetadata(ctx, req.(*ResultsForAlgorithmAndMetadataRead))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrcaCore_SubscribeResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResultsSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrcaCoreServer).SubscribeResults(m, &grpc.GenericServerStream[ResultsSubscription, ResultUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrcaCore_SubscribeResultsServer = grpc.ServerStreamingServer[ResultUpdate]
+
+func _OrcaCore_Annotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateWrite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).Annotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_Annotate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).Annotate(ctx, req.(*AnnotateWrite))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrcaCoreServer) ReadWindows(context.Context, *WindowsRead) (*Windows, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadWindows not implemented")
+
+func _OrcaCore_CreateWindowSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).CreateWindowSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_CreateWindowSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).CreateWindowSchedule(ctx, req.(*WindowSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrcaCoreServer) ReadDistinctMetadataForWindowType(context.Context, *DistinctMetadataForWindowTypeRead) (*DistinctMetadataForWindowType, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadDistinctMetadataForWindowType not implemented")
+
+func _OrcaCore_ReadWindowSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowSchedulesRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadWindowSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadWindowSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadWindowSchedules(ctx, req.(*WindowSchedulesRead))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrcaCoreServer) ReadWindowsForMetadata(context.Context, *WindowsForMetadataRead) (*WindowsForMetadata, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadWindowsForMetadata not implemented")
+
+func _OrcaCore_DeleteWindowSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowScheduleDeletion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).DeleteWindowSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_DeleteWindowSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).DeleteWindowSchedule(ctx, req.(*WindowScheduleDeletion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrcaCoreServer) ReadResultsForAlgorithmAndMetadata(context.Context, *ResultsForAlgorithmAndMetadataRead) (*ResultsForAlgorithmAndMetadata, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadResultsForAlgorithmAndMetadata not implemented")
+
+func _OrcaCore_CreateRollupRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollupRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).CreateRollupRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_CreateRollupRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).CreateRollupRule(ctx, req.(*RollupRule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrcaCoreServer) Annotate(context.Context, *AnnotateWrite) (*AnnotateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Annotate not implemented")
+
+func _OrcaCore_ReadRollupRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollupRulesRead)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadRollupRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadRollupRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadRollupRules(ctx, req.(*RollupRulesRead))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedOrcaCoreServer) mustEmbedUnimplementedOrcaCoreServer() {}
-func (UnimplementedOrcaCoreServer) testEmbeddedByValue()                  {}
 
-// UnsafeOrcaCoreServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OrcaCoreServer will
-// result in compilation errors.
-type UnsafeOrcaCoreServer interface {
-	mustEmbedUnimplementedOrcaCoreServer()
+func _OrcaCore_DeleteRollupRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollupRuleDeletion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).DeleteRollupRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_DeleteRollupRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).DeleteRollupRule(ctx, req.(*RollupRuleDeletion))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterOrcaCoreServer(s grpc.ServiceRegistrar, srv OrcaCoreServer) {
-	// If the following call pancis, it indicates UnimplementedOrcaCoreServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _OrcaCore_ReadChildWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChildWindowsRead)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&OrcaCore_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(OrcaCoreServer).ReadChildWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrcaCore_ReadChildWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrcaCoreServer).ReadChildWindows(ctx, req.(*ChildWindowsRead))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_RegisterProcessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProcessorRegistration)
+func _OrcaCore_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).RegisterProcessor(ctx, in)
+		return srv.(OrcaCoreServer).CreateAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_RegisterProcessor_FullMethodName,
+		FullMethod: OrcaCore_CreateAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).RegisterProcessor(ctx, req.(*ProcessorRegistration))
+		return srv.(OrcaCoreServer).CreateAlertRule(ctx, req.(*AlertRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_EmitWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Window)
+func _OrcaCore_ReadAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRulesRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).EmitWindow(ctx, in)
+		return srv.(OrcaCoreServer).ReadAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_EmitWindow_FullMethodName,
+		FullMethod: OrcaCore_ReadAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).EmitWindow(ctx, req.(*Window))
+		return srv.(OrcaCoreServer).ReadAlertRules(ctx, req.(*AlertRulesRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadWindowTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WindowTypeRead)
+func _OrcaCore_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRuleDeletion)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadWindowTypes(ctx, in)
+		return srv.(OrcaCoreServer).DeleteAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadWindowTypes_FullMethodName,
+		FullMethod: OrcaCore_DeleteAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadWindowTypes(ctx, req.(*WindowTypeRead))
+		return srv.(OrcaCoreServer).DeleteAlertRule(ctx, req.(*AlertRuleDeletion))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadAlgorithms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlgorithmsRead)
+func _OrcaCore_ReadAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertsRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadAlgorithms(ctx, in)
+		return srv.(OrcaCoreServer).ReadAlerts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadAlgorithms_FullMethodName,
+		FullMethod: OrcaCore_ReadAlerts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadAlgorithms(ctx, req.(*AlgorithmsRead))
+		return srv.(OrcaCoreServer).ReadAlerts(ctx, req.(*AlertsRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadProcessors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProcessorsRead)
+func _OrcaCore_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertAcknowledgement)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadProcessors(ctx, in)
+		return srv.(OrcaCoreServer).AcknowledgeAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadProcessors_FullMethodName,
+		FullMethod: OrcaCore_AcknowledgeAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadProcessors(ctx, req.(*ProcessorsRead))
+		return srv.(OrcaCoreServer).AcknowledgeAlert(ctx, req.(*AlertAcknowledgement))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadResultsStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResultsStatsRead)
+func _OrcaCore_ReadExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadResultsStats(ctx, in)
+		return srv.(OrcaCoreServer).ReadExecution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadResultsStats_FullMethodName,
+		FullMethod: OrcaCore_ReadExecution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadResultsStats(ctx, req.(*ResultsStatsRead))
+		return srv.(OrcaCoreServer).ReadExecution(ctx, req.(*ExecutionRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadResultFieldsForAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlgorithmFieldsRead)
+func _OrcaCore_ReadExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionsRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadResultFieldsForAlgorithm(ctx, in)
+		return srv.(OrcaCoreServer).ReadExecutions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadResultFieldsForAlgorithm_FullMethodName,
+		FullMethod: OrcaCore_ReadExecutions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadResultFieldsForAlgorithm(ctx, req.(*AlgorithmFieldsRead))
+		return srv.(OrcaCoreServer).ReadExecutions(ctx, req.(*ExecutionsRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadResultsForAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResultsForAlgorithmRead)
+func _OrcaCore_CancelExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionCancel)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadResultsForAlgorithm(ctx, in)
+		return srv.(OrcaCoreServer).CancelExecution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadResultsForAlgorithm_FullMethodName,
+		FullMethod: OrcaCore_CancelExecution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadResultsForAlgorithm(ctx, req.(*ResultsForAlgorithmRead))
+		return srv.(OrcaCoreServer).CancelExecution(ctx, req.(*ExecutionCancel))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WindowsRead)
+func _OrcaCore_ReadExecutionQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecutionQueueRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadWindows(ctx, in)
+		return srv.(OrcaCoreServer).ReadExecutionQueue(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadWindows_FullMethodName,
+		FullMethod: OrcaCore_ReadExecutionQueue_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadWindows(ctx, req.(*WindowsRead))
+		return srv.(OrcaCoreServer).ReadExecutionQueue(ctx, req.(*ExecutionQueueRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadDistinctMetadataForWindowType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DistinctMetadataForWindowTypeRead)
+func _OrcaCore_ReprocessWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindowsReprocess)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadDistinctMetadataForWindowType(ctx, in)
+		return srv.(OrcaCoreServer).ReprocessWindows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadDistinctMetadataForWindowType_FullMethodName,
+		FullMethod: OrcaCore_ReprocessWindows_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadDistinctMetadataForWindowType(ctx, req.(*DistinctMetadataForWindowTypeRead))
+		return srv.(OrcaCoreServer).ReprocessWindows(ctx, req.(*WindowsReprocess))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadWindowsForMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WindowsForMetadataRead)
+func _OrcaCore_ReadReprocess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReprocessRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadWindowsForMetadata(ctx, in)
+		return srv.(OrcaCoreServer).ReadReprocess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadWindowsForMetadata_FullMethodName,
+		FullMethod: OrcaCore_ReadReprocess_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadWindowsForMetadata(ctx, req.(*WindowsForMetadataRead))
+		return srv.(OrcaCoreServer).ReadReprocess(ctx, req.(*ReprocessRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_ReadResultsForAlgorithmAndMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResultsForAlgorithmAndMetadataRead)
+func _OrcaCore_ReadFailedExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailedExecutionsRead)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).ReadResultsForAlgorithmAndMetadata(ctx, in)
+		return srv.(OrcaCoreServer).ReadFailedExecutions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_ReadResultsForAlgorithmAndMetadata_FullMethodName,
+		FullMethod: OrcaCore_ReadFailedExecutions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).ReadResultsForAlgorithmAndMetadata(ctx, req.(*ResultsForAlgorithmAndMetadataRead))
+		return srv.(OrcaCoreServer).ReadFailedExecutions(ctx, req.(*FailedExecutionsRead))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrcaCore_Annotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AnnotateWrite)
+func _OrcaCore_RequeueFailedExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailedExecutionsRequeue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrcaCoreServer).Annotate(ctx, in)
+		return srv.(OrcaCoreServer).RequeueFailedExecutions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrcaCore_Annotate_FullMethodName,
+		FullMethod: OrcaCore_RequeueFailedExecutions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrcaCoreServer).Annotate(ctx, req.(*AnnotateWrite))
+		return srv.(OrcaCoreServer).RequeueFailedExecutions(ctx, req.(*FailedExecutionsRequeue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -547,6 +1719,42 @@ var OrcaCore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EmitWindow",
 			Handler:    _OrcaCore_EmitWindow_Handler,
 		},
+		{
+			MethodName: "EmitWindows",
+			Handler:    _OrcaCore_EmitWindows_Handler,
+		},
+		{
+			MethodName: "OpenWindow",
+			Handler:    _OrcaCore_OpenWindow_Handler,
+		},
+		{
+			MethodName: "ExtendWindow",
+			Handler:    _OrcaCore_ExtendWindow_Handler,
+		},
+		{
+			MethodName: "CloseWindow",
+			Handler:    _OrcaCore_CloseWindow_Handler,
+		},
+		{
+			MethodName: "AmendWindow",
+			Handler:    _OrcaCore_AmendWindow_Handler,
+		},
+		{
+			MethodName: "DeregisterProcessor",
+			Handler:    _OrcaCore_DeregisterProcessor_Handler,
+		},
+		{
+			MethodName: "RetireAlgorithm",
+			Handler:    _OrcaCore_RetireAlgorithm_Handler,
+		},
+		{
+			MethodName: "RemoveAlgorithmDependency",
+			Handler:    _OrcaCore_RemoveAlgorithmDependency_Handler,
+		},
+		{
+			MethodName: "SubmitResult",
+			Handler:    _OrcaCore_SubmitResult_Handler,
+		},
 		{
 			MethodName: "ReadWindowTypes",
 			Handler:    _OrcaCore_ReadWindowTypes_Handler,
@@ -591,8 +1799,104 @@ var OrcaCore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Annotate",
 			Handler:    _OrcaCore_Annotate_Handler,
 		},
+		{
+			MethodName: "CreateWindowSchedule",
+			Handler:    _OrcaCore_CreateWindowSchedule_Handler,
+		},
+		{
+			MethodName: "ReadWindowSchedules",
+			Handler:    _OrcaCore_ReadWindowSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteWindowSchedule",
+			Handler:    _OrcaCore_DeleteWindowSchedule_Handler,
+		},
+		{
+			MethodName: "CreateRollupRule",
+			Handler:    _OrcaCore_CreateRollupRule_Handler,
+		},
+		{
+			MethodName: "ReadRollupRules",
+			Handler:    _OrcaCore_ReadRollupRules_Handler,
+		},
+		{
+			MethodName: "DeleteRollupRule",
+			Handler:    _OrcaCore_DeleteRollupRule_Handler,
+		},
+		{
+			MethodName: "ReadChildWindows",
+			Handler:    _OrcaCore_ReadChildWindows_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _OrcaCore_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ReadAlertRules",
+			Handler:    _OrcaCore_ReadAlertRules_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _OrcaCore_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ReadAlerts",
+			Handler:    _OrcaCore_ReadAlerts_Handler,
+		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _OrcaCore_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "ReadExecution",
+			Handler:    _OrcaCore_ReadExecution_Handler,
+		},
+		{
+			MethodName: "ReadExecutions",
+			Handler:    _OrcaCore_ReadExecutions_Handler,
+		},
+		{
+			MethodName: "CancelExecution",
+			Handler:    _OrcaCore_CancelExecution_Handler,
+		},
+		{
+			MethodName: "ReadExecutionQueue",
+			Handler:    _OrcaCore_ReadExecutionQueue_Handler,
+		},
+		{
+			MethodName: "ReprocessWindows",
+			Handler:    _OrcaCore_ReprocessWindows_Handler,
+		},
+		{
+			MethodName: "ReadReprocess",
+			Handler:    _OrcaCore_ReadReprocess_Handler,
+		},
+		{
+			MethodName: "ReadFailedExecutions",
+			Handler:    _OrcaCore_ReadFailedExecutions_Handler,
+		},
+		{
+			MethodName: "RequeueFailedExecutions",
+			Handler:    _OrcaCore_RequeueFailedExecutions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EmitWindowStream",
+			Handler:       _OrcaCore_EmitWindowStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTasks",
+			Handler:       _OrcaCore_SubscribeTasks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeResults",
+			Handler:       _OrcaCore_SubscribeResults_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
 
@@ -13,6 +13,8 @@ import {
   type ClientOptions,
   type ClientReadableStream,
   type ClientUnaryCall,
+  type ClientWritableStream,
+  type handleClientStreamingCall,
   type handleServerStreamingCall,
   type handleUnaryCall,
   makeGenericClientConstructor,
@@ -133,6 +135,190 @@ export function resultStatusToJSON(object: ResultStatus): string {
   }
 }
 
+/** ConnectionState is the state of orca-core's pooled connection to a processor */
+export enum ConnectionState {
+  /** CONNECTION_STATE_UNSPECIFIED - placeholder sentinel to make explicit that nothing was provided */
+  CONNECTION_STATE_UNSPECIFIED = 0,
+  /** CONNECTION_STATE_DISCONNECTED - No connection is open, e.g. it has not been used or was evicted as idle */
+  CONNECTION_STATE_DISCONNECTED = 1,
+  /** CONNECTION_STATE_IDLE - The connection is open but has no active transport */
+  CONNECTION_STATE_IDLE = 2,
+  /** CONNECTION_STATE_CONNECTING - The connection is being established */
+  CONNECTION_STATE_CONNECTING = 3,
+  /** CONNECTION_STATE_READY - The connection is ready for tasks */
+  CONNECTION_STATE_READY = 4,
+  /** CONNECTION_STATE_TRANSIENT_FAILURE - The connection failed and is waiting to reconnect */
+  CONNECTION_STATE_TRANSIENT_FAILURE = 5,
+  /** CONNECTION_STATE_SHUTDOWN - The connection is shutting down */
+  CONNECTION_STATE_SHUTDOWN = 6,
+  UNRECOGNIZED = -1,
+}
+
+export function connectionStateFromJSON(object: any): ConnectionState {
+  switch (object) {
+    case 0:
+    case "CONNECTION_STATE_UNSPECIFIED":
+      return ConnectionState.CONNECTION_STATE_UNSPECIFIED;
+    case 1:
+    case "CONNECTION_STATE_DISCONNECTED":
+      return ConnectionState.CONNECTION_STATE_DISCONNECTED;
+    case 2:
+    case "CONNECTION_STATE_IDLE":
+      return ConnectionState.CONNECTION_STATE_IDLE;
+    case 3:
+    case "CONNECTION_STATE_CONNECTING":
+      return ConnectionState.CONNECTION_STATE_CONNECTING;
+    case 4:
+    case "CONNECTION_STATE_READY":
+      return ConnectionState.CONNECTION_STATE_READY;
+    case 5:
+    case "CONNECTION_STATE_TRANSIENT_FAILURE":
+      return ConnectionState.CONNECTION_STATE_TRANSIENT_FAILURE;
+    case 6:
+    case "CONNECTION_STATE_SHUTDOWN":
+      return ConnectionState.CONNECTION_STATE_SHUTDOWN;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ConnectionState.UNRECOGNIZED;
+  }
+}
+
+export function connectionStateToJSON(object: ConnectionState): string {
+  switch (object) {
+    case ConnectionState.CONNECTION_STATE_UNSPECIFIED:
+      return "CONNECTION_STATE_UNSPECIFIED";
+    case ConnectionState.CONNECTION_STATE_DISCONNECTED:
+      return "CONNECTION_STATE_DISCONNECTED";
+    case ConnectionState.CONNECTION_STATE_IDLE:
+      return "CONNECTION_STATE_IDLE";
+    case ConnectionState.CONNECTION_STATE_CONNECTING:
+      return "CONNECTION_STATE_CONNECTING";
+    case ConnectionState.CONNECTION_STATE_READY:
+      return "CONNECTION_STATE_READY";
+    case ConnectionState.CONNECTION_STATE_TRANSIENT_FAILURE:
+      return "CONNECTION_STATE_TRANSIENT_FAILURE";
+    case ConnectionState.CONNECTION_STATE_SHUTDOWN:
+      return "CONNECTION_STATE_SHUTDOWN";
+    case ConnectionState.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/** ExecutionStatus is the state of an execution, or of an algorithm within one */
+export enum ExecutionStatus {
+  /** EXECUTION_STATUS_UNSPECIFIED - placeholder sentinel to make explicit that nothing was provided */
+  EXECUTION_STATUS_UNSPECIFIED = 0,
+  /** EXECUTION_STATUS_PENDING - Waiting to be executed */
+  EXECUTION_STATUS_PENDING = 1,
+  /** EXECUTION_STATUS_RUNNING - Currently being executed */
+  EXECUTION_STATUS_RUNNING = 2,
+  /** EXECUTION_STATUS_SUCCEEDED - Executed successfully */
+  EXECUTION_STATUS_SUCCEEDED = 3,
+  /** EXECUTION_STATUS_FAILED - Execution failed - see the error message */
+  EXECUTION_STATUS_FAILED = 4,
+  /** EXECUTION_STATUS_SKIPPED - Not executed, as an upstream part of the execution failed */
+  EXECUTION_STATUS_SKIPPED = 5,
+  /** EXECUTION_STATUS_CANCELLED - Cancelled before it could complete */
+  EXECUTION_STATUS_CANCELLED = 6,
+  UNRECOGNIZED = -1,
+}
+
+export function executionStatusFromJSON(object: any): ExecutionStatus {
+  switch (object) {
+    case 0:
+    case "EXECUTION_STATUS_UNSPECIFIED":
+      return ExecutionStatus.EXECUTION_STATUS_UNSPECIFIED;
+    case 1:
+    case "EXECUTION_STATUS_PENDING":
+      return ExecutionStatus.EXECUTION_STATUS_PENDING;
+    case 2:
+    case "EXECUTION_STATUS_RUNNING":
+      return ExecutionStatus.EXECUTION_STATUS_RUNNING;
+    case 3:
+    case "EXECUTION_STATUS_SUCCEEDED":
+      return ExecutionStatus.EXECUTION_STATUS_SUCCEEDED;
+    case 4:
+    case "EXECUTION_STATUS_FAILED":
+      return ExecutionStatus.EXECUTION_STATUS_FAILED;
+    case 5:
+    case "EXECUTION_STATUS_SKIPPED":
+      return ExecutionStatus.EXECUTION_STATUS_SKIPPED;
+    case 6:
+    case "EXECUTION_STATUS_CANCELLED":
+      return ExecutionStatus.EXECUTION_STATUS_CANCELLED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ExecutionStatus.UNRECOGNIZED;
+  }
+}
+
+export function executionStatusToJSON(object: ExecutionStatus): string {
+  switch (object) {
+    case ExecutionStatus.EXECUTION_STATUS_UNSPECIFIED:
+      return "EXECUTION_STATUS_UNSPECIFIED";
+    case ExecutionStatus.EXECUTION_STATUS_PENDING:
+      return "EXECUTION_STATUS_PENDING";
+    case ExecutionStatus.EXECUTION_STATUS_RUNNING:
+      return "EXECUTION_STATUS_RUNNING";
+    case ExecutionStatus.EXECUTION_STATUS_SUCCEEDED:
+      return "EXECUTION_STATUS_SUCCEEDED";
+    case ExecutionStatus.EXECUTION_STATUS_FAILED:
+      return "EXECUTION_STATUS_FAILED";
+    case ExecutionStatus.EXECUTION_STATUS_SKIPPED:
+      return "EXECUTION_STATUS_SKIPPED";
+    case ExecutionStatus.EXECUTION_STATUS_CANCELLED:
+      return "EXECUTION_STATUS_CANCELLED";
+    case ExecutionStatus.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/** AlertStatus is the state of an alert */
+export enum AlertStatus {
+  ALERT_STATUS_UNSPECIFIED = 0,
+  /** ALERT_STATUS_FIRING - the rule holds for the latest result */
+  ALERT_STATUS_FIRING = 1,
+  /** ALERT_STATUS_RESOLVED - the rule has since stopped holding */
+  ALERT_STATUS_RESOLVED = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function alertStatusFromJSON(object: any): AlertStatus {
+  switch (object) {
+    case 0:
+    case "ALERT_STATUS_UNSPECIFIED":
+      return AlertStatus.ALERT_STATUS_UNSPECIFIED;
+    case 1:
+    case "ALERT_STATUS_FIRING":
+      return AlertStatus.ALERT_STATUS_FIRING;
+    case 2:
+    case "ALERT_STATUS_RESOLVED":
+      return AlertStatus.ALERT_STATUS_RESOLVED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return AlertStatus.UNRECOGNIZED;
+  }
+}
+
+export function alertStatusToJSON(object: AlertStatus): string {
+  switch (object) {
+    case AlertStatus.ALERT_STATUS_UNSPECIFIED:
+      return "ALERT_STATUS_UNSPECIFIED";
+    case AlertStatus.ALERT_STATUS_FIRING:
+      return "ALERT_STATUS_FIRING";
+    case AlertStatus.ALERT_STATUS_RESOLVED:
+      return "ALERT_STATUS_RESOLVED";
+    case AlertStatus.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 /** Window represents a time-bounded processing context that triggers algorithm execution. Windows are the primary input that start DAG processing flows. */
 export interface Window {
   /**
@@ -168,7 +354,24 @@ export interface Window {
    * defines its own required and optional metadata fields, as defined
    * at the time of registration.
    */
-  metadata?: { [key: string]: any } | undefined;
+  metadata?:
+    | { [key: string]: any }
+    | undefined;
+  /**
+   * Only trigger these algorithms of the window type, along with the
+   * algorithms they depend on. All algorithms are triggered when none are
+   * given
+   */
+  targetAlgorithms?:
+    | Algorithm[]
+    | undefined;
+  /**
+   * Identifies the window across retries by its emitter, scoped to the
+   * window type. A window emitted again with a key that has already been
+   * seen is not re-triggered. Without a key, windows are deduplicated on
+   * their type, time range, origin and metadata
+   */
+  idempotencyKey?: string | undefined;
 }
 
 /** MetadataField describes the metadata that can be carried along with windows */
@@ -219,7 +422,28 @@ export interface WindowType {
 
 /** WindowEmitStatus status message returned after emitting a window */
 export interface WindowEmitStatus {
-  status?: WindowEmitStatus_StatusEnum | undefined;
+  status?:
+    | WindowEmitStatus_StatusEnum
+    | undefined;
+  /**
+   * ID of the execution triggered by the window, used to read its progress
+   * Empty when no processing was triggered
+   */
+  execId?:
+    | string
+    | undefined;
+  /**
+   * Whether the window had already been emitted, in which case the status
+   * and exec_id are those of the original window and nothing was triggered
+   */
+  duplicate?:
+    | boolean
+    | undefined;
+  /**
+   * Why the window could not be emitted, for windows emitted in a batch
+   * Empty unless triggering failed
+   */
+  error?: string | undefined;
 }
 
 /** A status enum that captures scenarios regarding a window being emmited */
@@ -230,6 +454,11 @@ export enum WindowEmitStatus_StatusEnum {
   NO_TRIGGERED_ALGORITHMS = 1,
   /** PROCESSING_TRIGGERED - When processing has successfully been triggered */
   PROCESSING_TRIGGERED = 2,
+  /**
+   * QUEUED - When processing has been triggered, but is queued behind other
+   * executions as all execution workers are busy
+   */
+  QUEUED = 3,
   UNRECOGNIZED = -1,
 }
 
@@ -244,6 +473,9 @@ export function windowEmitStatus_StatusEnumFromJSON(object: any): WindowEmitStat
     case 2:
     case "PROCESSING_TRIGGERED":
       return WindowEmitStatus_StatusEnum.PROCESSING_TRIGGERED;
+    case 3:
+    case "QUEUED":
+      return WindowEmitStatus_StatusEnum.QUEUED;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -259,12 +491,127 @@ export function windowEmitStatus_StatusEnumToJSON(object: WindowEmitStatus_Statu
       return "NO_TRIGGERED_ALGORITHMS";
     case WindowEmitStatus_StatusEnum.PROCESSING_TRIGGERED:
       return "PROCESSING_TRIGGERED";
+    case WindowEmitStatus_StatusEnum.QUEUED:
+      return "QUEUED";
     case WindowEmitStatus_StatusEnum.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
   }
 }
 
+/** WindowsEmit is a batch of windows to be emitted together */
+export interface WindowsEmit {
+  /**
+   * The windows to emit. Each window is validated on its own, so that an
+   * invalid window fails without failing the rest of the batch
+   */
+  windows?: Window[] | undefined;
+}
+
+/** WindowsEmitStatus holds the status of each window emitted in a batch */
+export interface WindowsEmitStatus {
+  /** The status of each window, in the order that the windows were emitted */
+  statuses?: WindowEmitStatus[] | undefined;
+}
+
+/**
+ * WindowOpen opens a window whose end is not yet known, e.g. a machine fault
+ * that is still ongoing. The window is extended as time passes and closed
+ * once its end is known
+ */
+export interface WindowOpen {
+  /** Time that the window starts */
+  timeFrom?:
+    | Date
+    | undefined;
+  /** The name of the window type */
+  windowTypeName?:
+    | string
+    | undefined;
+  /** The version of the window type */
+  windowTypeVersion?:
+    | string
+    | undefined;
+  /** A unique identifier that defines where the window came from */
+  origin?:
+    | string
+    | undefined;
+  /** Additional metadata to attach to this window, as for emitted windows */
+  metadata?:
+    | { [key: string]: any }
+    | undefined;
+  /**
+   * Identifies the window when it is extended and closed, scoped to the
+   * window type. Opening a window again with a key that has already been
+   * seen has no effect
+   */
+  idempotencyKey?:
+    | string
+    | undefined;
+  /**
+   * Execute the window each time it is extended, rather than only once it is
+   * closed. The results of these provisional executions are replaced by
+   * those of the window once it is closed
+   */
+  provisional?: boolean | undefined;
+}
+
+/** WindowExtension extends an open window up to a later time */
+export interface WindowExtension {
+  /** The window type of the open window */
+  windowTypeName?: string | undefined;
+  windowTypeVersion?:
+    | string
+    | undefined;
+  /** The idempotency key the window was opened with */
+  idempotencyKey?:
+    | string
+    | undefined;
+  /**
+   * The time the window now extends to. Must be after the time it was last
+   * extended to
+   */
+  timeTo?: Date | undefined;
+}
+
+/** WindowClosure closes an open window at its end */
+export interface WindowClosure {
+  /** The window type of the open window */
+  windowTypeName?: string | undefined;
+  windowTypeVersion?:
+    | string
+    | undefined;
+  /** The idempotency key the window was opened with */
+  idempotencyKey?:
+    | string
+    | undefined;
+  /**
+   * The time that the window ends. Must be after the time it starts and no
+   * earlier than the time it was last extended to
+   */
+  timeTo?: Date | undefined;
+}
+
+/**
+ * WindowAmendment supersedes a window that has already been emitted with a
+ * corrected one, e.g. with the right time range or metadata
+ */
+export interface WindowAmendment {
+  /**
+   * The window as it was emitted. Matched on its idempotency key if it has
+   * one, or else on its window type, time range, origin and metadata
+   */
+  window?:
+    | Window
+    | undefined;
+  /**
+   * The corrected window, of the same window type. It takes over the
+   * idempotency key of the window it supersedes unless given its own. All
+   * of its algorithms are triggered
+   */
+  amendedWindow?: Window | undefined;
+}
+
 /**
  * AlgorithmDependency defines a requirement that one algorithm has on another's results.
  * These dependencies form the edges in the processing DAG.
@@ -330,7 +677,18 @@ export interface Algorithm {
    * The type of result that the algorithm produces. This is specified upfront
    * rather than introspected, to allow for validation
    */
-  resultType?: ResultType | undefined;
+  resultType?:
+    | ResultType
+    | undefined;
+  /** Overrides the retry policy of the processor when executing this algorithm */
+  retryPolicy?:
+    | RetryPolicy
+    | undefined;
+  /**
+   * The longest a processor may take to execute the algorithm, in milliseconds.
+   * If not set, the global default timeout applies
+   */
+  timeoutMs?: string | undefined;
 }
 
 /** Container for array of float values */
@@ -360,7 +718,11 @@ export interface Result {
     { $case: "structValue"; value: { [key: string]: any } | undefined }
     | undefined;
   /** Timestamp when the result was produced */
-  timestamp?: string | undefined;
+  timestamp?:
+    | string
+    | undefined;
+  /** Why the algorithm failed, when the status is not RESULT_STATUS_SUCEEDED */
+  errorMessage?: string | undefined;
 }
 
 /**
@@ -382,6 +744,8 @@ export interface ProcessorRegistration {
   /**
    * The connection string of the processor
    * e.g. grpc://localhost:5433
+   * Left empty by processors that instead receive their tasks through
+   * SubscribeTasks
    */
   connectionStr?:
     | string
@@ -390,7 +754,114 @@ export interface ProcessorRegistration {
    * Algorithms this processor can execute
    * The processor must implement all listed algorithms
    */
-  supportedAlgorithms?: Algorithm[] | undefined;
+  supportedAlgorithms?:
+    | Algorithm[]
+    | undefined;
+  /** Overrides the retry policy configured on Orca Core for calls to this processor */
+  retryPolicy?:
+    | RetryPolicy
+    | undefined;
+  /**
+   * Identifies the instance of the processor registering, e.g. its host or
+   * pod name, when the processor is scaled horizontally. An instance that
+   * registers again from a new connection string replaces its previous
+   * registration. Processors registered without one have a single instance
+   */
+  instanceId?: string | undefined;
+}
+
+/**
+ * ProcessorDeregistration removes a processor, retiring every algorithm it
+ * supports
+ */
+export interface ProcessorDeregistration {
+  /** name of the processor */
+  name?:
+    | string
+    | undefined;
+  /** runtime of the processor */
+  runtime?:
+    | string
+    | undefined;
+  /**
+   * also retire the live algorithms of other processors that depend on the
+   * algorithms of this processor. Without it, deregistration fails if any exist
+   */
+  force?: boolean | undefined;
+}
+
+/** AlgorithmRetirement stops an algorithm from being executed */
+export interface AlgorithmRetirement {
+  /** name of the algorithm */
+  name?:
+    | string
+    | undefined;
+  /** version of the algorithm */
+  version?:
+    | string
+    | undefined;
+  /**
+   * also retire the live algorithms that depend on this algorithm. Without it,
+   * retirement fails if any exist
+   */
+  force?: boolean | undefined;
+}
+
+/** AlgorithmDependencyRemoval removes the dependency of an algorithm on another */
+export interface AlgorithmDependencyRemoval {
+  /** name of the dependent algorithm */
+  algorithmName?:
+    | string
+    | undefined;
+  /** version of the dependent algorithm */
+  algorithmVersion?:
+    | string
+    | undefined;
+  /** name of the algorithm depended upon */
+  dependencyName?:
+    | string
+    | undefined;
+  /** version of the algorithm depended upon */
+  dependencyVersion?:
+    | string
+    | undefined;
+  /**
+   * remove the dependency even while executions of the dependent algorithm
+   * are in progress. Without it, removal fails if any are
+   */
+  force?: boolean | undefined;
+}
+
+/**
+ * RetryPolicy defines how failed calls to a processor are retried.
+ * Fields left unset inherit from the policy they override.
+ */
+export interface RetryPolicy {
+  /** Maximum number of attempts, including the first. 1 disables retries */
+  maxAttempts?:
+    | number
+    | undefined;
+  /** Backoff before the first retry, in milliseconds */
+  initialBackoffMs?:
+    | string
+    | undefined;
+  /** Upper bound on the backoff between attempts, in milliseconds */
+  maxBackoffMs?:
+    | string
+    | undefined;
+  /** Factor the backoff grows by after each attempt */
+  backoffMultiplier?:
+    | number
+    | undefined;
+  /** Fraction of the backoff that is randomised, between 0 and 1 */
+  jitter?:
+    | number
+    | undefined;
+  /**
+   * gRPC status codes that are retried
+   * Examples: "UNAVAILABLE", "RESOURCE_EXHAUSTED"
+   */
+  retryableCodes?: string[] | undefined;
 }
 
 /**
@@ -424,7 +895,34 @@ export interface ProcessingTask {
    * Contains all results that this algorithm declared dependencies on
    * All dependencies will be present when task is sent
    */
-  dependencyResults?: Result[] | undefined;
+  dependencyResults?:
+    | AlgorithmResult[]
+    | undefined;
+  /** The exec_id of the execution the task is part of */
+  execId?: string | undefined;
+}
+
+/** TaskSubscription is sent by a registered processor to receive its tasks */
+export interface TaskSubscription {
+  /** name of the processor */
+  name?:
+    | string
+    | undefined;
+  /** runtime of the processor */
+  runtime?: string | undefined;
+}
+
+/**
+ * TaskResult is the result of a ProcessingTask, submitted by the processor
+ * that received it
+ */
+export interface TaskResult {
+  /** the task_id of the ProcessingTask */
+  taskId?:
+    | string
+    | undefined;
+  /** the result of the algorithm */
+  result?: Result | undefined;
 }
 
 /**
@@ -583,39 +1081,114 @@ export interface Processors {
 
 export interface Processors_Processor {
   name?: string | undefined;
-  runtime?: string | undefined;
-}
-
-export interface ResultsStatsRead {
-}
-
-export interface ResultsStats {
-  Count?: string | undefined;
-}
-
-export interface AlgorithmFieldsRead {
-  timeFrom?: Date | undefined;
-  timeTo?: Date | undefined;
-  algorithm?: Algorithm | undefined;
-}
-
-export interface AlgorithmFields {
-  field?: string[] | undefined;
-}
-
-export interface ResultsForAlgorithmRead {
-  /** the time to read results from */
-  timeFrom?:
-    | Date
+  runtime?:
+    | string
     | undefined;
-  /** the time to read results to */
-  timeTo?:
-    | Date
+  /**
+   * the state of the connection to the most recently registered instance
+   * of the processor
+   */
+  connectionState?:
+    | ConnectionState
     | undefined;
-  /** the algorithm to read results for */
+  /**
+   * the instances registered under the processor, which tasks are
+   * dispatched between
+   */
+  instances?:
+    | Processors_Instance[]
+    | undefined;
+  /** when an instance of the processor last responded to a heartbeat */
+  lastSeen?:
+    | Date
+    | undefined;
+  /** the health reported by the most recently seen instance */
+  status?: HealthCheckResponse_Status | undefined;
+  statusMessage?: string | undefined;
+  metrics?:
+    | ProcessorMetrics
+    | undefined;
+  /** whether any instance of the processor is available to be dispatched to */
+  available?: boolean | undefined;
+}
+
+/**
+ * Instance is a replica of a processor, registered with its own
+ * connection string
+ */
+export interface Processors_Instance {
+  connectionStr?:
+    | string
+    | undefined;
+  /** the identity the instance registered with */
+  instanceId?:
+    | string
+    | undefined;
+  /** the state of the connection to the instance */
+  connectionState?:
+    | ConnectionState
+    | undefined;
+  /** when the instance last registered */
+  registered?:
+    | Date
+    | undefined;
+  /** when the instance last responded to a heartbeat */
+  lastSeen?:
+    | Date
+    | undefined;
+  /**
+   * the health the instance last reported, or the reason it could not be
+   * reached
+   */
+  status?: HealthCheckResponse_Status | undefined;
+  statusMessage?:
+    | string
+    | undefined;
+  /** the metrics the instance last reported */
+  metrics?:
+    | ProcessorMetrics
+    | undefined;
+  /**
+   * instances unseen for longer than ORCA_PROCESSOR_UNAVAILABLE_AFTER are
+   * unavailable, and are not dispatched to until they are seen again
+   */
+  available?: boolean | undefined;
+}
+
+export interface ResultsStatsRead {
+}
+
+export interface ResultsStats {
+  Count?: string | undefined;
+}
+
+export interface AlgorithmFieldsRead {
+  timeFrom?: Date | undefined;
+  timeTo?: Date | undefined;
   algorithm?: Algorithm | undefined;
 }
 
+export interface AlgorithmFields {
+  field?: string[] | undefined;
+}
+
+export interface ResultsForAlgorithmRead {
+  /** the time to read results from */
+  timeFrom?:
+    | Date
+    | undefined;
+  /** the time to read results to */
+  timeTo?:
+    | Date
+    | undefined;
+  /** the algorithm to read results for */
+  algorithm?:
+    | Algorithm
+    | undefined;
+  /** also read the results of windows that have been amended */
+  includeSuperseded?: boolean | undefined;
+}
+
 export interface ResultsForAlgorithm {
   results?: ResultsForAlgorithm_ResultsRow[] | undefined;
 }
@@ -640,6 +1213,19 @@ export interface ResultsForAlgorithm_ResultsRow {
      */
     { $case: "structValue"; value: { [key: string]: any } | undefined }
     | undefined;
+  /** the status of the result - failed results carry no result data */
+  status?:
+    | ResultStatus
+    | undefined;
+  /** why the algorithm failed, if it did */
+  errorMessage?:
+    | string
+    | undefined;
+  /**
+   * whether the window of the result has been amended, so that the result
+   * is out of date
+   */
+  superseded?: boolean | undefined;
 }
 
 export interface WindowsRead {
@@ -652,7 +1238,11 @@ export interface WindowsRead {
     | Date
     | undefined;
   /** the window */
-  window?: WindowType | undefined;
+  window?:
+    | WindowType
+    | undefined;
+  /** also read windows that have been amended */
+  includeSuperseded?: boolean | undefined;
 }
 
 export interface Windows {
@@ -702,6 +1292,42 @@ export interface WindowsForMetadata {
   window?: Window[] | undefined;
 }
 
+export interface ResultsSubscription {
+  /** only stream results of these algorithms. All algorithms when empty */
+  algorithms?:
+    | Algorithm[]
+    | undefined;
+  /** only stream results of windows of this type */
+  windowType?: WindowType | undefined;
+  metadata?:
+    | ResultsSubscription_Metadata[]
+    | undefined;
+  /**
+   * the cursor of the last result received, to resume streaming after it.
+   * Only results written after subscribing are streamed when not set
+   */
+  resumeAfter?: string | undefined;
+}
+
+/** only stream results of windows whose metadata contains these fields */
+export interface ResultsSubscription_Metadata {
+  field?: string | undefined;
+  value?: any | undefined;
+}
+
+export interface ResultUpdate {
+  /** the position of the result in the stream, which can be resumed after */
+  cursor?:
+    | string
+    | undefined;
+  /** the window the result is for */
+  window?:
+    | Window
+    | undefined;
+  /** the algorithm and its result */
+  algorithmResult?: AlgorithmResult | undefined;
+}
+
 export interface ResultsForAlgorithmAndMetadataRead {
   /** the time to read results from */
   timeFrom?:
@@ -745,6 +1371,12 @@ export interface ResultsForAlgorithmAndMetadata_ResultsRow {
      */
     { $case: "structValue"; value: { [key: string]: any } | undefined }
     | undefined;
+  /** the status of the result - failed results carry no result data */
+  status?:
+    | ResultStatus
+    | undefined;
+  /** why the algorithm failed, if it did */
+  errorMessage?: string | undefined;
 }
 
 /** ------------------------ Annotation Messages ------------------------ */
@@ -776,116 +1408,5324 @@ export interface AnnotateWrite {
 export interface AnnotateResponse {
 }
 
-function createBaseWindow(): Window {
-  return {
-    timeFrom: undefined,
-    timeTo: undefined,
-    windowTypeName: "",
-    windowTypeVersion: "",
-    origin: "",
-    metadata: undefined,
-  };
+export interface ExecutionRead {
+  /** the exec_id returned when the window was emitted */
+  execId?: string | undefined;
 }
 
-export const Window: MessageFns<Window> = {
-  encode(message: Window, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.timeFrom !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
-    }
-    if (message.timeTo !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
-    }
-    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
-      writer.uint32(26).string(message.windowTypeName);
-    }
-    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
-      writer.uint32(34).string(message.windowTypeVersion);
-    }
-    if (message.origin !== undefined && message.origin !== "") {
-      writer.uint32(42).string(message.origin);
-    }
-    if (message.metadata !== undefined) {
-      Struct.encode(Struct.wrap(message.metadata), writer.uint32(50).fork()).join();
-    }
-    return writer;
-  },
+export interface ExecutionQueueRead {
+}
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Window {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindow();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 10) {
-            break;
-          }
+export interface ExecutionQueue {
+  /** the number of executions that can be processed at once */
+  workers?:
+    | number
+    | undefined;
+  /** the number of executions being processed */
+  active?:
+    | number
+    | undefined;
+  /** the number of executions waiting for a worker */
+  queued?:
+    | number
+    | undefined;
+  /**
+   * the number of executions that can wait for a worker before emitted
+   * windows are rejected with RESOURCE_EXHAUSTED
+   */
+  queueSize?:
+    | number
+    | undefined;
+  /**
+   * the fraction of the capacity of the workers and queue that is in use,
+   * from 0 to 1
+   */
+  saturation?: number | undefined;
+}
 
-          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
+export interface ExecutionCancel {
+  /** the exec_id returned when the window was emitted */
+  execId?: string | undefined;
+}
 
-          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
+export interface ExecutionsRead {
+  /** the time to read executions of windows from */
+  timeFrom?:
+    | Date
+    | undefined;
+  /** the time to read executions of windows to */
+  timeTo?:
+    | Date
+    | undefined;
+  /** only read executions triggered by this type of window */
+  window?:
+    | WindowType
+    | undefined;
+  /** only read executions in this state */
+  status?: ExecutionStatus | undefined;
+}
 
-          message.windowTypeName = reader.string();
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
+/** ExecutionAttempt is a single attempt made at executing an algorithm */
+export interface ExecutionAttempt {
+  /** the attempt number, starting at 1 */
+  attempt?:
+    | number
+    | undefined;
+  /** the outcome of the attempt */
+  status?:
+    | ExecutionStatus
+    | undefined;
+  /** the gRPC status code of a failed attempt */
+  errorCode?:
+    | string
+    | undefined;
+  /** the error of a failed attempt */
+  errorMessage?:
+    | string
+    | undefined;
+  /** the backoff waited before the next attempt, in milliseconds */
+  backoffMs?:
+    | string
+    | undefined;
+  /** when the attempt started */
+  started?:
+    | Date
+    | undefined;
+  /** when the attempt finished */
+  finished?: Date | undefined;
+}
 
-          message.windowTypeVersion = reader.string();
-          continue;
-        }
-        case 5: {
-          if (tag !== 42) {
-            break;
-          }
+/** AlgorithmExecution is the state of a single algorithm within an execution */
+export interface AlgorithmExecution {
+  /** the algorithm being executed */
+  algorithm?:
+    | Algorithm
+    | undefined;
+  /** the name of the processor executing the algorithm */
+  processorName?:
+    | string
+    | undefined;
+  /** the runtime of the processor executing the algorithm */
+  processorRuntime?:
+    | string
+    | undefined;
+  /** the state of the algorithm */
+  status?:
+    | ExecutionStatus
+    | undefined;
+  /** when the algorithm started executing */
+  started?:
+    | Date
+    | undefined;
+  /** when the algorithm finished executing */
+  finished?:
+    | Date
+    | undefined;
+  /** the error the algorithm failed with */
+  errorMessage?:
+    | string
+    | undefined;
+  /** attempts made at executing the algorithm, including any retries */
+  attempts?: ExecutionAttempt[] | undefined;
+}
 
-          message.origin = reader.string();
-          continue;
-        }
-        case 6: {
-          if (tag !== 50) {
-            break;
-          }
+/** Execution is the state of the processing triggered by an emitted window */
+export interface Execution {
+  /** the exec_id returned when the window was emitted */
+  execId?:
+    | string
+    | undefined;
+  /** the window that triggered the execution */
+  window?:
+    | Window
+    | undefined;
+  /** the overall state of the execution */
+  status?:
+    | ExecutionStatus
+    | undefined;
+  /** when the window was emitted */
+  created?:
+    | Date
+    | undefined;
+  /** when the execution started */
+  started?:
+    | Date
+    | undefined;
+  /** when the execution finished */
+  finished?:
+    | Date
+    | undefined;
+  /** the state of each triggered algorithm, in execution order */
+  algorithms?:
+    | AlgorithmExecution[]
+    | undefined;
+  /**
+   * whether the execution is of an open window, whose results are replaced
+   * once the window closes
+   */
+  provisional?: boolean | undefined;
+}
 
-          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
-          continue;
-        }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
+export interface Executions {
+  /** the executions */
+  executions?: Execution[] | undefined;
+}
 
-  fromJSON(object: any): Window {
-    return {
+export interface WindowsReprocess {
+  /** the time to reprocess windows from */
+  timeFrom?:
+    | Date
+    | undefined;
+  /** the time to reprocess windows to */
+  timeTo?:
+    | Date
+    | undefined;
+  /** the type of window to reprocess */
+  window?:
+    | WindowType
+    | undefined;
+  /** only reprocess windows whose metadata contains these fields and values */
+  metadata?:
+    | { [key: string]: any }
+    | undefined;
+  /**
+   * only execute these algorithms of the window type. Algorithms that are not
+   * selected are never executed, with their stored results used as the inputs
+   * of selected algorithms. All algorithms are executed when none are given
+   */
+  algorithms?:
+    | Algorithm[]
+    | undefined;
+  /** the most windows to trigger per second. Defaults to ORCA_REPROCESS_RATE */
+  windowsPerSecond?: number | undefined;
+}
+
+export interface ReprocessRead {
+  /** the reprocess_id returned when the reprocess was started */
+  reprocessId?: string | undefined;
+}
+
+/** Reprocess is the progress of reprocessing stored windows */
+export interface Reprocess {
+  /** identifies the reprocess, to read its progress */
+  reprocessId?:
+    | string
+    | undefined;
+  /**
+   * the state of the reprocess, which finishes once every window has been
+   * triggered and its execution has finished
+   */
+  status?:
+    | ExecutionStatus
+    | undefined;
+  /** the number of windows to reprocess */
+  totalWindows?:
+    | number
+    | undefined;
+  /** the number of windows whose execution has been triggered */
+  triggeredWindows?:
+    | number
+    | undefined;
+  /** the number of windows whose execution succeeded */
+  succeededWindows?:
+    | number
+    | undefined;
+  /** the number of windows whose execution failed or was cancelled */
+  failedWindows?:
+    | number
+    | undefined;
+  /** when the reprocess was started */
+  created?:
+    | Date
+    | undefined;
+  /** when the reprocess finished */
+  finished?:
+    | Date
+    | undefined;
+  /** the error the reprocess failed with */
+  errorMessage?: string | undefined;
+}
+
+export interface FailedExecutionsRead {
+  /** the time to read failures of windows from */
+  timeFrom?:
+    | Date
+    | undefined;
+  /** the time to read failures of windows to */
+  timeTo?:
+    | Date
+    | undefined;
+  /** only read failures of tasks sent to processors with this name */
+  processorName?:
+    | string
+    | undefined;
+  /** only read failures of tasks sent to processors with this runtime */
+  processorRuntime?:
+    | string
+    | undefined;
+  /**
+   * only read failures of tasks executing this algorithm. The version may be
+   * left out to match every version
+   */
+  algorithm?:
+    | Algorithm
+    | undefined;
+  /** also read failures that have already been requeued */
+  includeRequeued?: boolean | undefined;
+}
+
+export interface FailedExecutionsRequeue {
+  /** the time to requeue failures of windows from */
+  timeFrom?:
+    | Date
+    | undefined;
+  /** the time to requeue failures of windows to */
+  timeTo?:
+    | Date
+    | undefined;
+  /** only requeue failures of tasks sent to processors with this name */
+  processorName?:
+    | string
+    | undefined;
+  /** only requeue failures of tasks sent to processors with this runtime */
+  processorRuntime?:
+    | string
+    | undefined;
+  /**
+   * only requeue failures of tasks executing this algorithm. The version may
+   * be left out to match every version
+   */
+  algorithm?: Algorithm | undefined;
+}
+
+/** FailedExecution is a processor task that failed after exhausting its retries */
+export interface FailedExecution {
+  /** the exec_id of the execution the task belongs to */
+  execId?:
+    | string
+    | undefined;
+  /** the exec_id of the request sent to the processor */
+  taskExecId?:
+    | string
+    | undefined;
+  /** the name of the processor the task was sent to */
+  processorName?:
+    | string
+    | undefined;
+  /** the runtime of the processor the task was sent to */
+  processorRuntime?:
+    | string
+    | undefined;
+  /**
+   * the request made in the last attempt, including the window, algorithms
+   * and dependency results
+   */
+  request?:
+    | ExecutionRequest
+    | undefined;
+  /** the gRPC status code the task failed with */
+  errorCode?:
+    | string
+    | undefined;
+  /** the error the task failed with */
+  errorMessage?:
+    | string
+    | undefined;
+  /** the attempts made at executing the task, including any requeues */
+  attempts?:
+    | number
+    | undefined;
+  /** when the task failed */
+  failed?:
+    | Date
+    | undefined;
+  /** when the failure was requeued, if it has been */
+  requeued?: Date | undefined;
+}
+
+export interface FailedExecutions {
+  /** the failures */
+  failedExecutions?: FailedExecution[] | undefined;
+}
+
+export interface RequeuedExecutions {
+  /** the exec_ids of the executions that were requeued */
+  execIds?: string[] | undefined;
+}
+
+/**
+ * AlertRule is checked against the results of an algorithm as they are
+ * written, firing an alert when its expression holds and resolving it once
+ * the expression no longer holds
+ */
+export interface AlertRule {
+  /** unique name of the rule */
+  name?:
+    | string
+    | undefined;
+  /** the algorithm whose results are checked */
+  algorithm?:
+    | Algorithm
+    | undefined;
+  /**
+   * a CEL expression evaluating to a bool. It is evaluated over each
+   * succeeded result as `result`, along with the `origin` and `metadata` of
+   * its window. E.g. `result.single_value > 10.0` or
+   * `result.struct_value.status == "fault" && metadata.site == "north"`
+   */
+  expression?:
+    | string
+    | undefined;
+  /** description of the rule */
+  description?:
+    | string
+    | undefined;
+  /**
+   * alerts are deduplicated per window origin and the value of this metadata
+   * field. Per origin alone when not set
+   */
+  metadataKey?: string | undefined;
+}
+
+export interface AlertRulesRead {
+}
+
+export interface AlertRules {
+  alertRules?: AlertRule[] | undefined;
+}
+
+export interface AlertRuleDeletion {
+  /** name of the rule */
+  name?: string | undefined;
+}
+
+export interface AlertsRead {
+  /** the time to read alerts fired from */
+  timeFrom?:
+    | Date
+    | undefined;
+  /** the time to read alerts fired to */
+  timeTo?:
+    | Date
+    | undefined;
+  /** only read alerts of this rule */
+  alertRuleName?:
+    | string
+    | undefined;
+  /** only read alerts in this state. Alerts in any state when not set */
+  status?:
+    | AlertStatus
+    | undefined;
+  /** also read alerts that have been acknowledged */
+  includeAcknowledged?: boolean | undefined;
+}
+
+export interface Alerts {
+  alerts?: Alerts_Alert[] | undefined;
+}
+
+export interface Alerts_Alert {
+  /** the ID of the alert, used to acknowledge it */
+  alertId?:
+    | string
+    | undefined;
+  /** the rule that fired the alert */
+  alertRuleName?:
+    | string
+    | undefined;
+  /** the algorithm whose result fired the alert */
+  algorithm?:
+    | Algorithm
+    | undefined;
+  /** the state of the alert */
+  status?:
+    | AlertStatus
+    | undefined;
+  /** the origin of the window whose result fired the alert */
+  origin?:
+    | string
+    | undefined;
+  /** the metadata of the window whose result fired the alert */
+  metadata?:
+    | { [key: string]: any }
+    | undefined;
+  /** the time the window whose result fired the alert starts */
+  windowTimeFrom?:
+    | Date
+    | undefined;
+  /** the time the window whose result fired the alert ends */
+  windowTimeTo?:
+    | Date
+    | undefined;
+  /** when the alert fired */
+  fired?:
+    | Date
+    | undefined;
+  /** when the alert resolved, if it has */
+  resolved?:
+    | Date
+    | undefined;
+  /** when the alert was acknowledged, if it has been */
+  acknowledged?:
+    | Date
+    | undefined;
+  /** who acknowledged the alert */
+  acknowledgedBy?: string | undefined;
+}
+
+export interface AlertAcknowledgement {
+  /** the ID of the alert */
+  alertId?:
+    | string
+    | undefined;
+  /** who is acknowledging the alert */
+  acknowledgedBy?: string | undefined;
+}
+
+/**
+ * WindowSchedule emits windows of a type periodically. Each window runs from
+ * one time of the cron expression to the next, and is emitted once it ends.
+ * Windows missed while orca-core was down are emitted once it is back up
+ */
+export interface WindowSchedule {
+  /** unique name of the schedule */
+  name?:
+    | string
+    | undefined;
+  /** the type of the windows emitted */
+  windowTypeName?: string | undefined;
+  windowTypeVersion?:
+    | string
+    | undefined;
+  /**
+   * a standard five field cron expression, or a descriptor such as `@hourly`,
+   * that the windows are aligned to. E.g. `0 6,14,22 * * *` for shifts
+   */
+  cron?:
+    | string
+    | undefined;
+  /**
+   * the IANA time zone that the cron expression is evaluated in. UTC when
+   * not set
+   */
+  timezone?:
+    | string
+    | undefined;
+  /** the origin of the windows emitted */
+  origin?:
+    | string
+    | undefined;
+  /**
+   * metadata attached to every window emitted, which must carry the
+   * metadata fields of the window type
+   */
+  metadata?:
+    | { [key: string]: any }
+    | undefined;
+  /**
+   * windows are emitted from the first time of the cron expression at or
+   * after this, including any that have already ended. When not set, a new
+   * schedule starts from now, and a replaced schedule carries on from the
+   * last window it emitted
+   */
+  start?:
+    | Date
+    | undefined;
+  /** the end of the last window emitted. Set when read */
+  emittedUntil?:
+    | Date
+    | undefined;
+  /** when the next window is due to be emitted. Set when read */
+  nextEmission?: Date | undefined;
+}
+
+export interface WindowSchedulesRead {
+}
+
+export interface WindowSchedules {
+  windowSchedules?: WindowSchedule[] | undefined;
+}
+
+export interface WindowScheduleDeletion {
+  /** name of the schedule */
+  name?: string | undefined;
+}
+
+/**
+ * RollupRule rolls windows of a child type up into windows of a parent type.
+ * Parent windows run from one time of the cron expression to the next. Once
+ * child windows with the same values of the metadata keys cover the whole of
+ * a parent window, the parent window is emitted, linked to its children, and
+ * triggers the algorithms of its own window type
+ */
+export interface RollupRule {
+  /** unique name of the rule */
+  name?:
+    | string
+    | undefined;
+  /** the type of the windows rolled up */
+  childWindowTypeName?: string | undefined;
+  childWindowTypeVersion?:
+    | string
+    | undefined;
+  /** the type of the windows emitted */
+  parentWindowTypeName?: string | undefined;
+  parentWindowTypeVersion?:
+    | string
+    | undefined;
+  /**
+   * a standard five field cron expression, or a descriptor such as `@daily`,
+   * that parent windows are aligned to
+   */
+  cron?:
+    | string
+    | undefined;
+  /**
+   * the IANA time zone that the cron expression is evaluated in. UTC when
+   * not set
+   */
+  timezone?:
+    | string
+    | undefined;
+  /**
+   * child windows are rolled up together when they have the same values of
+   * these metadata fields, which are carried over to the parent window.
+   * E.g. `asset` rolls up the windows of each asset separately. They must
+   * include the metadata fields of the parent window type
+   */
+  metadataKeys?:
+    | string[]
+    | undefined;
+  /** the origin of the parent windows. The name of the rule when not set */
+  origin?: string | undefined;
+}
+
+export interface RollupRulesRead {
+}
+
+export interface RollupRules {
+  rollupRules?: RollupRule[] | undefined;
+}
+
+export interface RollupRuleDeletion {
+  /** name of the rule */
+  name?: string | undefined;
+}
+
+export interface ChildWindowsRead {
+  /**
+   * the parent window, matched on its window type, time range and origin,
+   * along with any metadata given
+   */
+  window?: Window | undefined;
+}
+
+function createBaseWindow(): Window {
+  return {
+    timeFrom: undefined,
+    timeTo: undefined,
+    windowTypeName: "",
+    windowTypeVersion: "",
+    origin: "",
+    metadata: undefined,
+    targetAlgorithms: [],
+    idempotencyKey: "",
+  };
+}
+
+export const Window: MessageFns<Window> = {
+  encode(message: Window, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      writer.uint32(26).string(message.windowTypeName);
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      writer.uint32(34).string(message.windowTypeVersion);
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      writer.uint32(42).string(message.origin);
+    }
+    if (message.metadata !== undefined) {
+      Struct.encode(Struct.wrap(message.metadata), writer.uint32(50).fork()).join();
+    }
+    if (message.targetAlgorithms !== undefined && message.targetAlgorithms.length !== 0) {
+      for (const v of message.targetAlgorithms) {
+        Algorithm.encode(v!, writer.uint32(58).fork()).join();
+      }
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      writer.uint32(66).string(message.idempotencyKey);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Window {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindow();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.windowTypeName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.windowTypeVersion = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.origin = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          const el = Algorithm.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.targetAlgorithms!.push(el);
+          }
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.idempotencyKey = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Window {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      windowTypeName: isSet(object.windowTypeName) ? globalThis.String(object.windowTypeName) : "",
+      windowTypeVersion: isSet(object.windowTypeVersion) ? globalThis.String(object.windowTypeVersion) : "",
+      origin: isSet(object.origin) ? globalThis.String(object.origin) : "",
+      metadata: isObject(object.metadata) ? object.metadata : undefined,
+      targetAlgorithms: globalThis.Array.isArray(object?.targetAlgorithms)
+        ? object.targetAlgorithms.map((e: any) => Algorithm.fromJSON(e))
+        : [],
+      idempotencyKey: isSet(object.idempotencyKey) ? globalThis.String(object.idempotencyKey) : "",
+    };
+  },
+
+  toJSON(message: Window): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      obj.windowTypeName = message.windowTypeName;
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      obj.windowTypeVersion = message.windowTypeVersion;
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      obj.origin = message.origin;
+    }
+    if (message.metadata !== undefined) {
+      obj.metadata = message.metadata;
+    }
+    if (message.targetAlgorithms?.length) {
+      obj.targetAlgorithms = message.targetAlgorithms.map((e) => Algorithm.toJSON(e));
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      obj.idempotencyKey = message.idempotencyKey;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Window>, I>>(base?: I): Window {
+    return Window.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Window>, I>>(object: I): Window {
+    const message = createBaseWindow();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.windowTypeName = object.windowTypeName ?? "";
+    message.windowTypeVersion = object.windowTypeVersion ?? "";
+    message.origin = object.origin ?? "";
+    message.metadata = object.metadata ?? undefined;
+    message.targetAlgorithms = object.targetAlgorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+    message.idempotencyKey = object.idempotencyKey ?? "";
+    return message;
+  },
+};
+
+function createBaseMetadataField(): MetadataField {
+  return { name: "", description: "" };
+}
+
+export const MetadataField: MessageFns<MetadataField> = {
+  encode(message: MetadataField, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.description !== undefined && message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): MetadataField {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMetadataField();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MetadataField {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+    };
+  },
+
+  toJSON(message: MetadataField): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.description !== undefined && message.description !== "") {
+      obj.description = message.description;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<MetadataField>, I>>(base?: I): MetadataField {
+    return MetadataField.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<MetadataField>, I>>(object: I): MetadataField {
+    const message = createBaseMetadataField();
+    message.name = object.name ?? "";
+    message.description = object.description ?? "";
+    return message;
+  },
+};
+
+function createBaseWindowType(): WindowType {
+  return { name: "", version: "", description: "", metadataFields: [] };
+}
+
+export const WindowType: MessageFns<WindowType> = {
+  encode(message: WindowType, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.version !== undefined && message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    if (message.description !== undefined && message.description !== "") {
+      writer.uint32(26).string(message.description);
+    }
+    if (message.metadataFields !== undefined && message.metadataFields.length !== 0) {
+      for (const v of message.metadataFields) {
+        MetadataField.encode(v!, writer.uint32(34).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowType {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowType();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = MetadataField.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.metadataFields!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowType {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      metadataFields: globalThis.Array.isArray(object?.metadataFields)
+        ? object.metadataFields.map((e: any) => MetadataField.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: WindowType): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.version !== undefined && message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.description !== undefined && message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.metadataFields?.length) {
+      obj.metadataFields = message.metadataFields.map((e) => MetadataField.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowType>, I>>(base?: I): WindowType {
+    return WindowType.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowType>, I>>(object: I): WindowType {
+    const message = createBaseWindowType();
+    message.name = object.name ?? "";
+    message.version = object.version ?? "";
+    message.description = object.description ?? "";
+    message.metadataFields = object.metadataFields?.map((e) => MetadataField.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWindowEmitStatus(): WindowEmitStatus {
+  return { status: 0, execId: "", duplicate: false, error: "" };
+}
+
+export const WindowEmitStatus: MessageFns<WindowEmitStatus> = {
+  encode(message: WindowEmitStatus, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(8).int32(message.status);
+    }
+    if (message.execId !== undefined && message.execId !== "") {
+      writer.uint32(18).string(message.execId);
+    }
+    if (message.duplicate !== undefined && message.duplicate !== false) {
+      writer.uint32(24).bool(message.duplicate);
+    }
+    if (message.error !== undefined && message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowEmitStatus {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowEmitStatus();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.execId = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.duplicate = reader.bool();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowEmitStatus {
+    return {
+      status: isSet(object.status) ? windowEmitStatus_StatusEnumFromJSON(object.status) : 0,
+      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
+      duplicate: isSet(object.duplicate) ? globalThis.Boolean(object.duplicate) : false,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+    };
+  },
+
+  toJSON(message: WindowEmitStatus): unknown {
+    const obj: any = {};
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = windowEmitStatus_StatusEnumToJSON(message.status);
+    }
+    if (message.execId !== undefined && message.execId !== "") {
+      obj.execId = message.execId;
+    }
+    if (message.duplicate !== undefined && message.duplicate !== false) {
+      obj.duplicate = message.duplicate;
+    }
+    if (message.error !== undefined && message.error !== "") {
+      obj.error = message.error;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowEmitStatus>, I>>(base?: I): WindowEmitStatus {
+    return WindowEmitStatus.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowEmitStatus>, I>>(object: I): WindowEmitStatus {
+    const message = createBaseWindowEmitStatus();
+    message.status = object.status ?? 0;
+    message.execId = object.execId ?? "";
+    message.duplicate = object.duplicate ?? false;
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBaseWindowsEmit(): WindowsEmit {
+  return { windows: [] };
+}
+
+export const WindowsEmit: MessageFns<WindowsEmit> = {
+  encode(message: WindowsEmit, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.windows !== undefined && message.windows.length !== 0) {
+      for (const v of message.windows) {
+        Window.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowsEmit {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowsEmit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = Window.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.windows!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowsEmit {
+    return {
+      windows: globalThis.Array.isArray(object?.windows) ? object.windows.map((e: any) => Window.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: WindowsEmit): unknown {
+    const obj: any = {};
+    if (message.windows?.length) {
+      obj.windows = message.windows.map((e) => Window.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowsEmit>, I>>(base?: I): WindowsEmit {
+    return WindowsEmit.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowsEmit>, I>>(object: I): WindowsEmit {
+    const message = createBaseWindowsEmit();
+    message.windows = object.windows?.map((e) => Window.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWindowsEmitStatus(): WindowsEmitStatus {
+  return { statuses: [] };
+}
+
+export const WindowsEmitStatus: MessageFns<WindowsEmitStatus> = {
+  encode(message: WindowsEmitStatus, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.statuses !== undefined && message.statuses.length !== 0) {
+      for (const v of message.statuses) {
+        WindowEmitStatus.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowsEmitStatus {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowsEmitStatus();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = WindowEmitStatus.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.statuses!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowsEmitStatus {
+    return {
+      statuses: globalThis.Array.isArray(object?.statuses)
+        ? object.statuses.map((e: any) => WindowEmitStatus.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: WindowsEmitStatus): unknown {
+    const obj: any = {};
+    if (message.statuses?.length) {
+      obj.statuses = message.statuses.map((e) => WindowEmitStatus.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowsEmitStatus>, I>>(base?: I): WindowsEmitStatus {
+    return WindowsEmitStatus.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowsEmitStatus>, I>>(object: I): WindowsEmitStatus {
+    const message = createBaseWindowsEmitStatus();
+    message.statuses = object.statuses?.map((e) => WindowEmitStatus.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWindowOpen(): WindowOpen {
+  return {
+    timeFrom: undefined,
+    windowTypeName: "",
+    windowTypeVersion: "",
+    origin: "",
+    metadata: undefined,
+    idempotencyKey: "",
+    provisional: false,
+  };
+}
+
+export const WindowOpen: MessageFns<WindowOpen> = {
+  encode(message: WindowOpen, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      writer.uint32(18).string(message.windowTypeName);
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      writer.uint32(26).string(message.windowTypeVersion);
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      writer.uint32(34).string(message.origin);
+    }
+    if (message.metadata !== undefined) {
+      Struct.encode(Struct.wrap(message.metadata), writer.uint32(42).fork()).join();
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      writer.uint32(50).string(message.idempotencyKey);
+    }
+    if (message.provisional !== undefined && message.provisional !== false) {
+      writer.uint32(56).bool(message.provisional);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowOpen {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowOpen();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.windowTypeName = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.windowTypeVersion = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.origin = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.idempotencyKey = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.provisional = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowOpen {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      windowTypeName: isSet(object.windowTypeName) ? globalThis.String(object.windowTypeName) : "",
+      windowTypeVersion: isSet(object.windowTypeVersion) ? globalThis.String(object.windowTypeVersion) : "",
+      origin: isSet(object.origin) ? globalThis.String(object.origin) : "",
+      metadata: isObject(object.metadata) ? object.metadata : undefined,
+      idempotencyKey: isSet(object.idempotencyKey) ? globalThis.String(object.idempotencyKey) : "",
+      provisional: isSet(object.provisional) ? globalThis.Boolean(object.provisional) : false,
+    };
+  },
+
+  toJSON(message: WindowOpen): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      obj.windowTypeName = message.windowTypeName;
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      obj.windowTypeVersion = message.windowTypeVersion;
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      obj.origin = message.origin;
+    }
+    if (message.metadata !== undefined) {
+      obj.metadata = message.metadata;
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      obj.idempotencyKey = message.idempotencyKey;
+    }
+    if (message.provisional !== undefined && message.provisional !== false) {
+      obj.provisional = message.provisional;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowOpen>, I>>(base?: I): WindowOpen {
+    return WindowOpen.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowOpen>, I>>(object: I): WindowOpen {
+    const message = createBaseWindowOpen();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.windowTypeName = object.windowTypeName ?? "";
+    message.windowTypeVersion = object.windowTypeVersion ?? "";
+    message.origin = object.origin ?? "";
+    message.metadata = object.metadata ?? undefined;
+    message.idempotencyKey = object.idempotencyKey ?? "";
+    message.provisional = object.provisional ?? false;
+    return message;
+  },
+};
+
+function createBaseWindowExtension(): WindowExtension {
+  return { windowTypeName: "", windowTypeVersion: "", idempotencyKey: "", timeTo: undefined };
+}
+
+export const WindowExtension: MessageFns<WindowExtension> = {
+  encode(message: WindowExtension, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      writer.uint32(10).string(message.windowTypeName);
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      writer.uint32(18).string(message.windowTypeVersion);
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      writer.uint32(26).string(message.idempotencyKey);
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowExtension {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowExtension();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.windowTypeName = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.windowTypeVersion = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.idempotencyKey = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowExtension {
+    return {
+      windowTypeName: isSet(object.windowTypeName) ? globalThis.String(object.windowTypeName) : "",
+      windowTypeVersion: isSet(object.windowTypeVersion) ? globalThis.String(object.windowTypeVersion) : "",
+      idempotencyKey: isSet(object.idempotencyKey) ? globalThis.String(object.idempotencyKey) : "",
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+    };
+  },
+
+  toJSON(message: WindowExtension): unknown {
+    const obj: any = {};
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      obj.windowTypeName = message.windowTypeName;
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      obj.windowTypeVersion = message.windowTypeVersion;
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      obj.idempotencyKey = message.idempotencyKey;
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowExtension>, I>>(base?: I): WindowExtension {
+    return WindowExtension.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowExtension>, I>>(object: I): WindowExtension {
+    const message = createBaseWindowExtension();
+    message.windowTypeName = object.windowTypeName ?? "";
+    message.windowTypeVersion = object.windowTypeVersion ?? "";
+    message.idempotencyKey = object.idempotencyKey ?? "";
+    message.timeTo = object.timeTo ?? undefined;
+    return message;
+  },
+};
+
+function createBaseWindowClosure(): WindowClosure {
+  return { windowTypeName: "", windowTypeVersion: "", idempotencyKey: "", timeTo: undefined };
+}
+
+export const WindowClosure: MessageFns<WindowClosure> = {
+  encode(message: WindowClosure, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      writer.uint32(10).string(message.windowTypeName);
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      writer.uint32(18).string(message.windowTypeVersion);
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      writer.uint32(26).string(message.idempotencyKey);
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(34).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowClosure {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowClosure();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.windowTypeName = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.windowTypeVersion = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.idempotencyKey = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowClosure {
+    return {
+      windowTypeName: isSet(object.windowTypeName) ? globalThis.String(object.windowTypeName) : "",
+      windowTypeVersion: isSet(object.windowTypeVersion) ? globalThis.String(object.windowTypeVersion) : "",
+      idempotencyKey: isSet(object.idempotencyKey) ? globalThis.String(object.idempotencyKey) : "",
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+    };
+  },
+
+  toJSON(message: WindowClosure): unknown {
+    const obj: any = {};
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      obj.windowTypeName = message.windowTypeName;
+    }
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      obj.windowTypeVersion = message.windowTypeVersion;
+    }
+    if (message.idempotencyKey !== undefined && message.idempotencyKey !== "") {
+      obj.idempotencyKey = message.idempotencyKey;
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowClosure>, I>>(base?: I): WindowClosure {
+    return WindowClosure.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowClosure>, I>>(object: I): WindowClosure {
+    const message = createBaseWindowClosure();
+    message.windowTypeName = object.windowTypeName ?? "";
+    message.windowTypeVersion = object.windowTypeVersion ?? "";
+    message.idempotencyKey = object.idempotencyKey ?? "";
+    message.timeTo = object.timeTo ?? undefined;
+    return message;
+  },
+};
+
+function createBaseWindowAmendment(): WindowAmendment {
+  return { window: undefined, amendedWindow: undefined };
+}
+
+export const WindowAmendment: MessageFns<WindowAmendment> = {
+  encode(message: WindowAmendment, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.window !== undefined) {
+      Window.encode(message.window, writer.uint32(10).fork()).join();
+    }
+    if (message.amendedWindow !== undefined) {
+      Window.encode(message.amendedWindow, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowAmendment {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowAmendment();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.window = Window.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.amendedWindow = Window.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowAmendment {
+    return {
+      window: isSet(object.window) ? Window.fromJSON(object.window) : undefined,
+      amendedWindow: isSet(object.amendedWindow) ? Window.fromJSON(object.amendedWindow) : undefined,
+    };
+  },
+
+  toJSON(message: WindowAmendment): unknown {
+    const obj: any = {};
+    if (message.window !== undefined) {
+      obj.window = Window.toJSON(message.window);
+    }
+    if (message.amendedWindow !== undefined) {
+      obj.amendedWindow = Window.toJSON(message.amendedWindow);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowAmendment>, I>>(base?: I): WindowAmendment {
+    return WindowAmendment.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowAmendment>, I>>(object: I): WindowAmendment {
+    const message = createBaseWindowAmendment();
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Window.fromPartial(object.window)
+      : undefined;
+    message.amendedWindow = (object.amendedWindow !== undefined && object.amendedWindow !== null)
+      ? Window.fromPartial(object.amendedWindow)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseAlgorithmDependency(): AlgorithmDependency {
+  return { name: "", version: "", processorName: "", processorRuntime: "" };
+}
+
+export const AlgorithmDependency: MessageFns<AlgorithmDependency> = {
+  encode(message: AlgorithmDependency, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.version !== undefined && message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      writer.uint32(26).string(message.processorName);
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      writer.uint32(34).string(message.processorRuntime);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmDependency {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithmDependency();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.processorName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.processorRuntime = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlgorithmDependency {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      processorName: isSet(object.processorName) ? globalThis.String(object.processorName) : "",
+      processorRuntime: isSet(object.processorRuntime) ? globalThis.String(object.processorRuntime) : "",
+    };
+  },
+
+  toJSON(message: AlgorithmDependency): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.version !== undefined && message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      obj.processorName = message.processorName;
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      obj.processorRuntime = message.processorRuntime;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlgorithmDependency>, I>>(base?: I): AlgorithmDependency {
+    return AlgorithmDependency.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlgorithmDependency>, I>>(object: I): AlgorithmDependency {
+    const message = createBaseAlgorithmDependency();
+    message.name = object.name ?? "";
+    message.version = object.version ?? "";
+    message.processorName = object.processorName ?? "";
+    message.processorRuntime = object.processorRuntime ?? "";
+    return message;
+  },
+};
+
+function createBaseAlgorithm(): Algorithm {
+  return {
+    name: "",
+    version: "",
+    windowType: undefined,
+    dependencies: [],
+    resultType: 0,
+    retryPolicy: undefined,
+    timeoutMs: "0",
+  };
+}
+
+export const Algorithm: MessageFns<Algorithm> = {
+  encode(message: Algorithm, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.version !== undefined && message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    if (message.windowType !== undefined) {
+      WindowType.encode(message.windowType, writer.uint32(26).fork()).join();
+    }
+    if (message.dependencies !== undefined && message.dependencies.length !== 0) {
+      for (const v of message.dependencies) {
+        AlgorithmDependency.encode(v!, writer.uint32(34).fork()).join();
+      }
+    }
+    if (message.resultType !== undefined && message.resultType !== 0) {
+      writer.uint32(40).int32(message.resultType);
+    }
+    if (message.retryPolicy !== undefined) {
+      RetryPolicy.encode(message.retryPolicy, writer.uint32(50).fork()).join();
+    }
+    if (message.timeoutMs !== undefined && message.timeoutMs !== "0") {
+      writer.uint32(56).int64(message.timeoutMs);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Algorithm {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithm();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.windowType = WindowType.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = AlgorithmDependency.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.dependencies!.push(el);
+          }
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.resultType = reader.int32() as any;
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.retryPolicy = RetryPolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.timeoutMs = reader.int64().toString();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Algorithm {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      windowType: isSet(object.windowType) ? WindowType.fromJSON(object.windowType) : undefined,
+      dependencies: globalThis.Array.isArray(object?.dependencies)
+        ? object.dependencies.map((e: any) => AlgorithmDependency.fromJSON(e))
+        : [],
+      resultType: isSet(object.resultType) ? resultTypeFromJSON(object.resultType) : 0,
+      retryPolicy: isSet(object.retryPolicy) ? RetryPolicy.fromJSON(object.retryPolicy) : undefined,
+      timeoutMs: isSet(object.timeoutMs) ? globalThis.String(object.timeoutMs) : "0",
+    };
+  },
+
+  toJSON(message: Algorithm): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.version !== undefined && message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.windowType !== undefined) {
+      obj.windowType = WindowType.toJSON(message.windowType);
+    }
+    if (message.dependencies?.length) {
+      obj.dependencies = message.dependencies.map((e) => AlgorithmDependency.toJSON(e));
+    }
+    if (message.resultType !== undefined && message.resultType !== 0) {
+      obj.resultType = resultTypeToJSON(message.resultType);
+    }
+    if (message.retryPolicy !== undefined) {
+      obj.retryPolicy = RetryPolicy.toJSON(message.retryPolicy);
+    }
+    if (message.timeoutMs !== undefined && message.timeoutMs !== "0") {
+      obj.timeoutMs = message.timeoutMs;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Algorithm>, I>>(base?: I): Algorithm {
+    return Algorithm.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Algorithm>, I>>(object: I): Algorithm {
+    const message = createBaseAlgorithm();
+    message.name = object.name ?? "";
+    message.version = object.version ?? "";
+    message.windowType = (object.windowType !== undefined && object.windowType !== null)
+      ? WindowType.fromPartial(object.windowType)
+      : undefined;
+    message.dependencies = object.dependencies?.map((e) => AlgorithmDependency.fromPartial(e)) || [];
+    message.resultType = object.resultType ?? 0;
+    message.retryPolicy = (object.retryPolicy !== undefined && object.retryPolicy !== null)
+      ? RetryPolicy.fromPartial(object.retryPolicy)
+      : undefined;
+    message.timeoutMs = object.timeoutMs ?? "0";
+    return message;
+  },
+};
+
+function createBaseFloatArray(): FloatArray {
+  return { values: [] };
+}
+
+export const FloatArray: MessageFns<FloatArray> = {
+  encode(message: FloatArray, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.values !== undefined && message.values.length !== 0) {
+      writer.uint32(10).fork();
+      for (const v of message.values) {
+        writer.float(v);
+      }
+      writer.join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FloatArray {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFloatArray();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag === 13) {
+            message.values!.push(reader.float());
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.values!.push(reader.float());
+            }
+
+            continue;
+          }
+
+          break;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FloatArray {
+    return {
+      values: globalThis.Array.isArray(object?.values) ? object.values.map((e: any) => globalThis.Number(e)) : [],
+    };
+  },
+
+  toJSON(message: FloatArray): unknown {
+    const obj: any = {};
+    if (message.values?.length) {
+      obj.values = message.values;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FloatArray>, I>>(base?: I): FloatArray {
+    return FloatArray.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FloatArray>, I>>(object: I): FloatArray {
+    const message = createBaseFloatArray();
+    message.values = object.values?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseResult(): Result {
+  return { status: 0, resultData: undefined, timestamp: "0", errorMessage: "" };
+}
+
+export const Result: MessageFns<Result> = {
+  encode(message: Result, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(8).int32(message.status);
+    }
+    switch (message.resultData?.$case) {
+      case "singleValue":
+        writer.uint32(21).float(message.resultData.value);
+        break;
+      case "floatValues":
+        FloatArray.encode(message.resultData.value, writer.uint32(26).fork()).join();
+        break;
+      case "structValue":
+        Struct.encode(Struct.wrap(message.resultData.value), writer.uint32(34).fork()).join();
+        break;
+    }
+    if (message.timestamp !== undefined && message.timestamp !== "0") {
+      writer.uint32(40).int64(message.timestamp);
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      writer.uint32(50).string(message.errorMessage);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Result {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 2: {
+          if (tag !== 21) {
+            break;
+          }
+
+          message.resultData = { $case: "singleValue", value: reader.float() };
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.resultData = { $case: "floatValues", value: FloatArray.decode(reader, reader.uint32()) };
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.resultData = { $case: "structValue", value: Struct.unwrap(Struct.decode(reader, reader.uint32())) };
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.timestamp = reader.int64().toString();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.errorMessage = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Result {
+    return {
+      status: isSet(object.status) ? resultStatusFromJSON(object.status) : 0,
+      resultData: isSet(object.singleValue)
+        ? { $case: "singleValue", value: globalThis.Number(object.singleValue) }
+        : isSet(object.floatValues)
+        ? { $case: "floatValues", value: FloatArray.fromJSON(object.floatValues) }
+        : isSet(object.structValue)
+        ? { $case: "structValue", value: object.structValue }
+        : undefined,
+      timestamp: isSet(object.timestamp) ? globalThis.String(object.timestamp) : "0",
+      errorMessage: isSet(object.errorMessage) ? globalThis.String(object.errorMessage) : "",
+    };
+  },
+
+  toJSON(message: Result): unknown {
+    const obj: any = {};
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = resultStatusToJSON(message.status);
+    }
+    if (message.resultData?.$case === "singleValue") {
+      obj.singleValue = message.resultData.value;
+    } else if (message.resultData?.$case === "floatValues") {
+      obj.floatValues = FloatArray.toJSON(message.resultData.value);
+    } else if (message.resultData?.$case === "structValue") {
+      obj.structValue = message.resultData.value;
+    }
+    if (message.timestamp !== undefined && message.timestamp !== "0") {
+      obj.timestamp = message.timestamp;
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      obj.errorMessage = message.errorMessage;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Result>, I>>(base?: I): Result {
+    return Result.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Result>, I>>(object: I): Result {
+    const message = createBaseResult();
+    message.status = object.status ?? 0;
+    switch (object.resultData?.$case) {
+      case "singleValue": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "singleValue", value: object.resultData.value };
+        }
+        break;
+      }
+      case "floatValues": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "floatValues", value: FloatArray.fromPartial(object.resultData.value) };
+        }
+        break;
+      }
+      case "structValue": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "structValue", value: object.resultData.value };
+        }
+        break;
+      }
+    }
+    message.timestamp = object.timestamp ?? "0";
+    message.errorMessage = object.errorMessage ?? "";
+    return message;
+  },
+};
+
+function createBaseProcessorRegistration(): ProcessorRegistration {
+  return { name: "", runtime: "", connectionStr: "", supportedAlgorithms: [], retryPolicy: undefined, instanceId: "" };
+}
+
+export const ProcessorRegistration: MessageFns<ProcessorRegistration> = {
+  encode(message: ProcessorRegistration, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      writer.uint32(18).string(message.runtime);
+    }
+    if (message.connectionStr !== undefined && message.connectionStr !== "") {
+      writer.uint32(26).string(message.connectionStr);
+    }
+    if (message.supportedAlgorithms !== undefined && message.supportedAlgorithms.length !== 0) {
+      for (const v of message.supportedAlgorithms) {
+        Algorithm.encode(v!, writer.uint32(34).fork()).join();
+      }
+    }
+    if (message.retryPolicy !== undefined) {
+      RetryPolicy.encode(message.retryPolicy, writer.uint32(42).fork()).join();
+    }
+    if (message.instanceId !== undefined && message.instanceId !== "") {
+      writer.uint32(50).string(message.instanceId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ProcessorRegistration {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessorRegistration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.runtime = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.connectionStr = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = Algorithm.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.supportedAlgorithms!.push(el);
+          }
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.retryPolicy = RetryPolicy.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.instanceId = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProcessorRegistration {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      runtime: isSet(object.runtime) ? globalThis.String(object.runtime) : "",
+      connectionStr: isSet(object.connectionStr) ? globalThis.String(object.connectionStr) : "",
+      supportedAlgorithms: globalThis.Array.isArray(object?.supportedAlgorithms)
+        ? object.supportedAlgorithms.map((e: any) => Algorithm.fromJSON(e))
+        : [],
+      retryPolicy: isSet(object.retryPolicy) ? RetryPolicy.fromJSON(object.retryPolicy) : undefined,
+      instanceId: isSet(object.instanceId) ? globalThis.String(object.instanceId) : "",
+    };
+  },
+
+  toJSON(message: ProcessorRegistration): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      obj.runtime = message.runtime;
+    }
+    if (message.connectionStr !== undefined && message.connectionStr !== "") {
+      obj.connectionStr = message.connectionStr;
+    }
+    if (message.supportedAlgorithms?.length) {
+      obj.supportedAlgorithms = message.supportedAlgorithms.map((e) => Algorithm.toJSON(e));
+    }
+    if (message.retryPolicy !== undefined) {
+      obj.retryPolicy = RetryPolicy.toJSON(message.retryPolicy);
+    }
+    if (message.instanceId !== undefined && message.instanceId !== "") {
+      obj.instanceId = message.instanceId;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProcessorRegistration>, I>>(base?: I): ProcessorRegistration {
+    return ProcessorRegistration.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ProcessorRegistration>, I>>(object: I): ProcessorRegistration {
+    const message = createBaseProcessorRegistration();
+    message.name = object.name ?? "";
+    message.runtime = object.runtime ?? "";
+    message.connectionStr = object.connectionStr ?? "";
+    message.supportedAlgorithms = object.supportedAlgorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+    message.retryPolicy = (object.retryPolicy !== undefined && object.retryPolicy !== null)
+      ? RetryPolicy.fromPartial(object.retryPolicy)
+      : undefined;
+    message.instanceId = object.instanceId ?? "";
+    return message;
+  },
+};
+
+function createBaseProcessorDeregistration(): ProcessorDeregistration {
+  return { name: "", runtime: "", force: false };
+}
+
+export const ProcessorDeregistration: MessageFns<ProcessorDeregistration> = {
+  encode(message: ProcessorDeregistration, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      writer.uint32(18).string(message.runtime);
+    }
+    if (message.force !== undefined && message.force !== false) {
+      writer.uint32(24).bool(message.force);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ProcessorDeregistration {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessorDeregistration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.runtime = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.force = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProcessorDeregistration {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      runtime: isSet(object.runtime) ? globalThis.String(object.runtime) : "",
+      force: isSet(object.force) ? globalThis.Boolean(object.force) : false,
+    };
+  },
+
+  toJSON(message: ProcessorDeregistration): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      obj.runtime = message.runtime;
+    }
+    if (message.force !== undefined && message.force !== false) {
+      obj.force = message.force;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProcessorDeregistration>, I>>(base?: I): ProcessorDeregistration {
+    return ProcessorDeregistration.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ProcessorDeregistration>, I>>(object: I): ProcessorDeregistration {
+    const message = createBaseProcessorDeregistration();
+    message.name = object.name ?? "";
+    message.runtime = object.runtime ?? "";
+    message.force = object.force ?? false;
+    return message;
+  },
+};
+
+function createBaseAlgorithmRetirement(): AlgorithmRetirement {
+  return { name: "", version: "", force: false };
+}
+
+export const AlgorithmRetirement: MessageFns<AlgorithmRetirement> = {
+  encode(message: AlgorithmRetirement, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.version !== undefined && message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    if (message.force !== undefined && message.force !== false) {
+      writer.uint32(24).bool(message.force);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmRetirement {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithmRetirement();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.force = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlgorithmRetirement {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      force: isSet(object.force) ? globalThis.Boolean(object.force) : false,
+    };
+  },
+
+  toJSON(message: AlgorithmRetirement): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.version !== undefined && message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.force !== undefined && message.force !== false) {
+      obj.force = message.force;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlgorithmRetirement>, I>>(base?: I): AlgorithmRetirement {
+    return AlgorithmRetirement.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlgorithmRetirement>, I>>(object: I): AlgorithmRetirement {
+    const message = createBaseAlgorithmRetirement();
+    message.name = object.name ?? "";
+    message.version = object.version ?? "";
+    message.force = object.force ?? false;
+    return message;
+  },
+};
+
+function createBaseAlgorithmDependencyRemoval(): AlgorithmDependencyRemoval {
+  return { algorithmName: "", algorithmVersion: "", dependencyName: "", dependencyVersion: "", force: false };
+}
+
+export const AlgorithmDependencyRemoval: MessageFns<AlgorithmDependencyRemoval> = {
+  encode(message: AlgorithmDependencyRemoval, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.algorithmName !== undefined && message.algorithmName !== "") {
+      writer.uint32(10).string(message.algorithmName);
+    }
+    if (message.algorithmVersion !== undefined && message.algorithmVersion !== "") {
+      writer.uint32(18).string(message.algorithmVersion);
+    }
+    if (message.dependencyName !== undefined && message.dependencyName !== "") {
+      writer.uint32(26).string(message.dependencyName);
+    }
+    if (message.dependencyVersion !== undefined && message.dependencyVersion !== "") {
+      writer.uint32(34).string(message.dependencyVersion);
+    }
+    if (message.force !== undefined && message.force !== false) {
+      writer.uint32(40).bool(message.force);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmDependencyRemoval {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithmDependencyRemoval();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.algorithmName = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.algorithmVersion = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.dependencyName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.dependencyVersion = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.force = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlgorithmDependencyRemoval {
+    return {
+      algorithmName: isSet(object.algorithmName) ? globalThis.String(object.algorithmName) : "",
+      algorithmVersion: isSet(object.algorithmVersion) ? globalThis.String(object.algorithmVersion) : "",
+      dependencyName: isSet(object.dependencyName) ? globalThis.String(object.dependencyName) : "",
+      dependencyVersion: isSet(object.dependencyVersion) ? globalThis.String(object.dependencyVersion) : "",
+      force: isSet(object.force) ? globalThis.Boolean(object.force) : false,
+    };
+  },
+
+  toJSON(message: AlgorithmDependencyRemoval): unknown {
+    const obj: any = {};
+    if (message.algorithmName !== undefined && message.algorithmName !== "") {
+      obj.algorithmName = message.algorithmName;
+    }
+    if (message.algorithmVersion !== undefined && message.algorithmVersion !== "") {
+      obj.algorithmVersion = message.algorithmVersion;
+    }
+    if (message.dependencyName !== undefined && message.dependencyName !== "") {
+      obj.dependencyName = message.dependencyName;
+    }
+    if (message.dependencyVersion !== undefined && message.dependencyVersion !== "") {
+      obj.dependencyVersion = message.dependencyVersion;
+    }
+    if (message.force !== undefined && message.force !== false) {
+      obj.force = message.force;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlgorithmDependencyRemoval>, I>>(base?: I): AlgorithmDependencyRemoval {
+    return AlgorithmDependencyRemoval.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlgorithmDependencyRemoval>, I>>(object: I): AlgorithmDependencyRemoval {
+    const message = createBaseAlgorithmDependencyRemoval();
+    message.algorithmName = object.algorithmName ?? "";
+    message.algorithmVersion = object.algorithmVersion ?? "";
+    message.dependencyName = object.dependencyName ?? "";
+    message.dependencyVersion = object.dependencyVersion ?? "";
+    message.force = object.force ?? false;
+    return message;
+  },
+};
+
+function createBaseRetryPolicy(): RetryPolicy {
+  return {
+    maxAttempts: 0,
+    initialBackoffMs: "0",
+    maxBackoffMs: "0",
+    backoffMultiplier: 0,
+    jitter: 0,
+    retryableCodes: [],
+  };
+}
+
+export const RetryPolicy: MessageFns<RetryPolicy> = {
+  encode(message: RetryPolicy, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.maxAttempts !== undefined && message.maxAttempts !== 0) {
+      writer.uint32(8).int32(message.maxAttempts);
+    }
+    if (message.initialBackoffMs !== undefined && message.initialBackoffMs !== "0") {
+      writer.uint32(16).int64(message.initialBackoffMs);
+    }
+    if (message.maxBackoffMs !== undefined && message.maxBackoffMs !== "0") {
+      writer.uint32(24).int64(message.maxBackoffMs);
+    }
+    if (message.backoffMultiplier !== undefined && message.backoffMultiplier !== 0) {
+      writer.uint32(33).double(message.backoffMultiplier);
+    }
+    if (message.jitter !== undefined && message.jitter !== 0) {
+      writer.uint32(41).double(message.jitter);
+    }
+    if (message.retryableCodes !== undefined && message.retryableCodes.length !== 0) {
+      for (const v of message.retryableCodes) {
+        writer.uint32(50).string(v!);
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RetryPolicy {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRetryPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maxAttempts = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.initialBackoffMs = reader.int64().toString();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxBackoffMs = reader.int64().toString();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.backoffMultiplier = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.jitter = reader.double();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          const el = reader.string();
+          if (el !== undefined) {
+            message.retryableCodes!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RetryPolicy {
+    return {
+      maxAttempts: isSet(object.maxAttempts) ? globalThis.Number(object.maxAttempts) : 0,
+      initialBackoffMs: isSet(object.initialBackoffMs) ? globalThis.String(object.initialBackoffMs) : "0",
+      maxBackoffMs: isSet(object.maxBackoffMs) ? globalThis.String(object.maxBackoffMs) : "0",
+      backoffMultiplier: isSet(object.backoffMultiplier) ? globalThis.Number(object.backoffMultiplier) : 0,
+      jitter: isSet(object.jitter) ? globalThis.Number(object.jitter) : 0,
+      retryableCodes: globalThis.Array.isArray(object?.retryableCodes)
+        ? object.retryableCodes.map((e: any) => globalThis.String(e))
+        : [],
+    };
+  },
+
+  toJSON(message: RetryPolicy): unknown {
+    const obj: any = {};
+    if (message.maxAttempts !== undefined && message.maxAttempts !== 0) {
+      obj.maxAttempts = Math.round(message.maxAttempts);
+    }
+    if (message.initialBackoffMs !== undefined && message.initialBackoffMs !== "0") {
+      obj.initialBackoffMs = message.initialBackoffMs;
+    }
+    if (message.maxBackoffMs !== undefined && message.maxBackoffMs !== "0") {
+      obj.maxBackoffMs = message.maxBackoffMs;
+    }
+    if (message.backoffMultiplier !== undefined && message.backoffMultiplier !== 0) {
+      obj.backoffMultiplier = message.backoffMultiplier;
+    }
+    if (message.jitter !== undefined && message.jitter !== 0) {
+      obj.jitter = message.jitter;
+    }
+    if (message.retryableCodes?.length) {
+      obj.retryableCodes = message.retryableCodes;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RetryPolicy>, I>>(base?: I): RetryPolicy {
+    return RetryPolicy.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RetryPolicy>, I>>(object: I): RetryPolicy {
+    const message = createBaseRetryPolicy();
+    message.maxAttempts = object.maxAttempts ?? 0;
+    message.initialBackoffMs = object.initialBackoffMs ?? "0";
+    message.maxBackoffMs = object.maxBackoffMs ?? "0";
+    message.backoffMultiplier = object.backoffMultiplier ?? 0;
+    message.jitter = object.jitter ?? 0;
+    message.retryableCodes = object.retryableCodes?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseProcessingTask(): ProcessingTask {
+  return { taskId: "", algorithm: undefined, window: undefined, dependencyResults: [], execId: "" };
+}
+
+export const ProcessingTask: MessageFns<ProcessingTask> = {
+  encode(message: ProcessingTask, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.taskId !== undefined && message.taskId !== "") {
+      writer.uint32(10).string(message.taskId);
+    }
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(18).fork()).join();
+    }
+    if (message.window !== undefined) {
+      Window.encode(message.window, writer.uint32(26).fork()).join();
+    }
+    if (message.dependencyResults !== undefined && message.dependencyResults.length !== 0) {
+      for (const v of message.dependencyResults) {
+        AlgorithmResult.encode(v!, writer.uint32(34).fork()).join();
+      }
+    }
+    if (message.execId !== undefined && message.execId !== "") {
+      writer.uint32(42).string(message.execId);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ProcessingTask {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessingTask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.taskId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.window = Window.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = AlgorithmResult.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.dependencyResults!.push(el);
+          }
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.execId = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProcessingTask {
+    return {
+      taskId: isSet(object.taskId) ? globalThis.String(object.taskId) : "",
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      window: isSet(object.window) ? Window.fromJSON(object.window) : undefined,
+      dependencyResults: globalThis.Array.isArray(object?.dependencyResults)
+        ? object.dependencyResults.map((e: any) => AlgorithmResult.fromJSON(e))
+        : [],
+      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
+    };
+  },
+
+  toJSON(message: ProcessingTask): unknown {
+    const obj: any = {};
+    if (message.taskId !== undefined && message.taskId !== "") {
+      obj.taskId = message.taskId;
+    }
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.window !== undefined) {
+      obj.window = Window.toJSON(message.window);
+    }
+    if (message.dependencyResults?.length) {
+      obj.dependencyResults = message.dependencyResults.map((e) => AlgorithmResult.toJSON(e));
+    }
+    if (message.execId !== undefined && message.execId !== "") {
+      obj.execId = message.execId;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProcessingTask>, I>>(base?: I): ProcessingTask {
+    return ProcessingTask.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ProcessingTask>, I>>(object: I): ProcessingTask {
+    const message = createBaseProcessingTask();
+    message.taskId = object.taskId ?? "";
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Window.fromPartial(object.window)
+      : undefined;
+    message.dependencyResults = object.dependencyResults?.map((e) => AlgorithmResult.fromPartial(e)) || [];
+    message.execId = object.execId ?? "";
+    return message;
+  },
+};
+
+function createBaseTaskSubscription(): TaskSubscription {
+  return { name: "", runtime: "" };
+}
+
+export const TaskSubscription: MessageFns<TaskSubscription> = {
+  encode(message: TaskSubscription, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      writer.uint32(18).string(message.runtime);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TaskSubscription {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskSubscription();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.runtime = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskSubscription {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      runtime: isSet(object.runtime) ? globalThis.String(object.runtime) : "",
+    };
+  },
+
+  toJSON(message: TaskSubscription): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      obj.runtime = message.runtime;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TaskSubscription>, I>>(base?: I): TaskSubscription {
+    return TaskSubscription.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TaskSubscription>, I>>(object: I): TaskSubscription {
+    const message = createBaseTaskSubscription();
+    message.name = object.name ?? "";
+    message.runtime = object.runtime ?? "";
+    return message;
+  },
+};
+
+function createBaseTaskResult(): TaskResult {
+  return { taskId: "", result: undefined };
+}
+
+export const TaskResult: MessageFns<TaskResult> = {
+  encode(message: TaskResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.taskId !== undefined && message.taskId !== "") {
+      writer.uint32(10).string(message.taskId);
+    }
+    if (message.result !== undefined) {
+      Result.encode(message.result, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): TaskResult {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.taskId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.result = Result.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskResult {
+    return {
+      taskId: isSet(object.taskId) ? globalThis.String(object.taskId) : "",
+      result: isSet(object.result) ? Result.fromJSON(object.result) : undefined,
+    };
+  },
+
+  toJSON(message: TaskResult): unknown {
+    const obj: any = {};
+    if (message.taskId !== undefined && message.taskId !== "") {
+      obj.taskId = message.taskId;
+    }
+    if (message.result !== undefined) {
+      obj.result = Result.toJSON(message.result);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TaskResult>, I>>(base?: I): TaskResult {
+    return TaskResult.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<TaskResult>, I>>(object: I): TaskResult {
+    const message = createBaseTaskResult();
+    message.taskId = object.taskId ?? "";
+    message.result = (object.result !== undefined && object.result !== null)
+      ? Result.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseExecutionRequest(): ExecutionRequest {
+  return { execId: "", window: undefined, algorithmResults: [], algorithms: [] };
+}
+
+export const ExecutionRequest: MessageFns<ExecutionRequest> = {
+  encode(message: ExecutionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.execId !== undefined && message.execId !== "") {
+      writer.uint32(10).string(message.execId);
+    }
+    if (message.window !== undefined) {
+      Window.encode(message.window, writer.uint32(18).fork()).join();
+    }
+    if (message.algorithmResults !== undefined && message.algorithmResults.length !== 0) {
+      for (const v of message.algorithmResults) {
+        AlgorithmResult.encode(v!, writer.uint32(26).fork()).join();
+      }
+    }
+    if (message.algorithms !== undefined && message.algorithms.length !== 0) {
+      for (const v of message.algorithms) {
+        Algorithm.encode(v!, writer.uint32(34).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExecutionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.execId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.window = Window.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          const el = AlgorithmResult.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.algorithmResults!.push(el);
+          }
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = Algorithm.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.algorithms!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExecutionRequest {
+    return {
+      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
+      window: isSet(object.window) ? Window.fromJSON(object.window) : undefined,
+      algorithmResults: globalThis.Array.isArray(object?.algorithmResults)
+        ? object.algorithmResults.map((e: any) => AlgorithmResult.fromJSON(e))
+        : [],
+      algorithms: globalThis.Array.isArray(object?.algorithms)
+        ? object.algorithms.map((e: any) => Algorithm.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ExecutionRequest): unknown {
+    const obj: any = {};
+    if (message.execId !== undefined && message.execId !== "") {
+      obj.execId = message.execId;
+    }
+    if (message.window !== undefined) {
+      obj.window = Window.toJSON(message.window);
+    }
+    if (message.algorithmResults?.length) {
+      obj.algorithmResults = message.algorithmResults.map((e) => AlgorithmResult.toJSON(e));
+    }
+    if (message.algorithms?.length) {
+      obj.algorithms = message.algorithms.map((e) => Algorithm.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ExecutionRequest>, I>>(base?: I): ExecutionRequest {
+    return ExecutionRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ExecutionRequest>, I>>(object: I): ExecutionRequest {
+    const message = createBaseExecutionRequest();
+    message.execId = object.execId ?? "";
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Window.fromPartial(object.window)
+      : undefined;
+    message.algorithmResults = object.algorithmResults?.map((e) => AlgorithmResult.fromPartial(e)) || [];
+    message.algorithms = object.algorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseExecutionResult(): ExecutionResult {
+  return { execId: "", algorithmResult: undefined };
+}
+
+export const ExecutionResult: MessageFns<ExecutionResult> = {
+  encode(message: ExecutionResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.execId !== undefined && message.execId !== "") {
+      writer.uint32(10).string(message.execId);
+    }
+    if (message.algorithmResult !== undefined) {
+      AlgorithmResult.encode(message.algorithmResult, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionResult {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExecutionResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.execId = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.algorithmResult = AlgorithmResult.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExecutionResult {
+    return {
+      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
+      algorithmResult: isSet(object.algorithmResult) ? AlgorithmResult.fromJSON(object.algorithmResult) : undefined,
+    };
+  },
+
+  toJSON(message: ExecutionResult): unknown {
+    const obj: any = {};
+    if (message.execId !== undefined && message.execId !== "") {
+      obj.execId = message.execId;
+    }
+    if (message.algorithmResult !== undefined) {
+      obj.algorithmResult = AlgorithmResult.toJSON(message.algorithmResult);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ExecutionResult>, I>>(base?: I): ExecutionResult {
+    return ExecutionResult.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ExecutionResult>, I>>(object: I): ExecutionResult {
+    const message = createBaseExecutionResult();
+    message.execId = object.execId ?? "";
+    message.algorithmResult = (object.algorithmResult !== undefined && object.algorithmResult !== null)
+      ? AlgorithmResult.fromPartial(object.algorithmResult)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseAlgorithmResult(): AlgorithmResult {
+  return { algorithm: undefined, result: undefined };
+}
+
+export const AlgorithmResult: MessageFns<AlgorithmResult> = {
+  encode(message: AlgorithmResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(10).fork()).join();
+    }
+    if (message.result !== undefined) {
+      Result.encode(message.result, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmResult {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithmResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.result = Result.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlgorithmResult {
+    return {
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      result: isSet(object.result) ? Result.fromJSON(object.result) : undefined,
+    };
+  },
+
+  toJSON(message: AlgorithmResult): unknown {
+    const obj: any = {};
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.result !== undefined) {
+      obj.result = Result.toJSON(message.result);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlgorithmResult>, I>>(base?: I): AlgorithmResult {
+    return AlgorithmResult.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlgorithmResult>, I>>(object: I): AlgorithmResult {
+    const message = createBaseAlgorithmResult();
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.result = (object.result !== undefined && object.result !== null)
+      ? Result.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseStatus(): Status {
+  return { received: false, message: "" };
+}
+
+export const Status: MessageFns<Status> = {
+  encode(message: Status, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.received !== undefined && message.received !== false) {
+      writer.uint32(8).bool(message.received);
+    }
+    if (message.message !== undefined && message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Status {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatus();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.received = reader.bool();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Status {
+    return {
+      received: isSet(object.received) ? globalThis.Boolean(object.received) : false,
+      message: isSet(object.message) ? globalThis.String(object.message) : "",
+    };
+  },
+
+  toJSON(message: Status): unknown {
+    const obj: any = {};
+    if (message.received !== undefined && message.received !== false) {
+      obj.received = message.received;
+    }
+    if (message.message !== undefined && message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Status>, I>>(base?: I): Status {
+    return Status.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Status>, I>>(object: I): Status {
+    const message = createBaseStatus();
+    message.received = object.received ?? false;
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseHealthCheckRequest(): HealthCheckRequest {
+  return { timestamp: "0" };
+}
+
+export const HealthCheckRequest: MessageFns<HealthCheckRequest> = {
+  encode(message: HealthCheckRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timestamp !== undefined && message.timestamp !== "0") {
+      writer.uint32(8).int64(message.timestamp);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): HealthCheckRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHealthCheckRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.timestamp = reader.int64().toString();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HealthCheckRequest {
+    return { timestamp: isSet(object.timestamp) ? globalThis.String(object.timestamp) : "0" };
+  },
+
+  toJSON(message: HealthCheckRequest): unknown {
+    const obj: any = {};
+    if (message.timestamp !== undefined && message.timestamp !== "0") {
+      obj.timestamp = message.timestamp;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HealthCheckRequest>, I>>(base?: I): HealthCheckRequest {
+    return HealthCheckRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<HealthCheckRequest>, I>>(object: I): HealthCheckRequest {
+    const message = createBaseHealthCheckRequest();
+    message.timestamp = object.timestamp ?? "0";
+    return message;
+  },
+};
+
+function createBaseHealthCheckResponse(): HealthCheckResponse {
+  return { status: 0, message: "", metrics: undefined };
+}
+
+export const HealthCheckResponse: MessageFns<HealthCheckResponse> = {
+  encode(message: HealthCheckResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(8).int32(message.status);
+    }
+    if (message.message !== undefined && message.message !== "") {
+      writer.uint32(18).string(message.message);
+    }
+    if (message.metrics !== undefined) {
+      ProcessorMetrics.encode(message.metrics, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): HealthCheckResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseHealthCheckResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.metrics = ProcessorMetrics.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): HealthCheckResponse {
+    return {
+      status: isSet(object.status) ? healthCheckResponse_StatusFromJSON(object.status) : 0,
+      message: isSet(object.message) ? globalThis.String(object.message) : "",
+      metrics: isSet(object.metrics) ? ProcessorMetrics.fromJSON(object.metrics) : undefined,
+    };
+  },
+
+  toJSON(message: HealthCheckResponse): unknown {
+    const obj: any = {};
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = healthCheckResponse_StatusToJSON(message.status);
+    }
+    if (message.message !== undefined && message.message !== "") {
+      obj.message = message.message;
+    }
+    if (message.metrics !== undefined) {
+      obj.metrics = ProcessorMetrics.toJSON(message.metrics);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<HealthCheckResponse>, I>>(base?: I): HealthCheckResponse {
+    return HealthCheckResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<HealthCheckResponse>, I>>(object: I): HealthCheckResponse {
+    const message = createBaseHealthCheckResponse();
+    message.status = object.status ?? 0;
+    message.message = object.message ?? "";
+    message.metrics = (object.metrics !== undefined && object.metrics !== null)
+      ? ProcessorMetrics.fromPartial(object.metrics)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProcessorMetrics(): ProcessorMetrics {
+  return { activeTasks: 0, memoryBytes: "0", cpuPercent: 0, uptimeSeconds: "0" };
+}
+
+export const ProcessorMetrics: MessageFns<ProcessorMetrics> = {
+  encode(message: ProcessorMetrics, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.activeTasks !== undefined && message.activeTasks !== 0) {
+      writer.uint32(8).int32(message.activeTasks);
+    }
+    if (message.memoryBytes !== undefined && message.memoryBytes !== "0") {
+      writer.uint32(16).int64(message.memoryBytes);
+    }
+    if (message.cpuPercent !== undefined && message.cpuPercent !== 0) {
+      writer.uint32(29).float(message.cpuPercent);
+    }
+    if (message.uptimeSeconds !== undefined && message.uptimeSeconds !== "0") {
+      writer.uint32(32).int64(message.uptimeSeconds);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ProcessorMetrics {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessorMetrics();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.activeTasks = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.memoryBytes = reader.int64().toString();
+          continue;
+        }
+        case 3: {
+          if (tag !== 29) {
+            break;
+          }
+
+          message.cpuPercent = reader.float();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.uptimeSeconds = reader.int64().toString();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProcessorMetrics {
+    return {
+      activeTasks: isSet(object.activeTasks) ? globalThis.Number(object.activeTasks) : 0,
+      memoryBytes: isSet(object.memoryBytes) ? globalThis.String(object.memoryBytes) : "0",
+      cpuPercent: isSet(object.cpuPercent) ? globalThis.Number(object.cpuPercent) : 0,
+      uptimeSeconds: isSet(object.uptimeSeconds) ? globalThis.String(object.uptimeSeconds) : "0",
+    };
+  },
+
+  toJSON(message: ProcessorMetrics): unknown {
+    const obj: any = {};
+    if (message.activeTasks !== undefined && message.activeTasks !== 0) {
+      obj.activeTasks = Math.round(message.activeTasks);
+    }
+    if (message.memoryBytes !== undefined && message.memoryBytes !== "0") {
+      obj.memoryBytes = message.memoryBytes;
+    }
+    if (message.cpuPercent !== undefined && message.cpuPercent !== 0) {
+      obj.cpuPercent = message.cpuPercent;
+    }
+    if (message.uptimeSeconds !== undefined && message.uptimeSeconds !== "0") {
+      obj.uptimeSeconds = message.uptimeSeconds;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProcessorMetrics>, I>>(base?: I): ProcessorMetrics {
+    return ProcessorMetrics.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ProcessorMetrics>, I>>(object: I): ProcessorMetrics {
+    const message = createBaseProcessorMetrics();
+    message.activeTasks = object.activeTasks ?? 0;
+    message.memoryBytes = object.memoryBytes ?? "0";
+    message.cpuPercent = object.cpuPercent ?? 0;
+    message.uptimeSeconds = object.uptimeSeconds ?? "0";
+    return message;
+  },
+};
+
+function createBaseWindowTypeRead(): WindowTypeRead {
+  return {};
+}
+
+export const WindowTypeRead: MessageFns<WindowTypeRead> = {
+  encode(_: WindowTypeRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowTypeRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowTypeRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): WindowTypeRead {
+    return {};
+  },
+
+  toJSON(_: WindowTypeRead): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowTypeRead>, I>>(base?: I): WindowTypeRead {
+    return WindowTypeRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowTypeRead>, I>>(_: I): WindowTypeRead {
+    const message = createBaseWindowTypeRead();
+    return message;
+  },
+};
+
+function createBaseWindowTypes(): WindowTypes {
+  return { windows: [] };
+}
+
+export const WindowTypes: MessageFns<WindowTypes> = {
+  encode(message: WindowTypes, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.windows !== undefined && message.windows.length !== 0) {
+      for (const v of message.windows) {
+        WindowType.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowTypes {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowTypes();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = WindowType.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.windows!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowTypes {
+    return {
+      windows: globalThis.Array.isArray(object?.windows) ? object.windows.map((e: any) => WindowType.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: WindowTypes): unknown {
+    const obj: any = {};
+    if (message.windows?.length) {
+      obj.windows = message.windows.map((e) => WindowType.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowTypes>, I>>(base?: I): WindowTypes {
+    return WindowTypes.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowTypes>, I>>(object: I): WindowTypes {
+    const message = createBaseWindowTypes();
+    message.windows = object.windows?.map((e) => WindowType.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseAlgorithmsRead(): AlgorithmsRead {
+  return {};
+}
+
+export const AlgorithmsRead: MessageFns<AlgorithmsRead> = {
+  encode(_: AlgorithmsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmsRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithmsRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): AlgorithmsRead {
+    return {};
+  },
+
+  toJSON(_: AlgorithmsRead): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlgorithmsRead>, I>>(base?: I): AlgorithmsRead {
+    return AlgorithmsRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlgorithmsRead>, I>>(_: I): AlgorithmsRead {
+    const message = createBaseAlgorithmsRead();
+    return message;
+  },
+};
+
+function createBaseAlgorithms(): Algorithms {
+  return { algorithm: [] };
+}
+
+export const Algorithms: MessageFns<Algorithms> = {
+  encode(message: Algorithms, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.algorithm !== undefined && message.algorithm.length !== 0) {
+      for (const v of message.algorithm) {
+        Algorithm.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Algorithms {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithms();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = Algorithm.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.algorithm!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Algorithms {
+    return {
+      algorithm: globalThis.Array.isArray(object?.algorithm)
+        ? object.algorithm.map((e: any) => Algorithm.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: Algorithms): unknown {
+    const obj: any = {};
+    if (message.algorithm?.length) {
+      obj.algorithm = message.algorithm.map((e) => Algorithm.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Algorithms>, I>>(base?: I): Algorithms {
+    return Algorithms.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Algorithms>, I>>(object: I): Algorithms {
+    const message = createBaseAlgorithms();
+    message.algorithm = object.algorithm?.map((e) => Algorithm.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseProcessorsRead(): ProcessorsRead {
+  return {};
+}
+
+export const ProcessorsRead: MessageFns<ProcessorsRead> = {
+  encode(_: ProcessorsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ProcessorsRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessorsRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ProcessorsRead {
+    return {};
+  },
+
+  toJSON(_: ProcessorsRead): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProcessorsRead>, I>>(base?: I): ProcessorsRead {
+    return ProcessorsRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ProcessorsRead>, I>>(_: I): ProcessorsRead {
+    const message = createBaseProcessorsRead();
+    return message;
+  },
+};
+
+function createBaseProcessors(): Processors {
+  return { processor: [] };
+}
+
+export const Processors: MessageFns<Processors> = {
+  encode(message: Processors, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.processor !== undefined && message.processor.length !== 0) {
+      for (const v of message.processor) {
+        Processors_Processor.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Processors {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessors();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = Processors_Processor.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.processor!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Processors {
+    return {
+      processor: globalThis.Array.isArray(object?.processor)
+        ? object.processor.map((e: any) => Processors_Processor.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: Processors): unknown {
+    const obj: any = {};
+    if (message.processor?.length) {
+      obj.processor = message.processor.map((e) => Processors_Processor.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Processors>, I>>(base?: I): Processors {
+    return Processors.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Processors>, I>>(object: I): Processors {
+    const message = createBaseProcessors();
+    message.processor = object.processor?.map((e) => Processors_Processor.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseProcessors_Processor(): Processors_Processor {
+  return {
+    name: "",
+    runtime: "",
+    connectionState: 0,
+    instances: [],
+    lastSeen: undefined,
+    status: 0,
+    statusMessage: "",
+    metrics: undefined,
+    available: false,
+  };
+}
+
+export const Processors_Processor: MessageFns<Processors_Processor> = {
+  encode(message: Processors_Processor, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      writer.uint32(18).string(message.runtime);
+    }
+    if (message.connectionState !== undefined && message.connectionState !== 0) {
+      writer.uint32(24).int32(message.connectionState);
+    }
+    if (message.instances !== undefined && message.instances.length !== 0) {
+      for (const v of message.instances) {
+        Processors_Instance.encode(v!, writer.uint32(34).fork()).join();
+      }
+    }
+    if (message.lastSeen !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastSeen), writer.uint32(42).fork()).join();
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(48).int32(message.status);
+    }
+    if (message.statusMessage !== undefined && message.statusMessage !== "") {
+      writer.uint32(58).string(message.statusMessage);
+    }
+    if (message.metrics !== undefined) {
+      ProcessorMetrics.encode(message.metrics, writer.uint32(66).fork()).join();
+    }
+    if (message.available !== undefined && message.available !== false) {
+      writer.uint32(72).bool(message.available);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Processors_Processor {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessors_Processor();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.runtime = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.connectionState = reader.int32() as any;
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = Processors_Instance.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.instances!.push(el);
+          }
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.lastSeen = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.statusMessage = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.metrics = ProcessorMetrics.decode(reader, reader.uint32());
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.available = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Processors_Processor {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      runtime: isSet(object.runtime) ? globalThis.String(object.runtime) : "",
+      connectionState: isSet(object.connectionState) ? connectionStateFromJSON(object.connectionState) : 0,
+      instances: globalThis.Array.isArray(object?.instances)
+        ? object.instances.map((e: any) => Processors_Instance.fromJSON(e))
+        : [],
+      lastSeen: isSet(object.lastSeen) ? fromJsonTimestamp(object.lastSeen) : undefined,
+      status: isSet(object.status) ? healthCheckResponse_StatusFromJSON(object.status) : 0,
+      statusMessage: isSet(object.statusMessage) ? globalThis.String(object.statusMessage) : "",
+      metrics: isSet(object.metrics) ? ProcessorMetrics.fromJSON(object.metrics) : undefined,
+      available: isSet(object.available) ? globalThis.Boolean(object.available) : false,
+    };
+  },
+
+  toJSON(message: Processors_Processor): unknown {
+    const obj: any = {};
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.runtime !== undefined && message.runtime !== "") {
+      obj.runtime = message.runtime;
+    }
+    if (message.connectionState !== undefined && message.connectionState !== 0) {
+      obj.connectionState = connectionStateToJSON(message.connectionState);
+    }
+    if (message.instances?.length) {
+      obj.instances = message.instances.map((e) => Processors_Instance.toJSON(e));
+    }
+    if (message.lastSeen !== undefined) {
+      obj.lastSeen = message.lastSeen.toISOString();
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = healthCheckResponse_StatusToJSON(message.status);
+    }
+    if (message.statusMessage !== undefined && message.statusMessage !== "") {
+      obj.statusMessage = message.statusMessage;
+    }
+    if (message.metrics !== undefined) {
+      obj.metrics = ProcessorMetrics.toJSON(message.metrics);
+    }
+    if (message.available !== undefined && message.available !== false) {
+      obj.available = message.available;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Processors_Processor>, I>>(base?: I): Processors_Processor {
+    return Processors_Processor.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Processors_Processor>, I>>(object: I): Processors_Processor {
+    const message = createBaseProcessors_Processor();
+    message.name = object.name ?? "";
+    message.runtime = object.runtime ?? "";
+    message.connectionState = object.connectionState ?? 0;
+    message.instances = object.instances?.map((e) => Processors_Instance.fromPartial(e)) || [];
+    message.lastSeen = object.lastSeen ?? undefined;
+    message.status = object.status ?? 0;
+    message.statusMessage = object.statusMessage ?? "";
+    message.metrics = (object.metrics !== undefined && object.metrics !== null)
+      ? ProcessorMetrics.fromPartial(object.metrics)
+      : undefined;
+    message.available = object.available ?? false;
+    return message;
+  },
+};
+
+function createBaseProcessors_Instance(): Processors_Instance {
+  return {
+    connectionStr: "",
+    instanceId: "",
+    connectionState: 0,
+    registered: undefined,
+    lastSeen: undefined,
+    status: 0,
+    statusMessage: "",
+    metrics: undefined,
+    available: false,
+  };
+}
+
+export const Processors_Instance: MessageFns<Processors_Instance> = {
+  encode(message: Processors_Instance, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.connectionStr !== undefined && message.connectionStr !== "") {
+      writer.uint32(10).string(message.connectionStr);
+    }
+    if (message.instanceId !== undefined && message.instanceId !== "") {
+      writer.uint32(74).string(message.instanceId);
+    }
+    if (message.connectionState !== undefined && message.connectionState !== 0) {
+      writer.uint32(16).int32(message.connectionState);
+    }
+    if (message.registered !== undefined) {
+      Timestamp.encode(toTimestamp(message.registered), writer.uint32(26).fork()).join();
+    }
+    if (message.lastSeen !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastSeen), writer.uint32(34).fork()).join();
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(40).int32(message.status);
+    }
+    if (message.statusMessage !== undefined && message.statusMessage !== "") {
+      writer.uint32(50).string(message.statusMessage);
+    }
+    if (message.metrics !== undefined) {
+      ProcessorMetrics.encode(message.metrics, writer.uint32(58).fork()).join();
+    }
+    if (message.available !== undefined && message.available !== false) {
+      writer.uint32(64).bool(message.available);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Processors_Instance {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcessors_Instance();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.connectionStr = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.instanceId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.connectionState = reader.int32() as any;
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.registered = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.lastSeen = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.statusMessage = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.metrics = ProcessorMetrics.decode(reader, reader.uint32());
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.available = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Processors_Instance {
+    return {
+      connectionStr: isSet(object.connectionStr) ? globalThis.String(object.connectionStr) : "",
+      instanceId: isSet(object.instanceId) ? globalThis.String(object.instanceId) : "",
+      connectionState: isSet(object.connectionState) ? connectionStateFromJSON(object.connectionState) : 0,
+      registered: isSet(object.registered) ? fromJsonTimestamp(object.registered) : undefined,
+      lastSeen: isSet(object.lastSeen) ? fromJsonTimestamp(object.lastSeen) : undefined,
+      status: isSet(object.status) ? healthCheckResponse_StatusFromJSON(object.status) : 0,
+      statusMessage: isSet(object.statusMessage) ? globalThis.String(object.statusMessage) : "",
+      metrics: isSet(object.metrics) ? ProcessorMetrics.fromJSON(object.metrics) : undefined,
+      available: isSet(object.available) ? globalThis.Boolean(object.available) : false,
+    };
+  },
+
+  toJSON(message: Processors_Instance): unknown {
+    const obj: any = {};
+    if (message.connectionStr !== undefined && message.connectionStr !== "") {
+      obj.connectionStr = message.connectionStr;
+    }
+    if (message.instanceId !== undefined && message.instanceId !== "") {
+      obj.instanceId = message.instanceId;
+    }
+    if (message.connectionState !== undefined && message.connectionState !== 0) {
+      obj.connectionState = connectionStateToJSON(message.connectionState);
+    }
+    if (message.registered !== undefined) {
+      obj.registered = message.registered.toISOString();
+    }
+    if (message.lastSeen !== undefined) {
+      obj.lastSeen = message.lastSeen.toISOString();
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = healthCheckResponse_StatusToJSON(message.status);
+    }
+    if (message.statusMessage !== undefined && message.statusMessage !== "") {
+      obj.statusMessage = message.statusMessage;
+    }
+    if (message.metrics !== undefined) {
+      obj.metrics = ProcessorMetrics.toJSON(message.metrics);
+    }
+    if (message.available !== undefined && message.available !== false) {
+      obj.available = message.available;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Processors_Instance>, I>>(base?: I): Processors_Instance {
+    return Processors_Instance.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Processors_Instance>, I>>(object: I): Processors_Instance {
+    const message = createBaseProcessors_Instance();
+    message.connectionStr = object.connectionStr ?? "";
+    message.instanceId = object.instanceId ?? "";
+    message.connectionState = object.connectionState ?? 0;
+    message.registered = object.registered ?? undefined;
+    message.lastSeen = object.lastSeen ?? undefined;
+    message.status = object.status ?? 0;
+    message.statusMessage = object.statusMessage ?? "";
+    message.metrics = (object.metrics !== undefined && object.metrics !== null)
+      ? ProcessorMetrics.fromPartial(object.metrics)
+      : undefined;
+    message.available = object.available ?? false;
+    return message;
+  },
+};
+
+function createBaseResultsStatsRead(): ResultsStatsRead {
+  return {};
+}
+
+export const ResultsStatsRead: MessageFns<ResultsStatsRead> = {
+  encode(_: ResultsStatsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsStatsRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResultsStatsRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ResultsStatsRead {
+    return {};
+  },
+
+  toJSON(_: ResultsStatsRead): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResultsStatsRead>, I>>(base?: I): ResultsStatsRead {
+    return ResultsStatsRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResultsStatsRead>, I>>(_: I): ResultsStatsRead {
+    const message = createBaseResultsStatsRead();
+    return message;
+  },
+};
+
+function createBaseResultsStats(): ResultsStats {
+  return { Count: "0" };
+}
+
+export const ResultsStats: MessageFns<ResultsStats> = {
+  encode(message: ResultsStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.Count !== undefined && message.Count !== "0") {
+      writer.uint32(8).int64(message.Count);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsStats {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResultsStats();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.Count = reader.int64().toString();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResultsStats {
+    return { Count: isSet(object.Count) ? globalThis.String(object.Count) : "0" };
+  },
+
+  toJSON(message: ResultsStats): unknown {
+    const obj: any = {};
+    if (message.Count !== undefined && message.Count !== "0") {
+      obj.Count = message.Count;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResultsStats>, I>>(base?: I): ResultsStats {
+    return ResultsStats.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResultsStats>, I>>(object: I): ResultsStats {
+    const message = createBaseResultsStats();
+    message.Count = object.Count ?? "0";
+    return message;
+  },
+};
+
+function createBaseAlgorithmFieldsRead(): AlgorithmFieldsRead {
+  return { timeFrom: undefined, timeTo: undefined, algorithm: undefined };
+}
+
+export const AlgorithmFieldsRead: MessageFns<AlgorithmFieldsRead> = {
+  encode(message: AlgorithmFieldsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmFieldsRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithmFieldsRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlgorithmFieldsRead {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+    };
+  },
+
+  toJSON(message: AlgorithmFieldsRead): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlgorithmFieldsRead>, I>>(base?: I): AlgorithmFieldsRead {
+    return AlgorithmFieldsRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlgorithmFieldsRead>, I>>(object: I): AlgorithmFieldsRead {
+    const message = createBaseAlgorithmFieldsRead();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseAlgorithmFields(): AlgorithmFields {
+  return { field: [] };
+}
+
+export const AlgorithmFields: MessageFns<AlgorithmFields> = {
+  encode(message: AlgorithmFields, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== undefined && message.field.length !== 0) {
+      for (const v of message.field) {
+        writer.uint32(10).string(v!);
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmFields {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlgorithmFields();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = reader.string();
+          if (el !== undefined) {
+            message.field!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlgorithmFields {
+    return { field: globalThis.Array.isArray(object?.field) ? object.field.map((e: any) => globalThis.String(e)) : [] };
+  },
+
+  toJSON(message: AlgorithmFields): unknown {
+    const obj: any = {};
+    if (message.field?.length) {
+      obj.field = message.field;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlgorithmFields>, I>>(base?: I): AlgorithmFields {
+    return AlgorithmFields.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlgorithmFields>, I>>(object: I): AlgorithmFields {
+    const message = createBaseAlgorithmFields();
+    message.field = object.field?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseResultsForAlgorithmRead(): ResultsForAlgorithmRead {
+  return { timeFrom: undefined, timeTo: undefined, algorithm: undefined, includeSuperseded: false };
+}
+
+export const ResultsForAlgorithmRead: MessageFns<ResultsForAlgorithmRead> = {
+  encode(message: ResultsForAlgorithmRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(26).fork()).join();
+    }
+    if (message.includeSuperseded !== undefined && message.includeSuperseded !== false) {
+      writer.uint32(32).bool(message.includeSuperseded);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResultsForAlgorithmRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.includeSuperseded = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResultsForAlgorithmRead {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      includeSuperseded: isSet(object.includeSuperseded) ? globalThis.Boolean(object.includeSuperseded) : false,
+    };
+  },
+
+  toJSON(message: ResultsForAlgorithmRead): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.includeSuperseded !== undefined && message.includeSuperseded !== false) {
+      obj.includeSuperseded = message.includeSuperseded;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResultsForAlgorithmRead>, I>>(base?: I): ResultsForAlgorithmRead {
+    return ResultsForAlgorithmRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmRead>, I>>(object: I): ResultsForAlgorithmRead {
+    const message = createBaseResultsForAlgorithmRead();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.includeSuperseded = object.includeSuperseded ?? false;
+    return message;
+  },
+};
+
+function createBaseResultsForAlgorithm(): ResultsForAlgorithm {
+  return { results: [] };
+}
+
+export const ResultsForAlgorithm: MessageFns<ResultsForAlgorithm> = {
+  encode(message: ResultsForAlgorithm, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.results !== undefined && message.results.length !== 0) {
+      for (const v of message.results) {
+        ResultsForAlgorithm_ResultsRow.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithm {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResultsForAlgorithm();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = ResultsForAlgorithm_ResultsRow.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.results!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResultsForAlgorithm {
+    return {
+      results: globalThis.Array.isArray(object?.results)
+        ? object.results.map((e: any) => ResultsForAlgorithm_ResultsRow.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ResultsForAlgorithm): unknown {
+    const obj: any = {};
+    if (message.results?.length) {
+      obj.results = message.results.map((e) => ResultsForAlgorithm_ResultsRow.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResultsForAlgorithm>, I>>(base?: I): ResultsForAlgorithm {
+    return ResultsForAlgorithm.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithm>, I>>(object: I): ResultsForAlgorithm {
+    const message = createBaseResultsForAlgorithm();
+    message.results = object.results?.map((e) => ResultsForAlgorithm_ResultsRow.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseResultsForAlgorithm_ResultsRow(): ResultsForAlgorithm_ResultsRow {
+  return { time: undefined, resultData: undefined, status: 0, errorMessage: "", superseded: false };
+}
+
+export const ResultsForAlgorithm_ResultsRow: MessageFns<ResultsForAlgorithm_ResultsRow> = {
+  encode(message: ResultsForAlgorithm_ResultsRow, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(10).fork()).join();
+    }
+    switch (message.resultData?.$case) {
+      case "singleValue":
+        writer.uint32(21).float(message.resultData.value);
+        break;
+      case "arrayValues":
+        FloatArray.encode(message.resultData.value, writer.uint32(26).fork()).join();
+        break;
+      case "structValue":
+        Struct.encode(Struct.wrap(message.resultData.value), writer.uint32(34).fork()).join();
+        break;
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(40).int32(message.status);
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      writer.uint32(50).string(message.errorMessage);
+    }
+    if (message.superseded !== undefined && message.superseded !== false) {
+      writer.uint32(56).bool(message.superseded);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithm_ResultsRow {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResultsForAlgorithm_ResultsRow();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 21) {
+            break;
+          }
+
+          message.resultData = { $case: "singleValue", value: reader.float() };
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.resultData = { $case: "arrayValues", value: FloatArray.decode(reader, reader.uint32()) };
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.resultData = { $case: "structValue", value: Struct.unwrap(Struct.decode(reader, reader.uint32())) };
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.errorMessage = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 56) {
+            break;
+          }
+
+          message.superseded = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResultsForAlgorithm_ResultsRow {
+    return {
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+      resultData: isSet(object.singleValue)
+        ? { $case: "singleValue", value: globalThis.Number(object.singleValue) }
+        : isSet(object.arrayValues)
+        ? { $case: "arrayValues", value: FloatArray.fromJSON(object.arrayValues) }
+        : isSet(object.structValue)
+        ? { $case: "structValue", value: object.structValue }
+        : undefined,
+      status: isSet(object.status) ? resultStatusFromJSON(object.status) : 0,
+      errorMessage: isSet(object.errorMessage) ? globalThis.String(object.errorMessage) : "",
+      superseded: isSet(object.superseded) ? globalThis.Boolean(object.superseded) : false,
+    };
+  },
+
+  toJSON(message: ResultsForAlgorithm_ResultsRow): unknown {
+    const obj: any = {};
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
+    }
+    if (message.resultData?.$case === "singleValue") {
+      obj.singleValue = message.resultData.value;
+    } else if (message.resultData?.$case === "arrayValues") {
+      obj.arrayValues = FloatArray.toJSON(message.resultData.value);
+    } else if (message.resultData?.$case === "structValue") {
+      obj.structValue = message.resultData.value;
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = resultStatusToJSON(message.status);
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      obj.errorMessage = message.errorMessage;
+    }
+    if (message.superseded !== undefined && message.superseded !== false) {
+      obj.superseded = message.superseded;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ResultsForAlgorithm_ResultsRow>, I>>(base?: I): ResultsForAlgorithm_ResultsRow {
+    return ResultsForAlgorithm_ResultsRow.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithm_ResultsRow>, I>>(
+    object: I,
+  ): ResultsForAlgorithm_ResultsRow {
+    const message = createBaseResultsForAlgorithm_ResultsRow();
+    message.time = object.time ?? undefined;
+    switch (object.resultData?.$case) {
+      case "singleValue": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "singleValue", value: object.resultData.value };
+        }
+        break;
+      }
+      case "arrayValues": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "arrayValues", value: FloatArray.fromPartial(object.resultData.value) };
+        }
+        break;
+      }
+      case "structValue": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "structValue", value: object.resultData.value };
+        }
+        break;
+      }
+    }
+    message.status = object.status ?? 0;
+    message.errorMessage = object.errorMessage ?? "";
+    message.superseded = object.superseded ?? false;
+    return message;
+  },
+};
+
+function createBaseWindowsRead(): WindowsRead {
+  return { timeFrom: undefined, timeTo: undefined, window: undefined, includeSuperseded: false };
+}
+
+export const WindowsRead: MessageFns<WindowsRead> = {
+  encode(message: WindowsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.window !== undefined) {
+      WindowType.encode(message.window, writer.uint32(26).fork()).join();
+    }
+    if (message.includeSuperseded !== undefined && message.includeSuperseded !== false) {
+      writer.uint32(32).bool(message.includeSuperseded);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowsRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowsRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.window = WindowType.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.includeSuperseded = reader.bool();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowsRead {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      window: isSet(object.window) ? WindowType.fromJSON(object.window) : undefined,
+      includeSuperseded: isSet(object.includeSuperseded) ? globalThis.Boolean(object.includeSuperseded) : false,
+    };
+  },
+
+  toJSON(message: WindowsRead): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.window !== undefined) {
+      obj.window = WindowType.toJSON(message.window);
+    }
+    if (message.includeSuperseded !== undefined && message.includeSuperseded !== false) {
+      obj.includeSuperseded = message.includeSuperseded;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowsRead>, I>>(base?: I): WindowsRead {
+    return WindowsRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowsRead>, I>>(object: I): WindowsRead {
+    const message = createBaseWindowsRead();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.window = (object.window !== undefined && object.window !== null)
+      ? WindowType.fromPartial(object.window)
+      : undefined;
+    message.includeSuperseded = object.includeSuperseded ?? false;
+    return message;
+  },
+};
+
+function createBaseWindows(): Windows {
+  return { window: [] };
+}
+
+export const Windows: MessageFns<Windows> = {
+  encode(message: Windows, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.window !== undefined && message.window.length !== 0) {
+      for (const v of message.window) {
+        Window.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Windows {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindows();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          const el = Window.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.window!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Windows {
+    return {
+      window: globalThis.Array.isArray(object?.window) ? object.window.map((e: any) => Window.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: Windows): unknown {
+    const obj: any = {};
+    if (message.window?.length) {
+      obj.window = message.window.map((e) => Window.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Windows>, I>>(base?: I): Windows {
+    return Windows.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Windows>, I>>(object: I): Windows {
+    const message = createBaseWindows();
+    message.window = object.window?.map((e) => Window.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDistinctMetadataForWindowTypeRead(): DistinctMetadataForWindowTypeRead {
+  return { timeFrom: undefined, timeTo: undefined, windowType: undefined };
+}
+
+export const DistinctMetadataForWindowTypeRead: MessageFns<DistinctMetadataForWindowTypeRead> = {
+  encode(message: DistinctMetadataForWindowTypeRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.windowType !== undefined) {
+      WindowType.encode(message.windowType, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DistinctMetadataForWindowTypeRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDistinctMetadataForWindowTypeRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.windowType = WindowType.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DistinctMetadataForWindowTypeRead {
+    return {
       timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
       timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
-      windowTypeName: isSet(object.windowTypeName) ? globalThis.String(object.windowTypeName) : "",
-      windowTypeVersion: isSet(object.windowTypeVersion) ? globalThis.String(object.windowTypeVersion) : "",
-      origin: isSet(object.origin) ? globalThis.String(object.origin) : "",
-      metadata: isObject(object.metadata) ? object.metadata : undefined,
+      windowType: isSet(object.windowType) ? WindowType.fromJSON(object.windowType) : undefined,
     };
   },
 
-  toJSON(message: Window): unknown {
+  toJSON(message: DistinctMetadataForWindowTypeRead): unknown {
     const obj: any = {};
     if (message.timeFrom !== undefined) {
       obj.timeFrom = message.timeFrom.toISOString();
@@ -893,55 +6733,226 @@ export const Window: MessageFns<Window> = {
     if (message.timeTo !== undefined) {
       obj.timeTo = message.timeTo.toISOString();
     }
-    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
-      obj.windowTypeName = message.windowTypeName;
+    if (message.windowType !== undefined) {
+      obj.windowType = WindowType.toJSON(message.windowType);
     }
-    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
-      obj.windowTypeVersion = message.windowTypeVersion;
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DistinctMetadataForWindowTypeRead>, I>>(
+    base?: I,
+  ): DistinctMetadataForWindowTypeRead {
+    return DistinctMetadataForWindowTypeRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DistinctMetadataForWindowTypeRead>, I>>(
+    object: I,
+  ): DistinctMetadataForWindowTypeRead {
+    const message = createBaseDistinctMetadataForWindowTypeRead();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.windowType = (object.windowType !== undefined && object.windowType !== null)
+      ? WindowType.fromPartial(object.windowType)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseDistinctMetadataForWindowType(): DistinctMetadataForWindowType {
+  return { metadata: undefined };
+}
+
+export const DistinctMetadataForWindowType: MessageFns<DistinctMetadataForWindowType> = {
+  encode(message: DistinctMetadataForWindowType, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.metadata !== undefined) {
+      ListValue.encode(ListValue.wrap(message.metadata), writer.uint32(10).fork()).join();
     }
-    if (message.origin !== undefined && message.origin !== "") {
-      obj.origin = message.origin;
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DistinctMetadataForWindowType {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDistinctMetadataForWindowType();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.metadata = ListValue.unwrap(ListValue.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
     }
+    return message;
+  },
+
+  fromJSON(object: any): DistinctMetadataForWindowType {
+    return { metadata: globalThis.Array.isArray(object.metadata) ? [...object.metadata] : undefined };
+  },
+
+  toJSON(message: DistinctMetadataForWindowType): unknown {
+    const obj: any = {};
     if (message.metadata !== undefined) {
       obj.metadata = message.metadata;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Window>, I>>(base?: I): Window {
-    return Window.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<DistinctMetadataForWindowType>, I>>(base?: I): DistinctMetadataForWindowType {
+    return DistinctMetadataForWindowType.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DistinctMetadataForWindowType>, I>>(
+    object: I,
+  ): DistinctMetadataForWindowType {
+    const message = createBaseDistinctMetadataForWindowType();
+    message.metadata = object.metadata ?? undefined;
+    return message;
+  },
+};
+
+function createBaseWindowsForMetadataRead(): WindowsForMetadataRead {
+  return { timeFrom: undefined, timeTo: undefined, window: undefined, metadata: [] };
+}
+
+export const WindowsForMetadataRead: MessageFns<WindowsForMetadataRead> = {
+  encode(message: WindowsForMetadataRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.window !== undefined) {
+      WindowType.encode(message.window, writer.uint32(26).fork()).join();
+    }
+    if (message.metadata !== undefined && message.metadata.length !== 0) {
+      for (const v of message.metadata) {
+        WindowsForMetadataRead_Metadata.encode(v!, writer.uint32(34).fork()).join();
+      }
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowsForMetadataRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowsForMetadataRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.window = WindowType.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = WindowsForMetadataRead_Metadata.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.metadata!.push(el);
+          }
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WindowsForMetadataRead {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      window: isSet(object.window) ? WindowType.fromJSON(object.window) : undefined,
+      metadata: globalThis.Array.isArray(object?.metadata)
+        ? object.metadata.map((e: any) => WindowsForMetadataRead_Metadata.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: WindowsForMetadataRead): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.window !== undefined) {
+      obj.window = WindowType.toJSON(message.window);
+    }
+    if (message.metadata?.length) {
+      obj.metadata = message.metadata.map((e) => WindowsForMetadataRead_Metadata.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowsForMetadataRead>, I>>(base?: I): WindowsForMetadataRead {
+    return WindowsForMetadataRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Window>, I>>(object: I): Window {
-    const message = createBaseWindow();
+  fromPartial<I extends Exact<DeepPartial<WindowsForMetadataRead>, I>>(object: I): WindowsForMetadataRead {
+    const message = createBaseWindowsForMetadataRead();
     message.timeFrom = object.timeFrom ?? undefined;
     message.timeTo = object.timeTo ?? undefined;
-    message.windowTypeName = object.windowTypeName ?? "";
-    message.windowTypeVersion = object.windowTypeVersion ?? "";
-    message.origin = object.origin ?? "";
-    message.metadata = object.metadata ?? undefined;
+    message.window = (object.window !== undefined && object.window !== null)
+      ? WindowType.fromPartial(object.window)
+      : undefined;
+    message.metadata = object.metadata?.map((e) => WindowsForMetadataRead_Metadata.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseMetadataField(): MetadataField {
-  return { name: "", description: "" };
+function createBaseWindowsForMetadataRead_Metadata(): WindowsForMetadataRead_Metadata {
+  return { field: "", value: undefined };
 }
 
-export const MetadataField: MessageFns<MetadataField> = {
-  encode(message: MetadataField, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== undefined && message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const WindowsForMetadataRead_Metadata: MessageFns<WindowsForMetadataRead_Metadata> = {
+  encode(message: WindowsForMetadataRead_Metadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== undefined && message.field !== "") {
+      writer.uint32(10).string(message.field);
     }
-    if (message.description !== undefined && message.description !== "") {
-      writer.uint32(18).string(message.description);
+    if (message.value !== undefined) {
+      Value.encode(Value.wrap(message.value), writer.uint32(18).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): MetadataField {
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowsForMetadataRead_Metadata {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMetadataField();
+    const message = createBaseWindowsForMetadataRead_Metadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -950,7 +6961,7 @@ export const MetadataField: MessageFns<MetadataField> = {
             break;
           }
 
-          message.name = reader.string();
+          message.field = reader.string();
           continue;
         }
         case 2: {
@@ -958,7 +6969,7 @@ export const MetadataField: MessageFns<MetadataField> = {
             break;
           }
 
-          message.description = reader.string();
+          message.value = Value.unwrap(Value.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -970,62 +6981,55 @@ export const MetadataField: MessageFns<MetadataField> = {
     return message;
   },
 
-  fromJSON(object: any): MetadataField {
+  fromJSON(object: any): WindowsForMetadataRead_Metadata {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      field: isSet(object.field) ? globalThis.String(object.field) : "",
+      value: isSet(object?.value) ? object.value : undefined,
     };
   },
 
-  toJSON(message: MetadataField): unknown {
+  toJSON(message: WindowsForMetadataRead_Metadata): unknown {
     const obj: any = {};
-    if (message.name !== undefined && message.name !== "") {
-      obj.name = message.name;
+    if (message.field !== undefined && message.field !== "") {
+      obj.field = message.field;
     }
-    if (message.description !== undefined && message.description !== "") {
-      obj.description = message.description;
+    if (message.value !== undefined) {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<MetadataField>, I>>(base?: I): MetadataField {
-    return MetadataField.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<WindowsForMetadataRead_Metadata>, I>>(base?: I): WindowsForMetadataRead_Metadata {
+    return WindowsForMetadataRead_Metadata.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<MetadataField>, I>>(object: I): MetadataField {
-    const message = createBaseMetadataField();
-    message.name = object.name ?? "";
-    message.description = object.description ?? "";
+  fromPartial<I extends Exact<DeepPartial<WindowsForMetadataRead_Metadata>, I>>(
+    object: I,
+  ): WindowsForMetadataRead_Metadata {
+    const message = createBaseWindowsForMetadataRead_Metadata();
+    message.field = object.field ?? "";
+    message.value = object.value ?? undefined;
     return message;
   },
 };
 
-function createBaseWindowType(): WindowType {
-  return { name: "", version: "", description: "", metadataFields: [] };
+function createBaseWindowsForMetadata(): WindowsForMetadata {
+  return { window: [] };
 }
 
-export const WindowType: MessageFns<WindowType> = {
-  encode(message: WindowType, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== undefined && message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.version !== undefined && message.version !== "") {
-      writer.uint32(18).string(message.version);
-    }
-    if (message.description !== undefined && message.description !== "") {
-      writer.uint32(26).string(message.description);
-    }
-    if (message.metadataFields !== undefined && message.metadataFields.length !== 0) {
-      for (const v of message.metadataFields) {
-        MetadataField.encode(v!, writer.uint32(34).fork()).join();
+export const WindowsForMetadata: MessageFns<WindowsForMetadata> = {
+  encode(message: WindowsForMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.window !== undefined && message.window.length !== 0) {
+      for (const v of message.window) {
+        Window.encode(v!, writer.uint32(10).fork()).join();
       }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowType {
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowsForMetadata {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowType();
+    const message = createBaseWindowsForMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1034,33 +7038,9 @@ export const WindowType: MessageFns<WindowType> = {
             break;
           }
 
-          message.name = reader.string();
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
-
-          message.version = reader.string();
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          message.description = reader.string();
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
-
-          const el = MetadataField.decode(reader, reader.uint32());
+          const el = Window.decode(reader, reader.uint32());
           if (el !== undefined) {
-            message.metadataFields!.push(el);
+            message.window!.push(el);
           }
           continue;
         }
@@ -1073,72 +7053,98 @@ export const WindowType: MessageFns<WindowType> = {
     return message;
   },
 
-  fromJSON(object: any): WindowType {
+  fromJSON(object: any): WindowsForMetadata {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
-      metadataFields: globalThis.Array.isArray(object?.metadataFields)
-        ? object.metadataFields.map((e: any) => MetadataField.fromJSON(e))
-        : [],
+      window: globalThis.Array.isArray(object?.window) ? object.window.map((e: any) => Window.fromJSON(e)) : [],
     };
   },
 
-  toJSON(message: WindowType): unknown {
+  toJSON(message: WindowsForMetadata): unknown {
     const obj: any = {};
-    if (message.name !== undefined && message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.version !== undefined && message.version !== "") {
-      obj.version = message.version;
-    }
-    if (message.description !== undefined && message.description !== "") {
-      obj.description = message.description;
-    }
-    if (message.metadataFields?.length) {
-      obj.metadataFields = message.metadataFields.map((e) => MetadataField.toJSON(e));
+    if (message.window?.length) {
+      obj.window = message.window.map((e) => Window.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<WindowType>, I>>(base?: I): WindowType {
-    return WindowType.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<WindowsForMetadata>, I>>(base?: I): WindowsForMetadata {
+    return WindowsForMetadata.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<WindowType>, I>>(object: I): WindowType {
-    const message = createBaseWindowType();
-    message.name = object.name ?? "";
-    message.version = object.version ?? "";
-    message.description = object.description ?? "";
-    message.metadataFields = object.metadataFields?.map((e) => MetadataField.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<WindowsForMetadata>, I>>(object: I): WindowsForMetadata {
+    const message = createBaseWindowsForMetadata();
+    message.window = object.window?.map((e) => Window.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseWindowEmitStatus(): WindowEmitStatus {
-  return { status: 0 };
+function createBaseResultsSubscription(): ResultsSubscription {
+  return { algorithms: [], windowType: undefined, metadata: [], resumeAfter: "0" };
 }
 
-export const WindowEmitStatus: MessageFns<WindowEmitStatus> = {
-  encode(message: WindowEmitStatus, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.status !== undefined && message.status !== 0) {
-      writer.uint32(8).int32(message.status);
+export const ResultsSubscription: MessageFns<ResultsSubscription> = {
+  encode(message: ResultsSubscription, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.algorithms !== undefined && message.algorithms.length !== 0) {
+      for (const v of message.algorithms) {
+        Algorithm.encode(v!, writer.uint32(10).fork()).join();
+      }
+    }
+    if (message.windowType !== undefined) {
+      WindowType.encode(message.windowType, writer.uint32(18).fork()).join();
+    }
+    if (message.metadata !== undefined && message.metadata.length !== 0) {
+      for (const v of message.metadata) {
+        ResultsSubscription_Metadata.encode(v!, writer.uint32(26).fork()).join();
+      }
+    }
+    if (message.resumeAfter !== undefined && message.resumeAfter !== "0") {
+      writer.uint32(32).int64(message.resumeAfter);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowEmitStatus {
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsSubscription {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowEmitStatus();
+    const message = createBaseResultsSubscription();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.status = reader.int32() as any;
+          const el = Algorithm.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.algorithms!.push(el);
+          }
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.windowType = WindowType.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          const el = ResultsSubscription_Metadata.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.metadata!.push(el);
+          }
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.resumeAfter = reader.int64().toString();
           continue;
         }
       }
@@ -1150,53 +7156,70 @@ export const WindowEmitStatus: MessageFns<WindowEmitStatus> = {
     return message;
   },
 
-  fromJSON(object: any): WindowEmitStatus {
-    return { status: isSet(object.status) ? windowEmitStatus_StatusEnumFromJSON(object.status) : 0 };
+  fromJSON(object: any): ResultsSubscription {
+    return {
+      algorithms: globalThis.Array.isArray(object?.algorithms)
+        ? object.algorithms.map((e: any) => Algorithm.fromJSON(e))
+        : [],
+      windowType: isSet(object.windowType) ? WindowType.fromJSON(object.windowType) : undefined,
+      metadata: globalThis.Array.isArray(object?.metadata)
+        ? object.metadata.map((e: any) => ResultsSubscription_Metadata.fromJSON(e))
+        : [],
+      resumeAfter: isSet(object.resumeAfter) ? globalThis.String(object.resumeAfter) : "0",
+    };
   },
 
-  toJSON(message: WindowEmitStatus): unknown {
+  toJSON(message: ResultsSubscription): unknown {
     const obj: any = {};
-    if (message.status !== undefined && message.status !== 0) {
-      obj.status = windowEmitStatus_StatusEnumToJSON(message.status);
+    if (message.algorithms?.length) {
+      obj.algorithms = message.algorithms.map((e) => Algorithm.toJSON(e));
+    }
+    if (message.windowType !== undefined) {
+      obj.windowType = WindowType.toJSON(message.windowType);
+    }
+    if (message.metadata?.length) {
+      obj.metadata = message.metadata.map((e) => ResultsSubscription_Metadata.toJSON(e));
+    }
+    if (message.resumeAfter !== undefined && message.resumeAfter !== "0") {
+      obj.resumeAfter = message.resumeAfter;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<WindowEmitStatus>, I>>(base?: I): WindowEmitStatus {
-    return WindowEmitStatus.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ResultsSubscription>, I>>(base?: I): ResultsSubscription {
+    return ResultsSubscription.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<WindowEmitStatus>, I>>(object: I): WindowEmitStatus {
-    const message = createBaseWindowEmitStatus();
-    message.status = object.status ?? 0;
+  fromPartial<I extends Exact<DeepPartial<ResultsSubscription>, I>>(object: I): ResultsSubscription {
+    const message = createBaseResultsSubscription();
+    message.algorithms = object.algorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+    message.windowType = (object.windowType !== undefined && object.windowType !== null)
+      ? WindowType.fromPartial(object.windowType)
+      : undefined;
+    message.metadata = object.metadata?.map((e) => ResultsSubscription_Metadata.fromPartial(e)) || [];
+    message.resumeAfter = object.resumeAfter ?? "0";
     return message;
   },
 };
 
-function createBaseAlgorithmDependency(): AlgorithmDependency {
-  return { name: "", version: "", processorName: "", processorRuntime: "" };
-}
-
-export const AlgorithmDependency: MessageFns<AlgorithmDependency> = {
-  encode(message: AlgorithmDependency, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== undefined && message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.version !== undefined && message.version !== "") {
-      writer.uint32(18).string(message.version);
-    }
-    if (message.processorName !== undefined && message.processorName !== "") {
-      writer.uint32(26).string(message.processorName);
+function createBaseResultsSubscription_Metadata(): ResultsSubscription_Metadata {
+  return { field: "", value: undefined };
+}
+
+export const ResultsSubscription_Metadata: MessageFns<ResultsSubscription_Metadata> = {
+  encode(message: ResultsSubscription_Metadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.field !== undefined && message.field !== "") {
+      writer.uint32(10).string(message.field);
     }
-    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
-      writer.uint32(34).string(message.processorRuntime);
+    if (message.value !== undefined) {
+      Value.encode(Value.wrap(message.value), writer.uint32(18).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmDependency {
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsSubscription_Metadata {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAlgorithmDependency();
+    const message = createBaseResultsSubscription_Metadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1205,7 +7228,7 @@ export const AlgorithmDependency: MessageFns<AlgorithmDependency> = {
             break;
           }
 
-          message.name = reader.string();
+          message.field = reader.string();
           continue;
         }
         case 2: {
@@ -1213,23 +7236,7 @@ export const AlgorithmDependency: MessageFns<AlgorithmDependency> = {
             break;
           }
 
-          message.version = reader.string();
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          message.processorName = reader.string();
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
-
-          message.processorRuntime = reader.string();
+          message.value = Value.unwrap(Value.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -1241,84 +7248,66 @@ export const AlgorithmDependency: MessageFns<AlgorithmDependency> = {
     return message;
   },
 
-  fromJSON(object: any): AlgorithmDependency {
+  fromJSON(object: any): ResultsSubscription_Metadata {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
-      processorName: isSet(object.processorName) ? globalThis.String(object.processorName) : "",
-      processorRuntime: isSet(object.processorRuntime) ? globalThis.String(object.processorRuntime) : "",
+      field: isSet(object.field) ? globalThis.String(object.field) : "",
+      value: isSet(object?.value) ? object.value : undefined,
     };
   },
 
-  toJSON(message: AlgorithmDependency): unknown {
+  toJSON(message: ResultsSubscription_Metadata): unknown {
     const obj: any = {};
-    if (message.name !== undefined && message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.version !== undefined && message.version !== "") {
-      obj.version = message.version;
-    }
-    if (message.processorName !== undefined && message.processorName !== "") {
-      obj.processorName = message.processorName;
+    if (message.field !== undefined && message.field !== "") {
+      obj.field = message.field;
     }
-    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
-      obj.processorRuntime = message.processorRuntime;
+    if (message.value !== undefined) {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AlgorithmDependency>, I>>(base?: I): AlgorithmDependency {
-    return AlgorithmDependency.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ResultsSubscription_Metadata>, I>>(base?: I): ResultsSubscription_Metadata {
+    return ResultsSubscription_Metadata.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AlgorithmDependency>, I>>(object: I): AlgorithmDependency {
-    const message = createBaseAlgorithmDependency();
-    message.name = object.name ?? "";
-    message.version = object.version ?? "";
-    message.processorName = object.processorName ?? "";
-    message.processorRuntime = object.processorRuntime ?? "";
+  fromPartial<I extends Exact<DeepPartial<ResultsSubscription_Metadata>, I>>(object: I): ResultsSubscription_Metadata {
+    const message = createBaseResultsSubscription_Metadata();
+    message.field = object.field ?? "";
+    message.value = object.value ?? undefined;
     return message;
   },
 };
 
-function createBaseAlgorithm(): Algorithm {
-  return { name: "", version: "", windowType: undefined, dependencies: [], resultType: 0 };
+function createBaseResultUpdate(): ResultUpdate {
+  return { cursor: "0", window: undefined, algorithmResult: undefined };
 }
 
-export const Algorithm: MessageFns<Algorithm> = {
-  encode(message: Algorithm, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== undefined && message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.version !== undefined && message.version !== "") {
-      writer.uint32(18).string(message.version);
-    }
-    if (message.windowType !== undefined) {
-      WindowType.encode(message.windowType, writer.uint32(26).fork()).join();
+export const ResultUpdate: MessageFns<ResultUpdate> = {
+  encode(message: ResultUpdate, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.cursor !== undefined && message.cursor !== "0") {
+      writer.uint32(8).int64(message.cursor);
     }
-    if (message.dependencies !== undefined && message.dependencies.length !== 0) {
-      for (const v of message.dependencies) {
-        AlgorithmDependency.encode(v!, writer.uint32(34).fork()).join();
-      }
+    if (message.window !== undefined) {
+      Window.encode(message.window, writer.uint32(18).fork()).join();
     }
-    if (message.resultType !== undefined && message.resultType !== 0) {
-      writer.uint32(40).int32(message.resultType);
+    if (message.algorithmResult !== undefined) {
+      AlgorithmResult.encode(message.algorithmResult, writer.uint32(26).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Algorithm {
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultUpdate {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAlgorithm();
+    const message = createBaseResultUpdate();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.name = reader.string();
+          message.cursor = reader.int64().toString();
           continue;
         }
         case 2: {
@@ -1326,7 +7315,7 @@ export const Algorithm: MessageFns<Algorithm> = {
             break;
           }
 
-          message.version = reader.string();
+          message.window = Window.decode(reader, reader.uint32());
           continue;
         }
         case 3: {
@@ -1334,26 +7323,7 @@ export const Algorithm: MessageFns<Algorithm> = {
             break;
           }
 
-          message.windowType = WindowType.decode(reader, reader.uint32());
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
-
-          const el = AlgorithmDependency.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.dependencies!.push(el);
-          }
-          continue;
-        }
-        case 5: {
-          if (tag !== 40) {
-            break;
-          }
-
-          message.resultType = reader.int32() as any;
+          message.algorithmResult = AlgorithmResult.decode(reader, reader.uint32());
           continue;
         }
       }
@@ -1365,94 +7335,108 @@ export const Algorithm: MessageFns<Algorithm> = {
     return message;
   },
 
-  fromJSON(object: any): Algorithm {
+  fromJSON(object: any): ResultUpdate {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
-      windowType: isSet(object.windowType) ? WindowType.fromJSON(object.windowType) : undefined,
-      dependencies: globalThis.Array.isArray(object?.dependencies)
-        ? object.dependencies.map((e: any) => AlgorithmDependency.fromJSON(e))
-        : [],
-      resultType: isSet(object.resultType) ? resultTypeFromJSON(object.resultType) : 0,
+      cursor: isSet(object.cursor) ? globalThis.String(object.cursor) : "0",
+      window: isSet(object.window) ? Window.fromJSON(object.window) : undefined,
+      algorithmResult: isSet(object.algorithmResult) ? AlgorithmResult.fromJSON(object.algorithmResult) : undefined,
     };
   },
 
-  toJSON(message: Algorithm): unknown {
+  toJSON(message: ResultUpdate): unknown {
     const obj: any = {};
-    if (message.name !== undefined && message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.version !== undefined && message.version !== "") {
-      obj.version = message.version;
+    if (message.cursor !== undefined && message.cursor !== "0") {
+      obj.cursor = message.cursor;
     }
-    if (message.windowType !== undefined) {
-      obj.windowType = WindowType.toJSON(message.windowType);
-    }
-    if (message.dependencies?.length) {
-      obj.dependencies = message.dependencies.map((e) => AlgorithmDependency.toJSON(e));
+    if (message.window !== undefined) {
+      obj.window = Window.toJSON(message.window);
     }
-    if (message.resultType !== undefined && message.resultType !== 0) {
-      obj.resultType = resultTypeToJSON(message.resultType);
+    if (message.algorithmResult !== undefined) {
+      obj.algorithmResult = AlgorithmResult.toJSON(message.algorithmResult);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Algorithm>, I>>(base?: I): Algorithm {
-    return Algorithm.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ResultUpdate>, I>>(base?: I): ResultUpdate {
+    return ResultUpdate.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Algorithm>, I>>(object: I): Algorithm {
-    const message = createBaseAlgorithm();
-    message.name = object.name ?? "";
-    message.version = object.version ?? "";
-    message.windowType = (object.windowType !== undefined && object.windowType !== null)
-      ? WindowType.fromPartial(object.windowType)
+  fromPartial<I extends Exact<DeepPartial<ResultUpdate>, I>>(object: I): ResultUpdate {
+    const message = createBaseResultUpdate();
+    message.cursor = object.cursor ?? "0";
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Window.fromPartial(object.window)
+      : undefined;
+    message.algorithmResult = (object.algorithmResult !== undefined && object.algorithmResult !== null)
+      ? AlgorithmResult.fromPartial(object.algorithmResult)
       : undefined;
-    message.dependencies = object.dependencies?.map((e) => AlgorithmDependency.fromPartial(e)) || [];
-    message.resultType = object.resultType ?? 0;
     return message;
   },
 };
 
-function createBaseFloatArray(): FloatArray {
-  return { values: [] };
+function createBaseResultsForAlgorithmAndMetadataRead(): ResultsForAlgorithmAndMetadataRead {
+  return { timeFrom: undefined, timeTo: undefined, algorithm: undefined, metadata: [] };
 }
 
-export const FloatArray: MessageFns<FloatArray> = {
-  encode(message: FloatArray, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.values !== undefined && message.values.length !== 0) {
-      writer.uint32(10).fork();
-      for (const v of message.values) {
-        writer.float(v);
+export const ResultsForAlgorithmAndMetadataRead: MessageFns<ResultsForAlgorithmAndMetadataRead> = {
+  encode(message: ResultsForAlgorithmAndMetadataRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(26).fork()).join();
+    }
+    if (message.metadata !== undefined && message.metadata.length !== 0) {
+      for (const v of message.metadata) {
+        ResultsForAlgorithmAndMetadataRead_Metadata.encode(v!, writer.uint32(34).fork()).join();
       }
-      writer.join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): FloatArray {
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadataRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseFloatArray();
+    const message = createBaseResultsForAlgorithmAndMetadataRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag === 13) {
-            message.values!.push(reader.float());
+          if (tag !== 10) {
+            break;
+          }
 
-            continue;
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
           }
 
-          if (tag === 10) {
-            const end2 = reader.uint32() + reader.pos;
-            while (reader.pos < end2) {
-              message.values!.push(reader.float());
-            }
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
 
-            continue;
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
           }
 
-          break;
+          const el = ResultsForAlgorithmAndMetadataRead_Metadata.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.metadata!.push(el);
+          }
+          continue;
         }
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1463,101 +7447,92 @@ export const FloatArray: MessageFns<FloatArray> = {
     return message;
   },
 
-  fromJSON(object: any): FloatArray {
+  fromJSON(object: any): ResultsForAlgorithmAndMetadataRead {
     return {
-      values: globalThis.Array.isArray(object?.values) ? object.values.map((e: any) => globalThis.Number(e)) : [],
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      metadata: globalThis.Array.isArray(object?.metadata)
+        ? object.metadata.map((e: any) => ResultsForAlgorithmAndMetadataRead_Metadata.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: FloatArray): unknown {
+  toJSON(message: ResultsForAlgorithmAndMetadataRead): unknown {
     const obj: any = {};
-    if (message.values?.length) {
-      obj.values = message.values;
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.metadata?.length) {
+      obj.metadata = message.metadata.map((e) => ResultsForAlgorithmAndMetadataRead_Metadata.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<FloatArray>, I>>(base?: I): FloatArray {
-    return FloatArray.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead>, I>>(
+    base?: I,
+  ): ResultsForAlgorithmAndMetadataRead {
+    return ResultsForAlgorithmAndMetadataRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<FloatArray>, I>>(object: I): FloatArray {
-    const message = createBaseFloatArray();
-    message.values = object.values?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead>, I>>(
+    object: I,
+  ): ResultsForAlgorithmAndMetadataRead {
+    const message = createBaseResultsForAlgorithmAndMetadataRead();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.metadata = object.metadata?.map((e) => ResultsForAlgorithmAndMetadataRead_Metadata.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseResult(): Result {
-  return { status: 0, resultData: undefined, timestamp: "0" };
+function createBaseResultsForAlgorithmAndMetadataRead_Metadata(): ResultsForAlgorithmAndMetadataRead_Metadata {
+  return { field: "", value: undefined };
 }
 
-export const Result: MessageFns<Result> = {
-  encode(message: Result, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.status !== undefined && message.status !== 0) {
-      writer.uint32(8).int32(message.status);
-    }
-    switch (message.resultData?.$case) {
-      case "singleValue":
-        writer.uint32(21).float(message.resultData.value);
-        break;
-      case "floatValues":
-        FloatArray.encode(message.resultData.value, writer.uint32(26).fork()).join();
-        break;
-      case "structValue":
-        Struct.encode(Struct.wrap(message.resultData.value), writer.uint32(34).fork()).join();
-        break;
+export const ResultsForAlgorithmAndMetadataRead_Metadata: MessageFns<ResultsForAlgorithmAndMetadataRead_Metadata> = {
+  encode(
+    message: ResultsForAlgorithmAndMetadataRead_Metadata,
+    writer: BinaryWriter = new BinaryWriter(),
+  ): BinaryWriter {
+    if (message.field !== undefined && message.field !== "") {
+      writer.uint32(10).string(message.field);
     }
-    if (message.timestamp !== undefined && message.timestamp !== "0") {
-      writer.uint32(40).int64(message.timestamp);
+    if (message.value !== undefined) {
+      Value.encode(Value.wrap(message.value), writer.uint32(18).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Result {
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadataRead_Metadata {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResult();
+    const message = createBaseResultsForAlgorithmAndMetadataRead_Metadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.status = reader.int32() as any;
+          message.field = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 21) {
-            break;
-          }
-
-          message.resultData = { $case: "singleValue", value: reader.float() };
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          message.resultData = { $case: "floatValues", value: FloatArray.decode(reader, reader.uint32()) };
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
-
-          message.resultData = { $case: "structValue", value: Struct.unwrap(Struct.decode(reader, reader.uint32())) };
-          continue;
-        }
-        case 5: {
-          if (tag !== 40) {
+          if (tag !== 18) {
             break;
           }
 
-          message.timestamp = reader.int64().toString();
+          message.value = Value.unwrap(Value.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -1569,96 +7544,57 @@ export const Result: MessageFns<Result> = {
     return message;
   },
 
-  fromJSON(object: any): Result {
+  fromJSON(object: any): ResultsForAlgorithmAndMetadataRead_Metadata {
     return {
-      status: isSet(object.status) ? resultStatusFromJSON(object.status) : 0,
-      resultData: isSet(object.singleValue)
-        ? { $case: "singleValue", value: globalThis.Number(object.singleValue) }
-        : isSet(object.floatValues)
-        ? { $case: "floatValues", value: FloatArray.fromJSON(object.floatValues) }
-        : isSet(object.structValue)
-        ? { $case: "structValue", value: object.structValue }
-        : undefined,
-      timestamp: isSet(object.timestamp) ? globalThis.String(object.timestamp) : "0",
+      field: isSet(object.field) ? globalThis.String(object.field) : "",
+      value: isSet(object?.value) ? object.value : undefined,
     };
   },
 
-  toJSON(message: Result): unknown {
+  toJSON(message: ResultsForAlgorithmAndMetadataRead_Metadata): unknown {
     const obj: any = {};
-    if (message.status !== undefined && message.status !== 0) {
-      obj.status = resultStatusToJSON(message.status);
-    }
-    if (message.resultData?.$case === "singleValue") {
-      obj.singleValue = message.resultData.value;
-    } else if (message.resultData?.$case === "floatValues") {
-      obj.floatValues = FloatArray.toJSON(message.resultData.value);
-    } else if (message.resultData?.$case === "structValue") {
-      obj.structValue = message.resultData.value;
+    if (message.field !== undefined && message.field !== "") {
+      obj.field = message.field;
     }
-    if (message.timestamp !== undefined && message.timestamp !== "0") {
-      obj.timestamp = message.timestamp;
+    if (message.value !== undefined) {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Result>, I>>(base?: I): Result {
-    return Result.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead_Metadata>, I>>(
+    base?: I,
+  ): ResultsForAlgorithmAndMetadataRead_Metadata {
+    return ResultsForAlgorithmAndMetadataRead_Metadata.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Result>, I>>(object: I): Result {
-    const message = createBaseResult();
-    message.status = object.status ?? 0;
-    switch (object.resultData?.$case) {
-      case "singleValue": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "singleValue", value: object.resultData.value };
-        }
-        break;
-      }
-      case "floatValues": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "floatValues", value: FloatArray.fromPartial(object.resultData.value) };
-        }
-        break;
-      }
-      case "structValue": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "structValue", value: object.resultData.value };
-        }
-        break;
-      }
-    }
-    message.timestamp = object.timestamp ?? "0";
+  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead_Metadata>, I>>(
+    object: I,
+  ): ResultsForAlgorithmAndMetadataRead_Metadata {
+    const message = createBaseResultsForAlgorithmAndMetadataRead_Metadata();
+    message.field = object.field ?? "";
+    message.value = object.value ?? undefined;
     return message;
   },
 };
 
-function createBaseProcessorRegistration(): ProcessorRegistration {
-  return { name: "", runtime: "", connectionStr: "", supportedAlgorithms: [] };
+function createBaseResultsForAlgorithmAndMetadata(): ResultsForAlgorithmAndMetadata {
+  return { results: [] };
 }
 
-export const ProcessorRegistration: MessageFns<ProcessorRegistration> = {
-  encode(message: ProcessorRegistration, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== undefined && message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.runtime !== undefined && message.runtime !== "") {
-      writer.uint32(18).string(message.runtime);
-    }
-    if (message.connectionStr !== undefined && message.connectionStr !== "") {
-      writer.uint32(26).string(message.connectionStr);
-    }
-    if (message.supportedAlgorithms !== undefined && message.supportedAlgorithms.length !== 0) {
-      for (const v of message.supportedAlgorithms) {
-        Algorithm.encode(v!, writer.uint32(34).fork()).join();
+export const ResultsForAlgorithmAndMetadata: MessageFns<ResultsForAlgorithmAndMetadata> = {
+  encode(message: ResultsForAlgorithmAndMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.results !== undefined && message.results.length !== 0) {
+      for (const v of message.results) {
+        ResultsForAlgorithmAndMetadata_ResultsRow.encode(v!, writer.uint32(10).fork()).join();
       }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ProcessorRegistration {
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadata {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcessorRegistration();
+    const message = createBaseResultsForAlgorithmAndMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1667,33 +7603,9 @@ export const ProcessorRegistration: MessageFns<ProcessorRegistration> = {
             break;
           }
 
-          message.name = reader.string();
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
-
-          message.runtime = reader.string();
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          message.connectionStr = reader.string();
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
-
-          const el = Algorithm.decode(reader, reader.uint32());
+          const el = ResultsForAlgorithmAndMetadata_ResultsRow.decode(reader, reader.uint32());
           if (el !== undefined) {
-            message.supportedAlgorithms!.push(el);
+            message.results!.push(el);
           }
           continue;
         }
@@ -1706,74 +7618,67 @@ export const ProcessorRegistration: MessageFns<ProcessorRegistration> = {
     return message;
   },
 
-  fromJSON(object: any): ProcessorRegistration {
+  fromJSON(object: any): ResultsForAlgorithmAndMetadata {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      runtime: isSet(object.runtime) ? globalThis.String(object.runtime) : "",
-      connectionStr: isSet(object.connectionStr) ? globalThis.String(object.connectionStr) : "",
-      supportedAlgorithms: globalThis.Array.isArray(object?.supportedAlgorithms)
-        ? object.supportedAlgorithms.map((e: any) => Algorithm.fromJSON(e))
+      results: globalThis.Array.isArray(object?.results)
+        ? object.results.map((e: any) => ResultsForAlgorithmAndMetadata_ResultsRow.fromJSON(e))
         : [],
     };
   },
 
-  toJSON(message: ProcessorRegistration): unknown {
+  toJSON(message: ResultsForAlgorithmAndMetadata): unknown {
     const obj: any = {};
-    if (message.name !== undefined && message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.runtime !== undefined && message.runtime !== "") {
-      obj.runtime = message.runtime;
-    }
-    if (message.connectionStr !== undefined && message.connectionStr !== "") {
-      obj.connectionStr = message.connectionStr;
-    }
-    if (message.supportedAlgorithms?.length) {
-      obj.supportedAlgorithms = message.supportedAlgorithms.map((e) => Algorithm.toJSON(e));
+    if (message.results?.length) {
+      obj.results = message.results.map((e) => ResultsForAlgorithmAndMetadata_ResultsRow.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ProcessorRegistration>, I>>(base?: I): ProcessorRegistration {
-    return ProcessorRegistration.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata>, I>>(base?: I): ResultsForAlgorithmAndMetadata {
+    return ResultsForAlgorithmAndMetadata.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ProcessorRegistration>, I>>(object: I): ProcessorRegistration {
-    const message = createBaseProcessorRegistration();
-    message.name = object.name ?? "";
-    message.runtime = object.runtime ?? "";
-    message.connectionStr = object.connectionStr ?? "";
-    message.supportedAlgorithms = object.supportedAlgorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata>, I>>(
+    object: I,
+  ): ResultsForAlgorithmAndMetadata {
+    const message = createBaseResultsForAlgorithmAndMetadata();
+    message.results = object.results?.map((e) => ResultsForAlgorithmAndMetadata_ResultsRow.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseProcessingTask(): ProcessingTask {
-  return { taskId: "", algorithm: undefined, window: undefined, dependencyResults: [] };
+function createBaseResultsForAlgorithmAndMetadata_ResultsRow(): ResultsForAlgorithmAndMetadata_ResultsRow {
+  return { time: undefined, resultData: undefined, status: 0, errorMessage: "" };
 }
 
-export const ProcessingTask: MessageFns<ProcessingTask> = {
-  encode(message: ProcessingTask, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.taskId !== undefined && message.taskId !== "") {
-      writer.uint32(10).string(message.taskId);
+export const ResultsForAlgorithmAndMetadata_ResultsRow: MessageFns<ResultsForAlgorithmAndMetadata_ResultsRow> = {
+  encode(message: ResultsForAlgorithmAndMetadata_ResultsRow, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(10).fork()).join();
     }
-    if (message.algorithm !== undefined) {
-      Algorithm.encode(message.algorithm, writer.uint32(18).fork()).join();
+    switch (message.resultData?.$case) {
+      case "singleValue":
+        writer.uint32(21).float(message.resultData.value);
+        break;
+      case "arrayValues":
+        FloatArray.encode(message.resultData.value, writer.uint32(26).fork()).join();
+        break;
+      case "structValue":
+        Struct.encode(Struct.wrap(message.resultData.value), writer.uint32(34).fork()).join();
+        break;
     }
-    if (message.window !== undefined) {
-      Window.encode(message.window, writer.uint32(26).fork()).join();
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(40).int32(message.status);
     }
-    if (message.dependencyResults !== undefined && message.dependencyResults.length !== 0) {
-      for (const v of message.dependencyResults) {
-        Result.encode(v!, writer.uint32(34).fork()).join();
-      }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      writer.uint32(50).string(message.errorMessage);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ProcessingTask {
+  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadata_ResultsRow {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcessingTask();
+    const message = createBaseResultsForAlgorithmAndMetadata_ResultsRow();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1782,15 +7687,15 @@ export const ProcessingTask: MessageFns<ProcessingTask> = {
             break;
           }
 
-          message.taskId = reader.string();
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 21) {
             break;
           }
 
-          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          message.resultData = { $case: "singleValue", value: reader.float() };
           continue;
         }
         case 3: {
@@ -1798,7 +7703,7 @@ export const ProcessingTask: MessageFns<ProcessingTask> = {
             break;
           }
 
-          message.window = Window.decode(reader, reader.uint32());
+          message.resultData = { $case: "arrayValues", value: FloatArray.decode(reader, reader.uint32()) };
           continue;
         }
         case 4: {
@@ -1806,10 +7711,23 @@ export const ProcessingTask: MessageFns<ProcessingTask> = {
             break;
           }
 
-          const el = Result.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.dependencyResults!.push(el);
+          message.resultData = { $case: "structValue", value: Struct.unwrap(Struct.decode(reader, reader.uint32())) };
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
           }
+
+          message.errorMessage = reader.string();
           continue;
         }
       }
@@ -1821,80 +7739,120 @@ export const ProcessingTask: MessageFns<ProcessingTask> = {
     return message;
   },
 
-  fromJSON(object: any): ProcessingTask {
+  fromJSON(object: any): ResultsForAlgorithmAndMetadata_ResultsRow {
     return {
-      taskId: isSet(object.taskId) ? globalThis.String(object.taskId) : "",
-      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
-      window: isSet(object.window) ? Window.fromJSON(object.window) : undefined,
-      dependencyResults: globalThis.Array.isArray(object?.dependencyResults)
-        ? object.dependencyResults.map((e: any) => Result.fromJSON(e))
-        : [],
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+      resultData: isSet(object.singleValue)
+        ? { $case: "singleValue", value: globalThis.Number(object.singleValue) }
+        : isSet(object.arrayValues)
+        ? { $case: "arrayValues", value: FloatArray.fromJSON(object.arrayValues) }
+        : isSet(object.structValue)
+        ? { $case: "structValue", value: object.structValue }
+        : undefined,
+      status: isSet(object.status) ? resultStatusFromJSON(object.status) : 0,
+      errorMessage: isSet(object.errorMessage) ? globalThis.String(object.errorMessage) : "",
     };
   },
 
-  toJSON(message: ProcessingTask): unknown {
+  toJSON(message: ResultsForAlgorithmAndMetadata_ResultsRow): unknown {
     const obj: any = {};
-    if (message.taskId !== undefined && message.taskId !== "") {
-      obj.taskId = message.taskId;
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
     }
-    if (message.algorithm !== undefined) {
-      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    if (message.resultData?.$case === "singleValue") {
+      obj.singleValue = message.resultData.value;
+    } else if (message.resultData?.$case === "arrayValues") {
+      obj.arrayValues = FloatArray.toJSON(message.resultData.value);
+    } else if (message.resultData?.$case === "structValue") {
+      obj.structValue = message.resultData.value;
     }
-    if (message.window !== undefined) {
-      obj.window = Window.toJSON(message.window);
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = resultStatusToJSON(message.status);
     }
-    if (message.dependencyResults?.length) {
-      obj.dependencyResults = message.dependencyResults.map((e) => Result.toJSON(e));
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      obj.errorMessage = message.errorMessage;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ProcessingTask>, I>>(base?: I): ProcessingTask {
-    return ProcessingTask.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata_ResultsRow>, I>>(
+    base?: I,
+  ): ResultsForAlgorithmAndMetadata_ResultsRow {
+    return ResultsForAlgorithmAndMetadata_ResultsRow.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ProcessingTask>, I>>(object: I): ProcessingTask {
-    const message = createBaseProcessingTask();
-    message.taskId = object.taskId ?? "";
-    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
-      ? Algorithm.fromPartial(object.algorithm)
-      : undefined;
-    message.window = (object.window !== undefined && object.window !== null)
-      ? Window.fromPartial(object.window)
-      : undefined;
-    message.dependencyResults = object.dependencyResults?.map((e) => Result.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata_ResultsRow>, I>>(
+    object: I,
+  ): ResultsForAlgorithmAndMetadata_ResultsRow {
+    const message = createBaseResultsForAlgorithmAndMetadata_ResultsRow();
+    message.time = object.time ?? undefined;
+    switch (object.resultData?.$case) {
+      case "singleValue": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "singleValue", value: object.resultData.value };
+        }
+        break;
+      }
+      case "arrayValues": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "arrayValues", value: FloatArray.fromPartial(object.resultData.value) };
+        }
+        break;
+      }
+      case "structValue": {
+        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
+          message.resultData = { $case: "structValue", value: object.resultData.value };
+        }
+        break;
+      }
+    }
+    message.status = object.status ?? 0;
+    message.errorMessage = object.errorMessage ?? "";
     return message;
   },
 };
 
-function createBaseExecutionRequest(): ExecutionRequest {
-  return { execId: "", window: undefined, algorithmResults: [], algorithms: [] };
+function createBaseAnnotateWrite(): AnnotateWrite {
+  return {
+    timeFrom: undefined,
+    timeTo: undefined,
+    capturedAlgorithms: [],
+    capturedWindows: [],
+    description: "",
+    metadata: undefined,
+  };
 }
 
-export const ExecutionRequest: MessageFns<ExecutionRequest> = {
-  encode(message: ExecutionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.execId !== undefined && message.execId !== "") {
-      writer.uint32(10).string(message.execId);
+export const AnnotateWrite: MessageFns<AnnotateWrite> = {
+  encode(message: AnnotateWrite, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
     }
-    if (message.window !== undefined) {
-      Window.encode(message.window, writer.uint32(18).fork()).join();
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
     }
-    if (message.algorithmResults !== undefined && message.algorithmResults.length !== 0) {
-      for (const v of message.algorithmResults) {
-        AlgorithmResult.encode(v!, writer.uint32(26).fork()).join();
+    if (message.capturedAlgorithms !== undefined && message.capturedAlgorithms.length !== 0) {
+      for (const v of message.capturedAlgorithms) {
+        Algorithm.encode(v!, writer.uint32(26).fork()).join();
       }
     }
-    if (message.algorithms !== undefined && message.algorithms.length !== 0) {
-      for (const v of message.algorithms) {
-        Algorithm.encode(v!, writer.uint32(34).fork()).join();
+    if (message.capturedWindows !== undefined && message.capturedWindows.length !== 0) {
+      for (const v of message.capturedWindows) {
+        WindowType.encode(v!, writer.uint32(34).fork()).join();
       }
     }
+    if (message.description !== undefined && message.description !== "") {
+      writer.uint32(42).string(message.description);
+    }
+    if (message.metadata !== undefined) {
+      Struct.encode(Struct.wrap(message.metadata), writer.uint32(50).fork()).join();
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): AnnotateWrite {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseExecutionRequest();
+    const message = createBaseAnnotateWrite();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1903,7 +7861,7 @@ export const ExecutionRequest: MessageFns<ExecutionRequest> = {
             break;
           }
 
-          message.execId = reader.string();
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
@@ -1911,7 +7869,7 @@ export const ExecutionRequest: MessageFns<ExecutionRequest> = {
             break;
           }
 
-          message.window = Window.decode(reader, reader.uint32());
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
         case 3: {
@@ -1919,23 +7877,117 @@ export const ExecutionRequest: MessageFns<ExecutionRequest> = {
             break;
           }
 
-          const el = AlgorithmResult.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.algorithmResults!.push(el);
-          }
-          continue;
-        }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
+          const el = Algorithm.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.capturedAlgorithms!.push(el);
+          }
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          const el = WindowType.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.capturedWindows!.push(el);
+          }
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AnnotateWrite {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      capturedAlgorithms: globalThis.Array.isArray(object?.capturedAlgorithms)
+        ? object.capturedAlgorithms.map((e: any) => Algorithm.fromJSON(e))
+        : [],
+      capturedWindows: globalThis.Array.isArray(object?.capturedWindows)
+        ? object.capturedWindows.map((e: any) => WindowType.fromJSON(e))
+        : [],
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      metadata: isObject(object.metadata) ? object.metadata : undefined,
+    };
+  },
+
+  toJSON(message: AnnotateWrite): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.capturedAlgorithms?.length) {
+      obj.capturedAlgorithms = message.capturedAlgorithms.map((e) => Algorithm.toJSON(e));
+    }
+    if (message.capturedWindows?.length) {
+      obj.capturedWindows = message.capturedWindows.map((e) => WindowType.toJSON(e));
+    }
+    if (message.description !== undefined && message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.metadata !== undefined) {
+      obj.metadata = message.metadata;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AnnotateWrite>, I>>(base?: I): AnnotateWrite {
+    return AnnotateWrite.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AnnotateWrite>, I>>(object: I): AnnotateWrite {
+    const message = createBaseAnnotateWrite();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.capturedAlgorithms = object.capturedAlgorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+    message.capturedWindows = object.capturedWindows?.map((e) => WindowType.fromPartial(e)) || [];
+    message.description = object.description ?? "";
+    message.metadata = object.metadata ?? undefined;
+    return message;
+  },
+};
+
+function createBaseAnnotateResponse(): AnnotateResponse {
+  return {};
+}
+
+export const AnnotateResponse: MessageFns<AnnotateResponse> = {
+  encode(_: AnnotateResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
 
-          const el = Algorithm.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.algorithms!.push(el);
-          }
-          continue;
-        }
+  decode(input: BinaryReader | Uint8Array, length?: number): AnnotateResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnnotateResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1945,70 +7997,40 @@ export const ExecutionRequest: MessageFns<ExecutionRequest> = {
     return message;
   },
 
-  fromJSON(object: any): ExecutionRequest {
-    return {
-      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
-      window: isSet(object.window) ? Window.fromJSON(object.window) : undefined,
-      algorithmResults: globalThis.Array.isArray(object?.algorithmResults)
-        ? object.algorithmResults.map((e: any) => AlgorithmResult.fromJSON(e))
-        : [],
-      algorithms: globalThis.Array.isArray(object?.algorithms)
-        ? object.algorithms.map((e: any) => Algorithm.fromJSON(e))
-        : [],
-    };
+  fromJSON(_: any): AnnotateResponse {
+    return {};
   },
 
-  toJSON(message: ExecutionRequest): unknown {
+  toJSON(_: AnnotateResponse): unknown {
     const obj: any = {};
-    if (message.execId !== undefined && message.execId !== "") {
-      obj.execId = message.execId;
-    }
-    if (message.window !== undefined) {
-      obj.window = Window.toJSON(message.window);
-    }
-    if (message.algorithmResults?.length) {
-      obj.algorithmResults = message.algorithmResults.map((e) => AlgorithmResult.toJSON(e));
-    }
-    if (message.algorithms?.length) {
-      obj.algorithms = message.algorithms.map((e) => Algorithm.toJSON(e));
-    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ExecutionRequest>, I>>(base?: I): ExecutionRequest {
-    return ExecutionRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<AnnotateResponse>, I>>(base?: I): AnnotateResponse {
+    return AnnotateResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ExecutionRequest>, I>>(object: I): ExecutionRequest {
-    const message = createBaseExecutionRequest();
-    message.execId = object.execId ?? "";
-    message.window = (object.window !== undefined && object.window !== null)
-      ? Window.fromPartial(object.window)
-      : undefined;
-    message.algorithmResults = object.algorithmResults?.map((e) => AlgorithmResult.fromPartial(e)) || [];
-    message.algorithms = object.algorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<AnnotateResponse>, I>>(_: I): AnnotateResponse {
+    const message = createBaseAnnotateResponse();
     return message;
   },
 };
 
-function createBaseExecutionResult(): ExecutionResult {
-  return { execId: "", algorithmResult: undefined };
+function createBaseExecutionRead(): ExecutionRead {
+  return { execId: "" };
 }
 
-export const ExecutionResult: MessageFns<ExecutionResult> = {
-  encode(message: ExecutionResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const ExecutionRead: MessageFns<ExecutionRead> = {
+  encode(message: ExecutionRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.execId !== undefined && message.execId !== "") {
       writer.uint32(10).string(message.execId);
     }
-    if (message.algorithmResult !== undefined) {
-      AlgorithmResult.encode(message.algorithmResult, writer.uint32(26).fork()).join();
-    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionResult {
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseExecutionResult();
+    const message = createBaseExecutionRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2020,14 +8042,6 @@ export const ExecutionResult: MessageFns<ExecutionResult> = {
           message.execId = reader.string();
           continue;
         }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          message.algorithmResult = AlgorithmResult.decode(reader, reader.uint32());
-          continue;
-        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2037,75 +8051,44 @@ export const ExecutionResult: MessageFns<ExecutionResult> = {
     return message;
   },
 
-  fromJSON(object: any): ExecutionResult {
-    return {
-      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
-      algorithmResult: isSet(object.algorithmResult) ? AlgorithmResult.fromJSON(object.algorithmResult) : undefined,
-    };
+  fromJSON(object: any): ExecutionRead {
+    return { execId: isSet(object.execId) ? globalThis.String(object.execId) : "" };
   },
 
-  toJSON(message: ExecutionResult): unknown {
+  toJSON(message: ExecutionRead): unknown {
     const obj: any = {};
     if (message.execId !== undefined && message.execId !== "") {
       obj.execId = message.execId;
     }
-    if (message.algorithmResult !== undefined) {
-      obj.algorithmResult = AlgorithmResult.toJSON(message.algorithmResult);
-    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ExecutionResult>, I>>(base?: I): ExecutionResult {
-    return ExecutionResult.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ExecutionRead>, I>>(base?: I): ExecutionRead {
+    return ExecutionRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ExecutionResult>, I>>(object: I): ExecutionResult {
-    const message = createBaseExecutionResult();
+  fromPartial<I extends Exact<DeepPartial<ExecutionRead>, I>>(object: I): ExecutionRead {
+    const message = createBaseExecutionRead();
     message.execId = object.execId ?? "";
-    message.algorithmResult = (object.algorithmResult !== undefined && object.algorithmResult !== null)
-      ? AlgorithmResult.fromPartial(object.algorithmResult)
-      : undefined;
     return message;
   },
 };
 
-function createBaseAlgorithmResult(): AlgorithmResult {
-  return { algorithm: undefined, result: undefined };
+function createBaseExecutionQueueRead(): ExecutionQueueRead {
+  return {};
 }
 
-export const AlgorithmResult: MessageFns<AlgorithmResult> = {
-  encode(message: AlgorithmResult, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.algorithm !== undefined) {
-      Algorithm.encode(message.algorithm, writer.uint32(10).fork()).join();
-    }
-    if (message.result !== undefined) {
-      Result.encode(message.result, writer.uint32(18).fork()).join();
-    }
+export const ExecutionQueueRead: MessageFns<ExecutionQueueRead> = {
+  encode(_: ExecutionQueueRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmResult {
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionQueueRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAlgorithmResult();
+    const message = createBaseExecutionQueueRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 10) {
-            break;
-          }
-
-          message.algorithm = Algorithm.decode(reader, reader.uint32());
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
-
-          message.result = Result.decode(reader, reader.uint32());
-          continue;
-        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2115,58 +8098,52 @@ export const AlgorithmResult: MessageFns<AlgorithmResult> = {
     return message;
   },
 
-  fromJSON(object: any): AlgorithmResult {
-    return {
-      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
-      result: isSet(object.result) ? Result.fromJSON(object.result) : undefined,
-    };
+  fromJSON(_: any): ExecutionQueueRead {
+    return {};
   },
 
-  toJSON(message: AlgorithmResult): unknown {
+  toJSON(_: ExecutionQueueRead): unknown {
     const obj: any = {};
-    if (message.algorithm !== undefined) {
-      obj.algorithm = Algorithm.toJSON(message.algorithm);
-    }
-    if (message.result !== undefined) {
-      obj.result = Result.toJSON(message.result);
-    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AlgorithmResult>, I>>(base?: I): AlgorithmResult {
-    return AlgorithmResult.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ExecutionQueueRead>, I>>(base?: I): ExecutionQueueRead {
+    return ExecutionQueueRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AlgorithmResult>, I>>(object: I): AlgorithmResult {
-    const message = createBaseAlgorithmResult();
-    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
-      ? Algorithm.fromPartial(object.algorithm)
-      : undefined;
-    message.result = (object.result !== undefined && object.result !== null)
-      ? Result.fromPartial(object.result)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<ExecutionQueueRead>, I>>(_: I): ExecutionQueueRead {
+    const message = createBaseExecutionQueueRead();
     return message;
   },
 };
 
-function createBaseStatus(): Status {
-  return { received: false, message: "" };
+function createBaseExecutionQueue(): ExecutionQueue {
+  return { workers: 0, active: 0, queued: 0, queueSize: 0, saturation: 0 };
 }
 
-export const Status: MessageFns<Status> = {
-  encode(message: Status, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.received !== undefined && message.received !== false) {
-      writer.uint32(8).bool(message.received);
+export const ExecutionQueue: MessageFns<ExecutionQueue> = {
+  encode(message: ExecutionQueue, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.workers !== undefined && message.workers !== 0) {
+      writer.uint32(8).int32(message.workers);
     }
-    if (message.message !== undefined && message.message !== "") {
-      writer.uint32(18).string(message.message);
+    if (message.active !== undefined && message.active !== 0) {
+      writer.uint32(16).int32(message.active);
+    }
+    if (message.queued !== undefined && message.queued !== 0) {
+      writer.uint32(24).int32(message.queued);
+    }
+    if (message.queueSize !== undefined && message.queueSize !== 0) {
+      writer.uint32(32).int32(message.queueSize);
+    }
+    if (message.saturation !== undefined && message.saturation !== 0) {
+      writer.uint32(41).double(message.saturation);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Status {
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionQueue {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseStatus();
+    const message = createBaseExecutionQueue();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2175,15 +8152,39 @@ export const Status: MessageFns<Status> = {
             break;
           }
 
-          message.received = reader.bool();
+          message.workers = reader.int32();
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.message = reader.string();
+          message.active = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.queued = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.queueSize = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.saturation = reader.double();
           continue;
         }
       }
@@ -2195,60 +8196,75 @@ export const Status: MessageFns<Status> = {
     return message;
   },
 
-  fromJSON(object: any): Status {
+  fromJSON(object: any): ExecutionQueue {
     return {
-      received: isSet(object.received) ? globalThis.Boolean(object.received) : false,
-      message: isSet(object.message) ? globalThis.String(object.message) : "",
+      workers: isSet(object.workers) ? globalThis.Number(object.workers) : 0,
+      active: isSet(object.active) ? globalThis.Number(object.active) : 0,
+      queued: isSet(object.queued) ? globalThis.Number(object.queued) : 0,
+      queueSize: isSet(object.queueSize) ? globalThis.Number(object.queueSize) : 0,
+      saturation: isSet(object.saturation) ? globalThis.Number(object.saturation) : 0,
     };
   },
 
-  toJSON(message: Status): unknown {
+  toJSON(message: ExecutionQueue): unknown {
     const obj: any = {};
-    if (message.received !== undefined && message.received !== false) {
-      obj.received = message.received;
+    if (message.workers !== undefined && message.workers !== 0) {
+      obj.workers = Math.round(message.workers);
     }
-    if (message.message !== undefined && message.message !== "") {
-      obj.message = message.message;
+    if (message.active !== undefined && message.active !== 0) {
+      obj.active = Math.round(message.active);
+    }
+    if (message.queued !== undefined && message.queued !== 0) {
+      obj.queued = Math.round(message.queued);
+    }
+    if (message.queueSize !== undefined && message.queueSize !== 0) {
+      obj.queueSize = Math.round(message.queueSize);
+    }
+    if (message.saturation !== undefined && message.saturation !== 0) {
+      obj.saturation = message.saturation;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Status>, I>>(base?: I): Status {
-    return Status.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ExecutionQueue>, I>>(base?: I): ExecutionQueue {
+    return ExecutionQueue.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Status>, I>>(object: I): Status {
-    const message = createBaseStatus();
-    message.received = object.received ?? false;
-    message.message = object.message ?? "";
+  fromPartial<I extends Exact<DeepPartial<ExecutionQueue>, I>>(object: I): ExecutionQueue {
+    const message = createBaseExecutionQueue();
+    message.workers = object.workers ?? 0;
+    message.active = object.active ?? 0;
+    message.queued = object.queued ?? 0;
+    message.queueSize = object.queueSize ?? 0;
+    message.saturation = object.saturation ?? 0;
     return message;
   },
 };
 
-function createBaseHealthCheckRequest(): HealthCheckRequest {
-  return { timestamp: "0" };
+function createBaseExecutionCancel(): ExecutionCancel {
+  return { execId: "" };
 }
 
-export const HealthCheckRequest: MessageFns<HealthCheckRequest> = {
-  encode(message: HealthCheckRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.timestamp !== undefined && message.timestamp !== "0") {
-      writer.uint32(8).int64(message.timestamp);
+export const ExecutionCancel: MessageFns<ExecutionCancel> = {
+  encode(message: ExecutionCancel, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.execId !== undefined && message.execId !== "") {
+      writer.uint32(10).string(message.execId);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): HealthCheckRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionCancel {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseHealthCheckRequest();
+    const message = createBaseExecutionCancel();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.timestamp = reader.int64().toString();
+          message.execId = reader.string();
           continue;
         }
       }
@@ -2260,59 +8276,62 @@ export const HealthCheckRequest: MessageFns<HealthCheckRequest> = {
     return message;
   },
 
-  fromJSON(object: any): HealthCheckRequest {
-    return { timestamp: isSet(object.timestamp) ? globalThis.String(object.timestamp) : "0" };
+  fromJSON(object: any): ExecutionCancel {
+    return { execId: isSet(object.execId) ? globalThis.String(object.execId) : "" };
   },
 
-  toJSON(message: HealthCheckRequest): unknown {
+  toJSON(message: ExecutionCancel): unknown {
     const obj: any = {};
-    if (message.timestamp !== undefined && message.timestamp !== "0") {
-      obj.timestamp = message.timestamp;
+    if (message.execId !== undefined && message.execId !== "") {
+      obj.execId = message.execId;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<HealthCheckRequest>, I>>(base?: I): HealthCheckRequest {
-    return HealthCheckRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ExecutionCancel>, I>>(base?: I): ExecutionCancel {
+    return ExecutionCancel.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<HealthCheckRequest>, I>>(object: I): HealthCheckRequest {
-    const message = createBaseHealthCheckRequest();
-    message.timestamp = object.timestamp ?? "0";
+  fromPartial<I extends Exact<DeepPartial<ExecutionCancel>, I>>(object: I): ExecutionCancel {
+    const message = createBaseExecutionCancel();
+    message.execId = object.execId ?? "";
     return message;
   },
 };
 
-function createBaseHealthCheckResponse(): HealthCheckResponse {
-  return { status: 0, message: "", metrics: undefined };
+function createBaseExecutionsRead(): ExecutionsRead {
+  return { timeFrom: undefined, timeTo: undefined, window: undefined, status: 0 };
 }
 
-export const HealthCheckResponse: MessageFns<HealthCheckResponse> = {
-  encode(message: HealthCheckResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.status !== undefined && message.status !== 0) {
-      writer.uint32(8).int32(message.status);
+export const ExecutionsRead: MessageFns<ExecutionsRead> = {
+  encode(message: ExecutionsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
     }
-    if (message.message !== undefined && message.message !== "") {
-      writer.uint32(18).string(message.message);
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
     }
-    if (message.metrics !== undefined) {
-      ProcessorMetrics.encode(message.metrics, writer.uint32(26).fork()).join();
+    if (message.window !== undefined) {
+      WindowType.encode(message.window, writer.uint32(26).fork()).join();
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(32).int32(message.status);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): HealthCheckResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionsRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseHealthCheckResponse();
+    const message = createBaseExecutionsRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.status = reader.int32() as any;
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
@@ -2320,7 +8339,7 @@ export const HealthCheckResponse: MessageFns<HealthCheckResponse> = {
             break;
           }
 
-          message.message = reader.string();
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
         case 3: {
@@ -2328,7 +8347,15 @@ export const HealthCheckResponse: MessageFns<HealthCheckResponse> = {
             break;
           }
 
-          message.metrics = ProcessorMetrics.decode(reader, reader.uint32());
+          message.window = WindowType.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
           continue;
         }
       }
@@ -2340,67 +8367,89 @@ export const HealthCheckResponse: MessageFns<HealthCheckResponse> = {
     return message;
   },
 
-  fromJSON(object: any): HealthCheckResponse {
+  fromJSON(object: any): ExecutionsRead {
     return {
-      status: isSet(object.status) ? healthCheckResponse_StatusFromJSON(object.status) : 0,
-      message: isSet(object.message) ? globalThis.String(object.message) : "",
-      metrics: isSet(object.metrics) ? ProcessorMetrics.fromJSON(object.metrics) : undefined,
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      window: isSet(object.window) ? WindowType.fromJSON(object.window) : undefined,
+      status: isSet(object.status) ? executionStatusFromJSON(object.status) : 0,
     };
   },
 
-  toJSON(message: HealthCheckResponse): unknown {
+  toJSON(message: ExecutionsRead): unknown {
     const obj: any = {};
-    if (message.status !== undefined && message.status !== 0) {
-      obj.status = healthCheckResponse_StatusToJSON(message.status);
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
     }
-    if (message.message !== undefined && message.message !== "") {
-      obj.message = message.message;
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
     }
-    if (message.metrics !== undefined) {
-      obj.metrics = ProcessorMetrics.toJSON(message.metrics);
+    if (message.window !== undefined) {
+      obj.window = WindowType.toJSON(message.window);
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = executionStatusToJSON(message.status);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<HealthCheckResponse>, I>>(base?: I): HealthCheckResponse {
-    return HealthCheckResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ExecutionsRead>, I>>(base?: I): ExecutionsRead {
+    return ExecutionsRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<HealthCheckResponse>, I>>(object: I): HealthCheckResponse {
-    const message = createBaseHealthCheckResponse();
-    message.status = object.status ?? 0;
-    message.message = object.message ?? "";
-    message.metrics = (object.metrics !== undefined && object.metrics !== null)
-      ? ProcessorMetrics.fromPartial(object.metrics)
+  fromPartial<I extends Exact<DeepPartial<ExecutionsRead>, I>>(object: I): ExecutionsRead {
+    const message = createBaseExecutionsRead();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.window = (object.window !== undefined && object.window !== null)
+      ? WindowType.fromPartial(object.window)
       : undefined;
+    message.status = object.status ?? 0;
     return message;
   },
 };
 
-function createBaseProcessorMetrics(): ProcessorMetrics {
-  return { activeTasks: 0, memoryBytes: "0", cpuPercent: 0, uptimeSeconds: "0" };
+function createBaseExecutionAttempt(): ExecutionAttempt {
+  return {
+    attempt: 0,
+    status: 0,
+    errorCode: "",
+    errorMessage: "",
+    backoffMs: "0",
+    started: undefined,
+    finished: undefined,
+  };
 }
 
-export const ProcessorMetrics: MessageFns<ProcessorMetrics> = {
-  encode(message: ProcessorMetrics, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.activeTasks !== undefined && message.activeTasks !== 0) {
-      writer.uint32(8).int32(message.activeTasks);
+export const ExecutionAttempt: MessageFns<ExecutionAttempt> = {
+  encode(message: ExecutionAttempt, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.attempt !== undefined && message.attempt !== 0) {
+      writer.uint32(8).int32(message.attempt);
     }
-    if (message.memoryBytes !== undefined && message.memoryBytes !== "0") {
-      writer.uint32(16).int64(message.memoryBytes);
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(16).int32(message.status);
     }
-    if (message.cpuPercent !== undefined && message.cpuPercent !== 0) {
-      writer.uint32(29).float(message.cpuPercent);
+    if (message.errorCode !== undefined && message.errorCode !== "") {
+      writer.uint32(26).string(message.errorCode);
     }
-    if (message.uptimeSeconds !== undefined && message.uptimeSeconds !== "0") {
-      writer.uint32(32).int64(message.uptimeSeconds);
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      writer.uint32(34).string(message.errorMessage);
+    }
+    if (message.backoffMs !== undefined && message.backoffMs !== "0") {
+      writer.uint32(40).int64(message.backoffMs);
+    }
+    if (message.started !== undefined) {
+      Timestamp.encode(toTimestamp(message.started), writer.uint32(50).fork()).join();
+    }
+    if (message.finished !== undefined) {
+      Timestamp.encode(toTimestamp(message.finished), writer.uint32(58).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ProcessorMetrics {
+  decode(input: BinaryReader | Uint8Array, length?: number): ExecutionAttempt {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcessorMetrics();
+    const message = createBaseExecutionAttempt();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2409,7 +8458,7 @@ export const ProcessorMetrics: MessageFns<ProcessorMetrics> = {
             break;
           }
 
-          message.activeTasks = reader.int32();
+          message.attempt = reader.int32();
           continue;
         }
         case 2: {
@@ -2417,23 +8466,47 @@ export const ProcessorMetrics: MessageFns<ProcessorMetrics> = {
             break;
           }
 
-          message.memoryBytes = reader.int64().toString();
+          message.status = reader.int32() as any;
           continue;
         }
         case 3: {
-          if (tag !== 29) {
+          if (tag !== 26) {
             break;
           }
 
-          message.cpuPercent = reader.float();
+          message.errorCode = reader.string();
           continue;
         }
         case 4: {
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.uptimeSeconds = reader.int64().toString();
+          message.errorMessage = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.backoffMs = reader.int64().toString();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.started = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.finished = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -2445,61 +8518,178 @@ export const ProcessorMetrics: MessageFns<ProcessorMetrics> = {
     return message;
   },
 
-  fromJSON(object: any): ProcessorMetrics {
+  fromJSON(object: any): ExecutionAttempt {
     return {
-      activeTasks: isSet(object.activeTasks) ? globalThis.Number(object.activeTasks) : 0,
-      memoryBytes: isSet(object.memoryBytes) ? globalThis.String(object.memoryBytes) : "0",
-      cpuPercent: isSet(object.cpuPercent) ? globalThis.Number(object.cpuPercent) : 0,
-      uptimeSeconds: isSet(object.uptimeSeconds) ? globalThis.String(object.uptimeSeconds) : "0",
+      attempt: isSet(object.attempt) ? globalThis.Number(object.attempt) : 0,
+      status: isSet(object.status) ? executionStatusFromJSON(object.status) : 0,
+      errorCode: isSet(object.errorCode) ? globalThis.String(object.errorCode) : "",
+      errorMessage: isSet(object.errorMessage) ? globalThis.String(object.errorMessage) : "",
+      backoffMs: isSet(object.backoffMs) ? globalThis.String(object.backoffMs) : "0",
+      started: isSet(object.started) ? fromJsonTimestamp(object.started) : undefined,
+      finished: isSet(object.finished) ? fromJsonTimestamp(object.finished) : undefined,
     };
   },
 
-  toJSON(message: ProcessorMetrics): unknown {
+  toJSON(message: ExecutionAttempt): unknown {
     const obj: any = {};
-    if (message.activeTasks !== undefined && message.activeTasks !== 0) {
-      obj.activeTasks = Math.round(message.activeTasks);
+    if (message.attempt !== undefined && message.attempt !== 0) {
+      obj.attempt = Math.round(message.attempt);
     }
-    if (message.memoryBytes !== undefined && message.memoryBytes !== "0") {
-      obj.memoryBytes = message.memoryBytes;
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = executionStatusToJSON(message.status);
     }
-    if (message.cpuPercent !== undefined && message.cpuPercent !== 0) {
-      obj.cpuPercent = message.cpuPercent;
+    if (message.errorCode !== undefined && message.errorCode !== "") {
+      obj.errorCode = message.errorCode;
     }
-    if (message.uptimeSeconds !== undefined && message.uptimeSeconds !== "0") {
-      obj.uptimeSeconds = message.uptimeSeconds;
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      obj.errorMessage = message.errorMessage;
+    }
+    if (message.backoffMs !== undefined && message.backoffMs !== "0") {
+      obj.backoffMs = message.backoffMs;
+    }
+    if (message.started !== undefined) {
+      obj.started = message.started.toISOString();
+    }
+    if (message.finished !== undefined) {
+      obj.finished = message.finished.toISOString();
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ProcessorMetrics>, I>>(base?: I): ProcessorMetrics {
-    return ProcessorMetrics.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ExecutionAttempt>, I>>(base?: I): ExecutionAttempt {
+    return ExecutionAttempt.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ProcessorMetrics>, I>>(object: I): ProcessorMetrics {
-    const message = createBaseProcessorMetrics();
-    message.activeTasks = object.activeTasks ?? 0;
-    message.memoryBytes = object.memoryBytes ?? "0";
-    message.cpuPercent = object.cpuPercent ?? 0;
-    message.uptimeSeconds = object.uptimeSeconds ?? "0";
+  fromPartial<I extends Exact<DeepPartial<ExecutionAttempt>, I>>(object: I): ExecutionAttempt {
+    const message = createBaseExecutionAttempt();
+    message.attempt = object.attempt ?? 0;
+    message.status = object.status ?? 0;
+    message.errorCode = object.errorCode ?? "";
+    message.errorMessage = object.errorMessage ?? "";
+    message.backoffMs = object.backoffMs ?? "0";
+    message.started = object.started ?? undefined;
+    message.finished = object.finished ?? undefined;
     return message;
   },
 };
 
-function createBaseWindowTypeRead(): WindowTypeRead {
-  return {};
+function createBaseAlgorithmExecution(): AlgorithmExecution {
+  return {
+    algorithm: undefined,
+    processorName: "",
+    processorRuntime: "",
+    status: 0,
+    started: undefined,
+    finished: undefined,
+    errorMessage: "",
+    attempts: [],
+  };
 }
 
-export const WindowTypeRead: MessageFns<WindowTypeRead> = {
-  encode(_: WindowTypeRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const AlgorithmExecution: MessageFns<AlgorithmExecution> = {
+  encode(message: AlgorithmExecution, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(10).fork()).join();
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      writer.uint32(18).string(message.processorName);
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      writer.uint32(26).string(message.processorRuntime);
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(32).int32(message.status);
+    }
+    if (message.started !== undefined) {
+      Timestamp.encode(toTimestamp(message.started), writer.uint32(42).fork()).join();
+    }
+    if (message.finished !== undefined) {
+      Timestamp.encode(toTimestamp(message.finished), writer.uint32(50).fork()).join();
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      writer.uint32(58).string(message.errorMessage);
+    }
+    if (message.attempts !== undefined && message.attempts.length !== 0) {
+      for (const v of message.attempts) {
+        ExecutionAttempt.encode(v!, writer.uint32(66).fork()).join();
+      }
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowTypeRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmExecution {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowTypeRead();
+    const message = createBaseAlgorithmExecution();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.processorName = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.processorRuntime = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.started = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.finished = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.errorMessage = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          const el = ExecutionAttempt.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.attempts!.push(el);
+          }
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2509,42 +8699,117 @@ export const WindowTypeRead: MessageFns<WindowTypeRead> = {
     return message;
   },
 
-  fromJSON(_: any): WindowTypeRead {
-    return {};
+  fromJSON(object: any): AlgorithmExecution {
+    return {
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      processorName: isSet(object.processorName) ? globalThis.String(object.processorName) : "",
+      processorRuntime: isSet(object.processorRuntime) ? globalThis.String(object.processorRuntime) : "",
+      status: isSet(object.status) ? executionStatusFromJSON(object.status) : 0,
+      started: isSet(object.started) ? fromJsonTimestamp(object.started) : undefined,
+      finished: isSet(object.finished) ? fromJsonTimestamp(object.finished) : undefined,
+      errorMessage: isSet(object.errorMessage) ? globalThis.String(object.errorMessage) : "",
+      attempts: globalThis.Array.isArray(object?.attempts)
+        ? object.attempts.map((e: any) => ExecutionAttempt.fromJSON(e))
+        : [],
+    };
   },
 
-  toJSON(_: WindowTypeRead): unknown {
+  toJSON(message: AlgorithmExecution): unknown {
     const obj: any = {};
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      obj.processorName = message.processorName;
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      obj.processorRuntime = message.processorRuntime;
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = executionStatusToJSON(message.status);
+    }
+    if (message.started !== undefined) {
+      obj.started = message.started.toISOString();
+    }
+    if (message.finished !== undefined) {
+      obj.finished = message.finished.toISOString();
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      obj.errorMessage = message.errorMessage;
+    }
+    if (message.attempts?.length) {
+      obj.attempts = message.attempts.map((e) => ExecutionAttempt.toJSON(e));
+    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<WindowTypeRead>, I>>(base?: I): WindowTypeRead {
-    return WindowTypeRead.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<AlgorithmExecution>, I>>(base?: I): AlgorithmExecution {
+    return AlgorithmExecution.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<WindowTypeRead>, I>>(_: I): WindowTypeRead {
-    const message = createBaseWindowTypeRead();
+  fromPartial<I extends Exact<DeepPartial<AlgorithmExecution>, I>>(object: I): AlgorithmExecution {
+    const message = createBaseAlgorithmExecution();
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.processorName = object.processorName ?? "";
+    message.processorRuntime = object.processorRuntime ?? "";
+    message.status = object.status ?? 0;
+    message.started = object.started ?? undefined;
+    message.finished = object.finished ?? undefined;
+    message.errorMessage = object.errorMessage ?? "";
+    message.attempts = object.attempts?.map((e) => ExecutionAttempt.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseWindowTypes(): WindowTypes {
-  return { windows: [] };
+function createBaseExecution(): Execution {
+  return {
+    execId: "",
+    window: undefined,
+    status: 0,
+    created: undefined,
+    started: undefined,
+    finished: undefined,
+    algorithms: [],
+    provisional: false,
+  };
 }
 
-export const WindowTypes: MessageFns<WindowTypes> = {
-  encode(message: WindowTypes, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.windows !== undefined && message.windows.length !== 0) {
-      for (const v of message.windows) {
-        WindowType.encode(v!, writer.uint32(10).fork()).join();
+export const Execution: MessageFns<Execution> = {
+  encode(message: Execution, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.execId !== undefined && message.execId !== "") {
+      writer.uint32(10).string(message.execId);
+    }
+    if (message.window !== undefined) {
+      Window.encode(message.window, writer.uint32(18).fork()).join();
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(24).int32(message.status);
+    }
+    if (message.created !== undefined) {
+      Timestamp.encode(toTimestamp(message.created), writer.uint32(34).fork()).join();
+    }
+    if (message.started !== undefined) {
+      Timestamp.encode(toTimestamp(message.started), writer.uint32(42).fork()).join();
+    }
+    if (message.finished !== undefined) {
+      Timestamp.encode(toTimestamp(message.finished), writer.uint32(50).fork()).join();
+    }
+    if (message.algorithms !== undefined && message.algorithms.length !== 0) {
+      for (const v of message.algorithms) {
+        AlgorithmExecution.encode(v!, writer.uint32(58).fork()).join();
       }
     }
+    if (message.provisional !== undefined && message.provisional !== false) {
+      writer.uint32(64).bool(message.provisional);
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowTypes {
+  decode(input: BinaryReader | Uint8Array, length?: number): Execution {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowTypes();
+    const message = createBaseExecution();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2553,61 +8818,68 @@ export const WindowTypes: MessageFns<WindowTypes> = {
             break;
           }
 
-          const el = WindowType.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.windows!.push(el);
+          message.execId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
           }
+
+          message.window = Window.decode(reader, reader.uint32());
           continue;
         }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
 
-  fromJSON(object: any): WindowTypes {
-    return {
-      windows: globalThis.Array.isArray(object?.windows) ? object.windows.map((e: any) => WindowType.fromJSON(e)) : [],
-    };
-  },
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
 
-  toJSON(message: WindowTypes): unknown {
-    const obj: any = {};
-    if (message.windows?.length) {
-      obj.windows = message.windows.map((e) => WindowType.toJSON(e));
-    }
-    return obj;
-  },
+          message.created = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
 
-  create<I extends Exact<DeepPartial<WindowTypes>, I>>(base?: I): WindowTypes {
-    return WindowTypes.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<WindowTypes>, I>>(object: I): WindowTypes {
-    const message = createBaseWindowTypes();
-    message.windows = object.windows?.map((e) => WindowType.fromPartial(e)) || [];
-    return message;
-  },
-};
+          message.started = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
 
-function createBaseAlgorithmsRead(): AlgorithmsRead {
-  return {};
-}
+          message.finished = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
 
-export const AlgorithmsRead: MessageFns<AlgorithmsRead> = {
-  encode(_: AlgorithmsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    return writer;
-  },
+          const el = AlgorithmExecution.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.algorithms!.push(el);
+          }
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmsRead {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAlgorithmsRead();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
+          message.provisional = reader.bool();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2617,42 +8889,87 @@ export const AlgorithmsRead: MessageFns<AlgorithmsRead> = {
     return message;
   },
 
-  fromJSON(_: any): AlgorithmsRead {
-    return {};
+  fromJSON(object: any): Execution {
+    return {
+      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
+      window: isSet(object.window) ? Window.fromJSON(object.window) : undefined,
+      status: isSet(object.status) ? executionStatusFromJSON(object.status) : 0,
+      created: isSet(object.created) ? fromJsonTimestamp(object.created) : undefined,
+      started: isSet(object.started) ? fromJsonTimestamp(object.started) : undefined,
+      finished: isSet(object.finished) ? fromJsonTimestamp(object.finished) : undefined,
+      algorithms: globalThis.Array.isArray(object?.algorithms)
+        ? object.algorithms.map((e: any) => AlgorithmExecution.fromJSON(e))
+        : [],
+      provisional: isSet(object.provisional) ? globalThis.Boolean(object.provisional) : false,
+    };
   },
 
-  toJSON(_: AlgorithmsRead): unknown {
+  toJSON(message: Execution): unknown {
     const obj: any = {};
+    if (message.execId !== undefined && message.execId !== "") {
+      obj.execId = message.execId;
+    }
+    if (message.window !== undefined) {
+      obj.window = Window.toJSON(message.window);
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = executionStatusToJSON(message.status);
+    }
+    if (message.created !== undefined) {
+      obj.created = message.created.toISOString();
+    }
+    if (message.started !== undefined) {
+      obj.started = message.started.toISOString();
+    }
+    if (message.finished !== undefined) {
+      obj.finished = message.finished.toISOString();
+    }
+    if (message.algorithms?.length) {
+      obj.algorithms = message.algorithms.map((e) => AlgorithmExecution.toJSON(e));
+    }
+    if (message.provisional !== undefined && message.provisional !== false) {
+      obj.provisional = message.provisional;
+    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AlgorithmsRead>, I>>(base?: I): AlgorithmsRead {
-    return AlgorithmsRead.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Execution>, I>>(base?: I): Execution {
+    return Execution.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AlgorithmsRead>, I>>(_: I): AlgorithmsRead {
-    const message = createBaseAlgorithmsRead();
+  fromPartial<I extends Exact<DeepPartial<Execution>, I>>(object: I): Execution {
+    const message = createBaseExecution();
+    message.execId = object.execId ?? "";
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Window.fromPartial(object.window)
+      : undefined;
+    message.status = object.status ?? 0;
+    message.created = object.created ?? undefined;
+    message.started = object.started ?? undefined;
+    message.finished = object.finished ?? undefined;
+    message.algorithms = object.algorithms?.map((e) => AlgorithmExecution.fromPartial(e)) || [];
+    message.provisional = object.provisional ?? false;
     return message;
   },
 };
 
-function createBaseAlgorithms(): Algorithms {
-  return { algorithm: [] };
+function createBaseExecutions(): Executions {
+  return { executions: [] };
 }
 
-export const Algorithms: MessageFns<Algorithms> = {
-  encode(message: Algorithms, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.algorithm !== undefined && message.algorithm.length !== 0) {
-      for (const v of message.algorithm) {
-        Algorithm.encode(v!, writer.uint32(10).fork()).join();
+export const Executions: MessageFns<Executions> = {
+  encode(message: Executions, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.executions !== undefined && message.executions.length !== 0) {
+      for (const v of message.executions) {
+        Execution.encode(v!, writer.uint32(10).fork()).join();
       }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Algorithms {
+  decode(input: BinaryReader | Uint8Array, length?: number): Executions {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAlgorithms();
+    const message = createBaseExecutions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2661,9 +8978,9 @@ export const Algorithms: MessageFns<Algorithms> = {
             break;
           }
 
-          const el = Algorithm.decode(reader, reader.uint32());
+          const el = Execution.decode(reader, reader.uint32());
           if (el !== undefined) {
-            message.algorithm!.push(el);
+            message.executions!.push(el);
           }
           continue;
         }
@@ -2676,48 +8993,126 @@ export const Algorithms: MessageFns<Algorithms> = {
     return message;
   },
 
-  fromJSON(object: any): Algorithms {
+  fromJSON(object: any): Executions {
     return {
-      algorithm: globalThis.Array.isArray(object?.algorithm)
-        ? object.algorithm.map((e: any) => Algorithm.fromJSON(e))
+      executions: globalThis.Array.isArray(object?.executions)
+        ? object.executions.map((e: any) => Execution.fromJSON(e))
         : [],
     };
   },
 
-  toJSON(message: Algorithms): unknown {
+  toJSON(message: Executions): unknown {
     const obj: any = {};
-    if (message.algorithm?.length) {
-      obj.algorithm = message.algorithm.map((e) => Algorithm.toJSON(e));
+    if (message.executions?.length) {
+      obj.executions = message.executions.map((e) => Execution.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Algorithms>, I>>(base?: I): Algorithms {
-    return Algorithms.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Executions>, I>>(base?: I): Executions {
+    return Executions.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Algorithms>, I>>(object: I): Algorithms {
-    const message = createBaseAlgorithms();
-    message.algorithm = object.algorithm?.map((e) => Algorithm.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<Executions>, I>>(object: I): Executions {
+    const message = createBaseExecutions();
+    message.executions = object.executions?.map((e) => Execution.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseProcessorsRead(): ProcessorsRead {
-  return {};
+function createBaseWindowsReprocess(): WindowsReprocess {
+  return {
+    timeFrom: undefined,
+    timeTo: undefined,
+    window: undefined,
+    metadata: undefined,
+    algorithms: [],
+    windowsPerSecond: 0,
+  };
 }
 
-export const ProcessorsRead: MessageFns<ProcessorsRead> = {
-  encode(_: ProcessorsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const WindowsReprocess: MessageFns<WindowsReprocess> = {
+  encode(message: WindowsReprocess, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.window !== undefined) {
+      WindowType.encode(message.window, writer.uint32(26).fork()).join();
+    }
+    if (message.metadata !== undefined) {
+      Struct.encode(Struct.wrap(message.metadata), writer.uint32(34).fork()).join();
+    }
+    if (message.algorithms !== undefined && message.algorithms.length !== 0) {
+      for (const v of message.algorithms) {
+        Algorithm.encode(v!, writer.uint32(42).fork()).join();
+      }
+    }
+    if (message.windowsPerSecond !== undefined && message.windowsPerSecond !== 0) {
+      writer.uint32(49).double(message.windowsPerSecond);
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ProcessorsRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowsReprocess {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcessorsRead();
+    const message = createBaseWindowsReprocess();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.window = WindowType.decode(reader, reader.uint32());
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          const el = Algorithm.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.algorithms!.push(el);
+          }
+          continue;
+        }
+        case 6: {
+          if (tag !== 49) {
+            break;
+          }
+
+          message.windowsPerSecond = reader.double();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2727,42 +9122,75 @@ export const ProcessorsRead: MessageFns<ProcessorsRead> = {
     return message;
   },
 
-  fromJSON(_: any): ProcessorsRead {
-    return {};
+  fromJSON(object: any): WindowsReprocess {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      window: isSet(object.window) ? WindowType.fromJSON(object.window) : undefined,
+      metadata: isObject(object.metadata) ? object.metadata : undefined,
+      algorithms: globalThis.Array.isArray(object?.algorithms)
+        ? object.algorithms.map((e: any) => Algorithm.fromJSON(e))
+        : [],
+      windowsPerSecond: isSet(object.windowsPerSecond) ? globalThis.Number(object.windowsPerSecond) : 0,
+    };
   },
 
-  toJSON(_: ProcessorsRead): unknown {
+  toJSON(message: WindowsReprocess): unknown {
     const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.window !== undefined) {
+      obj.window = WindowType.toJSON(message.window);
+    }
+    if (message.metadata !== undefined) {
+      obj.metadata = message.metadata;
+    }
+    if (message.algorithms?.length) {
+      obj.algorithms = message.algorithms.map((e) => Algorithm.toJSON(e));
+    }
+    if (message.windowsPerSecond !== undefined && message.windowsPerSecond !== 0) {
+      obj.windowsPerSecond = message.windowsPerSecond;
+    }
     return obj;
   },
-
-  create<I extends Exact<DeepPartial<ProcessorsRead>, I>>(base?: I): ProcessorsRead {
-    return ProcessorsRead.fromPartial(base ?? ({} as any));
+
+  create<I extends Exact<DeepPartial<WindowsReprocess>, I>>(base?: I): WindowsReprocess {
+    return WindowsReprocess.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ProcessorsRead>, I>>(_: I): ProcessorsRead {
-    const message = createBaseProcessorsRead();
+  fromPartial<I extends Exact<DeepPartial<WindowsReprocess>, I>>(object: I): WindowsReprocess {
+    const message = createBaseWindowsReprocess();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.window = (object.window !== undefined && object.window !== null)
+      ? WindowType.fromPartial(object.window)
+      : undefined;
+    message.metadata = object.metadata ?? undefined;
+    message.algorithms = object.algorithms?.map((e) => Algorithm.fromPartial(e)) || [];
+    message.windowsPerSecond = object.windowsPerSecond ?? 0;
     return message;
   },
 };
 
-function createBaseProcessors(): Processors {
-  return { processor: [] };
+function createBaseReprocessRead(): ReprocessRead {
+  return { reprocessId: "" };
 }
 
-export const Processors: MessageFns<Processors> = {
-  encode(message: Processors, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.processor !== undefined && message.processor.length !== 0) {
-      for (const v of message.processor) {
-        Processors_Processor.encode(v!, writer.uint32(10).fork()).join();
-      }
+export const ReprocessRead: MessageFns<ReprocessRead> = {
+  encode(message: ReprocessRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.reprocessId !== undefined && message.reprocessId !== "") {
+      writer.uint32(10).string(message.reprocessId);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Processors {
+  decode(input: BinaryReader | Uint8Array, length?: number): ReprocessRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcessors();
+    const message = createBaseReprocessRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2771,10 +9199,7 @@ export const Processors: MessageFns<Processors> = {
             break;
           }
 
-          const el = Processors_Processor.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.processor!.push(el);
-          }
+          message.reprocessId = reader.string();
           continue;
         }
       }
@@ -2786,51 +9211,78 @@ export const Processors: MessageFns<Processors> = {
     return message;
   },
 
-  fromJSON(object: any): Processors {
-    return {
-      processor: globalThis.Array.isArray(object?.processor)
-        ? object.processor.map((e: any) => Processors_Processor.fromJSON(e))
-        : [],
-    };
+  fromJSON(object: any): ReprocessRead {
+    return { reprocessId: isSet(object.reprocessId) ? globalThis.String(object.reprocessId) : "" };
   },
 
-  toJSON(message: Processors): unknown {
+  toJSON(message: ReprocessRead): unknown {
     const obj: any = {};
-    if (message.processor?.length) {
-      obj.processor = message.processor.map((e) => Processors_Processor.toJSON(e));
+    if (message.reprocessId !== undefined && message.reprocessId !== "") {
+      obj.reprocessId = message.reprocessId;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Processors>, I>>(base?: I): Processors {
-    return Processors.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ReprocessRead>, I>>(base?: I): ReprocessRead {
+    return ReprocessRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Processors>, I>>(object: I): Processors {
-    const message = createBaseProcessors();
-    message.processor = object.processor?.map((e) => Processors_Processor.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<ReprocessRead>, I>>(object: I): ReprocessRead {
+    const message = createBaseReprocessRead();
+    message.reprocessId = object.reprocessId ?? "";
     return message;
   },
 };
 
-function createBaseProcessors_Processor(): Processors_Processor {
-  return { name: "", runtime: "" };
+function createBaseReprocess(): Reprocess {
+  return {
+    reprocessId: "",
+    status: 0,
+    totalWindows: 0,
+    triggeredWindows: 0,
+    succeededWindows: 0,
+    failedWindows: 0,
+    created: undefined,
+    finished: undefined,
+    errorMessage: "",
+  };
 }
 
-export const Processors_Processor: MessageFns<Processors_Processor> = {
-  encode(message: Processors_Processor, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.name !== undefined && message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const Reprocess: MessageFns<Reprocess> = {
+  encode(message: Reprocess, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.reprocessId !== undefined && message.reprocessId !== "") {
+      writer.uint32(10).string(message.reprocessId);
     }
-    if (message.runtime !== undefined && message.runtime !== "") {
-      writer.uint32(18).string(message.runtime);
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(16).int32(message.status);
+    }
+    if (message.totalWindows !== undefined && message.totalWindows !== 0) {
+      writer.uint32(24).int32(message.totalWindows);
+    }
+    if (message.triggeredWindows !== undefined && message.triggeredWindows !== 0) {
+      writer.uint32(32).int32(message.triggeredWindows);
+    }
+    if (message.succeededWindows !== undefined && message.succeededWindows !== 0) {
+      writer.uint32(40).int32(message.succeededWindows);
+    }
+    if (message.failedWindows !== undefined && message.failedWindows !== 0) {
+      writer.uint32(48).int32(message.failedWindows);
+    }
+    if (message.created !== undefined) {
+      Timestamp.encode(toTimestamp(message.created), writer.uint32(58).fork()).join();
+    }
+    if (message.finished !== undefined) {
+      Timestamp.encode(toTimestamp(message.finished), writer.uint32(66).fork()).join();
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      writer.uint32(74).string(message.errorMessage);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Processors_Processor {
+  decode(input: BinaryReader | Uint8Array, length?: number): Reprocess {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcessors_Processor();
+    const message = createBaseReprocess();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2839,15 +9291,71 @@ export const Processors_Processor: MessageFns<Processors_Processor> = {
             break;
           }
 
-          message.name = reader.string();
+          message.reprocessId = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.runtime = reader.string();
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.totalWindows = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.triggeredWindows = reader.int32();
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
+            break;
+          }
+
+          message.succeededWindows = reader.int32();
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
+            break;
+          }
+
+          message.failedWindows = reader.int32();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.created = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.finished = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.errorMessage = reader.string();
           continue;
         }
       }
@@ -2859,103 +9367,157 @@ export const Processors_Processor: MessageFns<Processors_Processor> = {
     return message;
   },
 
-  fromJSON(object: any): Processors_Processor {
+  fromJSON(object: any): Reprocess {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      runtime: isSet(object.runtime) ? globalThis.String(object.runtime) : "",
+      reprocessId: isSet(object.reprocessId) ? globalThis.String(object.reprocessId) : "",
+      status: isSet(object.status) ? executionStatusFromJSON(object.status) : 0,
+      totalWindows: isSet(object.totalWindows) ? globalThis.Number(object.totalWindows) : 0,
+      triggeredWindows: isSet(object.triggeredWindows) ? globalThis.Number(object.triggeredWindows) : 0,
+      succeededWindows: isSet(object.succeededWindows) ? globalThis.Number(object.succeededWindows) : 0,
+      failedWindows: isSet(object.failedWindows) ? globalThis.Number(object.failedWindows) : 0,
+      created: isSet(object.created) ? fromJsonTimestamp(object.created) : undefined,
+      finished: isSet(object.finished) ? fromJsonTimestamp(object.finished) : undefined,
+      errorMessage: isSet(object.errorMessage) ? globalThis.String(object.errorMessage) : "",
     };
   },
 
-  toJSON(message: Processors_Processor): unknown {
+  toJSON(message: Reprocess): unknown {
     const obj: any = {};
-    if (message.name !== undefined && message.name !== "") {
-      obj.name = message.name;
+    if (message.reprocessId !== undefined && message.reprocessId !== "") {
+      obj.reprocessId = message.reprocessId;
     }
-    if (message.runtime !== undefined && message.runtime !== "") {
-      obj.runtime = message.runtime;
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = executionStatusToJSON(message.status);
+    }
+    if (message.totalWindows !== undefined && message.totalWindows !== 0) {
+      obj.totalWindows = Math.round(message.totalWindows);
+    }
+    if (message.triggeredWindows !== undefined && message.triggeredWindows !== 0) {
+      obj.triggeredWindows = Math.round(message.triggeredWindows);
+    }
+    if (message.succeededWindows !== undefined && message.succeededWindows !== 0) {
+      obj.succeededWindows = Math.round(message.succeededWindows);
+    }
+    if (message.failedWindows !== undefined && message.failedWindows !== 0) {
+      obj.failedWindows = Math.round(message.failedWindows);
+    }
+    if (message.created !== undefined) {
+      obj.created = message.created.toISOString();
+    }
+    if (message.finished !== undefined) {
+      obj.finished = message.finished.toISOString();
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      obj.errorMessage = message.errorMessage;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Processors_Processor>, I>>(base?: I): Processors_Processor {
-    return Processors_Processor.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Reprocess>, I>>(base?: I): Reprocess {
+    return Reprocess.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Processors_Processor>, I>>(object: I): Processors_Processor {
-    const message = createBaseProcessors_Processor();
-    message.name = object.name ?? "";
-    message.runtime = object.runtime ?? "";
+  fromPartial<I extends Exact<DeepPartial<Reprocess>, I>>(object: I): Reprocess {
+    const message = createBaseReprocess();
+    message.reprocessId = object.reprocessId ?? "";
+    message.status = object.status ?? 0;
+    message.totalWindows = object.totalWindows ?? 0;
+    message.triggeredWindows = object.triggeredWindows ?? 0;
+    message.succeededWindows = object.succeededWindows ?? 0;
+    message.failedWindows = object.failedWindows ?? 0;
+    message.created = object.created ?? undefined;
+    message.finished = object.finished ?? undefined;
+    message.errorMessage = object.errorMessage ?? "";
     return message;
   },
 };
 
-function createBaseResultsStatsRead(): ResultsStatsRead {
-  return {};
+function createBaseFailedExecutionsRead(): FailedExecutionsRead {
+  return {
+    timeFrom: undefined,
+    timeTo: undefined,
+    processorName: "",
+    processorRuntime: "",
+    algorithm: undefined,
+    includeRequeued: false,
+  };
 }
 
-export const ResultsStatsRead: MessageFns<ResultsStatsRead> = {
-  encode(_: ResultsStatsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const FailedExecutionsRead: MessageFns<FailedExecutionsRead> = {
+  encode(message: FailedExecutionsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+    }
+    if (message.timeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      writer.uint32(26).string(message.processorName);
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      writer.uint32(34).string(message.processorRuntime);
+    }
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(42).fork()).join();
+    }
+    if (message.includeRequeued !== undefined && message.includeRequeued !== false) {
+      writer.uint32(48).bool(message.includeRequeued);
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsStatsRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): FailedExecutionsRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsStatsRead();
+    const message = createBaseFailedExecutionsRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(_: any): ResultsStatsRead {
-    return {};
-  },
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
 
-  toJSON(_: ResultsStatsRead): unknown {
-    const obj: any = {};
-    return obj;
-  },
+          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
 
-  create<I extends Exact<DeepPartial<ResultsStatsRead>, I>>(base?: I): ResultsStatsRead {
-    return ResultsStatsRead.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<ResultsStatsRead>, I>>(_: I): ResultsStatsRead {
-    const message = createBaseResultsStatsRead();
-    return message;
-  },
-};
+          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
 
-function createBaseResultsStats(): ResultsStats {
-  return { Count: "0" };
-}
+          message.processorName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
 
-export const ResultsStats: MessageFns<ResultsStats> = {
-  encode(message: ResultsStats, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.Count !== undefined && message.Count !== "0") {
-      writer.uint32(8).int64(message.Count);
-    }
-    return writer;
-  },
+          message.processorRuntime = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsStats {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsStats();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 8) {
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 48) {
             break;
           }
 
-          message.Count = reader.int64().toString();
+          message.includeRequeued = reader.bool();
           continue;
         }
       }
@@ -2964,53 +9526,88 @@ export const ResultsStats: MessageFns<ResultsStats> = {
       }
       reader.skip(tag & 7);
     }
-    return message;
-  },
-
-  fromJSON(object: any): ResultsStats {
-    return { Count: isSet(object.Count) ? globalThis.String(object.Count) : "0" };
-  },
-
-  toJSON(message: ResultsStats): unknown {
-    const obj: any = {};
-    if (message.Count !== undefined && message.Count !== "0") {
-      obj.Count = message.Count;
+    return message;
+  },
+
+  fromJSON(object: any): FailedExecutionsRead {
+    return {
+      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
+      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      processorName: isSet(object.processorName) ? globalThis.String(object.processorName) : "",
+      processorRuntime: isSet(object.processorRuntime) ? globalThis.String(object.processorRuntime) : "",
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      includeRequeued: isSet(object.includeRequeued) ? globalThis.Boolean(object.includeRequeued) : false,
+    };
+  },
+
+  toJSON(message: FailedExecutionsRead): unknown {
+    const obj: any = {};
+    if (message.timeFrom !== undefined) {
+      obj.timeFrom = message.timeFrom.toISOString();
+    }
+    if (message.timeTo !== undefined) {
+      obj.timeTo = message.timeTo.toISOString();
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      obj.processorName = message.processorName;
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      obj.processorRuntime = message.processorRuntime;
+    }
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.includeRequeued !== undefined && message.includeRequeued !== false) {
+      obj.includeRequeued = message.includeRequeued;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsStats>, I>>(base?: I): ResultsStats {
-    return ResultsStats.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<FailedExecutionsRead>, I>>(base?: I): FailedExecutionsRead {
+    return FailedExecutionsRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsStats>, I>>(object: I): ResultsStats {
-    const message = createBaseResultsStats();
-    message.Count = object.Count ?? "0";
+  fromPartial<I extends Exact<DeepPartial<FailedExecutionsRead>, I>>(object: I): FailedExecutionsRead {
+    const message = createBaseFailedExecutionsRead();
+    message.timeFrom = object.timeFrom ?? undefined;
+    message.timeTo = object.timeTo ?? undefined;
+    message.processorName = object.processorName ?? "";
+    message.processorRuntime = object.processorRuntime ?? "";
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.includeRequeued = object.includeRequeued ?? false;
     return message;
   },
 };
 
-function createBaseAlgorithmFieldsRead(): AlgorithmFieldsRead {
-  return { timeFrom: undefined, timeTo: undefined, algorithm: undefined };
+function createBaseFailedExecutionsRequeue(): FailedExecutionsRequeue {
+  return { timeFrom: undefined, timeTo: undefined, processorName: "", processorRuntime: "", algorithm: undefined };
 }
 
-export const AlgorithmFieldsRead: MessageFns<AlgorithmFieldsRead> = {
-  encode(message: AlgorithmFieldsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const FailedExecutionsRequeue: MessageFns<FailedExecutionsRequeue> = {
+  encode(message: FailedExecutionsRequeue, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.timeFrom !== undefined) {
       Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
     }
     if (message.timeTo !== undefined) {
       Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
     }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      writer.uint32(26).string(message.processorName);
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      writer.uint32(34).string(message.processorRuntime);
+    }
     if (message.algorithm !== undefined) {
-      Algorithm.encode(message.algorithm, writer.uint32(26).fork()).join();
+      Algorithm.encode(message.algorithm, writer.uint32(42).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmFieldsRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): FailedExecutionsRequeue {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAlgorithmFieldsRead();
+    const message = createBaseFailedExecutionsRequeue();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3035,6 +9632,22 @@ export const AlgorithmFieldsRead: MessageFns<AlgorithmFieldsRead> = {
             break;
           }
 
+          message.processorName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.processorRuntime = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
           message.algorithm = Algorithm.decode(reader, reader.uint32());
           continue;
         }
@@ -3047,15 +9660,17 @@ export const AlgorithmFieldsRead: MessageFns<AlgorithmFieldsRead> = {
     return message;
   },
 
-  fromJSON(object: any): AlgorithmFieldsRead {
+  fromJSON(object: any): FailedExecutionsRequeue {
     return {
       timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
       timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
+      processorName: isSet(object.processorName) ? globalThis.String(object.processorName) : "",
+      processorRuntime: isSet(object.processorRuntime) ? globalThis.String(object.processorRuntime) : "",
       algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
     };
   },
 
-  toJSON(message: AlgorithmFieldsRead): unknown {
+  toJSON(message: FailedExecutionsRequeue): unknown {
     const obj: any = {};
     if (message.timeFrom !== undefined) {
       obj.timeFrom = message.timeFrom.toISOString();
@@ -3063,19 +9678,27 @@ export const AlgorithmFieldsRead: MessageFns<AlgorithmFieldsRead> = {
     if (message.timeTo !== undefined) {
       obj.timeTo = message.timeTo.toISOString();
     }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      obj.processorName = message.processorName;
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      obj.processorRuntime = message.processorRuntime;
+    }
     if (message.algorithm !== undefined) {
       obj.algorithm = Algorithm.toJSON(message.algorithm);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AlgorithmFieldsRead>, I>>(base?: I): AlgorithmFieldsRead {
-    return AlgorithmFieldsRead.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<FailedExecutionsRequeue>, I>>(base?: I): FailedExecutionsRequeue {
+    return FailedExecutionsRequeue.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AlgorithmFieldsRead>, I>>(object: I): AlgorithmFieldsRead {
-    const message = createBaseAlgorithmFieldsRead();
+  fromPartial<I extends Exact<DeepPartial<FailedExecutionsRequeue>, I>>(object: I): FailedExecutionsRequeue {
+    const message = createBaseFailedExecutionsRequeue();
     message.timeFrom = object.timeFrom ?? undefined;
     message.timeTo = object.timeTo ?? undefined;
+    message.processorName = object.processorName ?? "";
+    message.processorRuntime = object.processorRuntime ?? "";
     message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
       ? Algorithm.fromPartial(object.algorithm)
       : undefined;
@@ -3083,24 +9706,60 @@ export const AlgorithmFieldsRead: MessageFns<AlgorithmFieldsRead> = {
   },
 };
 
-function createBaseAlgorithmFields(): AlgorithmFields {
-  return { field: [] };
+function createBaseFailedExecution(): FailedExecution {
+  return {
+    execId: "",
+    taskExecId: "",
+    processorName: "",
+    processorRuntime: "",
+    request: undefined,
+    errorCode: "",
+    errorMessage: "",
+    attempts: 0,
+    failed: undefined,
+    requeued: undefined,
+  };
 }
 
-export const AlgorithmFields: MessageFns<AlgorithmFields> = {
-  encode(message: AlgorithmFields, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.field !== undefined && message.field.length !== 0) {
-      for (const v of message.field) {
-        writer.uint32(10).string(v!);
-      }
+export const FailedExecution: MessageFns<FailedExecution> = {
+  encode(message: FailedExecution, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.execId !== undefined && message.execId !== "") {
+      writer.uint32(10).string(message.execId);
+    }
+    if (message.taskExecId !== undefined && message.taskExecId !== "") {
+      writer.uint32(18).string(message.taskExecId);
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      writer.uint32(26).string(message.processorName);
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      writer.uint32(34).string(message.processorRuntime);
+    }
+    if (message.request !== undefined) {
+      ExecutionRequest.encode(message.request, writer.uint32(42).fork()).join();
+    }
+    if (message.errorCode !== undefined && message.errorCode !== "") {
+      writer.uint32(50).string(message.errorCode);
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      writer.uint32(58).string(message.errorMessage);
+    }
+    if (message.attempts !== undefined && message.attempts !== 0) {
+      writer.uint32(64).int32(message.attempts);
+    }
+    if (message.failed !== undefined) {
+      Timestamp.encode(toTimestamp(message.failed), writer.uint32(74).fork()).join();
+    }
+    if (message.requeued !== undefined) {
+      Timestamp.encode(toTimestamp(message.requeued), writer.uint32(82).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AlgorithmFields {
+  decode(input: BinaryReader | Uint8Array, length?: number): FailedExecution {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAlgorithmFields();
+    const message = createBaseFailedExecution();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3109,10 +9768,79 @@ export const AlgorithmFields: MessageFns<AlgorithmFields> = {
             break;
           }
 
-          const el = reader.string();
-          if (el !== undefined) {
-            message.field!.push(el);
+          message.execId = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.taskExecId = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.processorName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.processorRuntime = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.request = ExecutionRequest.decode(reader, reader.uint32());
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.errorCode = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.errorMessage = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 64) {
+            break;
+          }
+
+          message.attempts = reader.int32();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.failed = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
           }
+
+          message.requeued = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -3124,50 +9852,95 @@ export const AlgorithmFields: MessageFns<AlgorithmFields> = {
     return message;
   },
 
-  fromJSON(object: any): AlgorithmFields {
-    return { field: globalThis.Array.isArray(object?.field) ? object.field.map((e: any) => globalThis.String(e)) : [] };
+  fromJSON(object: any): FailedExecution {
+    return {
+      execId: isSet(object.execId) ? globalThis.String(object.execId) : "",
+      taskExecId: isSet(object.taskExecId) ? globalThis.String(object.taskExecId) : "",
+      processorName: isSet(object.processorName) ? globalThis.String(object.processorName) : "",
+      processorRuntime: isSet(object.processorRuntime) ? globalThis.String(object.processorRuntime) : "",
+      request: isSet(object.request) ? ExecutionRequest.fromJSON(object.request) : undefined,
+      errorCode: isSet(object.errorCode) ? globalThis.String(object.errorCode) : "",
+      errorMessage: isSet(object.errorMessage) ? globalThis.String(object.errorMessage) : "",
+      attempts: isSet(object.attempts) ? globalThis.Number(object.attempts) : 0,
+      failed: isSet(object.failed) ? fromJsonTimestamp(object.failed) : undefined,
+      requeued: isSet(object.requeued) ? fromJsonTimestamp(object.requeued) : undefined,
+    };
   },
 
-  toJSON(message: AlgorithmFields): unknown {
+  toJSON(message: FailedExecution): unknown {
     const obj: any = {};
-    if (message.field?.length) {
-      obj.field = message.field;
+    if (message.execId !== undefined && message.execId !== "") {
+      obj.execId = message.execId;
+    }
+    if (message.taskExecId !== undefined && message.taskExecId !== "") {
+      obj.taskExecId = message.taskExecId;
+    }
+    if (message.processorName !== undefined && message.processorName !== "") {
+      obj.processorName = message.processorName;
+    }
+    if (message.processorRuntime !== undefined && message.processorRuntime !== "") {
+      obj.processorRuntime = message.processorRuntime;
+    }
+    if (message.request !== undefined) {
+      obj.request = ExecutionRequest.toJSON(message.request);
+    }
+    if (message.errorCode !== undefined && message.errorCode !== "") {
+      obj.errorCode = message.errorCode;
+    }
+    if (message.errorMessage !== undefined && message.errorMessage !== "") {
+      obj.errorMessage = message.errorMessage;
+    }
+    if (message.attempts !== undefined && message.attempts !== 0) {
+      obj.attempts = Math.round(message.attempts);
+    }
+    if (message.failed !== undefined) {
+      obj.failed = message.failed.toISOString();
+    }
+    if (message.requeued !== undefined) {
+      obj.requeued = message.requeued.toISOString();
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AlgorithmFields>, I>>(base?: I): AlgorithmFields {
-    return AlgorithmFields.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<FailedExecution>, I>>(base?: I): FailedExecution {
+    return FailedExecution.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AlgorithmFields>, I>>(object: I): AlgorithmFields {
-    const message = createBaseAlgorithmFields();
-    message.field = object.field?.map((e) => e) || [];
+  fromPartial<I extends Exact<DeepPartial<FailedExecution>, I>>(object: I): FailedExecution {
+    const message = createBaseFailedExecution();
+    message.execId = object.execId ?? "";
+    message.taskExecId = object.taskExecId ?? "";
+    message.processorName = object.processorName ?? "";
+    message.processorRuntime = object.processorRuntime ?? "";
+    message.request = (object.request !== undefined && object.request !== null)
+      ? ExecutionRequest.fromPartial(object.request)
+      : undefined;
+    message.errorCode = object.errorCode ?? "";
+    message.errorMessage = object.errorMessage ?? "";
+    message.attempts = object.attempts ?? 0;
+    message.failed = object.failed ?? undefined;
+    message.requeued = object.requeued ?? undefined;
     return message;
   },
 };
 
-function createBaseResultsForAlgorithmRead(): ResultsForAlgorithmRead {
-  return { timeFrom: undefined, timeTo: undefined, algorithm: undefined };
+function createBaseFailedExecutions(): FailedExecutions {
+  return { failedExecutions: [] };
 }
 
-export const ResultsForAlgorithmRead: MessageFns<ResultsForAlgorithmRead> = {
-  encode(message: ResultsForAlgorithmRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.timeFrom !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
-    }
-    if (message.timeTo !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
-    }
-    if (message.algorithm !== undefined) {
-      Algorithm.encode(message.algorithm, writer.uint32(26).fork()).join();
+export const FailedExecutions: MessageFns<FailedExecutions> = {
+  encode(message: FailedExecutions, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.failedExecutions !== undefined && message.failedExecutions.length !== 0) {
+      for (const v of message.failedExecutions) {
+        FailedExecution.encode(v!, writer.uint32(10).fork()).join();
+      }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): FailedExecutions {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsForAlgorithmRead();
+    const message = createBaseFailedExecutions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3176,23 +9949,10 @@ export const ResultsForAlgorithmRead: MessageFns<ResultsForAlgorithmRead> = {
             break;
           }
 
-          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
-
-          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
+          const el = FailedExecution.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.failedExecutions!.push(el);
           }
-
-          message.algorithm = Algorithm.decode(reader, reader.uint32());
           continue;
         }
       }
@@ -3204,60 +9964,50 @@ export const ResultsForAlgorithmRead: MessageFns<ResultsForAlgorithmRead> = {
     return message;
   },
 
-  fromJSON(object: any): ResultsForAlgorithmRead {
+  fromJSON(object: any): FailedExecutions {
     return {
-      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
-      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
-      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      failedExecutions: globalThis.Array.isArray(object?.failedExecutions)
+        ? object.failedExecutions.map((e: any) => FailedExecution.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: ResultsForAlgorithmRead): unknown {
+  toJSON(message: FailedExecutions): unknown {
     const obj: any = {};
-    if (message.timeFrom !== undefined) {
-      obj.timeFrom = message.timeFrom.toISOString();
-    }
-    if (message.timeTo !== undefined) {
-      obj.timeTo = message.timeTo.toISOString();
-    }
-    if (message.algorithm !== undefined) {
-      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    if (message.failedExecutions?.length) {
+      obj.failedExecutions = message.failedExecutions.map((e) => FailedExecution.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsForAlgorithmRead>, I>>(base?: I): ResultsForAlgorithmRead {
-    return ResultsForAlgorithmRead.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<FailedExecutions>, I>>(base?: I): FailedExecutions {
+    return FailedExecutions.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmRead>, I>>(object: I): ResultsForAlgorithmRead {
-    const message = createBaseResultsForAlgorithmRead();
-    message.timeFrom = object.timeFrom ?? undefined;
-    message.timeTo = object.timeTo ?? undefined;
-    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
-      ? Algorithm.fromPartial(object.algorithm)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<FailedExecutions>, I>>(object: I): FailedExecutions {
+    const message = createBaseFailedExecutions();
+    message.failedExecutions = object.failedExecutions?.map((e) => FailedExecution.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseResultsForAlgorithm(): ResultsForAlgorithm {
-  return { results: [] };
+function createBaseRequeuedExecutions(): RequeuedExecutions {
+  return { execIds: [] };
 }
 
-export const ResultsForAlgorithm: MessageFns<ResultsForAlgorithm> = {
-  encode(message: ResultsForAlgorithm, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.results !== undefined && message.results.length !== 0) {
-      for (const v of message.results) {
-        ResultsForAlgorithm_ResultsRow.encode(v!, writer.uint32(10).fork()).join();
+export const RequeuedExecutions: MessageFns<RequeuedExecutions> = {
+  encode(message: RequeuedExecutions, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.execIds !== undefined && message.execIds.length !== 0) {
+      for (const v of message.execIds) {
+        writer.uint32(10).string(v!);
       }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithm {
+  decode(input: BinaryReader | Uint8Array, length?: number): RequeuedExecutions {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsForAlgorithm();
+    const message = createBaseRequeuedExecutions();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3266,9 +10016,9 @@ export const ResultsForAlgorithm: MessageFns<ResultsForAlgorithm> = {
             break;
           }
 
-          const el = ResultsForAlgorithm_ResultsRow.decode(reader, reader.uint32());
+          const el = reader.string();
           if (el !== undefined) {
-            message.results!.push(el);
+            message.execIds!.push(el);
           }
           continue;
         }
@@ -3281,59 +10031,58 @@ export const ResultsForAlgorithm: MessageFns<ResultsForAlgorithm> = {
     return message;
   },
 
-  fromJSON(object: any): ResultsForAlgorithm {
+  fromJSON(object: any): RequeuedExecutions {
     return {
-      results: globalThis.Array.isArray(object?.results)
-        ? object.results.map((e: any) => ResultsForAlgorithm_ResultsRow.fromJSON(e))
-        : [],
+      execIds: globalThis.Array.isArray(object?.execIds) ? object.execIds.map((e: any) => globalThis.String(e)) : [],
     };
   },
 
-  toJSON(message: ResultsForAlgorithm): unknown {
+  toJSON(message: RequeuedExecutions): unknown {
     const obj: any = {};
-    if (message.results?.length) {
-      obj.results = message.results.map((e) => ResultsForAlgorithm_ResultsRow.toJSON(e));
+    if (message.execIds?.length) {
+      obj.execIds = message.execIds;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsForAlgorithm>, I>>(base?: I): ResultsForAlgorithm {
-    return ResultsForAlgorithm.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<RequeuedExecutions>, I>>(base?: I): RequeuedExecutions {
+    return RequeuedExecutions.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithm>, I>>(object: I): ResultsForAlgorithm {
-    const message = createBaseResultsForAlgorithm();
-    message.results = object.results?.map((e) => ResultsForAlgorithm_ResultsRow.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<RequeuedExecutions>, I>>(object: I): RequeuedExecutions {
+    const message = createBaseRequeuedExecutions();
+    message.execIds = object.execIds?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseResultsForAlgorithm_ResultsRow(): ResultsForAlgorithm_ResultsRow {
-  return { time: undefined, resultData: undefined };
+function createBaseAlertRule(): AlertRule {
+  return { name: "", algorithm: undefined, expression: "", description: "", metadataKey: "" };
 }
 
-export const ResultsForAlgorithm_ResultsRow: MessageFns<ResultsForAlgorithm_ResultsRow> = {
-  encode(message: ResultsForAlgorithm_ResultsRow, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.time !== undefined) {
-      Timestamp.encode(toTimestamp(message.time), writer.uint32(10).fork()).join();
+export const AlertRule: MessageFns<AlertRule> = {
+  encode(message: AlertRule, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    switch (message.resultData?.$case) {
-      case "singleValue":
-        writer.uint32(21).float(message.resultData.value);
-        break;
-      case "arrayValues":
-        FloatArray.encode(message.resultData.value, writer.uint32(26).fork()).join();
-        break;
-      case "structValue":
-        Struct.encode(Struct.wrap(message.resultData.value), writer.uint32(34).fork()).join();
-        break;
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(18).fork()).join();
+    }
+    if (message.expression !== undefined && message.expression !== "") {
+      writer.uint32(26).string(message.expression);
+    }
+    if (message.description !== undefined && message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    if (message.metadataKey !== undefined && message.metadataKey !== "") {
+      writer.uint32(42).string(message.metadataKey);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithm_ResultsRow {
+  decode(input: BinaryReader | Uint8Array, length?: number): AlertRule {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsForAlgorithm_ResultsRow();
+    const message = createBaseAlertRule();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3342,15 +10091,15 @@ export const ResultsForAlgorithm_ResultsRow: MessageFns<ResultsForAlgorithm_Resu
             break;
           }
 
-          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 21) {
+          if (tag !== 18) {
             break;
           }
 
-          message.resultData = { $case: "singleValue", value: reader.float() };
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
           continue;
         }
         case 3: {
@@ -3358,7 +10107,7 @@ export const ResultsForAlgorithm_ResultsRow: MessageFns<ResultsForAlgorithm_Resu
             break;
           }
 
-          message.resultData = { $case: "arrayValues", value: FloatArray.decode(reader, reader.uint32()) };
+          message.expression = reader.string();
           continue;
         }
         case 4: {
@@ -3366,7 +10115,15 @@ export const ResultsForAlgorithm_ResultsRow: MessageFns<ResultsForAlgorithm_Resu
             break;
           }
 
-          message.resultData = { $case: "structValue", value: Struct.unwrap(Struct.decode(reader, reader.uint32())) };
+          message.description = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.metadataKey = reader.string();
           continue;
         }
       }
@@ -3378,88 +10135,113 @@ export const ResultsForAlgorithm_ResultsRow: MessageFns<ResultsForAlgorithm_Resu
     return message;
   },
 
-  fromJSON(object: any): ResultsForAlgorithm_ResultsRow {
+  fromJSON(object: any): AlertRule {
     return {
-      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
-      resultData: isSet(object.singleValue)
-        ? { $case: "singleValue", value: globalThis.Number(object.singleValue) }
-        : isSet(object.arrayValues)
-        ? { $case: "arrayValues", value: FloatArray.fromJSON(object.arrayValues) }
-        : isSet(object.structValue)
-        ? { $case: "structValue", value: object.structValue }
-        : undefined,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      expression: isSet(object.expression) ? globalThis.String(object.expression) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      metadataKey: isSet(object.metadataKey) ? globalThis.String(object.metadataKey) : "",
     };
   },
 
-  toJSON(message: ResultsForAlgorithm_ResultsRow): unknown {
+  toJSON(message: AlertRule): unknown {
     const obj: any = {};
-    if (message.time !== undefined) {
-      obj.time = message.time.toISOString();
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.resultData?.$case === "singleValue") {
-      obj.singleValue = message.resultData.value;
-    } else if (message.resultData?.$case === "arrayValues") {
-      obj.arrayValues = FloatArray.toJSON(message.resultData.value);
-    } else if (message.resultData?.$case === "structValue") {
-      obj.structValue = message.resultData.value;
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.expression !== undefined && message.expression !== "") {
+      obj.expression = message.expression;
+    }
+    if (message.description !== undefined && message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.metadataKey !== undefined && message.metadataKey !== "") {
+      obj.metadataKey = message.metadataKey;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsForAlgorithm_ResultsRow>, I>>(base?: I): ResultsForAlgorithm_ResultsRow {
-    return ResultsForAlgorithm_ResultsRow.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<AlertRule>, I>>(base?: I): AlertRule {
+    return AlertRule.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithm_ResultsRow>, I>>(
-    object: I,
-  ): ResultsForAlgorithm_ResultsRow {
-    const message = createBaseResultsForAlgorithm_ResultsRow();
-    message.time = object.time ?? undefined;
-    switch (object.resultData?.$case) {
-      case "singleValue": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "singleValue", value: object.resultData.value };
-        }
-        break;
-      }
-      case "arrayValues": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "arrayValues", value: FloatArray.fromPartial(object.resultData.value) };
-        }
-        break;
+  fromPartial<I extends Exact<DeepPartial<AlertRule>, I>>(object: I): AlertRule {
+    const message = createBaseAlertRule();
+    message.name = object.name ?? "";
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.expression = object.expression ?? "";
+    message.description = object.description ?? "";
+    message.metadataKey = object.metadataKey ?? "";
+    return message;
+  },
+};
+
+function createBaseAlertRulesRead(): AlertRulesRead {
+  return {};
+}
+
+export const AlertRulesRead: MessageFns<AlertRulesRead> = {
+  encode(_: AlertRulesRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): AlertRulesRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlertRulesRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
       }
-      case "structValue": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "structValue", value: object.resultData.value };
-        }
+      if ((tag & 7) === 4 || tag === 0) {
         break;
       }
+      reader.skip(tag & 7);
     }
     return message;
   },
+
+  fromJSON(_: any): AlertRulesRead {
+    return {};
+  },
+
+  toJSON(_: AlertRulesRead): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlertRulesRead>, I>>(base?: I): AlertRulesRead {
+    return AlertRulesRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<AlertRulesRead>, I>>(_: I): AlertRulesRead {
+    const message = createBaseAlertRulesRead();
+    return message;
+  },
 };
 
-function createBaseWindowsRead(): WindowsRead {
-  return { timeFrom: undefined, timeTo: undefined, window: undefined };
+function createBaseAlertRules(): AlertRules {
+  return { alertRules: [] };
 }
 
-export const WindowsRead: MessageFns<WindowsRead> = {
-  encode(message: WindowsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.timeFrom !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
-    }
-    if (message.timeTo !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
-    }
-    if (message.window !== undefined) {
-      WindowType.encode(message.window, writer.uint32(26).fork()).join();
+export const AlertRules: MessageFns<AlertRules> = {
+  encode(message: AlertRules, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.alertRules !== undefined && message.alertRules.length !== 0) {
+      for (const v of message.alertRules) {
+        AlertRule.encode(v!, writer.uint32(10).fork()).join();
+      }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowsRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): AlertRules {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowsRead();
+    const message = createBaseAlertRules();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3468,23 +10250,10 @@ export const WindowsRead: MessageFns<WindowsRead> = {
             break;
           }
 
-          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
-
-          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
+          const el = AlertRule.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.alertRules!.push(el);
           }
-
-          message.window = WindowType.decode(reader, reader.uint32());
           continue;
         }
       }
@@ -3496,60 +10265,48 @@ export const WindowsRead: MessageFns<WindowsRead> = {
     return message;
   },
 
-  fromJSON(object: any): WindowsRead {
+  fromJSON(object: any): AlertRules {
     return {
-      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
-      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
-      window: isSet(object.window) ? WindowType.fromJSON(object.window) : undefined,
+      alertRules: globalThis.Array.isArray(object?.alertRules)
+        ? object.alertRules.map((e: any) => AlertRule.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: WindowsRead): unknown {
+  toJSON(message: AlertRules): unknown {
     const obj: any = {};
-    if (message.timeFrom !== undefined) {
-      obj.timeFrom = message.timeFrom.toISOString();
-    }
-    if (message.timeTo !== undefined) {
-      obj.timeTo = message.timeTo.toISOString();
-    }
-    if (message.window !== undefined) {
-      obj.window = WindowType.toJSON(message.window);
+    if (message.alertRules?.length) {
+      obj.alertRules = message.alertRules.map((e) => AlertRule.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<WindowsRead>, I>>(base?: I): WindowsRead {
-    return WindowsRead.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<AlertRules>, I>>(base?: I): AlertRules {
+    return AlertRules.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<WindowsRead>, I>>(object: I): WindowsRead {
-    const message = createBaseWindowsRead();
-    message.timeFrom = object.timeFrom ?? undefined;
-    message.timeTo = object.timeTo ?? undefined;
-    message.window = (object.window !== undefined && object.window !== null)
-      ? WindowType.fromPartial(object.window)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<AlertRules>, I>>(object: I): AlertRules {
+    const message = createBaseAlertRules();
+    message.alertRules = object.alertRules?.map((e) => AlertRule.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseWindows(): Windows {
-  return { window: [] };
+function createBaseAlertRuleDeletion(): AlertRuleDeletion {
+  return { name: "" };
 }
 
-export const Windows: MessageFns<Windows> = {
-  encode(message: Windows, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.window !== undefined && message.window.length !== 0) {
-      for (const v of message.window) {
-        Window.encode(v!, writer.uint32(10).fork()).join();
-      }
+export const AlertRuleDeletion: MessageFns<AlertRuleDeletion> = {
+  encode(message: AlertRuleDeletion, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): Windows {
+  decode(input: BinaryReader | Uint8Array, length?: number): AlertRuleDeletion {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindows();
+    const message = createBaseAlertRuleDeletion();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3558,10 +10315,7 @@ export const Windows: MessageFns<Windows> = {
             break;
           }
 
-          const el = Window.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.window!.push(el);
-          }
+          message.name = reader.string();
           continue;
         }
       }
@@ -3573,52 +10327,56 @@ export const Windows: MessageFns<Windows> = {
     return message;
   },
 
-  fromJSON(object: any): Windows {
-    return {
-      window: globalThis.Array.isArray(object?.window) ? object.window.map((e: any) => Window.fromJSON(e)) : [],
-    };
+  fromJSON(object: any): AlertRuleDeletion {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
   },
 
-  toJSON(message: Windows): unknown {
+  toJSON(message: AlertRuleDeletion): unknown {
     const obj: any = {};
-    if (message.window?.length) {
-      obj.window = message.window.map((e) => Window.toJSON(e));
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Windows>, I>>(base?: I): Windows {
-    return Windows.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<AlertRuleDeletion>, I>>(base?: I): AlertRuleDeletion {
+    return AlertRuleDeletion.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<Windows>, I>>(object: I): Windows {
-    const message = createBaseWindows();
-    message.window = object.window?.map((e) => Window.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<AlertRuleDeletion>, I>>(object: I): AlertRuleDeletion {
+    const message = createBaseAlertRuleDeletion();
+    message.name = object.name ?? "";
     return message;
   },
 };
 
-function createBaseDistinctMetadataForWindowTypeRead(): DistinctMetadataForWindowTypeRead {
-  return { timeFrom: undefined, timeTo: undefined, windowType: undefined };
+function createBaseAlertsRead(): AlertsRead {
+  return { timeFrom: undefined, timeTo: undefined, alertRuleName: "", status: 0, includeAcknowledged: false };
 }
 
-export const DistinctMetadataForWindowTypeRead: MessageFns<DistinctMetadataForWindowTypeRead> = {
-  encode(message: DistinctMetadataForWindowTypeRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const AlertsRead: MessageFns<AlertsRead> = {
+  encode(message: AlertsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.timeFrom !== undefined) {
       Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
     }
     if (message.timeTo !== undefined) {
       Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
     }
-    if (message.windowType !== undefined) {
-      WindowType.encode(message.windowType, writer.uint32(26).fork()).join();
+    if (message.alertRuleName !== undefined && message.alertRuleName !== "") {
+      writer.uint32(26).string(message.alertRuleName);
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(32).int32(message.status);
+    }
+    if (message.includeAcknowledged !== undefined && message.includeAcknowledged !== false) {
+      writer.uint32(40).bool(message.includeAcknowledged);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): DistinctMetadataForWindowTypeRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): AlertsRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDistinctMetadataForWindowTypeRead();
+    const message = createBaseAlertsRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3638,12 +10396,28 @@ export const DistinctMetadataForWindowTypeRead: MessageFns<DistinctMetadataForWi
           message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
-        case 3: {
-          if (tag !== 26) {
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.alertRuleName = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        }
+        case 5: {
+          if (tag !== 40) {
             break;
           }
 
-          message.windowType = WindowType.decode(reader, reader.uint32());
+          message.includeAcknowledged = reader.bool();
           continue;
         }
       }
@@ -3655,15 +10429,17 @@ export const DistinctMetadataForWindowTypeRead: MessageFns<DistinctMetadataForWi
     return message;
   },
 
-  fromJSON(object: any): DistinctMetadataForWindowTypeRead {
+  fromJSON(object: any): AlertsRead {
     return {
       timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
       timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
-      windowType: isSet(object.windowType) ? WindowType.fromJSON(object.windowType) : undefined,
+      alertRuleName: isSet(object.alertRuleName) ? globalThis.String(object.alertRuleName) : "",
+      status: isSet(object.status) ? alertStatusFromJSON(object.status) : 0,
+      includeAcknowledged: isSet(object.includeAcknowledged) ? globalThis.Boolean(object.includeAcknowledged) : false,
     };
   },
 
-  toJSON(message: DistinctMetadataForWindowTypeRead): unknown {
+  toJSON(message: AlertsRead): unknown {
     const obj: any = {};
     if (message.timeFrom !== undefined) {
       obj.timeFrom = message.timeFrom.toISOString();
@@ -3671,46 +10447,50 @@ export const DistinctMetadataForWindowTypeRead: MessageFns<DistinctMetadataForWi
     if (message.timeTo !== undefined) {
       obj.timeTo = message.timeTo.toISOString();
     }
-    if (message.windowType !== undefined) {
-      obj.windowType = WindowType.toJSON(message.windowType);
+    if (message.alertRuleName !== undefined && message.alertRuleName !== "") {
+      obj.alertRuleName = message.alertRuleName;
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = alertStatusToJSON(message.status);
+    }
+    if (message.includeAcknowledged !== undefined && message.includeAcknowledged !== false) {
+      obj.includeAcknowledged = message.includeAcknowledged;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DistinctMetadataForWindowTypeRead>, I>>(
-    base?: I,
-  ): DistinctMetadataForWindowTypeRead {
-    return DistinctMetadataForWindowTypeRead.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<AlertsRead>, I>>(base?: I): AlertsRead {
+    return AlertsRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<DistinctMetadataForWindowTypeRead>, I>>(
-    object: I,
-  ): DistinctMetadataForWindowTypeRead {
-    const message = createBaseDistinctMetadataForWindowTypeRead();
+  fromPartial<I extends Exact<DeepPartial<AlertsRead>, I>>(object: I): AlertsRead {
+    const message = createBaseAlertsRead();
     message.timeFrom = object.timeFrom ?? undefined;
     message.timeTo = object.timeTo ?? undefined;
-    message.windowType = (object.windowType !== undefined && object.windowType !== null)
-      ? WindowType.fromPartial(object.windowType)
-      : undefined;
+    message.alertRuleName = object.alertRuleName ?? "";
+    message.status = object.status ?? 0;
+    message.includeAcknowledged = object.includeAcknowledged ?? false;
     return message;
   },
 };
 
-function createBaseDistinctMetadataForWindowType(): DistinctMetadataForWindowType {
-  return { metadata: undefined };
+function createBaseAlerts(): Alerts {
+  return { alerts: [] };
 }
 
-export const DistinctMetadataForWindowType: MessageFns<DistinctMetadataForWindowType> = {
-  encode(message: DistinctMetadataForWindowType, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.metadata !== undefined) {
-      ListValue.encode(ListValue.wrap(message.metadata), writer.uint32(10).fork()).join();
+export const Alerts: MessageFns<Alerts> = {
+  encode(message: Alerts, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.alerts !== undefined && message.alerts.length !== 0) {
+      for (const v of message.alerts) {
+        Alerts_Alert.encode(v!, writer.uint32(10).fork()).join();
+      }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): DistinctMetadataForWindowType {
+  decode(input: BinaryReader | Uint8Array, length?: number): Alerts {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDistinctMetadataForWindowType();
+    const message = createBaseAlerts();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3719,7 +10499,10 @@ export const DistinctMetadataForWindowType: MessageFns<DistinctMetadataForWindow
             break;
           }
 
-          message.metadata = ListValue.unwrap(ListValue.decode(reader, reader.uint32()));
+          const el = Alerts_Alert.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.alerts!.push(el);
+          }
           continue;
         }
       }
@@ -3731,66 +10514,101 @@ export const DistinctMetadataForWindowType: MessageFns<DistinctMetadataForWindow
     return message;
   },
 
-  fromJSON(object: any): DistinctMetadataForWindowType {
-    return { metadata: globalThis.Array.isArray(object.metadata) ? [...object.metadata] : undefined };
+  fromJSON(object: any): Alerts {
+    return {
+      alerts: globalThis.Array.isArray(object?.alerts) ? object.alerts.map((e: any) => Alerts_Alert.fromJSON(e)) : [],
+    };
   },
 
-  toJSON(message: DistinctMetadataForWindowType): unknown {
+  toJSON(message: Alerts): unknown {
     const obj: any = {};
-    if (message.metadata !== undefined) {
-      obj.metadata = message.metadata;
+    if (message.alerts?.length) {
+      obj.alerts = message.alerts.map((e) => Alerts_Alert.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<DistinctMetadataForWindowType>, I>>(base?: I): DistinctMetadataForWindowType {
-    return DistinctMetadataForWindowType.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Alerts>, I>>(base?: I): Alerts {
+    return Alerts.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<DistinctMetadataForWindowType>, I>>(
-    object: I,
-  ): DistinctMetadataForWindowType {
-    const message = createBaseDistinctMetadataForWindowType();
-    message.metadata = object.metadata ?? undefined;
+  fromPartial<I extends Exact<DeepPartial<Alerts>, I>>(object: I): Alerts {
+    const message = createBaseAlerts();
+    message.alerts = object.alerts?.map((e) => Alerts_Alert.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseWindowsForMetadataRead(): WindowsForMetadataRead {
-  return { timeFrom: undefined, timeTo: undefined, window: undefined, metadata: [] };
+function createBaseAlerts_Alert(): Alerts_Alert {
+  return {
+    alertId: "0",
+    alertRuleName: "",
+    algorithm: undefined,
+    status: 0,
+    origin: "",
+    metadata: undefined,
+    windowTimeFrom: undefined,
+    windowTimeTo: undefined,
+    fired: undefined,
+    resolved: undefined,
+    acknowledged: undefined,
+    acknowledgedBy: "",
+  };
 }
 
-export const WindowsForMetadataRead: MessageFns<WindowsForMetadataRead> = {
-  encode(message: WindowsForMetadataRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.timeFrom !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+export const Alerts_Alert: MessageFns<Alerts_Alert> = {
+  encode(message: Alerts_Alert, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.alertId !== undefined && message.alertId !== "0") {
+      writer.uint32(8).int64(message.alertId);
     }
-    if (message.timeTo !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    if (message.alertRuleName !== undefined && message.alertRuleName !== "") {
+      writer.uint32(18).string(message.alertRuleName);
     }
-    if (message.window !== undefined) {
-      WindowType.encode(message.window, writer.uint32(26).fork()).join();
+    if (message.algorithm !== undefined) {
+      Algorithm.encode(message.algorithm, writer.uint32(26).fork()).join();
     }
-    if (message.metadata !== undefined && message.metadata.length !== 0) {
-      for (const v of message.metadata) {
-        WindowsForMetadataRead_Metadata.encode(v!, writer.uint32(34).fork()).join();
-      }
+    if (message.status !== undefined && message.status !== 0) {
+      writer.uint32(32).int32(message.status);
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      writer.uint32(42).string(message.origin);
+    }
+    if (message.metadata !== undefined) {
+      Struct.encode(Struct.wrap(message.metadata), writer.uint32(50).fork()).join();
+    }
+    if (message.windowTimeFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.windowTimeFrom), writer.uint32(58).fork()).join();
+    }
+    if (message.windowTimeTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.windowTimeTo), writer.uint32(66).fork()).join();
+    }
+    if (message.fired !== undefined) {
+      Timestamp.encode(toTimestamp(message.fired), writer.uint32(74).fork()).join();
+    }
+    if (message.resolved !== undefined) {
+      Timestamp.encode(toTimestamp(message.resolved), writer.uint32(82).fork()).join();
+    }
+    if (message.acknowledged !== undefined) {
+      Timestamp.encode(toTimestamp(message.acknowledged), writer.uint32(90).fork()).join();
+    }
+    if (message.acknowledgedBy !== undefined && message.acknowledgedBy !== "") {
+      writer.uint32(98).string(message.acknowledgedBy);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowsForMetadataRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): Alerts_Alert {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowsForMetadataRead();
+    const message = createBaseAlerts_Alert();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.alertId = reader.int64().toString();
           continue;
         }
         case 2: {
@@ -3798,7 +10616,7 @@ export const WindowsForMetadataRead: MessageFns<WindowsForMetadataRead> = {
             break;
           }
 
-          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.alertRuleName = reader.string();
           continue;
         }
         case 3: {
@@ -3806,108 +10624,79 @@ export const WindowsForMetadataRead: MessageFns<WindowsForMetadataRead> = {
             break;
           }
 
-          message.window = WindowType.decode(reader, reader.uint32());
+          message.algorithm = Algorithm.decode(reader, reader.uint32());
           continue;
         }
         case 4: {
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          const el = WindowsForMetadataRead_Metadata.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.metadata!.push(el);
-          }
+          message.status = reader.int32() as any;
           continue;
         }
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skip(tag & 7);
-    }
-    return message;
-  },
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
 
-  fromJSON(object: any): WindowsForMetadataRead {
-    return {
-      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
-      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
-      window: isSet(object.window) ? WindowType.fromJSON(object.window) : undefined,
-      metadata: globalThis.Array.isArray(object?.metadata)
-        ? object.metadata.map((e: any) => WindowsForMetadataRead_Metadata.fromJSON(e))
-        : [],
-    };
-  },
+          message.origin = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
 
-  toJSON(message: WindowsForMetadataRead): unknown {
-    const obj: any = {};
-    if (message.timeFrom !== undefined) {
-      obj.timeFrom = message.timeFrom.toISOString();
-    }
-    if (message.timeTo !== undefined) {
-      obj.timeTo = message.timeTo.toISOString();
-    }
-    if (message.window !== undefined) {
-      obj.window = WindowType.toJSON(message.window);
-    }
-    if (message.metadata?.length) {
-      obj.metadata = message.metadata.map((e) => WindowsForMetadataRead_Metadata.toJSON(e));
-    }
-    return obj;
-  },
+          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
 
-  create<I extends Exact<DeepPartial<WindowsForMetadataRead>, I>>(base?: I): WindowsForMetadataRead {
-    return WindowsForMetadataRead.fromPartial(base ?? ({} as any));
-  },
-  fromPartial<I extends Exact<DeepPartial<WindowsForMetadataRead>, I>>(object: I): WindowsForMetadataRead {
-    const message = createBaseWindowsForMetadataRead();
-    message.timeFrom = object.timeFrom ?? undefined;
-    message.timeTo = object.timeTo ?? undefined;
-    message.window = (object.window !== undefined && object.window !== null)
-      ? WindowType.fromPartial(object.window)
-      : undefined;
-    message.metadata = object.metadata?.map((e) => WindowsForMetadataRead_Metadata.fromPartial(e)) || [];
-    return message;
-  },
-};
+          message.windowTimeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
 
-function createBaseWindowsForMetadataRead_Metadata(): WindowsForMetadataRead_Metadata {
-  return { field: "", value: undefined };
-}
+          message.windowTimeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
 
-export const WindowsForMetadataRead_Metadata: MessageFns<WindowsForMetadataRead_Metadata> = {
-  encode(message: WindowsForMetadataRead_Metadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.field !== undefined && message.field !== "") {
-      writer.uint32(10).string(message.field);
-    }
-    if (message.value !== undefined) {
-      Value.encode(Value.wrap(message.value), writer.uint32(18).fork()).join();
-    }
-    return writer;
-  },
+          message.fired = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowsForMetadataRead_Metadata {
-    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
-    const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowsForMetadataRead_Metadata();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1: {
-          if (tag !== 10) {
+          message.resolved = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
             break;
           }
 
-          message.field = reader.string();
+          message.acknowledged = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
-        case 2: {
-          if (tag !== 18) {
+        case 12: {
+          if (tag !== 98) {
             break;
           }
 
-          message.value = Value.unwrap(Value.decode(reader, reader.uint32()));
+          message.acknowledgedBy = reader.string();
           continue;
         }
       }
@@ -3919,67 +10708,123 @@ export const WindowsForMetadataRead_Metadata: MessageFns<WindowsForMetadataRead_
     return message;
   },
 
-  fromJSON(object: any): WindowsForMetadataRead_Metadata {
+  fromJSON(object: any): Alerts_Alert {
     return {
-      field: isSet(object.field) ? globalThis.String(object.field) : "",
-      value: isSet(object?.value) ? object.value : undefined,
+      alertId: isSet(object.alertId) ? globalThis.String(object.alertId) : "0",
+      alertRuleName: isSet(object.alertRuleName) ? globalThis.String(object.alertRuleName) : "",
+      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
+      status: isSet(object.status) ? alertStatusFromJSON(object.status) : 0,
+      origin: isSet(object.origin) ? globalThis.String(object.origin) : "",
+      metadata: isObject(object.metadata) ? object.metadata : undefined,
+      windowTimeFrom: isSet(object.windowTimeFrom) ? fromJsonTimestamp(object.windowTimeFrom) : undefined,
+      windowTimeTo: isSet(object.windowTimeTo) ? fromJsonTimestamp(object.windowTimeTo) : undefined,
+      fired: isSet(object.fired) ? fromJsonTimestamp(object.fired) : undefined,
+      resolved: isSet(object.resolved) ? fromJsonTimestamp(object.resolved) : undefined,
+      acknowledged: isSet(object.acknowledged) ? fromJsonTimestamp(object.acknowledged) : undefined,
+      acknowledgedBy: isSet(object.acknowledgedBy) ? globalThis.String(object.acknowledgedBy) : "",
     };
   },
 
-  toJSON(message: WindowsForMetadataRead_Metadata): unknown {
+  toJSON(message: Alerts_Alert): unknown {
     const obj: any = {};
-    if (message.field !== undefined && message.field !== "") {
-      obj.field = message.field;
+    if (message.alertId !== undefined && message.alertId !== "0") {
+      obj.alertId = message.alertId;
     }
-    if (message.value !== undefined) {
-      obj.value = message.value;
+    if (message.alertRuleName !== undefined && message.alertRuleName !== "") {
+      obj.alertRuleName = message.alertRuleName;
+    }
+    if (message.algorithm !== undefined) {
+      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    }
+    if (message.status !== undefined && message.status !== 0) {
+      obj.status = alertStatusToJSON(message.status);
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      obj.origin = message.origin;
+    }
+    if (message.metadata !== undefined) {
+      obj.metadata = message.metadata;
+    }
+    if (message.windowTimeFrom !== undefined) {
+      obj.windowTimeFrom = message.windowTimeFrom.toISOString();
+    }
+    if (message.windowTimeTo !== undefined) {
+      obj.windowTimeTo = message.windowTimeTo.toISOString();
+    }
+    if (message.fired !== undefined) {
+      obj.fired = message.fired.toISOString();
+    }
+    if (message.resolved !== undefined) {
+      obj.resolved = message.resolved.toISOString();
+    }
+    if (message.acknowledged !== undefined) {
+      obj.acknowledged = message.acknowledged.toISOString();
+    }
+    if (message.acknowledgedBy !== undefined && message.acknowledgedBy !== "") {
+      obj.acknowledgedBy = message.acknowledgedBy;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<WindowsForMetadataRead_Metadata>, I>>(base?: I): WindowsForMetadataRead_Metadata {
-    return WindowsForMetadataRead_Metadata.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Alerts_Alert>, I>>(base?: I): Alerts_Alert {
+    return Alerts_Alert.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<WindowsForMetadataRead_Metadata>, I>>(
-    object: I,
-  ): WindowsForMetadataRead_Metadata {
-    const message = createBaseWindowsForMetadataRead_Metadata();
-    message.field = object.field ?? "";
-    message.value = object.value ?? undefined;
+  fromPartial<I extends Exact<DeepPartial<Alerts_Alert>, I>>(object: I): Alerts_Alert {
+    const message = createBaseAlerts_Alert();
+    message.alertId = object.alertId ?? "0";
+    message.alertRuleName = object.alertRuleName ?? "";
+    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
+      ? Algorithm.fromPartial(object.algorithm)
+      : undefined;
+    message.status = object.status ?? 0;
+    message.origin = object.origin ?? "";
+    message.metadata = object.metadata ?? undefined;
+    message.windowTimeFrom = object.windowTimeFrom ?? undefined;
+    message.windowTimeTo = object.windowTimeTo ?? undefined;
+    message.fired = object.fired ?? undefined;
+    message.resolved = object.resolved ?? undefined;
+    message.acknowledged = object.acknowledged ?? undefined;
+    message.acknowledgedBy = object.acknowledgedBy ?? "";
     return message;
   },
 };
 
-function createBaseWindowsForMetadata(): WindowsForMetadata {
-  return { window: [] };
+function createBaseAlertAcknowledgement(): AlertAcknowledgement {
+  return { alertId: "0", acknowledgedBy: "" };
 }
 
-export const WindowsForMetadata: MessageFns<WindowsForMetadata> = {
-  encode(message: WindowsForMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.window !== undefined && message.window.length !== 0) {
-      for (const v of message.window) {
-        Window.encode(v!, writer.uint32(10).fork()).join();
-      }
+export const AlertAcknowledgement: MessageFns<AlertAcknowledgement> = {
+  encode(message: AlertAcknowledgement, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.alertId !== undefined && message.alertId !== "0") {
+      writer.uint32(8).int64(message.alertId);
+    }
+    if (message.acknowledgedBy !== undefined && message.acknowledgedBy !== "") {
+      writer.uint32(18).string(message.acknowledgedBy);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): WindowsForMetadata {
+  decode(input: BinaryReader | Uint8Array, length?: number): AlertAcknowledgement {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseWindowsForMetadata();
+    const message = createBaseAlertAcknowledgement();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1: {
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          const el = Window.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.window!.push(el);
+          message.alertId = reader.int64().toString();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
           }
+
+          message.acknowledgedBy = reader.string();
           continue;
         }
       }
@@ -3991,57 +10836,89 @@ export const WindowsForMetadata: MessageFns<WindowsForMetadata> = {
     return message;
   },
 
-  fromJSON(object: any): WindowsForMetadata {
+  fromJSON(object: any): AlertAcknowledgement {
     return {
-      window: globalThis.Array.isArray(object?.window) ? object.window.map((e: any) => Window.fromJSON(e)) : [],
+      alertId: isSet(object.alertId) ? globalThis.String(object.alertId) : "0",
+      acknowledgedBy: isSet(object.acknowledgedBy) ? globalThis.String(object.acknowledgedBy) : "",
     };
   },
 
-  toJSON(message: WindowsForMetadata): unknown {
+  toJSON(message: AlertAcknowledgement): unknown {
     const obj: any = {};
-    if (message.window?.length) {
-      obj.window = message.window.map((e) => Window.toJSON(e));
+    if (message.alertId !== undefined && message.alertId !== "0") {
+      obj.alertId = message.alertId;
+    }
+    if (message.acknowledgedBy !== undefined && message.acknowledgedBy !== "") {
+      obj.acknowledgedBy = message.acknowledgedBy;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<WindowsForMetadata>, I>>(base?: I): WindowsForMetadata {
-    return WindowsForMetadata.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<AlertAcknowledgement>, I>>(base?: I): AlertAcknowledgement {
+    return AlertAcknowledgement.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<WindowsForMetadata>, I>>(object: I): WindowsForMetadata {
-    const message = createBaseWindowsForMetadata();
-    message.window = object.window?.map((e) => Window.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<AlertAcknowledgement>, I>>(object: I): AlertAcknowledgement {
+    const message = createBaseAlertAcknowledgement();
+    message.alertId = object.alertId ?? "0";
+    message.acknowledgedBy = object.acknowledgedBy ?? "";
     return message;
   },
 };
 
-function createBaseResultsForAlgorithmAndMetadataRead(): ResultsForAlgorithmAndMetadataRead {
-  return { timeFrom: undefined, timeTo: undefined, algorithm: undefined, metadata: [] };
+function createBaseWindowSchedule(): WindowSchedule {
+  return {
+    name: "",
+    windowTypeName: "",
+    windowTypeVersion: "",
+    cron: "",
+    timezone: "",
+    origin: "",
+    metadata: undefined,
+    start: undefined,
+    emittedUntil: undefined,
+    nextEmission: undefined,
+  };
 }
 
-export const ResultsForAlgorithmAndMetadataRead: MessageFns<ResultsForAlgorithmAndMetadataRead> = {
-  encode(message: ResultsForAlgorithmAndMetadataRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.timeFrom !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
+export const WindowSchedule: MessageFns<WindowSchedule> = {
+  encode(message: WindowSchedule, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.timeTo !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      writer.uint32(18).string(message.windowTypeName);
     }
-    if (message.algorithm !== undefined) {
-      Algorithm.encode(message.algorithm, writer.uint32(26).fork()).join();
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      writer.uint32(26).string(message.windowTypeVersion);
     }
-    if (message.metadata !== undefined && message.metadata.length !== 0) {
-      for (const v of message.metadata) {
-        ResultsForAlgorithmAndMetadataRead_Metadata.encode(v!, writer.uint32(34).fork()).join();
-      }
+    if (message.cron !== undefined && message.cron !== "") {
+      writer.uint32(34).string(message.cron);
+    }
+    if (message.timezone !== undefined && message.timezone !== "") {
+      writer.uint32(42).string(message.timezone);
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      writer.uint32(50).string(message.origin);
+    }
+    if (message.metadata !== undefined) {
+      Struct.encode(Struct.wrap(message.metadata), writer.uint32(58).fork()).join();
+    }
+    if (message.start !== undefined) {
+      Timestamp.encode(toTimestamp(message.start), writer.uint32(66).fork()).join();
+    }
+    if (message.emittedUntil !== undefined) {
+      Timestamp.encode(toTimestamp(message.emittedUntil), writer.uint32(74).fork()).join();
+    }
+    if (message.nextEmission !== undefined) {
+      Timestamp.encode(toTimestamp(message.nextEmission), writer.uint32(82).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadataRead {
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowSchedule {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsForAlgorithmAndMetadataRead();
+    const message = createBaseWindowSchedule();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4050,7 +10927,7 @@ export const ResultsForAlgorithmAndMetadataRead: MessageFns<ResultsForAlgorithmA
             break;
           }
 
-          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
         }
         case 2: {
@@ -4058,7 +10935,7 @@ export const ResultsForAlgorithmAndMetadataRead: MessageFns<ResultsForAlgorithmA
             break;
           }
 
-          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.windowTypeName = reader.string();
           continue;
         }
         case 3: {
@@ -4066,7 +10943,7 @@ export const ResultsForAlgorithmAndMetadataRead: MessageFns<ResultsForAlgorithmA
             break;
           }
 
-          message.algorithm = Algorithm.decode(reader, reader.uint32());
+          message.windowTypeVersion = reader.string();
           continue;
         }
         case 4: {
@@ -4074,10 +10951,55 @@ export const ResultsForAlgorithmAndMetadataRead: MessageFns<ResultsForAlgorithmA
             break;
           }
 
-          const el = ResultsForAlgorithmAndMetadataRead_Metadata.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.metadata!.push(el);
+          message.cron = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.timezone = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.origin = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.start = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.emittedUntil = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
           }
+
+          message.nextEmission = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -4089,75 +11011,136 @@ export const ResultsForAlgorithmAndMetadataRead: MessageFns<ResultsForAlgorithmA
     return message;
   },
 
-  fromJSON(object: any): ResultsForAlgorithmAndMetadataRead {
+  fromJSON(object: any): WindowSchedule {
     return {
-      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
-      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
-      algorithm: isSet(object.algorithm) ? Algorithm.fromJSON(object.algorithm) : undefined,
-      metadata: globalThis.Array.isArray(object?.metadata)
-        ? object.metadata.map((e: any) => ResultsForAlgorithmAndMetadataRead_Metadata.fromJSON(e))
-        : [],
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      windowTypeName: isSet(object.windowTypeName) ? globalThis.String(object.windowTypeName) : "",
+      windowTypeVersion: isSet(object.windowTypeVersion) ? globalThis.String(object.windowTypeVersion) : "",
+      cron: isSet(object.cron) ? globalThis.String(object.cron) : "",
+      timezone: isSet(object.timezone) ? globalThis.String(object.timezone) : "",
+      origin: isSet(object.origin) ? globalThis.String(object.origin) : "",
+      metadata: isObject(object.metadata) ? object.metadata : undefined,
+      start: isSet(object.start) ? fromJsonTimestamp(object.start) : undefined,
+      emittedUntil: isSet(object.emittedUntil) ? fromJsonTimestamp(object.emittedUntil) : undefined,
+      nextEmission: isSet(object.nextEmission) ? fromJsonTimestamp(object.nextEmission) : undefined,
     };
   },
 
-  toJSON(message: ResultsForAlgorithmAndMetadataRead): unknown {
+  toJSON(message: WindowSchedule): unknown {
     const obj: any = {};
-    if (message.timeFrom !== undefined) {
-      obj.timeFrom = message.timeFrom.toISOString();
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.timeTo !== undefined) {
-      obj.timeTo = message.timeTo.toISOString();
+    if (message.windowTypeName !== undefined && message.windowTypeName !== "") {
+      obj.windowTypeName = message.windowTypeName;
     }
-    if (message.algorithm !== undefined) {
-      obj.algorithm = Algorithm.toJSON(message.algorithm);
+    if (message.windowTypeVersion !== undefined && message.windowTypeVersion !== "") {
+      obj.windowTypeVersion = message.windowTypeVersion;
     }
-    if (message.metadata?.length) {
-      obj.metadata = message.metadata.map((e) => ResultsForAlgorithmAndMetadataRead_Metadata.toJSON(e));
+    if (message.cron !== undefined && message.cron !== "") {
+      obj.cron = message.cron;
+    }
+    if (message.timezone !== undefined && message.timezone !== "") {
+      obj.timezone = message.timezone;
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      obj.origin = message.origin;
+    }
+    if (message.metadata !== undefined) {
+      obj.metadata = message.metadata;
+    }
+    if (message.start !== undefined) {
+      obj.start = message.start.toISOString();
+    }
+    if (message.emittedUntil !== undefined) {
+      obj.emittedUntil = message.emittedUntil.toISOString();
+    }
+    if (message.nextEmission !== undefined) {
+      obj.nextEmission = message.nextEmission.toISOString();
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead>, I>>(
-    base?: I,
-  ): ResultsForAlgorithmAndMetadataRead {
-    return ResultsForAlgorithmAndMetadataRead.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<WindowSchedule>, I>>(base?: I): WindowSchedule {
+    return WindowSchedule.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead>, I>>(
-    object: I,
-  ): ResultsForAlgorithmAndMetadataRead {
-    const message = createBaseResultsForAlgorithmAndMetadataRead();
-    message.timeFrom = object.timeFrom ?? undefined;
-    message.timeTo = object.timeTo ?? undefined;
-    message.algorithm = (object.algorithm !== undefined && object.algorithm !== null)
-      ? Algorithm.fromPartial(object.algorithm)
-      : undefined;
-    message.metadata = object.metadata?.map((e) => ResultsForAlgorithmAndMetadataRead_Metadata.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<WindowSchedule>, I>>(object: I): WindowSchedule {
+    const message = createBaseWindowSchedule();
+    message.name = object.name ?? "";
+    message.windowTypeName = object.windowTypeName ?? "";
+    message.windowTypeVersion = object.windowTypeVersion ?? "";
+    message.cron = object.cron ?? "";
+    message.timezone = object.timezone ?? "";
+    message.origin = object.origin ?? "";
+    message.metadata = object.metadata ?? undefined;
+    message.start = object.start ?? undefined;
+    message.emittedUntil = object.emittedUntil ?? undefined;
+    message.nextEmission = object.nextEmission ?? undefined;
     return message;
   },
 };
 
-function createBaseResultsForAlgorithmAndMetadataRead_Metadata(): ResultsForAlgorithmAndMetadataRead_Metadata {
-  return { field: "", value: undefined };
+function createBaseWindowSchedulesRead(): WindowSchedulesRead {
+  return {};
 }
 
-export const ResultsForAlgorithmAndMetadataRead_Metadata: MessageFns<ResultsForAlgorithmAndMetadataRead_Metadata> = {
-  encode(
-    message: ResultsForAlgorithmAndMetadataRead_Metadata,
-    writer: BinaryWriter = new BinaryWriter(),
-  ): BinaryWriter {
-    if (message.field !== undefined && message.field !== "") {
-      writer.uint32(10).string(message.field);
+export const WindowSchedulesRead: MessageFns<WindowSchedulesRead> = {
+  encode(_: WindowSchedulesRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowSchedulesRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWindowSchedulesRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
     }
-    if (message.value !== undefined) {
-      Value.encode(Value.wrap(message.value), writer.uint32(18).fork()).join();
+    return message;
+  },
+
+  fromJSON(_: any): WindowSchedulesRead {
+    return {};
+  },
+
+  toJSON(_: WindowSchedulesRead): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WindowSchedulesRead>, I>>(base?: I): WindowSchedulesRead {
+    return WindowSchedulesRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<WindowSchedulesRead>, I>>(_: I): WindowSchedulesRead {
+    const message = createBaseWindowSchedulesRead();
+    return message;
+  },
+};
+
+function createBaseWindowSchedules(): WindowSchedules {
+  return { windowSchedules: [] };
+}
+
+export const WindowSchedules: MessageFns<WindowSchedules> = {
+  encode(message: WindowSchedules, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.windowSchedules !== undefined && message.windowSchedules.length !== 0) {
+      for (const v of message.windowSchedules) {
+        WindowSchedule.encode(v!, writer.uint32(10).fork()).join();
+      }
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadataRead_Metadata {
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowSchedules {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsForAlgorithmAndMetadataRead_Metadata();
+    const message = createBaseWindowSchedules();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4166,15 +11149,10 @@ export const ResultsForAlgorithmAndMetadataRead_Metadata: MessageFns<ResultsForA
             break;
           }
 
-          message.field = reader.string();
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
+          const el = WindowSchedule.decode(reader, reader.uint32());
+          if (el !== undefined) {
+            message.windowSchedules!.push(el);
           }
-
-          message.value = Value.unwrap(Value.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -4186,57 +11164,48 @@ export const ResultsForAlgorithmAndMetadataRead_Metadata: MessageFns<ResultsForA
     return message;
   },
 
-  fromJSON(object: any): ResultsForAlgorithmAndMetadataRead_Metadata {
+  fromJSON(object: any): WindowSchedules {
     return {
-      field: isSet(object.field) ? globalThis.String(object.field) : "",
-      value: isSet(object?.value) ? object.value : undefined,
+      windowSchedules: globalThis.Array.isArray(object?.windowSchedules)
+        ? object.windowSchedules.map((e: any) => WindowSchedule.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: ResultsForAlgorithmAndMetadataRead_Metadata): unknown {
+  toJSON(message: WindowSchedules): unknown {
     const obj: any = {};
-    if (message.field !== undefined && message.field !== "") {
-      obj.field = message.field;
-    }
-    if (message.value !== undefined) {
-      obj.value = message.value;
+    if (message.windowSchedules?.length) {
+      obj.windowSchedules = message.windowSchedules.map((e) => WindowSchedule.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead_Metadata>, I>>(
-    base?: I,
-  ): ResultsForAlgorithmAndMetadataRead_Metadata {
-    return ResultsForAlgorithmAndMetadataRead_Metadata.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<WindowSchedules>, I>>(base?: I): WindowSchedules {
+    return WindowSchedules.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadataRead_Metadata>, I>>(
-    object: I,
-  ): ResultsForAlgorithmAndMetadataRead_Metadata {
-    const message = createBaseResultsForAlgorithmAndMetadataRead_Metadata();
-    message.field = object.field ?? "";
-    message.value = object.value ?? undefined;
+  fromPartial<I extends Exact<DeepPartial<WindowSchedules>, I>>(object: I): WindowSchedules {
+    const message = createBaseWindowSchedules();
+    message.windowSchedules = object.windowSchedules?.map((e) => WindowSchedule.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseResultsForAlgorithmAndMetadata(): ResultsForAlgorithmAndMetadata {
-  return { results: [] };
+function createBaseWindowScheduleDeletion(): WindowScheduleDeletion {
+  return { name: "" };
 }
 
-export const ResultsForAlgorithmAndMetadata: MessageFns<ResultsForAlgorithmAndMetadata> = {
-  encode(message: ResultsForAlgorithmAndMetadata, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.results !== undefined && message.results.length !== 0) {
-      for (const v of message.results) {
-        ResultsForAlgorithmAndMetadata_ResultsRow.encode(v!, writer.uint32(10).fork()).join();
-      }
+export const WindowScheduleDeletion: MessageFns<WindowScheduleDeletion> = {
+  encode(message: WindowScheduleDeletion, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadata {
+  decode(input: BinaryReader | Uint8Array, length?: number): WindowScheduleDeletion {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsForAlgorithmAndMetadata();
+    const message = createBaseWindowScheduleDeletion();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4245,10 +11214,7 @@ export const ResultsForAlgorithmAndMetadata: MessageFns<ResultsForAlgorithmAndMe
             break;
           }
 
-          const el = ResultsForAlgorithmAndMetadata_ResultsRow.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.results!.push(el);
-          }
+          message.name = reader.string();
           continue;
         }
       }
@@ -4257,64 +11223,83 @@ export const ResultsForAlgorithmAndMetadata: MessageFns<ResultsForAlgorithmAndMe
       }
       reader.skip(tag & 7);
     }
-    return message;
-  },
-
-  fromJSON(object: any): ResultsForAlgorithmAndMetadata {
-    return {
-      results: globalThis.Array.isArray(object?.results)
-        ? object.results.map((e: any) => ResultsForAlgorithmAndMetadata_ResultsRow.fromJSON(e))
-        : [],
-    };
+    return message;
   },
 
-  toJSON(message: ResultsForAlgorithmAndMetadata): unknown {
+  fromJSON(object: any): WindowScheduleDeletion {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: WindowScheduleDeletion): unknown {
     const obj: any = {};
-    if (message.results?.length) {
-      obj.results = message.results.map((e) => ResultsForAlgorithmAndMetadata_ResultsRow.toJSON(e));
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata>, I>>(base?: I): ResultsForAlgorithmAndMetadata {
-    return ResultsForAlgorithmAndMetadata.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<WindowScheduleDeletion>, I>>(base?: I): WindowScheduleDeletion {
+    return WindowScheduleDeletion.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata>, I>>(
-    object: I,
-  ): ResultsForAlgorithmAndMetadata {
-    const message = createBaseResultsForAlgorithmAndMetadata();
-    message.results = object.results?.map((e) => ResultsForAlgorithmAndMetadata_ResultsRow.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<WindowScheduleDeletion>, I>>(object: I): WindowScheduleDeletion {
+    const message = createBaseWindowScheduleDeletion();
+    message.name = object.name ?? "";
     return message;
   },
 };
 
-function createBaseResultsForAlgorithmAndMetadata_ResultsRow(): ResultsForAlgorithmAndMetadata_ResultsRow {
-  return { time: undefined, resultData: undefined };
+function createBaseRollupRule(): RollupRule {
+  return {
+    name: "",
+    childWindowTypeName: "",
+    childWindowTypeVersion: "",
+    parentWindowTypeName: "",
+    parentWindowTypeVersion: "",
+    cron: "",
+    timezone: "",
+    metadataKeys: [],
+    origin: "",
+  };
 }
 
-export const ResultsForAlgorithmAndMetadata_ResultsRow: MessageFns<ResultsForAlgorithmAndMetadata_ResultsRow> = {
-  encode(message: ResultsForAlgorithmAndMetadata_ResultsRow, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.time !== undefined) {
-      Timestamp.encode(toTimestamp(message.time), writer.uint32(10).fork()).join();
+export const RollupRule: MessageFns<RollupRule> = {
+  encode(message: RollupRule, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    switch (message.resultData?.$case) {
-      case "singleValue":
-        writer.uint32(21).float(message.resultData.value);
-        break;
-      case "arrayValues":
-        FloatArray.encode(message.resultData.value, writer.uint32(26).fork()).join();
-        break;
-      case "structValue":
-        Struct.encode(Struct.wrap(message.resultData.value), writer.uint32(34).fork()).join();
-        break;
+    if (message.childWindowTypeName !== undefined && message.childWindowTypeName !== "") {
+      writer.uint32(18).string(message.childWindowTypeName);
+    }
+    if (message.childWindowTypeVersion !== undefined && message.childWindowTypeVersion !== "") {
+      writer.uint32(26).string(message.childWindowTypeVersion);
+    }
+    if (message.parentWindowTypeName !== undefined && message.parentWindowTypeName !== "") {
+      writer.uint32(34).string(message.parentWindowTypeName);
+    }
+    if (message.parentWindowTypeVersion !== undefined && message.parentWindowTypeVersion !== "") {
+      writer.uint32(42).string(message.parentWindowTypeVersion);
+    }
+    if (message.cron !== undefined && message.cron !== "") {
+      writer.uint32(50).string(message.cron);
+    }
+    if (message.timezone !== undefined && message.timezone !== "") {
+      writer.uint32(58).string(message.timezone);
+    }
+    if (message.metadataKeys !== undefined && message.metadataKeys.length !== 0) {
+      for (const v of message.metadataKeys) {
+        writer.uint32(66).string(v!);
+      }
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      writer.uint32(74).string(message.origin);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): ResultsForAlgorithmAndMetadata_ResultsRow {
+  decode(input: BinaryReader | Uint8Array, length?: number): RollupRule {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseResultsForAlgorithmAndMetadata_ResultsRow();
+    const message = createBaseRollupRule();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4323,15 +11308,15 @@ export const ResultsForAlgorithmAndMetadata_ResultsRow: MessageFns<ResultsForAlg
             break;
           }
 
-          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
         }
         case 2: {
-          if (tag !== 21) {
+          if (tag !== 18) {
             break;
           }
 
-          message.resultData = { $case: "singleValue", value: reader.float() };
+          message.childWindowTypeName = reader.string();
           continue;
         }
         case 3: {
@@ -4339,7 +11324,7 @@ export const ResultsForAlgorithmAndMetadata_ResultsRow: MessageFns<ResultsForAlg
             break;
           }
 
-          message.resultData = { $case: "arrayValues", value: FloatArray.decode(reader, reader.uint32()) };
+          message.childWindowTypeVersion = reader.string();
           continue;
         }
         case 4: {
@@ -4347,7 +11332,50 @@ export const ResultsForAlgorithmAndMetadata_ResultsRow: MessageFns<ResultsForAlg
             break;
           }
 
-          message.resultData = { $case: "structValue", value: Struct.unwrap(Struct.decode(reader, reader.uint32())) };
+          message.parentWindowTypeName = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.parentWindowTypeVersion = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.cron = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.timezone = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          const el = reader.string();
+          if (el !== undefined) {
+            message.metadataKeys!.push(el);
+          }
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.origin = reader.string();
           continue;
         }
       }
@@ -4359,110 +11387,137 @@ export const ResultsForAlgorithmAndMetadata_ResultsRow: MessageFns<ResultsForAlg
     return message;
   },
 
-  fromJSON(object: any): ResultsForAlgorithmAndMetadata_ResultsRow {
+  fromJSON(object: any): RollupRule {
     return {
-      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
-      resultData: isSet(object.singleValue)
-        ? { $case: "singleValue", value: globalThis.Number(object.singleValue) }
-        : isSet(object.arrayValues)
-        ? { $case: "arrayValues", value: FloatArray.fromJSON(object.arrayValues) }
-        : isSet(object.structValue)
-        ? { $case: "structValue", value: object.structValue }
-        : undefined,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      childWindowTypeName: isSet(object.childWindowTypeName) ? globalThis.String(object.childWindowTypeName) : "",
+      childWindowTypeVersion: isSet(object.childWindowTypeVersion)
+        ? globalThis.String(object.childWindowTypeVersion)
+        : "",
+      parentWindowTypeName: isSet(object.parentWindowTypeName) ? globalThis.String(object.parentWindowTypeName) : "",
+      parentWindowTypeVersion: isSet(object.parentWindowTypeVersion)
+        ? globalThis.String(object.parentWindowTypeVersion)
+        : "",
+      cron: isSet(object.cron) ? globalThis.String(object.cron) : "",
+      timezone: isSet(object.timezone) ? globalThis.String(object.timezone) : "",
+      metadataKeys: globalThis.Array.isArray(object?.metadataKeys)
+        ? object.metadataKeys.map((e: any) => globalThis.String(e))
+        : [],
+      origin: isSet(object.origin) ? globalThis.String(object.origin) : "",
     };
   },
 
-  toJSON(message: ResultsForAlgorithmAndMetadata_ResultsRow): unknown {
+  toJSON(message: RollupRule): unknown {
     const obj: any = {};
-    if (message.time !== undefined) {
-      obj.time = message.time.toISOString();
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.resultData?.$case === "singleValue") {
-      obj.singleValue = message.resultData.value;
-    } else if (message.resultData?.$case === "arrayValues") {
-      obj.arrayValues = FloatArray.toJSON(message.resultData.value);
-    } else if (message.resultData?.$case === "structValue") {
-      obj.structValue = message.resultData.value;
+    if (message.childWindowTypeName !== undefined && message.childWindowTypeName !== "") {
+      obj.childWindowTypeName = message.childWindowTypeName;
+    }
+    if (message.childWindowTypeVersion !== undefined && message.childWindowTypeVersion !== "") {
+      obj.childWindowTypeVersion = message.childWindowTypeVersion;
+    }
+    if (message.parentWindowTypeName !== undefined && message.parentWindowTypeName !== "") {
+      obj.parentWindowTypeName = message.parentWindowTypeName;
+    }
+    if (message.parentWindowTypeVersion !== undefined && message.parentWindowTypeVersion !== "") {
+      obj.parentWindowTypeVersion = message.parentWindowTypeVersion;
+    }
+    if (message.cron !== undefined && message.cron !== "") {
+      obj.cron = message.cron;
+    }
+    if (message.timezone !== undefined && message.timezone !== "") {
+      obj.timezone = message.timezone;
+    }
+    if (message.metadataKeys?.length) {
+      obj.metadataKeys = message.metadataKeys;
+    }
+    if (message.origin !== undefined && message.origin !== "") {
+      obj.origin = message.origin;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata_ResultsRow>, I>>(
-    base?: I,
-  ): ResultsForAlgorithmAndMetadata_ResultsRow {
-    return ResultsForAlgorithmAndMetadata_ResultsRow.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<RollupRule>, I>>(base?: I): RollupRule {
+    return RollupRule.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<ResultsForAlgorithmAndMetadata_ResultsRow>, I>>(
-    object: I,
-  ): ResultsForAlgorithmAndMetadata_ResultsRow {
-    const message = createBaseResultsForAlgorithmAndMetadata_ResultsRow();
-    message.time = object.time ?? undefined;
-    switch (object.resultData?.$case) {
-      case "singleValue": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "singleValue", value: object.resultData.value };
-        }
-        break;
-      }
-      case "arrayValues": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "arrayValues", value: FloatArray.fromPartial(object.resultData.value) };
-        }
-        break;
+  fromPartial<I extends Exact<DeepPartial<RollupRule>, I>>(object: I): RollupRule {
+    const message = createBaseRollupRule();
+    message.name = object.name ?? "";
+    message.childWindowTypeName = object.childWindowTypeName ?? "";
+    message.childWindowTypeVersion = object.childWindowTypeVersion ?? "";
+    message.parentWindowTypeName = object.parentWindowTypeName ?? "";
+    message.parentWindowTypeVersion = object.parentWindowTypeVersion ?? "";
+    message.cron = object.cron ?? "";
+    message.timezone = object.timezone ?? "";
+    message.metadataKeys = object.metadataKeys?.map((e) => e) || [];
+    message.origin = object.origin ?? "";
+    return message;
+  },
+};
+
+function createBaseRollupRulesRead(): RollupRulesRead {
+  return {};
+}
+
+export const RollupRulesRead: MessageFns<RollupRulesRead> = {
+  encode(_: RollupRulesRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RollupRulesRead {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRollupRulesRead();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
       }
-      case "structValue": {
-        if (object.resultData?.value !== undefined && object.resultData?.value !== null) {
-          message.resultData = { $case: "structValue", value: object.resultData.value };
-        }
+      if ((tag & 7) === 4 || tag === 0) {
         break;
       }
+      reader.skip(tag & 7);
     }
     return message;
   },
+
+  fromJSON(_: any): RollupRulesRead {
+    return {};
+  },
+
+  toJSON(_: RollupRulesRead): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<RollupRulesRead>, I>>(base?: I): RollupRulesRead {
+    return RollupRulesRead.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RollupRulesRead>, I>>(_: I): RollupRulesRead {
+    const message = createBaseRollupRulesRead();
+    return message;
+  },
 };
 
-function createBaseAnnotateWrite(): AnnotateWrite {
-  return {
-    timeFrom: undefined,
-    timeTo: undefined,
-    capturedAlgorithms: [],
-    capturedWindows: [],
-    description: "",
-    metadata: undefined,
-  };
+function createBaseRollupRules(): RollupRules {
+  return { rollupRules: [] };
 }
 
-export const AnnotateWrite: MessageFns<AnnotateWrite> = {
-  encode(message: AnnotateWrite, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.timeFrom !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeFrom), writer.uint32(10).fork()).join();
-    }
-    if (message.timeTo !== undefined) {
-      Timestamp.encode(toTimestamp(message.timeTo), writer.uint32(18).fork()).join();
-    }
-    if (message.capturedAlgorithms !== undefined && message.capturedAlgorithms.length !== 0) {
-      for (const v of message.capturedAlgorithms) {
-        Algorithm.encode(v!, writer.uint32(26).fork()).join();
-      }
-    }
-    if (message.capturedWindows !== undefined && message.capturedWindows.length !== 0) {
-      for (const v of message.capturedWindows) {
-        WindowType.encode(v!, writer.uint32(34).fork()).join();
+export const RollupRules: MessageFns<RollupRules> = {
+  encode(message: RollupRules, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.rollupRules !== undefined && message.rollupRules.length !== 0) {
+      for (const v of message.rollupRules) {
+        RollupRule.encode(v!, writer.uint32(10).fork()).join();
       }
     }
-    if (message.description !== undefined && message.description !== "") {
-      writer.uint32(42).string(message.description);
-    }
-    if (message.metadata !== undefined) {
-      Struct.encode(Struct.wrap(message.metadata), writer.uint32(50).fork()).join();
-    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AnnotateWrite {
+  decode(input: BinaryReader | Uint8Array, length?: number): RollupRules {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAnnotateWrite();
+    const message = createBaseRollupRules();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4471,53 +11526,72 @@ export const AnnotateWrite: MessageFns<AnnotateWrite> = {
             break;
           }
 
-          message.timeFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 2: {
-          if (tag !== 18) {
-            break;
-          }
-
-          message.timeTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        }
-        case 3: {
-          if (tag !== 26) {
-            break;
-          }
-
-          const el = Algorithm.decode(reader, reader.uint32());
+          const el = RollupRule.decode(reader, reader.uint32());
           if (el !== undefined) {
-            message.capturedAlgorithms!.push(el);
+            message.rollupRules!.push(el);
           }
           continue;
         }
-        case 4: {
-          if (tag !== 34) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
 
-          const el = WindowType.decode(reader, reader.uint32());
-          if (el !== undefined) {
-            message.capturedWindows!.push(el);
-          }
-          continue;
-        }
-        case 5: {
-          if (tag !== 42) {
-            break;
-          }
+  fromJSON(object: any): RollupRules {
+    return {
+      rollupRules: globalThis.Array.isArray(object?.rollupRules)
+        ? object.rollupRules.map((e: any) => RollupRule.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: RollupRules): unknown {
+    const obj: any = {};
+    if (message.rollupRules?.length) {
+      obj.rollupRules = message.rollupRules.map((e) => RollupRule.toJSON(e));
+    }
+    return obj;
+  },
 
-          message.description = reader.string();
-          continue;
-        }
-        case 6: {
-          if (tag !== 50) {
+  create<I extends Exact<DeepPartial<RollupRules>, I>>(base?: I): RollupRules {
+    return RollupRules.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<RollupRules>, I>>(object: I): RollupRules {
+    const message = createBaseRollupRules();
+    message.rollupRules = object.rollupRules?.map((e) => RollupRule.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRollupRuleDeletion(): RollupRuleDeletion {
+  return { name: "" };
+}
+
+export const RollupRuleDeletion: MessageFns<RollupRuleDeletion> = {
+  encode(message: RollupRuleDeletion, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.name !== undefined && message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): RollupRuleDeletion {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRollupRuleDeletion();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
             break;
           }
 
-          message.metadata = Struct.unwrap(Struct.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
         }
       }
@@ -4529,75 +11603,55 @@ export const AnnotateWrite: MessageFns<AnnotateWrite> = {
     return message;
   },
 
-  fromJSON(object: any): AnnotateWrite {
-    return {
-      timeFrom: isSet(object.timeFrom) ? fromJsonTimestamp(object.timeFrom) : undefined,
-      timeTo: isSet(object.timeTo) ? fromJsonTimestamp(object.timeTo) : undefined,
-      capturedAlgorithms: globalThis.Array.isArray(object?.capturedAlgorithms)
-        ? object.capturedAlgorithms.map((e: any) => Algorithm.fromJSON(e))
-        : [],
-      capturedWindows: globalThis.Array.isArray(object?.capturedWindows)
-        ? object.capturedWindows.map((e: any) => WindowType.fromJSON(e))
-        : [],
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
-      metadata: isObject(object.metadata) ? object.metadata : undefined,
-    };
+  fromJSON(object: any): RollupRuleDeletion {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
   },
 
-  toJSON(message: AnnotateWrite): unknown {
+  toJSON(message: RollupRuleDeletion): unknown {
     const obj: any = {};
-    if (message.timeFrom !== undefined) {
-      obj.timeFrom = message.timeFrom.toISOString();
-    }
-    if (message.timeTo !== undefined) {
-      obj.timeTo = message.timeTo.toISOString();
-    }
-    if (message.capturedAlgorithms?.length) {
-      obj.capturedAlgorithms = message.capturedAlgorithms.map((e) => Algorithm.toJSON(e));
-    }
-    if (message.capturedWindows?.length) {
-      obj.capturedWindows = message.capturedWindows.map((e) => WindowType.toJSON(e));
-    }
-    if (message.description !== undefined && message.description !== "") {
-      obj.description = message.description;
-    }
-    if (message.metadata !== undefined) {
-      obj.metadata = message.metadata;
+    if (message.name !== undefined && message.name !== "") {
+      obj.name = message.name;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AnnotateWrite>, I>>(base?: I): AnnotateWrite {
-    return AnnotateWrite.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<RollupRuleDeletion>, I>>(base?: I): RollupRuleDeletion {
+    return RollupRuleDeletion.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AnnotateWrite>, I>>(object: I): AnnotateWrite {
-    const message = createBaseAnnotateWrite();
-    message.timeFrom = object.timeFrom ?? undefined;
-    message.timeTo = object.timeTo ?? undefined;
-    message.capturedAlgorithms = object.capturedAlgorithms?.map((e) => Algorithm.fromPartial(e)) || [];
-    message.capturedWindows = object.capturedWindows?.map((e) => WindowType.fromPartial(e)) || [];
-    message.description = object.description ?? "";
-    message.metadata = object.metadata ?? undefined;
+  fromPartial<I extends Exact<DeepPartial<RollupRuleDeletion>, I>>(object: I): RollupRuleDeletion {
+    const message = createBaseRollupRuleDeletion();
+    message.name = object.name ?? "";
     return message;
   },
 };
 
-function createBaseAnnotateResponse(): AnnotateResponse {
-  return {};
+function createBaseChildWindowsRead(): ChildWindowsRead {
+  return { window: undefined };
 }
 
-export const AnnotateResponse: MessageFns<AnnotateResponse> = {
-  encode(_: AnnotateResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const ChildWindowsRead: MessageFns<ChildWindowsRead> = {
+  encode(message: ChildWindowsRead, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.window !== undefined) {
+      Window.encode(message.window, writer.uint32(10).fork()).join();
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): AnnotateResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): ChildWindowsRead {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAnnotateResponse();
+    const message = createBaseChildWindowsRead();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.window = Window.decode(reader, reader.uint32());
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -4607,20 +11661,26 @@ export const AnnotateResponse: MessageFns<AnnotateResponse> = {
     return message;
   },
 
-  fromJSON(_: any): AnnotateResponse {
-    return {};
+  fromJSON(object: any): ChildWindowsRead {
+    return { window: isSet(object.window) ? Window.fromJSON(object.window) : undefined };
   },
 
-  toJSON(_: AnnotateResponse): unknown {
+  toJSON(message: ChildWindowsRead): unknown {
     const obj: any = {};
+    if (message.window !== undefined) {
+      obj.window = Window.toJSON(message.window);
+    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<AnnotateResponse>, I>>(base?: I): AnnotateResponse {
-    return AnnotateResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<ChildWindowsRead>, I>>(base?: I): ChildWindowsRead {
+    return ChildWindowsRead.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<AnnotateResponse>, I>>(_: I): AnnotateResponse {
-    const message = createBaseAnnotateResponse();
+  fromPartial<I extends Exact<DeepPartial<ChildWindowsRead>, I>>(object: I): ChildWindowsRead {
+    const message = createBaseChildWindowsRead();
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Window.fromPartial(object.window)
+      : undefined;
     return message;
   },
 };
@@ -4655,6 +11715,135 @@ export const OrcaCoreService = {
     responseSerialize: (value: WindowEmitStatus): Buffer => Buffer.from(WindowEmitStatus.encode(value).finish()),
     responseDeserialize: (value: Buffer): WindowEmitStatus => WindowEmitStatus.decode(value),
   },
+  /** Submit a batch of windows for processing, returning the status of each */
+  emitWindows: {
+    path: "/OrcaCore/EmitWindows",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowsEmit): Buffer => Buffer.from(WindowsEmit.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowsEmit => WindowsEmit.decode(value),
+    responseSerialize: (value: WindowsEmitStatus): Buffer => Buffer.from(WindowsEmitStatus.encode(value).finish()),
+    responseDeserialize: (value: Buffer): WindowsEmitStatus => WindowsEmitStatus.decode(value),
+  },
+  /**
+   * Stream windows for processing, returning the status of each once the
+   * stream is closed
+   */
+  emitWindowStream: {
+    path: "/OrcaCore/EmitWindowStream",
+    requestStream: true,
+    responseStream: false,
+    requestSerialize: (value: Window): Buffer => Buffer.from(Window.encode(value).finish()),
+    requestDeserialize: (value: Buffer): Window => Window.decode(value),
+    responseSerialize: (value: WindowsEmitStatus): Buffer => Buffer.from(WindowsEmitStatus.encode(value).finish()),
+    responseDeserialize: (value: Buffer): WindowsEmitStatus => WindowsEmitStatus.decode(value),
+  },
+  /**
+   * Open a window whose end is not yet known. Its algorithms are not
+   * triggered until it is closed, unless it is provisional
+   */
+  openWindow: {
+    path: "/OrcaCore/OpenWindow",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowOpen): Buffer => Buffer.from(WindowOpen.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowOpen => WindowOpen.decode(value),
+    responseSerialize: (value: WindowEmitStatus): Buffer => Buffer.from(WindowEmitStatus.encode(value).finish()),
+    responseDeserialize: (value: Buffer): WindowEmitStatus => WindowEmitStatus.decode(value),
+  },
+  /** Extend an open window up to a later time */
+  extendWindow: {
+    path: "/OrcaCore/ExtendWindow",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowExtension): Buffer => Buffer.from(WindowExtension.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowExtension => WindowExtension.decode(value),
+    responseSerialize: (value: WindowEmitStatus): Buffer => Buffer.from(WindowEmitStatus.encode(value).finish()),
+    responseDeserialize: (value: Buffer): WindowEmitStatus => WindowEmitStatus.decode(value),
+  },
+  /** Close an open window at its end, triggering its algorithms */
+  closeWindow: {
+    path: "/OrcaCore/CloseWindow",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowClosure): Buffer => Buffer.from(WindowClosure.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowClosure => WindowClosure.decode(value),
+    responseSerialize: (value: WindowEmitStatus): Buffer => Buffer.from(WindowEmitStatus.encode(value).finish()),
+    responseDeserialize: (value: Buffer): WindowEmitStatus => WindowEmitStatus.decode(value),
+  },
+  /**
+   * Supersede an emitted window with a corrected one, triggering its
+   * algorithms again. The superseded window and its results are hidden from
+   * reads by default
+   */
+  amendWindow: {
+    path: "/OrcaCore/AmendWindow",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowAmendment): Buffer => Buffer.from(WindowAmendment.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowAmendment => WindowAmendment.decode(value),
+    responseSerialize: (value: WindowEmitStatus): Buffer => Buffer.from(WindowEmitStatus.encode(value).finish()),
+    responseDeserialize: (value: Buffer): WindowEmitStatus => WindowEmitStatus.decode(value),
+  },
+  /** Deregister a processor, retiring the algorithms it supports */
+  deregisterProcessor: {
+    path: "/OrcaCore/DeregisterProcessor",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ProcessorDeregistration): Buffer =>
+      Buffer.from(ProcessorDeregistration.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ProcessorDeregistration => ProcessorDeregistration.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /**
+   * Retire an algorithm so that it is no longer executed. Results it has
+   * already produced remain readable
+   */
+  retireAlgorithm: {
+    path: "/OrcaCore/RetireAlgorithm",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlgorithmRetirement): Buffer => Buffer.from(AlgorithmRetirement.encode(value).finish()),
+    requestDeserialize: (value: Buffer): AlgorithmRetirement => AlgorithmRetirement.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Remove the dependency of one algorithm on another */
+  removeAlgorithmDependency: {
+    path: "/OrcaCore/RemoveAlgorithmDependency",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlgorithmDependencyRemoval): Buffer =>
+      Buffer.from(AlgorithmDependencyRemoval.encode(value).finish()),
+    requestDeserialize: (value: Buffer): AlgorithmDependencyRemoval => AlgorithmDependencyRemoval.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /**
+   * Subscribe a registered processor for the tasks it is to execute. This is
+   * an alternative to orca-core dialling out to the processor, for
+   * processors that cannot be reached, e.g. behind NAT or a firewall
+   */
+  subscribeTasks: {
+    path: "/OrcaCore/SubscribeTasks",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: TaskSubscription): Buffer => Buffer.from(TaskSubscription.encode(value).finish()),
+    requestDeserialize: (value: Buffer): TaskSubscription => TaskSubscription.decode(value),
+    responseSerialize: (value: ProcessingTask): Buffer => Buffer.from(ProcessingTask.encode(value).finish()),
+    responseDeserialize: (value: Buffer): ProcessingTask => ProcessingTask.decode(value),
+  },
+  /** Submit the result of a task received through SubscribeTasks */
+  submitResult: {
+    path: "/OrcaCore/SubmitResult",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: TaskResult): Buffer => Buffer.from(TaskResult.encode(value).finish()),
+    requestDeserialize: (value: Buffer): TaskResult => TaskResult.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
   /** ------------------- Data operations ------------------- */
   readWindowTypes: {
     path: "/OrcaCore/ReadWindowTypes",
@@ -4755,6 +11944,19 @@ export const OrcaCoreService = {
     responseDeserialize: (value: Buffer): ResultsForAlgorithmAndMetadata =>
       ResultsForAlgorithmAndMetadata.decode(value),
   },
+  /**
+   * Stream results as they are written, optionally filtered by algorithm,
+   * window type and window metadata
+   */
+  subscribeResults: {
+    path: "/OrcaCore/SubscribeResults",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: ResultsSubscription): Buffer => Buffer.from(ResultsSubscription.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ResultsSubscription => ResultsSubscription.decode(value),
+    responseSerialize: (value: ResultUpdate): Buffer => Buffer.from(ResultUpdate.encode(value).finish()),
+    responseDeserialize: (value: Buffer): ResultUpdate => ResultUpdate.decode(value),
+  },
   /** ------------------ Annotation operations ----------------- */
   annotate: {
     path: "/OrcaCore/Annotate",
@@ -4765,6 +11967,220 @@ export const OrcaCoreService = {
     responseSerialize: (value: AnnotateResponse): Buffer => Buffer.from(AnnotateResponse.encode(value).finish()),
     responseDeserialize: (value: Buffer): AnnotateResponse => AnnotateResponse.decode(value),
   },
+  /**
+   * Create a schedule that windows of a type are emitted on, or replace the
+   * schedule of the same name
+   */
+  createWindowSchedule: {
+    path: "/OrcaCore/CreateWindowSchedule",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowSchedule): Buffer => Buffer.from(WindowSchedule.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowSchedule => WindowSchedule.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Read the window schedules */
+  readWindowSchedules: {
+    path: "/OrcaCore/ReadWindowSchedules",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowSchedulesRead): Buffer => Buffer.from(WindowSchedulesRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowSchedulesRead => WindowSchedulesRead.decode(value),
+    responseSerialize: (value: WindowSchedules): Buffer => Buffer.from(WindowSchedules.encode(value).finish()),
+    responseDeserialize: (value: Buffer): WindowSchedules => WindowSchedules.decode(value),
+  },
+  /** Delete a window schedule. Windows it has already emitted are kept */
+  deleteWindowSchedule: {
+    path: "/OrcaCore/DeleteWindowSchedule",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowScheduleDeletion): Buffer =>
+      Buffer.from(WindowScheduleDeletion.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowScheduleDeletion => WindowScheduleDeletion.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /**
+   * Create a rule that rolls windows of one type up into windows of
+   * another, or replace the rule of the same name
+   */
+  createRollupRule: {
+    path: "/OrcaCore/CreateRollupRule",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: RollupRule): Buffer => Buffer.from(RollupRule.encode(value).finish()),
+    requestDeserialize: (value: Buffer): RollupRule => RollupRule.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Read the roll-up rules */
+  readRollupRules: {
+    path: "/OrcaCore/ReadRollupRules",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: RollupRulesRead): Buffer => Buffer.from(RollupRulesRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): RollupRulesRead => RollupRulesRead.decode(value),
+    responseSerialize: (value: RollupRules): Buffer => Buffer.from(RollupRules.encode(value).finish()),
+    responseDeserialize: (value: Buffer): RollupRules => RollupRules.decode(value),
+  },
+  /** Delete a roll-up rule. Windows it has already rolled up are kept */
+  deleteRollupRule: {
+    path: "/OrcaCore/DeleteRollupRule",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: RollupRuleDeletion): Buffer => Buffer.from(RollupRuleDeletion.encode(value).finish()),
+    requestDeserialize: (value: Buffer): RollupRuleDeletion => RollupRuleDeletion.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Read the child windows that a window was rolled up from */
+  readChildWindows: {
+    path: "/OrcaCore/ReadChildWindows",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ChildWindowsRead): Buffer => Buffer.from(ChildWindowsRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ChildWindowsRead => ChildWindowsRead.decode(value),
+    responseSerialize: (value: Windows): Buffer => Buffer.from(Windows.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Windows => Windows.decode(value),
+  },
+  /** Create an alert rule, or replace the rule of the same name */
+  createAlertRule: {
+    path: "/OrcaCore/CreateAlertRule",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlertRule): Buffer => Buffer.from(AlertRule.encode(value).finish()),
+    requestDeserialize: (value: Buffer): AlertRule => AlertRule.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Read the alert rules */
+  readAlertRules: {
+    path: "/OrcaCore/ReadAlertRules",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlertRulesRead): Buffer => Buffer.from(AlertRulesRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): AlertRulesRead => AlertRulesRead.decode(value),
+    responseSerialize: (value: AlertRules): Buffer => Buffer.from(AlertRules.encode(value).finish()),
+    responseDeserialize: (value: Buffer): AlertRules => AlertRules.decode(value),
+  },
+  /** Delete an alert rule along with its alerts */
+  deleteAlertRule: {
+    path: "/OrcaCore/DeleteAlertRule",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlertRuleDeletion): Buffer => Buffer.from(AlertRuleDeletion.encode(value).finish()),
+    requestDeserialize: (value: Buffer): AlertRuleDeletion => AlertRuleDeletion.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Read the alerts fired within a time range */
+  readAlerts: {
+    path: "/OrcaCore/ReadAlerts",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlertsRead): Buffer => Buffer.from(AlertsRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): AlertsRead => AlertsRead.decode(value),
+    responseSerialize: (value: Alerts): Buffer => Buffer.from(Alerts.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Alerts => Alerts.decode(value),
+  },
+  /** Acknowledge an alert */
+  acknowledgeAlert: {
+    path: "/OrcaCore/AcknowledgeAlert",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlertAcknowledgement): Buffer => Buffer.from(AlertAcknowledgement.encode(value).finish()),
+    requestDeserialize: (value: Buffer): AlertAcknowledgement => AlertAcknowledgement.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Read the state of the execution triggered by an emitted window */
+  readExecution: {
+    path: "/OrcaCore/ReadExecution",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ExecutionRead): Buffer => Buffer.from(ExecutionRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ExecutionRead => ExecutionRead.decode(value),
+    responseSerialize: (value: Execution): Buffer => Buffer.from(Execution.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Execution => Execution.decode(value),
+  },
+  /** Read the state of the executions triggered by windows in a time range */
+  readExecutions: {
+    path: "/OrcaCore/ReadExecutions",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ExecutionsRead): Buffer => Buffer.from(ExecutionsRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ExecutionsRead => ExecutionsRead.decode(value),
+    responseSerialize: (value: Executions): Buffer => Buffer.from(Executions.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Executions => Executions.decode(value),
+  },
+  /** Cancel an in-flight execution, including the work sent to processors */
+  cancelExecution: {
+    path: "/OrcaCore/CancelExecution",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ExecutionCancel): Buffer => Buffer.from(ExecutionCancel.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ExecutionCancel => ExecutionCancel.decode(value),
+    responseSerialize: (value: Status): Buffer => Buffer.from(Status.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Status => Status.decode(value),
+  },
+  /** Read the depth and saturation of the queue of executions */
+  readExecutionQueue: {
+    path: "/OrcaCore/ReadExecutionQueue",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ExecutionQueueRead): Buffer => Buffer.from(ExecutionQueueRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ExecutionQueueRead => ExecutionQueueRead.decode(value),
+    responseSerialize: (value: ExecutionQueue): Buffer => Buffer.from(ExecutionQueue.encode(value).finish()),
+    responseDeserialize: (value: Buffer): ExecutionQueue => ExecutionQueue.decode(value),
+  },
+  /**
+   * Execute algorithms over windows that have already been emitted, e.g. to
+   * backfill the results of a newly registered algorithm
+   */
+  reprocessWindows: {
+    path: "/OrcaCore/ReprocessWindows",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WindowsReprocess): Buffer => Buffer.from(WindowsReprocess.encode(value).finish()),
+    requestDeserialize: (value: Buffer): WindowsReprocess => WindowsReprocess.decode(value),
+    responseSerialize: (value: Reprocess): Buffer => Buffer.from(Reprocess.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Reprocess => Reprocess.decode(value),
+  },
+  /** Read the progress of a reprocess */
+  readReprocess: {
+    path: "/OrcaCore/ReadReprocess",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ReprocessRead): Buffer => Buffer.from(ReprocessRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): ReprocessRead => ReprocessRead.decode(value),
+    responseSerialize: (value: Reprocess): Buffer => Buffer.from(Reprocess.encode(value).finish()),
+    responseDeserialize: (value: Buffer): Reprocess => Reprocess.decode(value),
+  },
+  /** Read the processor tasks that failed after exhausting their retries */
+  readFailedExecutions: {
+    path: "/OrcaCore/ReadFailedExecutions",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: FailedExecutionsRead): Buffer => Buffer.from(FailedExecutionsRead.encode(value).finish()),
+    requestDeserialize: (value: Buffer): FailedExecutionsRead => FailedExecutionsRead.decode(value),
+    responseSerialize: (value: FailedExecutions): Buffer => Buffer.from(FailedExecutions.encode(value).finish()),
+    responseDeserialize: (value: Buffer): FailedExecutions => FailedExecutions.decode(value),
+  },
+  /**
+   * Re-execute the executions of failed processor tasks, along with the
+   * algorithms that were skipped because of them
+   */
+  requeueFailedExecutions: {
+    path: "/OrcaCore/RequeueFailedExecutions",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: FailedExecutionsRequeue): Buffer =>
+      Buffer.from(FailedExecutionsRequeue.encode(value).finish()),
+    requestDeserialize: (value: Buffer): FailedExecutionsRequeue => FailedExecutionsRequeue.decode(value),
+    responseSerialize: (value: RequeuedExecutions): Buffer => Buffer.from(RequeuedExecutions.encode(value).finish()),
+    responseDeserialize: (value: Buffer): RequeuedExecutions => RequeuedExecutions.decode(value),
+  },
 } as const;
 
 export interface OrcaCoreServer extends UntypedServiceImplementation {
@@ -4772,6 +12188,45 @@ export interface OrcaCoreServer extends UntypedServiceImplementation {
   registerProcessor: handleUnaryCall<ProcessorRegistration, Status>;
   /** Submit a window for processing */
   emitWindow: handleUnaryCall<Window, WindowEmitStatus>;
+  /** Submit a batch of windows for processing, returning the status of each */
+  emitWindows: handleUnaryCall<WindowsEmit, WindowsEmitStatus>;
+  /**
+   * Stream windows for processing, returning the status of each once the
+   * stream is closed
+   */
+  emitWindowStream: handleClientStreamingCall<Window, WindowsEmitStatus>;
+  /**
+   * Open a window whose end is not yet known. Its algorithms are not
+   * triggered until it is closed, unless it is provisional
+   */
+  openWindow: handleUnaryCall<WindowOpen, WindowEmitStatus>;
+  /** Extend an open window up to a later time */
+  extendWindow: handleUnaryCall<WindowExtension, WindowEmitStatus>;
+  /** Close an open window at its end, triggering its algorithms */
+  closeWindow: handleUnaryCall<WindowClosure, WindowEmitStatus>;
+  /**
+   * Supersede an emitted window with a corrected one, triggering its
+   * algorithms again. The superseded window and its results are hidden from
+   * reads by default
+   */
+  amendWindow: handleUnaryCall<WindowAmendment, WindowEmitStatus>;
+  /** Deregister a processor, retiring the algorithms it supports */
+  deregisterProcessor: handleUnaryCall<ProcessorDeregistration, Status>;
+  /**
+   * Retire an algorithm so that it is no longer executed. Results it has
+   * already produced remain readable
+   */
+  retireAlgorithm: handleUnaryCall<AlgorithmRetirement, Status>;
+  /** Remove the dependency of one algorithm on another */
+  removeAlgorithmDependency: handleUnaryCall<AlgorithmDependencyRemoval, Status>;
+  /**
+   * Subscribe a registered processor for the tasks it is to execute. This is
+   * an alternative to orca-core dialling out to the processor, for
+   * processors that cannot be reached, e.g. behind NAT or a firewall
+   */
+  subscribeTasks: handleServerStreamingCall<TaskSubscription, ProcessingTask>;
+  /** Submit the result of a task received through SubscribeTasks */
+  submitResult: handleUnaryCall<TaskResult, Status>;
   /** ------------------- Data operations ------------------- */
   readWindowTypes: handleUnaryCall<WindowTypeRead, WindowTypes>;
   readAlgorithms: handleUnaryCall<AlgorithmsRead, Algorithms>;
@@ -4786,8 +12241,65 @@ export interface OrcaCoreServer extends UntypedServiceImplementation {
     ResultsForAlgorithmAndMetadataRead,
     ResultsForAlgorithmAndMetadata
   >;
+  /**
+   * Stream results as they are written, optionally filtered by algorithm,
+   * window type and window metadata
+   */
+  subscribeResults: handleServerStreamingCall<ResultsSubscription, ResultUpdate>;
   /** ------------------ Annotation operations ----------------- */
   annotate: handleUnaryCall<AnnotateWrite, AnnotateResponse>;
+  /**
+   * Create a schedule that windows of a type are emitted on, or replace the
+   * schedule of the same name
+   */
+  createWindowSchedule: handleUnaryCall<WindowSchedule, Status>;
+  /** Read the window schedules */
+  readWindowSchedules: handleUnaryCall<WindowSchedulesRead, WindowSchedules>;
+  /** Delete a window schedule. Windows it has already emitted are kept */
+  deleteWindowSchedule: handleUnaryCall<WindowScheduleDeletion, Status>;
+  /**
+   * Create a rule that rolls windows of one type up into windows of
+   * another, or replace the rule of the same name
+   */
+  createRollupRule: handleUnaryCall<RollupRule, Status>;
+  /** Read the roll-up rules */
+  readRollupRules: handleUnaryCall<RollupRulesRead, RollupRules>;
+  /** Delete a roll-up rule. Windows it has already rolled up are kept */
+  deleteRollupRule: handleUnaryCall<RollupRuleDeletion, Status>;
+  /** Read the child windows that a window was rolled up from */
+  readChildWindows: handleUnaryCall<ChildWindowsRead, Windows>;
+  /** Create an alert rule, or replace the rule of the same name */
+  createAlertRule: handleUnaryCall<AlertRule, Status>;
+  /** Read the alert rules */
+  readAlertRules: handleUnaryCall<AlertRulesRead, AlertRules>;
+  /** Delete an alert rule along with its alerts */
+  deleteAlertRule: handleUnaryCall<AlertRuleDeletion, Status>;
+  /** Read the alerts fired within a time range */
+  readAlerts: handleUnaryCall<AlertsRead, Alerts>;
+  /** Acknowledge an alert */
+  acknowledgeAlert: handleUnaryCall<AlertAcknowledgement, Status>;
+  /** Read the state of the execution triggered by an emitted window */
+  readExecution: handleUnaryCall<ExecutionRead, Execution>;
+  /** Read the state of the executions triggered by windows in a time range */
+  readExecutions: handleUnaryCall<ExecutionsRead, Executions>;
+  /** Cancel an in-flight execution, including the work sent to processors */
+  cancelExecution: handleUnaryCall<ExecutionCancel, Status>;
+  /** Read the depth and saturation of the queue of executions */
+  readExecutionQueue: handleUnaryCall<ExecutionQueueRead, ExecutionQueue>;
+  /**
+   * Execute algorithms over windows that have already been emitted, e.g. to
+   * backfill the results of a newly registered algorithm
+   */
+  reprocessWindows: handleUnaryCall<WindowsReprocess, Reprocess>;
+  /** Read the progress of a reprocess */
+  readReprocess: handleUnaryCall<ReprocessRead, Reprocess>;
+  /** Read the processor tasks that failed after exhausting their retries */
+  readFailedExecutions: handleUnaryCall<FailedExecutionsRead, FailedExecutions>;
+  /**
+   * Re-execute the executions of failed processor tasks, along with the
+   * algorithms that were skipped because of them
+   */
+  requeueFailedExecutions: handleUnaryCall<FailedExecutionsRequeue, RequeuedExecutions>;
 }
 
 export interface OrcaCoreClient extends Client {
@@ -4823,6 +12335,188 @@ export interface OrcaCoreClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
   ): ClientUnaryCall;
+  /** Submit a batch of windows for processing, returning the status of each */
+  emitWindows(
+    request: WindowsEmit,
+    callback: (error: ServiceError | null, response: WindowsEmitStatus) => void,
+  ): ClientUnaryCall;
+  emitWindows(
+    request: WindowsEmit,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WindowsEmitStatus) => void,
+  ): ClientUnaryCall;
+  emitWindows(
+    request: WindowsEmit,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowsEmitStatus) => void,
+  ): ClientUnaryCall;
+  /**
+   * Stream windows for processing, returning the status of each once the
+   * stream is closed
+   */
+  emitWindowStream(
+    callback: (error: ServiceError | null, response: WindowsEmitStatus) => void,
+  ): ClientWritableStream<Window>;
+  emitWindowStream(
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WindowsEmitStatus) => void,
+  ): ClientWritableStream<Window>;
+  emitWindowStream(
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowsEmitStatus) => void,
+  ): ClientWritableStream<Window>;
+  emitWindowStream(
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowsEmitStatus) => void,
+  ): ClientWritableStream<Window>;
+  /**
+   * Open a window whose end is not yet known. Its algorithms are not
+   * triggered until it is closed, unless it is provisional
+   */
+  openWindow(
+    request: WindowOpen,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  openWindow(
+    request: WindowOpen,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  openWindow(
+    request: WindowOpen,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  /** Extend an open window up to a later time */
+  extendWindow(
+    request: WindowExtension,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  extendWindow(
+    request: WindowExtension,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  extendWindow(
+    request: WindowExtension,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  /** Close an open window at its end, triggering its algorithms */
+  closeWindow(
+    request: WindowClosure,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  closeWindow(
+    request: WindowClosure,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  closeWindow(
+    request: WindowClosure,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  /**
+   * Supersede an emitted window with a corrected one, triggering its
+   * algorithms again. The superseded window and its results are hidden from
+   * reads by default
+   */
+  amendWindow(
+    request: WindowAmendment,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  amendWindow(
+    request: WindowAmendment,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  amendWindow(
+    request: WindowAmendment,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowEmitStatus) => void,
+  ): ClientUnaryCall;
+  /** Deregister a processor, retiring the algorithms it supports */
+  deregisterProcessor(
+    request: ProcessorDeregistration,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deregisterProcessor(
+    request: ProcessorDeregistration,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deregisterProcessor(
+    request: ProcessorDeregistration,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /**
+   * Retire an algorithm so that it is no longer executed. Results it has
+   * already produced remain readable
+   */
+  retireAlgorithm(
+    request: AlgorithmRetirement,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  retireAlgorithm(
+    request: AlgorithmRetirement,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  retireAlgorithm(
+    request: AlgorithmRetirement,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Remove the dependency of one algorithm on another */
+  removeAlgorithmDependency(
+    request: AlgorithmDependencyRemoval,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  removeAlgorithmDependency(
+    request: AlgorithmDependencyRemoval,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  removeAlgorithmDependency(
+    request: AlgorithmDependencyRemoval,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /**
+   * Subscribe a registered processor for the tasks it is to execute. This is
+   * an alternative to orca-core dialling out to the processor, for
+   * processors that cannot be reached, e.g. behind NAT or a firewall
+   */
+  subscribeTasks(request: TaskSubscription, options?: Partial<CallOptions>): ClientReadableStream<ProcessingTask>;
+  subscribeTasks(
+    request: TaskSubscription,
+    metadata?: Metadata,
+    options?: Partial<CallOptions>,
+  ): ClientReadableStream<ProcessingTask>;
+  /** Submit the result of a task received through SubscribeTasks */
+  submitResult(request: TaskResult, callback: (error: ServiceError | null, response: Status) => void): ClientUnaryCall;
+  submitResult(
+    request: TaskResult,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  submitResult(
+    request: TaskResult,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
   /** ------------------- Data operations ------------------- */
   readWindowTypes(
     request: WindowTypeRead,
@@ -4971,6 +12665,16 @@ export interface OrcaCoreClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: ResultsForAlgorithmAndMetadata) => void,
   ): ClientUnaryCall;
+  /**
+   * Stream results as they are written, optionally filtered by algorithm,
+   * window type and window metadata
+   */
+  subscribeResults(request: ResultsSubscription, options?: Partial<CallOptions>): ClientReadableStream<ResultUpdate>;
+  subscribeResults(
+    request: ResultsSubscription,
+    metadata?: Metadata,
+    options?: Partial<CallOptions>,
+  ): ClientReadableStream<ResultUpdate>;
   /** ------------------ Annotation operations ----------------- */
   annotate(
     request: AnnotateWrite,
@@ -4987,6 +12691,335 @@ export interface OrcaCoreClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: AnnotateResponse) => void,
   ): ClientUnaryCall;
+  /**
+   * Create a schedule that windows of a type are emitted on, or replace the
+   * schedule of the same name
+   */
+  createWindowSchedule(
+    request: WindowSchedule,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  createWindowSchedule(
+    request: WindowSchedule,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  createWindowSchedule(
+    request: WindowSchedule,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Read the window schedules */
+  readWindowSchedules(
+    request: WindowSchedulesRead,
+    callback: (error: ServiceError | null, response: WindowSchedules) => void,
+  ): ClientUnaryCall;
+  readWindowSchedules(
+    request: WindowSchedulesRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WindowSchedules) => void,
+  ): ClientUnaryCall;
+  readWindowSchedules(
+    request: WindowSchedulesRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WindowSchedules) => void,
+  ): ClientUnaryCall;
+  /** Delete a window schedule. Windows it has already emitted are kept */
+  deleteWindowSchedule(
+    request: WindowScheduleDeletion,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deleteWindowSchedule(
+    request: WindowScheduleDeletion,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deleteWindowSchedule(
+    request: WindowScheduleDeletion,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /**
+   * Create a rule that rolls windows of one type up into windows of
+   * another, or replace the rule of the same name
+   */
+  createRollupRule(
+    request: RollupRule,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  createRollupRule(
+    request: RollupRule,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  createRollupRule(
+    request: RollupRule,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Read the roll-up rules */
+  readRollupRules(
+    request: RollupRulesRead,
+    callback: (error: ServiceError | null, response: RollupRules) => void,
+  ): ClientUnaryCall;
+  readRollupRules(
+    request: RollupRulesRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: RollupRules) => void,
+  ): ClientUnaryCall;
+  readRollupRules(
+    request: RollupRulesRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: RollupRules) => void,
+  ): ClientUnaryCall;
+  /** Delete a roll-up rule. Windows it has already rolled up are kept */
+  deleteRollupRule(
+    request: RollupRuleDeletion,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deleteRollupRule(
+    request: RollupRuleDeletion,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deleteRollupRule(
+    request: RollupRuleDeletion,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Read the child windows that a window was rolled up from */
+  readChildWindows(
+    request: ChildWindowsRead,
+    callback: (error: ServiceError | null, response: Windows) => void,
+  ): ClientUnaryCall;
+  readChildWindows(
+    request: ChildWindowsRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Windows) => void,
+  ): ClientUnaryCall;
+  readChildWindows(
+    request: ChildWindowsRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Windows) => void,
+  ): ClientUnaryCall;
+  /** Create an alert rule, or replace the rule of the same name */
+  createAlertRule(
+    request: AlertRule,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  createAlertRule(
+    request: AlertRule,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  createAlertRule(
+    request: AlertRule,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Read the alert rules */
+  readAlertRules(
+    request: AlertRulesRead,
+    callback: (error: ServiceError | null, response: AlertRules) => void,
+  ): ClientUnaryCall;
+  readAlertRules(
+    request: AlertRulesRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: AlertRules) => void,
+  ): ClientUnaryCall;
+  readAlertRules(
+    request: AlertRulesRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: AlertRules) => void,
+  ): ClientUnaryCall;
+  /** Delete an alert rule along with its alerts */
+  deleteAlertRule(
+    request: AlertRuleDeletion,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deleteAlertRule(
+    request: AlertRuleDeletion,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  deleteAlertRule(
+    request: AlertRuleDeletion,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Read the alerts fired within a time range */
+  readAlerts(request: AlertsRead, callback: (error: ServiceError | null, response: Alerts) => void): ClientUnaryCall;
+  readAlerts(
+    request: AlertsRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Alerts) => void,
+  ): ClientUnaryCall;
+  readAlerts(
+    request: AlertsRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Alerts) => void,
+  ): ClientUnaryCall;
+  /** Acknowledge an alert */
+  acknowledgeAlert(
+    request: AlertAcknowledgement,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  acknowledgeAlert(
+    request: AlertAcknowledgement,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  acknowledgeAlert(
+    request: AlertAcknowledgement,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Read the state of the execution triggered by an emitted window */
+  readExecution(
+    request: ExecutionRead,
+    callback: (error: ServiceError | null, response: Execution) => void,
+  ): ClientUnaryCall;
+  readExecution(
+    request: ExecutionRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Execution) => void,
+  ): ClientUnaryCall;
+  readExecution(
+    request: ExecutionRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Execution) => void,
+  ): ClientUnaryCall;
+  /** Read the state of the executions triggered by windows in a time range */
+  readExecutions(
+    request: ExecutionsRead,
+    callback: (error: ServiceError | null, response: Executions) => void,
+  ): ClientUnaryCall;
+  readExecutions(
+    request: ExecutionsRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Executions) => void,
+  ): ClientUnaryCall;
+  readExecutions(
+    request: ExecutionsRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Executions) => void,
+  ): ClientUnaryCall;
+  /** Cancel an in-flight execution, including the work sent to processors */
+  cancelExecution(
+    request: ExecutionCancel,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  cancelExecution(
+    request: ExecutionCancel,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  cancelExecution(
+    request: ExecutionCancel,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Status) => void,
+  ): ClientUnaryCall;
+  /** Read the depth and saturation of the queue of executions */
+  readExecutionQueue(
+    request: ExecutionQueueRead,
+    callback: (error: ServiceError | null, response: ExecutionQueue) => void,
+  ): ClientUnaryCall;
+  readExecutionQueue(
+    request: ExecutionQueueRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: ExecutionQueue) => void,
+  ): ClientUnaryCall;
+  readExecutionQueue(
+    request: ExecutionQueueRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: ExecutionQueue) => void,
+  ): ClientUnaryCall;
+  /**
+   * Execute algorithms over windows that have already been emitted, e.g. to
+   * backfill the results of a newly registered algorithm
+   */
+  reprocessWindows(
+    request: WindowsReprocess,
+    callback: (error: ServiceError | null, response: Reprocess) => void,
+  ): ClientUnaryCall;
+  reprocessWindows(
+    request: WindowsReprocess,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Reprocess) => void,
+  ): ClientUnaryCall;
+  reprocessWindows(
+    request: WindowsReprocess,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Reprocess) => void,
+  ): ClientUnaryCall;
+  /** Read the progress of a reprocess */
+  readReprocess(
+    request: ReprocessRead,
+    callback: (error: ServiceError | null, response: Reprocess) => void,
+  ): ClientUnaryCall;
+  readReprocess(
+    request: ReprocessRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Reprocess) => void,
+  ): ClientUnaryCall;
+  readReprocess(
+    request: ReprocessRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Reprocess) => void,
+  ): ClientUnaryCall;
+  /** Read the processor tasks that failed after exhausting their retries */
+  readFailedExecutions(
+    request: FailedExecutionsRead,
+    callback: (error: ServiceError | null, response: FailedExecutions) => void,
+  ): ClientUnaryCall;
+  readFailedExecutions(
+    request: FailedExecutionsRead,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: FailedExecutions) => void,
+  ): ClientUnaryCall;
+  readFailedExecutions(
+    request: FailedExecutionsRead,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: FailedExecutions) => void,
+  ): ClientUnaryCall;
+  /**
+   * Re-execute the executions of failed processor tasks, along with the
+   * algorithms that were skipped because of them
+   */
+  requeueFailedExecutions(
+    request: FailedExecutionsRequeue,
+    callback: (error: ServiceError | null, response: RequeuedExecutions) => void,
+  ): ClientUnaryCall;
+  requeueFailedExecutions(
+    request: FailedExecutionsRequeue,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: RequeuedExecutions) => void,
+  ): ClientUnaryCall;
+  requeueFailedExecutions(
+    request: FailedExecutionsRequeue,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: RequeuedExecutions) => void,
+  ): ClientUnaryCall;
 }
 
 export const OrcaCoreClient = makeGenericClientConstructor(OrcaCoreService, "OrcaCore") as unknown as {
@@ -27,7 +27,7 @@ from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__
 from vendor import validate_pb2 as vendor_dot_validate__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rservice.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15vendor/validate.proto\"\xf8\x02\n\x06Window\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12$\n\x10window_type_name\x18\x03 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\'\n\x13window_type_version\x18\x04 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\x1a\n\x06origin\x18\x05 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12)\n\x08metadata\x18\x06 \x01(\x0b\x32\x17.google.protobuf.Struct:b\xbaH_\x1a]\n\x14window.time_ordering\x12&time_to must be greater than time_from\x1a\x1dthis.time_to > this.time_from\"B\n\rMetadataField\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1b\n\x0b\x64\x65scription\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"\x80\x01\n\nWindowType\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1b\n\x0b\x64\x65scription\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12&\n\x0emetadataFields\x18\x04 \x03(\x0b\x32\x0e.MetadataField\"\xa4\x01\n\x10WindowEmitStatus\x12\x34\n\x06status\x18\x01 \x01(\x0e\x32\x1c.WindowEmitStatus.StatusEnumB\x06\xbaH\x03\xc8\x01\x01\"Z\n\nStatusEnum\x12\x15\n\x11TRIGGERING_FAILED\x10\x00\x12\x1b\n\x17NO_TRIGGERED_ALGORITHMS\x10\x01\x12\x18\n\x14PROCESSING_TRIGGERED\x10\x02\"\x87\x01\n\x13\x41lgorithmDependency\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1e\n\x0eprocessor_name\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12!\n\x11processor_runtime\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"\xba\x01\n\tAlgorithm\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12(\n\x0bwindow_type\x18\x03 \x01(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12*\n\x0c\x64\x65pendencies\x18\x04 \x03(\x0b\x32\x14.AlgorithmDependency\x12(\n\x0bresult_type\x18\x05 \x01(\x0e\x32\x0b.ResultTypeB\x06\xbaH\x03\xc8\x01\x01\"\x1c\n\nFloatArray\x12\x0e\n\x06values\x18\x01 \x03(\x02\"\xc7\x01\n\x06Result\x12%\n\x06status\x18\x01 \x01(\x0e\x32\r.ResultStatusB\x06\xbaH\x03\xc8\x01\x01\x12\x16\n\x0csingle_value\x18\x02 \x01(\x02H\x00\x12#\n\x0c\x66loat_values\x18\x03 \x01(\x0b\x32\x0b.FloatArrayH\x00\x12/\n\x0cstruct_value\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x12\x19\n\ttimestamp\x18\x05 \x01(\x03\x42\x06\xbaH\x03\xc8\x01\x01\x42\r\n\x0bresult_data\"\x98\x01\n\x15ProcessorRegistration\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07runtime\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1e\n\x0e\x63onnection_str\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x30\n\x14supported_algorithms\x18\x04 \x03(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\"\x96\x01\n\x0eProcessingTask\x12\x17\n\x07task_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12%\n\talgorithm\x18\x02 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x06window\x18\x03 \x01(\x0b\x32\x07.WindowB\x06\xbaH\x03\xc8\x01\x01\x12#\n\x12\x64\x65pendency_results\x18\x04 \x03(\x0b\x32\x07.Result\"\x99\x01\n\x10\x45xecutionRequest\x12\x17\n\x07\x65xec_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x06window\x18\x02 \x01(\x0b\x32\x07.WindowB\x06\xbaH\x03\xc8\x01\x01\x12+\n\x11\x61lgorithm_results\x18\x03 \x03(\x0b\x32\x10.AlgorithmResult\x12\x1e\n\nalgorithms\x18\x04 \x03(\x0b\x32\n.Algorithm\"^\n\x0f\x45xecutionResult\x12\x17\n\x07\x65xec_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x32\n\x10\x61lgorithm_result\x18\x03 \x01(\x0b\x32\x10.AlgorithmResultB\x06\xbaH\x03\xc8\x01\x01\"Y\n\x0f\x41lgorithmResult\x12%\n\talgorithm\x18\x01 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x06result\x18\x02 \x01(\x0b\x32\x07.ResultB\x06\xbaH\x03\xc8\x01\x01\"+\n\x06Status\x12\x10\n\x08received\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"/\n\x12HealthCheckRequest\x12\x19\n\ttimestamp\x18\x01 \x01(\x03\x42\x06\xbaH\x03\xc8\x01\x01\"\xe3\x01\n\x13HealthCheckResponse\x12\x33\n\x06status\x18\x01 \x01(\x0e\x32\x1b.HealthCheckResponse.StatusB\x06\xbaH\x03\xc8\x01\x01\x12\x0f\n\x07message\x18\x02 \x01(\t\x12\"\n\x07metrics\x18\x03 \x01(\x0b\x32\x11.ProcessorMetrics\"b\n\x06Status\x12\x12\n\x0eSTATUS_UNKNOWN\x10\x00\x12\x12\n\x0eSTATUS_SERVING\x10\x01\x12\x18\n\x14STATUS_TRANSITIONING\x10\x02\x12\x16\n\x12STATUS_NOT_SERVING\x10\x03\"k\n\x10ProcessorMetrics\x12\x14\n\x0c\x61\x63tive_tasks\x18\x01 \x01(\x05\x12\x14\n\x0cmemory_bytes\x18\x02 \x01(\x03\x12\x13\n\x0b\x63pu_percent\x18\x03 \x01(\x02\x12\x16\n\x0euptime_seconds\x18\x04 \x01(\x03\"\x10\n\x0eWindowTypeRead\"+\n\x0bWindowTypes\x12\x1c\n\x07windows\x18\x01 \x03(\x0b\x32\x0b.WindowType\"\x10\n\x0e\x41lgorithmsRead\"+\n\nAlgorithms\x12\x1d\n\talgorithm\x18\x01 \x03(\x0b\x32\n.Algorithm\"\x10\n\x0eProcessorsRead\"b\n\nProcessors\x12(\n\tprocessor\x18\x01 \x03(\x0b\x32\x15.Processors.Processor\x1a*\n\tProcessor\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07runtime\x18\x02 \x01(\t\"\x12\n\x10ResultsStatsRead\"\x1d\n\x0cResultsStats\x12\r\n\x05\x43ount\x18\x01 \x01(\x03\"\xb2\x01\n\x13\x41lgorithmFieldsRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12%\n\talgorithm\x18\x03 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\" \n\x0f\x41lgorithmFields\x12\r\n\x05\x66ield\x18\x01 \x03(\t\"\xb6\x01\n\x17ResultsForAlgorithmRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12%\n\talgorithm\x18\x03 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\"\x8a\x02\n\x13ResultsForAlgorithm\x12\x30\n\x07results\x18\x01 \x03(\x0b\x32\x1f.ResultsForAlgorithm.ResultsRow\x1a\xc0\x01\n\nResultsRow\x12\x35\n\x04time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x16\n\x0csingle_value\x18\x02 \x01(\x02H\x00\x12#\n\x0c\x61rray_values\x18\x03 \x01(\x0b\x32\x0b.FloatArrayH\x00\x12/\n\x0cstruct_value\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x42\r\n\x0bresult_data\"\xa8\x01\n\x0bWindowsRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12#\n\x06window\x18\x03 \x01(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\"\"\n\x07Windows\x12\x17\n\x06window\x18\x01 \x03(\x0b\x32\x07.Window\"\xbb\x01\n!DistinctMetadataForWindowTypeRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12 \n\x0bwindow_type\x18\x03 \x01(\x0b\x32\x0b.WindowType\"M\n\x1d\x44istinctMetadataForWindowType\x12,\n\x08metadata\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.ListValue\"\xb1\x02\n\x16WindowsForMetadataRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12#\n\x06window\x18\x03 \x01(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12:\n\x08metadata\x18\x04 \x03(\x0b\x32 .WindowsForMetadataRead.MetadataB\x06\xbaH\x03\xc8\x01\x01\x1a@\n\x08Metadata\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value\"-\n\x12WindowsForMetadata\x12\x17\n\x06window\x18\x01 \x03(\x0b\x32\x07.Window\"\xcb\x02\n\"ResultsForAlgorithmAndMetadataRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12%\n\talgorithm\x18\x03 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x46\n\x08metadata\x18\x04 \x03(\x0b\x32,.ResultsForAlgorithmAndMetadataRead.MetadataB\x06\xbaH\x03\xc8\x01\x01\x1a@\n\x08Metadata\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value\"\xa0\x02\n\x1eResultsForAlgorithmAndMetadata\x12;\n\x07results\x18\x01 \x03(\x0b\x32*.ResultsForAlgorithmAndMetadata.ResultsRow\x1a\xc0\x01\n\nResultsRow\x12\x35\n\x04time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x16\n\x0csingle_value\x18\x02 \x01(\x02H\x00\x12#\n\x0c\x61rray_values\x18\x03 \x01(\x0b\x32\x0b.FloatArrayH\x00\x12/\n\x0cstruct_value\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x42\r\n\x0bresult_data\"\x91\x03\n\rAnnotateWrite\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12/\n\x13\x63\x61ptured_algorithms\x18\x03 \x03(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12-\n\x10\x63\x61ptured_windows\x18\x04 \x03(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12\x1b\n\x0b\x64\x65scription\x18\x05 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12)\n\x08metadata\x18\x06 \x01(\x0b\x32\x17.google.protobuf.Struct:b\xbaH_\x1a]\n\x14window.time_ordering\x12&time_to must be greater than time_from\x1a\x1dthis.time_to > this.time_from\"\x12\n\x10\x41nnotateResponse*K\n\nResultType\x12\x11\n\rNOT_SPECIFIED\x10\x00\x12\n\n\x06STRUCT\x10\x01\x12\t\n\x05VALUE\x10\x02\x12\t\n\x05\x41RRAY\x10\x03\x12\x08\n\x04NONE\x10\x04*p\n\x0cResultStatus\x12 \n\x1cRESULT_STATUS_HANDLED_FAILED\x10\x00\x12\"\n\x1eRESULT_STATUS_UNHANDLED_FAILED\x10\x01\x12\x1a\n\x16RESULT_STATUS_SUCEEDED\x10\x02\x32\xb8\x06\n\x08OrcaCore\x12\x34\n\x11RegisterProcessor\x12\x16.ProcessorRegistration\x1a\x07.Status\x12(\n\nEmitWindow\x12\x07.Window\x1a\x11.WindowEmitStatus\x12\x30\n\x0fReadWindowTypes\x12\x0f.WindowTypeRead\x1a\x0c.WindowTypes\x12.\n\x0eReadAlgorithms\x12\x0f.AlgorithmsRead\x1a\x0b.Algorithms\x12.\n\x0eReadProcessors\x12\x0f.ProcessorsRead\x1a\x0b.Processors\x12\x34\n\x10ReadResultsStats\x12\x11.ResultsStatsRead\x1a\r.ResultsStats\x12\x46\n\x1cReadResultFieldsForAlgorithm\x12\x14.AlgorithmFieldsRead\x1a\x10.AlgorithmFields\x12I\n\x17ReadResultsForAlgorithm\x12\x18.ResultsForAlgorithmRead\x1a\x14.ResultsForAlgorithm\x12%\n\x0bReadWindows\x12\x0c.WindowsRead\x1a\x08.Windows\x12g\n!ReadDistinctMetadataForWindowType\x12\".DistinctMetadataForWindowTypeRead\x1a\x1e.DistinctMetadataForWindowType\x12\x46\n\x16ReadWindowsForMetadata\x12\x17.WindowsForMetadataRead\x1a\x13.WindowsForMetadata\x12j\n\"ReadResultsForAlgorithmAndMetadata\x12#.ResultsForAlgorithmAndMetadataRead\x1a\x1f.ResultsForAlgorithmAndMetadata\x12-\n\x08\x41nnotate\x12\x0e.AnnotateWrite\x1a\x11.AnnotateResponse2\x82\x01\n\rOrcaProcessor\x12\x37\n\x0e\x45xecuteDagPart\x12\x11.ExecutionRequest\x1a\x10.ExecutionResult0\x01\x12\x38\n\x0bHealthCheck\x12\x13.HealthCheckRequest\x1a\x14.HealthCheckResponseB,Z*github.com/orc-analytics/orca/protobufs/gob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rservice.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15vendor/validate.proto\"\xc2\x03\n\x06Window\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12$\n\x10window_type_name\x18\x03 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\'\n\x13window_type_version\x18\x04 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\x1a\n\x06origin\x18\x05 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12)\n\x08metadata\x18\x06 \x01(\x0b\x32\x17.google.protobuf.Struct\x12%\n\x11target_algorithms\x18\x07 \x03(\x0b\x32\n.Algorithm\x12!\n\x0fidempotency_key\x18\x08 \x01(\tB\x08\xbaH\x05r\x03\x18\x80\x02:b\xbaH_\x1a]\n\x14window.time_ordering\x12&time_to must be greater than time_from\x1a\x1dthis.time_to > this.time_from\"B\n\rMetadataField\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1b\n\x0b\x64\x65scription\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"\x80\x01\n\nWindowType\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1b\n\x0b\x64\x65scription\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12&\n\x0emetadataFields\x18\x04 \x03(\x0b\x32\x0e.MetadataField\"\xe3\x01\n\x10WindowEmitStatus\x12\x34\n\x06status\x18\x01 \x01(\x0e\x32\x1c.WindowEmitStatus.StatusEnumB\x06\xbaH\x03\xc8\x01\x01\x12\x0f\n\x07\x65xec_id\x18\x02 \x01(\t\x12\x11\n\tduplicate\x18\x03 \x01(\x08\x12\r\n\x05\x65rror\x18\x04 \x01(\t\"f\n\nStatusEnum\x12\x15\n\x11TRIGGERING_FAILED\x10\x00\x12\x1b\n\x17NO_TRIGGERED_ALGORITHMS\x10\x01\x12\x18\n\x14PROCESSING_TRIGGERED\x10\x02\x12\n\n\x06QUEUED\x10\x03\"\'\n\x0bWindowsEmit\x12\x18\n\x07windows\x18\x01 \x03(\x0b\x32\x07.Window\"8\n\x11WindowsEmitStatus\x12#\n\x08statuses\x18\x01 \x03(\x0b\x32\x11.WindowEmitStatus\"\x9b\x02\n\nWindowOpen\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12$\n\x10window_type_name\x18\x02 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\'\n\x13window_type_version\x18\x03 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\x1a\n\x06origin\x18\x04 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12)\n\x08metadata\x18\x05 \x01(\x0b\x32\x17.google.protobuf.Struct\x12&\n\x0fidempotency_key\x18\x06 \x01(\tB\r\xbaH\nr\x05\x10\x01\x18\x80\x02\xc8\x01\x01\x12\x13\n\x0bprovisional\x18\x07 \x01(\x08\"\xc2\x01\n\x0fWindowExtension\x12$\n\x10window_type_name\x18\x01 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\'\n\x13window_type_version\x18\x02 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12&\n\x0fidempotency_key\x18\x03 \x01(\tB\r\xbaH\nr\x05\x10\x01\x18\x80\x02\xc8\x01\x01\x12\x38\n\x07time_to\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\"\xc0\x01\n\rWindowClosure\x12$\n\x10window_type_name\x18\x01 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12\'\n\x13window_type_version\x18\x02 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\x12&\n\x0fidempotency_key\x18\x03 \x01(\tB\r\xbaH\nr\x05\x10\x01\x18\x80\x02\xc8\x01\x01\x12\x38\n\x07time_to\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\"[\n\x0fWindowAmendment\x12\x1f\n\x06window\x18\x01 \x01(\x0b\x32\x07.WindowB\x06\xbaH\x03\xc8\x01\x01\x12\'\n\x0e\x61mended_window\x18\x02 \x01(\x0b\x32\x07.WindowB\x06\xbaH\x03\xc8\x01\x01\"\x87\x01\n\x13\x41lgorithmDependency\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1e\n\x0eprocessor_name\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12!\n\x11processor_runtime\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"\xfb\x01\n\tAlgorithm\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12(\n\x0bwindow_type\x18\x03 \x01(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12*\n\x0c\x64\x65pendencies\x18\x04 \x03(\x0b\x32\x14.AlgorithmDependency\x12(\n\x0bresult_type\x18\x05 \x01(\x0e\x32\x0b.ResultTypeB\x06\xbaH\x03\xc8\x01\x01\x12\"\n\x0cretry_policy\x18\x06 \x01(\x0b\x32\x0c.RetryPolicy\x12\x1b\n\ntimeout_ms\x18\x07 \x01(\x03\x42\x07\xbaH\x04\"\x02(\x00\"\x1c\n\nFloatArray\x12\x0e\n\x06values\x18\x01 \x03(\x02\"\xde\x01\n\x06Result\x12%\n\x06status\x18\x01 \x01(\x0e\x32\r.ResultStatusB\x06\xbaH\x03\xc8\x01\x01\x12\x16\n\x0csingle_value\x18\x02 \x01(\x02H\x00\x12#\n\x0c\x66loat_values\x18\x03 \x01(\x0b\x32\x0b.FloatArrayH\x00\x12/\n\x0cstruct_value\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x12\x19\n\ttimestamp\x18\x05 \x01(\x03\x42\x06\xbaH\x03\xc8\x01\x01\x12\x15\n\rerror_message\x18\x06 \x01(\tB\r\n\x0bresult_data\"\xc9\x01\n\x15ProcessorRegistration\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07runtime\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x16\n\x0e\x63onnection_str\x18\x03 \x01(\t\x12\x30\n\x14supported_algorithms\x18\x04 \x03(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\"\n\x0cretry_policy\x18\x05 \x01(\x0b\x32\x0c.RetryPolicy\x12\x13\n\x0binstance_id\x18\x06 \x01(\t\"W\n\x17ProcessorDeregistration\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07runtime\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\r\n\x05\x66orce\x18\x03 \x01(\x08\"S\n\x13\x41lgorithmRetirement\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\r\n\x05\x66orce\x18\x03 \x01(\x08\"\xb3\x01\n\x1a\x41lgorithmDependencyRemoval\x12\x1e\n\x0e\x61lgorithm_name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12!\n\x11\x61lgorithm_version\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x0f\x64\x65pendency_name\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\"\n\x12\x64\x65pendency_version\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\r\n\x05\x66orce\x18\x05 \x01(\x08\"\xe0\x01\n\x0bRetryPolicy\x12\x1d\n\x0cmax_attempts\x18\x01 \x01(\x05\x42\x07\xbaH\x04\x1a\x02(\x00\x12#\n\x12initial_backoff_ms\x18\x02 \x01(\x03\x42\x07\xbaH\x04\"\x02(\x00\x12\x1f\n\x0emax_backoff_ms\x18\x03 \x01(\x03\x42\x07\xbaH\x04\"\x02(\x00\x12*\n\x12\x62\x61\x63koff_multiplier\x18\x04 \x01(\x01\x42\x0e\xbaH\x0b\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\x12\'\n\x06jitter\x18\x05 \x01(\x01\x42\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00\x12\x17\n\x0fretryable_codes\x18\x06 \x03(\t\"\xb0\x01\n\x0eProcessingTask\x12\x17\n\x07task_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12%\n\talgorithm\x18\x02 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x06window\x18\x03 \x01(\x0b\x32\x07.WindowB\x06\xbaH\x03\xc8\x01\x01\x12,\n\x12\x64\x65pendency_results\x18\x04 \x03(\x0b\x32\x10.AlgorithmResult\x12\x0f\n\x07\x65xec_id\x18\x05 \x01(\t\"A\n\x10TaskSubscription\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x17\n\x07runtime\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"F\n\nTaskResult\x12\x17\n\x07task_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x06result\x18\x02 \x01(\x0b\x32\x07.ResultB\x06\xbaH\x03\xc8\x01\x01\"\x99\x01\n\x10\x45xecutionRequest\x12\x17\n\x07\x65xec_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x06window\x18\x02 \x01(\x0b\x32\x07.WindowB\x06\xbaH\x03\xc8\x01\x01\x12+\n\x11\x61lgorithm_results\x18\x03 \x03(\x0b\x32\x10.AlgorithmResult\x12\x1e\n\nalgorithms\x18\x04 \x03(\x0b\x32\n.Algorithm\"^\n\x0f\x45xecutionResult\x12\x17\n\x07\x65xec_id\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x32\n\x10\x61lgorithm_result\x18\x03 \x01(\x0b\x32\x10.AlgorithmResultB\x06\xbaH\x03\xc8\x01\x01\"Y\n\x0f\x41lgorithmResult\x12%\n\talgorithm\x18\x01 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x1f\n\x06result\x18\x02 \x01(\x0b\x32\x07.ResultB\x06\xbaH\x03\xc8\x01\x01\"+\n\x06Status\x12\x10\n\x08received\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"/\n\x12HealthCheckRequest\x12\x19\n\ttimestamp\x18\x01 \x01(\x03\x42\x06\xbaH\x03\xc8\x01\x01\"\xe3\x01\n\x13HealthCheckResponse\x12\x33\n\x06status\x18\x01 \x01(\x0e\x32\x1b.HealthCheckResponse.StatusB\x06\xbaH\x03\xc8\x01\x01\x12\x0f\n\x07message\x18\x02 \x01(\t\x12\"\n\x07metrics\x18\x03 \x01(\x0b\x32\x11.ProcessorMetrics\"b\n\x06Status\x12\x12\n\x0eSTATUS_UNKNOWN\x10\x00\x12\x12\n\x0eSTATUS_SERVING\x10\x01\x12\x18\n\x14STATUS_TRANSITIONING\x10\x02\x12\x16\n\x12STATUS_NOT_SERVING\x10\x03\"k\n\x10ProcessorMetrics\x12\x14\n\x0c\x61\x63tive_tasks\x18\x01 \x01(\x05\x12\x14\n\x0cmemory_bytes\x18\x02 \x01(\x03\x12\x13\n\x0b\x63pu_percent\x18\x03 \x01(\x02\x12\x16\n\x0euptime_seconds\x18\x04 \x01(\x03\"\x10\n\x0eWindowTypeRead\"+\n\x0bWindowTypes\x12\x1c\n\x07windows\x18\x01 \x03(\x0b\x32\x0b.WindowType\"\x10\n\x0e\x41lgorithmsRead\"+\n\nAlgorithms\x12\x1d\n\talgorithm\x18\x01 \x03(\x0b\x32\n.Algorithm\"\x10\n\x0eProcessorsRead\"\xa4\x05\n\nProcessors\x12(\n\tprocessor\x18\x01 \x03(\x0b\x32\x15.Processors.Processor\x1a\xaa\x02\n\tProcessor\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07runtime\x18\x02 \x01(\t\x12*\n\x10\x63onnection_state\x18\x03 \x01(\x0e\x32\x10.ConnectionState\x12\'\n\tinstances\x18\x04 \x03(\x0b\x32\x14.Processors.Instance\x12-\n\tlast_seen\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x06status\x18\x06 \x01(\x0e\x32\x1b.HealthCheckResponse.Status\x12\x16\n\x0estatus_message\x18\x07 \x01(\t\x12\"\n\x07metrics\x18\x08 \x01(\x0b\x32\x11.ProcessorMetrics\x12\x11\n\tavailable\x18\t \x01(\x08\x1a\xbe\x02\n\x08Instance\x12\x16\n\x0e\x63onnection_str\x18\x01 \x01(\t\x12\x13\n\x0binstance_id\x18\t \x01(\t\x12*\n\x10\x63onnection_state\x18\x02 \x01(\x0e\x32\x10.ConnectionState\x12.\n\nregistered\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tlast_seen\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x06status\x18\x05 \x01(\x0e\x32\x1b.HealthCheckResponse.Status\x12\x16\n\x0estatus_message\x18\x06 \x01(\t\x12\"\n\x07metrics\x18\x07 \x01(\x0b\x32\x11.ProcessorMetrics\x12\x11\n\tavailable\x18\x08 \x01(\x08\"\x12\n\x10ResultsStatsRead\"\x1d\n\x0cResultsStats\x12\r\n\x05\x43ount\x18\x01 \x01(\x03\"\xb2\x01\n\x13\x41lgorithmFieldsRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12%\n\talgorithm\x18\x03 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\" \n\x0f\x41lgorithmFields\x12\r\n\x05\x66ield\x18\x01 \x03(\t\"\xd2\x01\n\x17ResultsForAlgorithmRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12%\n\talgorithm\x18\x03 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x1a\n\x12include_superseded\x18\x04 \x01(\x08\"\xd4\x02\n\x13ResultsForAlgorithm\x12\x30\n\x07results\x18\x01 \x03(\x0b\x32\x1f.ResultsForAlgorithm.ResultsRow\x1a\x8a\x02\n\nResultsRow\x12\x35\n\x04time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x16\n\x0csingle_value\x18\x02 \x01(\x02H\x00\x12#\n\x0c\x61rray_values\x18\x03 \x01(\x0b\x32\x0b.FloatArrayH\x00\x12/\n\x0cstruct_value\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x12\x1d\n\x06status\x18\x05 \x01(\x0e\x32\r.ResultStatus\x12\x15\n\rerror_message\x18\x06 \x01(\t\x12\x12\n\nsuperseded\x18\x07 \x01(\x08\x42\r\n\x0bresult_data\"\xc4\x01\n\x0bWindowsRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12#\n\x06window\x18\x03 \x01(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12\x1a\n\x12include_superseded\x18\x04 \x01(\x08\"\"\n\x07Windows\x12\x17\n\x06window\x18\x01 \x03(\x0b\x32\x07.Window\"\xbb\x01\n!DistinctMetadataForWindowTypeRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12 \n\x0bwindow_type\x18\x03 \x01(\x0b\x32\x0b.WindowType\"M\n\x1d\x44istinctMetadataForWindowType\x12,\n\x08metadata\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.ListValue\"\xb1\x02\n\x16WindowsForMetadataRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12#\n\x06window\x18\x03 \x01(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12:\n\x08metadata\x18\x04 \x03(\x0b\x32 .WindowsForMetadataRead.MetadataB\x06\xbaH\x03\xc8\x01\x01\x1a@\n\x08Metadata\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value\"-\n\x12WindowsForMetadata\x12\x17\n\x06window\x18\x01 \x03(\x0b\x32\x07.Window\"\xe9\x01\n\x13ResultsSubscription\x12\x1e\n\nalgorithms\x18\x01 \x03(\x0b\x32\n.Algorithm\x12 \n\x0bwindow_type\x18\x02 \x01(\x0b\x32\x0b.WindowType\x12/\n\x08metadata\x18\x03 \x03(\x0b\x32\x1d.ResultsSubscription.Metadata\x12\x1d\n\x0cresume_after\x18\x04 \x01(\x03\x42\x07\xbaH\x04\"\x02(\x00\x1a@\n\x08Metadata\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value\"c\n\x0cResultUpdate\x12\x0e\n\x06\x63ursor\x18\x01 \x01(\x03\x12\x17\n\x06window\x18\x02 \x01(\x0b\x32\x07.Window\x12*\n\x10\x61lgorithm_result\x18\x03 \x01(\x0b\x32\x10.AlgorithmResult\"\xcb\x02\n\"ResultsForAlgorithmAndMetadataRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12%\n\talgorithm\x18\x03 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x46\n\x08metadata\x18\x04 \x03(\x0b\x32,.ResultsForAlgorithmAndMetadataRead.MetadataB\x06\xbaH\x03\xc8\x01\x01\x1a@\n\x08Metadata\x12\r\n\x05\x66ield\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value\"\xd6\x02\n\x1eResultsForAlgorithmAndMetadata\x12;\n\x07results\x18\x01 \x03(\x0b\x32*.ResultsForAlgorithmAndMetadata.ResultsRow\x1a\xf6\x01\n\nResultsRow\x12\x35\n\x04time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x16\n\x0csingle_value\x18\x02 \x01(\x02H\x00\x12#\n\x0c\x61rray_values\x18\x03 \x01(\x0b\x32\x0b.FloatArrayH\x00\x12/\n\x0cstruct_value\x18\x04 \x01(\x0b\x32\x17.google.protobuf.StructH\x00\x12\x1d\n\x06status\x18\x05 \x01(\x0e\x32\r.ResultStatus\x12\x15\n\rerror_message\x18\x06 \x01(\tB\r\n\x0bresult_data\"\x91\x03\n\rAnnotateWrite\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12/\n\x13\x63\x61ptured_algorithms\x18\x03 \x03(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12-\n\x10\x63\x61ptured_windows\x18\x04 \x03(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12\x1b\n\x0b\x64\x65scription\x18\x05 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12)\n\x08metadata\x18\x06 \x01(\x0b\x32\x17.google.protobuf.Struct:b\xbaH_\x1a]\n\x14window.time_ordering\x12&time_to must be greater than time_from\x1a\x1dthis.time_to > this.time_from\"\x12\n\x10\x41nnotateResponse\",\n\rExecutionRead\x12\x1b\n\x07\x65xec_id\x18\x01 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\"\x14\n\x12\x45xecutionQueueRead\"i\n\x0e\x45xecutionQueue\x12\x0f\n\x07workers\x18\x01 \x01(\x05\x12\x0e\n\x06\x61\x63tive\x18\x02 \x01(\x05\x12\x0e\n\x06queued\x18\x03 \x01(\x05\x12\x12\n\nqueue_size\x18\x04 \x01(\x05\x12\x12\n\nsaturation\x18\x05 \x01(\x01\".\n\x0f\x45xecutionCancel\x12\x1b\n\x07\x65xec_id\x18\x01 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\"\xc5\x01\n\x0e\x45xecutionsRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x1b\n\x06window\x18\x03 \x01(\x0b\x32\x0b.WindowType\x12 \n\x06status\x18\x04 \x01(\x0e\x32\x10.ExecutionStatus\"\xdf\x01\n\x10\x45xecutionAttempt\x12\x0f\n\x07\x61ttempt\x18\x01 \x01(\x05\x12 \n\x06status\x18\x02 \x01(\x0e\x32\x10.ExecutionStatus\x12\x12\n\nerror_code\x18\x03 \x01(\t\x12\x15\n\rerror_message\x18\x04 \x01(\t\x12\x12\n\nbackoff_ms\x18\x05 \x01(\x03\x12+\n\x07started\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x66inished\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x9f\x02\n\x12\x41lgorithmExecution\x12\x1d\n\talgorithm\x18\x01 \x01(\x0b\x32\n.Algorithm\x12\x16\n\x0eprocessor_name\x18\x02 \x01(\t\x12\x19\n\x11processor_runtime\x18\x03 \x01(\t\x12 \n\x06status\x18\x04 \x01(\x0e\x32\x10.ExecutionStatus\x12+\n\x07started\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x66inished\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rerror_message\x18\x07 \x01(\t\x12#\n\x08\x61ttempts\x18\x08 \x03(\x0b\x32\x11.ExecutionAttempt\"\x9d\x02\n\tExecution\x12\x0f\n\x07\x65xec_id\x18\x01 \x01(\t\x12\x17\n\x06window\x18\x02 \x01(\x0b\x32\x07.Window\x12 \n\x06status\x18\x03 \x01(\x0e\x32\x10.ExecutionStatus\x12+\n\x07\x63reated\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07started\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x66inished\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\nalgorithms\x18\x07 \x03(\x0b\x32\x13.AlgorithmExecution\x12\x13\n\x0bprovisional\x18\x08 \x01(\x08\",\n\nExecutions\x12\x1e\n\nexecutions\x18\x01 \x03(\x0b\x32\n.Execution\"\xa4\x02\n\x10WindowsReprocess\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12#\n\x06window\x18\x03 \x01(\x0b\x32\x0b.WindowTypeB\x06\xbaH\x03\xc8\x01\x01\x12)\n\x08metadata\x18\x04 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x1e\n\nalgorithms\x18\x05 \x03(\x0b\x32\n.Algorithm\x12*\n\x12windows_per_second\x18\x06 \x01(\x01\x42\x0e\xbaH\x0b\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00\"1\n\rReprocessRead\x12 \n\x0creprocess_id\x18\x01 \x01(\tB\n\xbaH\x07r\x02\x10\x01\xc8\x01\x01\"\x9a\x02\n\tReprocess\x12\x14\n\x0creprocess_id\x18\x01 \x01(\t\x12 \n\x06status\x18\x02 \x01(\x0e\x32\x10.ExecutionStatus\x12\x15\n\rtotal_windows\x18\x03 \x01(\x05\x12\x19\n\x11triggered_windows\x18\x04 \x01(\x05\x12\x19\n\x11succeeded_windows\x18\x05 \x01(\x05\x12\x16\n\x0e\x66\x61iled_windows\x18\x06 \x01(\x05\x12+\n\x07\x63reated\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x66inished\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x15\n\rerror_message\x18\t \x01(\t\"\xf8\x01\n\x14\x46\x61iledExecutionsRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x16\n\x0eprocessor_name\x18\x03 \x01(\t\x12\x19\n\x11processor_runtime\x18\x04 \x01(\t\x12\x1d\n\talgorithm\x18\x05 \x01(\x0b\x32\n.Algorithm\x12\x18\n\x10include_requeued\x18\x06 \x01(\x08\"\xe1\x01\n\x17\x46\x61iledExecutionsRequeue\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x16\n\x0eprocessor_name\x18\x03 \x01(\t\x12\x19\n\x11processor_runtime\x18\x04 \x01(\t\x12\x1d\n\talgorithm\x18\x05 \x01(\x0b\x32\n.Algorithm\"\xa6\x02\n\x0f\x46\x61iledExecution\x12\x0f\n\x07\x65xec_id\x18\x01 \x01(\t\x12\x14\n\x0ctask_exec_id\x18\x02 \x01(\t\x12\x16\n\x0eprocessor_name\x18\x03 \x01(\t\x12\x19\n\x11processor_runtime\x18\x04 \x01(\t\x12\"\n\x07request\x18\x05 \x01(\x0b\x32\x11.ExecutionRequest\x12\x12\n\nerror_code\x18\x06 \x01(\t\x12\x15\n\rerror_message\x18\x07 \x01(\t\x12\x10\n\x08\x61ttempts\x18\x08 \x01(\x05\x12*\n\x06\x66\x61iled\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08requeued\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"?\n\x10\x46\x61iledExecutions\x12+\n\x11\x66\x61iled_executions\x18\x01 \x03(\x0b\x32\x10.FailedExecution\"&\n\x12RequeuedExecutions\x12\x10\n\x08\x65xec_ids\x18\x01 \x03(\t\"\x8f\x01\n\tAlertRule\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12%\n\talgorithm\x18\x02 \x01(\x0b\x32\n.AlgorithmB\x06\xbaH\x03\xc8\x01\x01\x12\x1a\n\nexpression\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\x12\x14\n\x0cmetadata_key\x18\x05 \x01(\t\"\x10\n\x0e\x41lertRulesRead\"-\n\nAlertRules\x12\x1f\n\x0b\x61lert_rules\x18\x01 \x03(\x0b\x32\n.AlertRule\")\n\x11\x41lertRuleDeletion\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"\xd7\x01\n\nAlertsRead\x12:\n\ttime_from\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x38\n\x07time_to\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\x0b\xbaH\x08\xb2\x01\x02*\x00\xc8\x01\x01\x12\x17\n\x0f\x61lert_rule_name\x18\x03 \x01(\t\x12\x1c\n\x06status\x18\x04 \x01(\x0e\x32\x0c.AlertStatus\x12\x1c\n\x14include_acknowledged\x18\x05 \x01(\x08\"\xe2\x03\n\x06\x41lerts\x12\x1d\n\x06\x61lerts\x18\x01 \x03(\x0b\x32\r.Alerts.Alert\x1a\xb8\x03\n\x05\x41lert\x12\x10\n\x08\x61lert_id\x18\x01 \x01(\x03\x12\x17\n\x0f\x61lert_rule_name\x18\x02 \x01(\t\x12\x1d\n\talgorithm\x18\x03 \x01(\x0b\x32\n.Algorithm\x12\x1c\n\x06status\x18\x04 \x01(\x0e\x32\x0c.AlertStatus\x12\x0e\n\x06origin\x18\x05 \x01(\t\x12)\n\x08metadata\x18\x06 \x01(\x0b\x32\x17.google.protobuf.Struct\x12\x34\n\x10window_time_from\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0ewindow_time_to\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12)\n\x05\x66ired\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08resolved\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x30\n\x0c\x61\x63knowledged\x18\x0b \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x17\n\x0f\x61\x63knowledged_by\x18\x0c \x01(\t\"J\n\x14\x41lertAcknowledgement\x12\x19\n\x08\x61lert_id\x18\x01 \x01(\x03\x42\x07\xbaH\x04\"\x02 \x00\x12\x17\n\x0f\x61\x63knowledged_by\x18\x02 \x01(\t\"\xe9\x02\n\x0eWindowSchedule\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12 \n\x10window_type_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12#\n\x13window_type_version\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x14\n\x04\x63ron\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x10\n\x08timezone\x18\x05 \x01(\t\x12\x16\n\x06origin\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12)\n\x08metadata\x18\x07 \x01(\x0b\x32\x17.google.protobuf.Struct\x12)\n\x05start\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\remitted_until\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\rnext_emission\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x15\n\x13WindowSchedulesRead\"<\n\x0fWindowSchedules\x12)\n\x10window_schedules\x18\x01 \x03(\x0b\x32\x0f.WindowSchedule\".\n\x16WindowScheduleDeletion\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"\x99\x02\n\nRollupRule\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12&\n\x16\x63hild_window_type_name\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12)\n\x19\x63hild_window_type_version\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\'\n\x17parent_window_type_name\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12*\n\x1aparent_window_type_version\x18\x05 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x14\n\x04\x63ron\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\x12\x10\n\x08timezone\x18\x07 \x01(\t\x12\x15\n\rmetadata_keys\x18\x08 \x03(\t\x12\x0e\n\x06origin\x18\t \x01(\t\"\x11\n\x0fRollupRulesRead\"0\n\x0bRollupRules\x12!\n\x0crollup_rules\x18\x01 \x03(\x0b\x32\x0b.RollupRule\"*\n\x12RollupRuleDeletion\x12\x14\n\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01\"3\n\x10\x43hildWindowsRead\x12\x1f\n\x06window\x18\x01 \x01(\x0b\x32\x07.WindowB\x06\xbaH\x03\xc8\x01\x01*K\n\nResultType\x12\x11\n\rNOT_SPECIFIED\x10\x00\x12\n\n\x06STRUCT\x10\x01\x12\t\n\x05VALUE\x10\x02\x12\t\n\x05\x41RRAY\x10\x03\x12\x08\n\x04NONE\x10\x04*p\n\x0cResultStatus\x12 \n\x1cRESULT_STATUS_HANDLED_FAILED\x10\x00\x12\"\n\x1eRESULT_STATUS_UNHANDLED_FAILED\x10\x01\x12\x1a\n\x16RESULT_STATUS_SUCEEDED\x10\x02*\xf5\x01\n\x0f\x43onnectionState\x12 \n\x1c\x43ONNECTION_STATE_UNSPECIFIED\x10\x00\x12!\n\x1d\x43ONNECTION_STATE_DISCONNECTED\x10\x01\x12\x19\n\x15\x43ONNECTION_STATE_IDLE\x10\x02\x12\x1f\n\x1b\x43ONNECTION_STATE_CONNECTING\x10\x03\x12\x1a\n\x16\x43ONNECTION_STATE_READY\x10\x04\x12&\n\"CONNECTION_STATE_TRANSIENT_FAILURE\x10\x05\x12\x1d\n\x19\x43ONNECTION_STATE_SHUTDOWN\x10\x06*\xea\x01\n\x0f\x45xecutionStatus\x12 \n\x1c\x45XECUTION_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n\x18\x45XECUTION_STATUS_PENDING\x10\x01\x12\x1c\n\x18\x45XECUTION_STATUS_RUNNING\x10\x02\x12\x1e\n\x1a\x45XECUTION_STATUS_SUCCEEDED\x10\x03\x12\x1b\n\x17\x45XECUTION_STATUS_FAILED\x10\x04\x12\x1c\n\x18\x45XECUTION_STATUS_SKIPPED\x10\x05\x12\x1e\n\x1a\x45XECUTION_STATUS_CANCELLED\x10\x06*_\n\x0b\x41lertStatus\x12\x1c\n\x18\x41LERT_STATUS_UNSPECIFIED\x10\x00\x12\x17\n\x13\x41LERT_STATUS_FIRING\x10\x01\x12\x19\n\x15\x41LERT_STATUS_RESOLVED\x10\x02\x32\xab\x13\n\x08OrcaCore\x12\x34\n\x11RegisterProcessor\x12\x16.ProcessorRegistration\x1a\x07.Status\x12(\n\nEmitWindow\x12\x07.Window\x1a\x11.WindowEmitStatus\x12/\n\x0b\x45mitWindows\x12\x0c.WindowsEmit\x1a\x12.WindowsEmitStatus\x12\x31\n\x10\x45mitWindowStream\x12\x07.Window\x1a\x12.WindowsEmitStatus(\x01\x12,\n\nOpenWindow\x12\x0b.WindowOpen\x1a\x11.WindowEmitStatus\x12\x33\n\x0c\x45xtendWindow\x12\x10.WindowExtension\x1a\x11.WindowEmitStatus\x12\x30\n\x0b\x43loseWindow\x12\x0e.WindowClosure\x1a\x11.WindowEmitStatus\x12\x32\n\x0b\x41mendWindow\x12\x10.WindowAmendment\x1a\x11.WindowEmitStatus\x12\x38\n\x13\x44\x65registerProcessor\x12\x18.ProcessorDeregistration\x1a\x07.Status\x12\x30\n\x0fRetireAlgorithm\x12\x14.AlgorithmRetirement\x1a\x07.Status\x12\x41\n\x19RemoveAlgorithmDependency\x12\x1b.AlgorithmDependencyRemoval\x1a\x07.Status\x12\x36\n\x0eSubscribeTasks\x12\x11.TaskSubscription\x1a\x0f.ProcessingTask0\x01\x12$\n\x0cSubmitResult\x12\x0b.TaskResult\x1a\x07.Status\x12\x30\n\x0fReadWindowTypes\x12\x0f.WindowTypeRead\x1a\x0c.WindowTypes\x12.\n\x0eReadAlgorithms\x12\x0f.AlgorithmsRead\x1a\x0b.Algorithms\x12.\n\x0eReadProcessors\x12\x0f.ProcessorsRead\x1a\x0b.Processors\x12\x34\n\x10ReadResultsStats\x12\x11.ResultsStatsRead\x1a\r.ResultsStats\x12\x46\n\x1cReadResultFieldsForAlgorithm\x12\x14.AlgorithmFieldsRead\x1a\x10.AlgorithmFields\x12I\n\x17ReadResultsForAlgorithm\x12\x18.ResultsForAlgorithmRead\x1a\x14.ResultsForAlgorithm\x12%\n\x0bReadWindows\x12\x0c.WindowsRead\x1a\x08.Windows\x12g\n!ReadDistinctMetadataForWindowType\x12\".DistinctMetadataForWindowTypeRead\x1a\x1e.DistinctMetadataForWindowType\x12\x46\n\x16ReadWindowsForMetadata\x12\x17.WindowsForMetadataRead\x1a\x13.WindowsForMetadata\x12j\n\"ReadResultsForAlgorithmAndMetadata\x12#.ResultsForAlgorithmAndMetadataRead\x1a\x1f.ResultsForAlgorithmAndMetadata\x12\x39\n\x10SubscribeResults\x12\x14.ResultsSubscription\x1a\r.ResultUpdate0\x01\x12-\n\x08\x41nnotate\x12\x0e.AnnotateWrite\x1a\x11.AnnotateResponse\x12\x30\n\x14\x43reateWindowSchedule\x12\x0f.WindowSchedule\x1a\x07.Status\x12=\n\x13ReadWindowSchedules\x12\x14.WindowSchedulesRead\x1a\x10.WindowSchedules\x12\x38\n\x14\x44\x65leteWindowSchedule\x12\x17.WindowScheduleDeletion\x1a\x07.Status\x12(\n\x10\x43reateRollupRule\x12\x0b.RollupRule\x1a\x07.Status\x12\x31\n\x0fReadRollupRules\x12\x10.RollupRulesRead\x1a\x0c.RollupRules\x12\x30\n\x10\x44\x65leteRollupRule\x12\x13.RollupRuleDeletion\x1a\x07.Status\x12/\n\x10ReadChildWindows\x12\x11.ChildWindowsRead\x1a\x08.Windows\x12&\n\x0f\x43reateAlertRule\x12\n.AlertRule\x1a\x07.Status\x12.\n\x0eReadAlertRules\x12\x0f.AlertRulesRead\x1a\x0b.AlertRules\x12.\n\x0f\x44\x65leteAlertRule\x12\x12.AlertRuleDeletion\x1a\x07.Status\x12\"\n\nReadAlerts\x12\x0b.AlertsRead\x1a\x07.Alerts\x12\x32\n\x10\x41\x63knowledgeAlert\x12\x15.AlertAcknowledgement\x1a\x07.Status\x12+\n\rReadExecution\x12\x0e.ExecutionRead\x1a\n.Execution\x12.\n\x0eReadExecutions\x12\x0f.ExecutionsRead\x1a\x0b.Executions\x12,\n\x0f\x43\x61ncelExecution\x12\x10.ExecutionCancel\x1a\x07.Status\x12:\n\x12ReadExecutionQueue\x12\x13.ExecutionQueueRead\x1a\x0f.ExecutionQueue\x12\x31\n\x10ReprocessWindows\x12\x11.WindowsReprocess\x1a\n.Reprocess\x12+\n\rReadReprocess\x12\x0e.ReprocessRead\x1a\n.Reprocess\x12@\n\x14ReadFailedExecutions\x12\x15.FailedExecutionsRead\x1a\x11.FailedExecutions\x12H\n\x17RequeueFailedExecutions\x12\x18.FailedExecutionsRequeue\x1a\x13.RequeuedExecutions2\x82\x01\n\rOrcaProcessor\x12\x37\n\x0e\x45xecuteDagPart\x12\x11.ExecutionRequest\x1a\x10.ExecutionResult0\x01\x12\x38\n\x0bHealthCheck\x12\x13.HealthCheckRequest\x1a\x14.HealthCheckResponseB,Z*github.com/orc-analytics/orca/protobufs/gob\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -45,6 +45,8 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_WINDOW'].fields_by_name['window_type_version']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
   _globals['_WINDOW'].fields_by_name['origin']._loaded_options = None
   _globals['_WINDOW'].fields_by_name['origin']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOW'].fields_by_name['idempotency_key']._loaded_options = None
+  _globals['_WINDOW'].fields_by_name['idempotency_key']._serialized_options = b'\272H\005r\003\030\200\002'
   _globals['_WINDOW']._loaded_options = None
   _globals['_WINDOW']._serialized_options = b'\272H_\032]\n\024window.time_ordering\022&time_to must be greater than time_from\032\035this.time_to > this.time_from'
   _globals['_METADATAFIELD'].fields_by_name['name']._loaded_options = None
@@ -59,6 +61,36 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_WINDOWTYPE'].fields_by_name['description']._serialized_options = b'\272H\003\310\001\001'
   _globals['_WINDOWEMITSTATUS'].fields_by_name['status']._loaded_options = None
   _globals['_WINDOWEMITSTATUS'].fields_by_name['status']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWOPEN'].fields_by_name['time_from']._loaded_options = None
+  _globals['_WINDOWOPEN'].fields_by_name['time_from']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_WINDOWOPEN'].fields_by_name['window_type_name']._loaded_options = None
+  _globals['_WINDOWOPEN'].fields_by_name['window_type_name']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOWOPEN'].fields_by_name['window_type_version']._loaded_options = None
+  _globals['_WINDOWOPEN'].fields_by_name['window_type_version']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOWOPEN'].fields_by_name['origin']._loaded_options = None
+  _globals['_WINDOWOPEN'].fields_by_name['origin']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOWOPEN'].fields_by_name['idempotency_key']._loaded_options = None
+  _globals['_WINDOWOPEN'].fields_by_name['idempotency_key']._serialized_options = b'\272H\nr\005\020\001\030\200\002\310\001\001'
+  _globals['_WINDOWEXTENSION'].fields_by_name['window_type_name']._loaded_options = None
+  _globals['_WINDOWEXTENSION'].fields_by_name['window_type_name']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOWEXTENSION'].fields_by_name['window_type_version']._loaded_options = None
+  _globals['_WINDOWEXTENSION'].fields_by_name['window_type_version']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOWEXTENSION'].fields_by_name['idempotency_key']._loaded_options = None
+  _globals['_WINDOWEXTENSION'].fields_by_name['idempotency_key']._serialized_options = b'\272H\nr\005\020\001\030\200\002\310\001\001'
+  _globals['_WINDOWEXTENSION'].fields_by_name['time_to']._loaded_options = None
+  _globals['_WINDOWEXTENSION'].fields_by_name['time_to']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_WINDOWCLOSURE'].fields_by_name['window_type_name']._loaded_options = None
+  _globals['_WINDOWCLOSURE'].fields_by_name['window_type_name']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOWCLOSURE'].fields_by_name['window_type_version']._loaded_options = None
+  _globals['_WINDOWCLOSURE'].fields_by_name['window_type_version']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_WINDOWCLOSURE'].fields_by_name['idempotency_key']._loaded_options = None
+  _globals['_WINDOWCLOSURE'].fields_by_name['idempotency_key']._serialized_options = b'\272H\nr\005\020\001\030\200\002\310\001\001'
+  _globals['_WINDOWCLOSURE'].fields_by_name['time_to']._loaded_options = None
+  _globals['_WINDOWCLOSURE'].fields_by_name['time_to']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_WINDOWAMENDMENT'].fields_by_name['window']._loaded_options = None
+  _globals['_WINDOWAMENDMENT'].fields_by_name['window']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWAMENDMENT'].fields_by_name['amended_window']._loaded_options = None
+  _globals['_WINDOWAMENDMENT'].fields_by_name['amended_window']._serialized_options = b'\272H\003\310\001\001'
   _globals['_ALGORITHMDEPENDENCY'].fields_by_name['name']._loaded_options = None
   _globals['_ALGORITHMDEPENDENCY'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
   _globals['_ALGORITHMDEPENDENCY'].fields_by_name['version']._loaded_options = None
@@ -75,6 +107,8 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_ALGORITHM'].fields_by_name['window_type']._serialized_options = b'\272H\003\310\001\001'
   _globals['_ALGORITHM'].fields_by_name['result_type']._loaded_options = None
   _globals['_ALGORITHM'].fields_by_name['result_type']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALGORITHM'].fields_by_name['timeout_ms']._loaded_options = None
+  _globals['_ALGORITHM'].fields_by_name['timeout_ms']._serialized_options = b'\272H\004\"\002(\000'
   _globals['_RESULT'].fields_by_name['status']._loaded_options = None
   _globals['_RESULT'].fields_by_name['status']._serialized_options = b'\272H\003\310\001\001'
   _globals['_RESULT'].fields_by_name['timestamp']._loaded_options = None
@@ -83,16 +117,48 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_PROCESSORREGISTRATION'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
   _globals['_PROCESSORREGISTRATION'].fields_by_name['runtime']._loaded_options = None
   _globals['_PROCESSORREGISTRATION'].fields_by_name['runtime']._serialized_options = b'\272H\003\310\001\001'
-  _globals['_PROCESSORREGISTRATION'].fields_by_name['connection_str']._loaded_options = None
-  _globals['_PROCESSORREGISTRATION'].fields_by_name['connection_str']._serialized_options = b'\272H\003\310\001\001'
   _globals['_PROCESSORREGISTRATION'].fields_by_name['supported_algorithms']._loaded_options = None
   _globals['_PROCESSORREGISTRATION'].fields_by_name['supported_algorithms']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_PROCESSORDEREGISTRATION'].fields_by_name['name']._loaded_options = None
+  _globals['_PROCESSORDEREGISTRATION'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_PROCESSORDEREGISTRATION'].fields_by_name['runtime']._loaded_options = None
+  _globals['_PROCESSORDEREGISTRATION'].fields_by_name['runtime']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALGORITHMRETIREMENT'].fields_by_name['name']._loaded_options = None
+  _globals['_ALGORITHMRETIREMENT'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALGORITHMRETIREMENT'].fields_by_name['version']._loaded_options = None
+  _globals['_ALGORITHMRETIREMENT'].fields_by_name['version']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['algorithm_name']._loaded_options = None
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['algorithm_name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['algorithm_version']._loaded_options = None
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['algorithm_version']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['dependency_name']._loaded_options = None
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['dependency_name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['dependency_version']._loaded_options = None
+  _globals['_ALGORITHMDEPENDENCYREMOVAL'].fields_by_name['dependency_version']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_RETRYPOLICY'].fields_by_name['max_attempts']._loaded_options = None
+  _globals['_RETRYPOLICY'].fields_by_name['max_attempts']._serialized_options = b'\272H\004\032\002(\000'
+  _globals['_RETRYPOLICY'].fields_by_name['initial_backoff_ms']._loaded_options = None
+  _globals['_RETRYPOLICY'].fields_by_name['initial_backoff_ms']._serialized_options = b'\272H\004\"\002(\000'
+  _globals['_RETRYPOLICY'].fields_by_name['max_backoff_ms']._loaded_options = None
+  _globals['_RETRYPOLICY'].fields_by_name['max_backoff_ms']._serialized_options = b'\272H\004\"\002(\000'
+  _globals['_RETRYPOLICY'].fields_by_name['backoff_multiplier']._loaded_options = None
+  _globals['_RETRYPOLICY'].fields_by_name['backoff_multiplier']._serialized_options = b'\272H\013\022\t)\000\000\000\000\000\000\000\000'
+  _globals['_RETRYPOLICY'].fields_by_name['jitter']._loaded_options = None
+  _globals['_RETRYPOLICY'].fields_by_name['jitter']._serialized_options = b'\272H\024\022\022\031\000\000\000\000\000\000\360?)\000\000\000\000\000\000\000\000'
   _globals['_PROCESSINGTASK'].fields_by_name['task_id']._loaded_options = None
   _globals['_PROCESSINGTASK'].fields_by_name['task_id']._serialized_options = b'\272H\003\310\001\001'
   _globals['_PROCESSINGTASK'].fields_by_name['algorithm']._loaded_options = None
   _globals['_PROCESSINGTASK'].fields_by_name['algorithm']._serialized_options = b'\272H\003\310\001\001'
   _globals['_PROCESSINGTASK'].fields_by_name['window']._loaded_options = None
   _globals['_PROCESSINGTASK'].fields_by_name['window']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_TASKSUBSCRIPTION'].fields_by_name['name']._loaded_options = None
+  _globals['_TASKSUBSCRIPTION'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_TASKSUBSCRIPTION'].fields_by_name['runtime']._loaded_options = None
+  _globals['_TASKSUBSCRIPTION'].fields_by_name['runtime']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_TASKRESULT'].fields_by_name['task_id']._loaded_options = None
+  _globals['_TASKRESULT'].fields_by_name['task_id']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_TASKRESULT'].fields_by_name['result']._loaded_options = None
+  _globals['_TASKRESULT'].fields_by_name['result']._serialized_options = b'\272H\003\310\001\001'
   _globals['_EXECUTIONREQUEST'].fields_by_name['exec_id']._loaded_options = None
   _globals['_EXECUTIONREQUEST'].fields_by_name['exec_id']._serialized_options = b'\272H\003\310\001\001'
   _globals['_EXECUTIONREQUEST'].fields_by_name['window']._loaded_options = None
@@ -141,6 +207,8 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_WINDOWSFORMETADATAREAD'].fields_by_name['window']._serialized_options = b'\272H\003\310\001\001'
   _globals['_WINDOWSFORMETADATAREAD'].fields_by_name['metadata']._loaded_options = None
   _globals['_WINDOWSFORMETADATAREAD'].fields_by_name['metadata']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_RESULTSSUBSCRIPTION'].fields_by_name['resume_after']._loaded_options = None
+  _globals['_RESULTSSUBSCRIPTION'].fields_by_name['resume_after']._serialized_options = b'\272H\004\"\002(\000'
   _globals['_RESULTSFORALGORITHMANDMETADATAREAD'].fields_by_name['time_from']._loaded_options = None
   _globals['_RESULTSFORALGORITHMANDMETADATAREAD'].fields_by_name['time_from']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
   _globals['_RESULTSFORALGORITHMANDMETADATAREAD'].fields_by_name['time_to']._loaded_options = None
@@ -163,104 +231,278 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_ANNOTATEWRITE'].fields_by_name['description']._serialized_options = b'\272H\003\310\001\001'
   _globals['_ANNOTATEWRITE']._loaded_options = None
   _globals['_ANNOTATEWRITE']._serialized_options = b'\272H_\032]\n\024window.time_ordering\022&time_to must be greater than time_from\032\035this.time_to > this.time_from'
-  _globals['_RESULTTYPE']._serialized_start=5335
-  _globals['_RESULTTYPE']._serialized_end=5410
-  _globals['_RESULTSTATUS']._serialized_start=5412
-  _globals['_RESULTSTATUS']._serialized_end=5524
+  _globals['_EXECUTIONREAD'].fields_by_name['exec_id']._loaded_options = None
+  _globals['_EXECUTIONREAD'].fields_by_name['exec_id']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_EXECUTIONCANCEL'].fields_by_name['exec_id']._loaded_options = None
+  _globals['_EXECUTIONCANCEL'].fields_by_name['exec_id']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_EXECUTIONSREAD'].fields_by_name['time_from']._loaded_options = None
+  _globals['_EXECUTIONSREAD'].fields_by_name['time_from']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_EXECUTIONSREAD'].fields_by_name['time_to']._loaded_options = None
+  _globals['_EXECUTIONSREAD'].fields_by_name['time_to']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_WINDOWSREPROCESS'].fields_by_name['time_from']._loaded_options = None
+  _globals['_WINDOWSREPROCESS'].fields_by_name['time_from']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_WINDOWSREPROCESS'].fields_by_name['time_to']._loaded_options = None
+  _globals['_WINDOWSREPROCESS'].fields_by_name['time_to']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_WINDOWSREPROCESS'].fields_by_name['window']._loaded_options = None
+  _globals['_WINDOWSREPROCESS'].fields_by_name['window']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWSREPROCESS'].fields_by_name['windows_per_second']._loaded_options = None
+  _globals['_WINDOWSREPROCESS'].fields_by_name['windows_per_second']._serialized_options = b'\272H\013\022\t)\000\000\000\000\000\000\000\000'
+  _globals['_REPROCESSREAD'].fields_by_name['reprocess_id']._loaded_options = None
+  _globals['_REPROCESSREAD'].fields_by_name['reprocess_id']._serialized_options = b'\272H\007r\002\020\001\310\001\001'
+  _globals['_FAILEDEXECUTIONSREAD'].fields_by_name['time_from']._loaded_options = None
+  _globals['_FAILEDEXECUTIONSREAD'].fields_by_name['time_from']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_FAILEDEXECUTIONSREAD'].fields_by_name['time_to']._loaded_options = None
+  _globals['_FAILEDEXECUTIONSREAD'].fields_by_name['time_to']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_FAILEDEXECUTIONSREQUEUE'].fields_by_name['time_from']._loaded_options = None
+  _globals['_FAILEDEXECUTIONSREQUEUE'].fields_by_name['time_from']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_FAILEDEXECUTIONSREQUEUE'].fields_by_name['time_to']._loaded_options = None
+  _globals['_FAILEDEXECUTIONSREQUEUE'].fields_by_name['time_to']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_ALERTRULE'].fields_by_name['name']._loaded_options = None
+  _globals['_ALERTRULE'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALERTRULE'].fields_by_name['algorithm']._loaded_options = None
+  _globals['_ALERTRULE'].fields_by_name['algorithm']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALERTRULE'].fields_by_name['expression']._loaded_options = None
+  _globals['_ALERTRULE'].fields_by_name['expression']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALERTRULEDELETION'].fields_by_name['name']._loaded_options = None
+  _globals['_ALERTRULEDELETION'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ALERTSREAD'].fields_by_name['time_from']._loaded_options = None
+  _globals['_ALERTSREAD'].fields_by_name['time_from']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_ALERTSREAD'].fields_by_name['time_to']._loaded_options = None
+  _globals['_ALERTSREAD'].fields_by_name['time_to']._serialized_options = b'\272H\010\262\001\002*\000\310\001\001'
+  _globals['_ALERTACKNOWLEDGEMENT'].fields_by_name['alert_id']._loaded_options = None
+  _globals['_ALERTACKNOWLEDGEMENT'].fields_by_name['alert_id']._serialized_options = b'\272H\004\"\002 \000'
+  _globals['_WINDOWSCHEDULE'].fields_by_name['name']._loaded_options = None
+  _globals['_WINDOWSCHEDULE'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWSCHEDULE'].fields_by_name['window_type_name']._loaded_options = None
+  _globals['_WINDOWSCHEDULE'].fields_by_name['window_type_name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWSCHEDULE'].fields_by_name['window_type_version']._loaded_options = None
+  _globals['_WINDOWSCHEDULE'].fields_by_name['window_type_version']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWSCHEDULE'].fields_by_name['cron']._loaded_options = None
+  _globals['_WINDOWSCHEDULE'].fields_by_name['cron']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWSCHEDULE'].fields_by_name['origin']._loaded_options = None
+  _globals['_WINDOWSCHEDULE'].fields_by_name['origin']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_WINDOWSCHEDULEDELETION'].fields_by_name['name']._loaded_options = None
+  _globals['_WINDOWSCHEDULEDELETION'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ROLLUPRULE'].fields_by_name['name']._loaded_options = None
+  _globals['_ROLLUPRULE'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ROLLUPRULE'].fields_by_name['child_window_type_name']._loaded_options = None
+  _globals['_ROLLUPRULE'].fields_by_name['child_window_type_name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ROLLUPRULE'].fields_by_name['child_window_type_version']._loaded_options = None
+  _globals['_ROLLUPRULE'].fields_by_name['child_window_type_version']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ROLLUPRULE'].fields_by_name['parent_window_type_name']._loaded_options = None
+  _globals['_ROLLUPRULE'].fields_by_name['parent_window_type_name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ROLLUPRULE'].fields_by_name['parent_window_type_version']._loaded_options = None
+  _globals['_ROLLUPRULE'].fields_by_name['parent_window_type_version']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ROLLUPRULE'].fields_by_name['cron']._loaded_options = None
+  _globals['_ROLLUPRULE'].fields_by_name['cron']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_ROLLUPRULEDELETION'].fields_by_name['name']._loaded_options = None
+  _globals['_ROLLUPRULEDELETION'].fields_by_name['name']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_CHILDWINDOWSREAD'].fields_by_name['window']._loaded_options = None
+  _globals['_CHILDWINDOWSREAD'].fields_by_name['window']._serialized_options = b'\272H\003\310\001\001'
+  _globals['_RESULTTYPE']._serialized_start=13092
+  _globals['_RESULTTYPE']._serialized_end=13167
+  _globals['_RESULTSTATUS']._serialized_start=13169
+  _globals['_RESULTSTATUS']._serialized_end=13281
+  _globals['_CONNECTIONSTATE']._serialized_start=13284
+  _globals['_CONNECTIONSTATE']._serialized_end=13529
+  _globals['_EXECUTIONSTATUS']._serialized_start=13532
+  _globals['_EXECUTIONSTATUS']._serialized_end=13766
+  _globals['_ALERTSTATUS']._serialized_start=13768
+  _globals['_ALERTSTATUS']._serialized_end=13863
   _globals['_WINDOW']._serialized_start=104
-  _globals['_WINDOW']._serialized_end=480
-  _globals['_METADATAFIELD']._serialized_start=482
-  _globals['_METADATAFIELD']._serialized_end=548
-  _globals['_WINDOWTYPE']._serialized_start=551
-  _globals['_WINDOWTYPE']._serialized_end=679
-  _globals['_WINDOWEMITSTATUS']._serialized_start=682
-  _globals['_WINDOWEMITSTATUS']._serialized_end=846
-  _globals['_WINDOWEMITSTATUS_STATUSENUM']._serialized_start=756
-  _globals['_WINDOWEMITSTATUS_STATUSENUM']._serialized_end=846
-  _globals['_ALGORITHMDEPENDENCY']._serialized_start=849
-  _globals['_ALGORITHMDEPENDENCY']._serialized_end=984
-  _globals['_ALGORITHM']._serialized_start=987
-  _globals['_ALGORITHM']._serialized_end=1173
-  _globals['_FLOATARRAY']._serialized_start=1175
-  _globals['_FLOATARRAY']._serialized_end=1203
-  _globals['_RESULT']._serialized_start=1206
-  _globals['_RESULT']._serialized_end=1405
-  _globals['_PROCESSORREGISTRATION']._serialized_start=1408
-  _globals['_PROCESSORREGISTRATION']._serialized_end=1560
-  _globals['_PROCESSINGTASK']._serialized_start=1563
-  _globals['_PROCESSINGTASK']._serialized_end=1713
-  _globals['_EXECUTIONREQUEST']._serialized_start=1716
-  _globals['_EXECUTIONREQUEST']._serialized_end=1869
-  _globals['_EXECUTIONRESULT']._serialized_start=1871
-  _globals['_EXECUTIONRESULT']._serialized_end=1965
-  _globals['_ALGORITHMRESULT']._serialized_start=1967
-  _globals['_ALGORITHMRESULT']._serialized_end=2056
-  _globals['_STATUS']._serialized_start=2058
-  _globals['_STATUS']._serialized_end=2101
-  _globals['_HEALTHCHECKREQUEST']._serialized_start=2103
-  _globals['_HEALTHCHECKREQUEST']._serialized_end=2150
-  _globals['_HEALTHCHECKRESPONSE']._serialized_start=2153
-  _globals['_HEALTHCHECKRESPONSE']._serialized_end=2380
-  _globals['_HEALTHCHECKRESPONSE_STATUS']._serialized_start=2282
-  _globals['_HEALTHCHECKRESPONSE_STATUS']._serialized_end=2380
-  _globals['_PROCESSORMETRICS']._serialized_start=2382
-  _globals['_PROCESSORMETRICS']._serialized_end=2489
-  _globals['_WINDOWTYPEREAD']._serialized_start=2491
-  _globals['_WINDOWTYPEREAD']._serialized_end=2507
-  _globals['_WINDOWTYPES']._serialized_start=2509
-  _globals['_WINDOWTYPES']._serialized_end=2552
-  _globals['_ALGORITHMSREAD']._serialized_start=2554
-  _globals['_ALGORITHMSREAD']._serialized_end=2570
-  _globals['_ALGORITHMS']._serialized_start=2572
-  _globals['_ALGORITHMS']._serialized_end=2615
-  _globals['_PROCESSORSREAD']._serialized_start=2617
-  _globals['_PROCESSORSREAD']._serialized_end=2633
-  _globals['_PROCESSORS']._serialized_start=2635
-  _globals['_PROCESSORS']._serialized_end=2733
-  _globals['_PROCESSORS_PROCESSOR']._serialized_start=2691
-  _globals['_PROCESSORS_PROCESSOR']._serialized_end=2733
-  _globals['_RESULTSSTATSREAD']._serialized_start=2735
-  _globals['_RESULTSSTATSREAD']._serialized_end=2753
-  _globals['_RESULTSSTATS']._serialized_start=2755
-  _globals['_RESULTSSTATS']._serialized_end=2784
-  _globals['_ALGORITHMFIELDSREAD']._serialized_start=2787
-  _globals['_ALGORITHMFIELDSREAD']._serialized_end=2965
-  _globals['_ALGORITHMFIELDS']._serialized_start=2967
-  _globals['_ALGORITHMFIELDS']._serialized_end=2999
-  _globals['_RESULTSFORALGORITHMREAD']._serialized_start=3002
-  _globals['_RESULTSFORALGORITHMREAD']._serialized_end=3184
-  _globals['_RESULTSFORALGORITHM']._serialized_start=3187
-  _globals['_RESULTSFORALGORITHM']._serialized_end=3453
-  _globals['_RESULTSFORALGORITHM_RESULTSROW']._serialized_start=3261
-  _globals['_RESULTSFORALGORITHM_RESULTSROW']._serialized_end=3453
-  _globals['_WINDOWSREAD']._serialized_start=3456
-  _globals['_WINDOWSREAD']._serialized_end=3624
-  _globals['_WINDOWS']._serialized_start=3626
-  _globals['_WINDOWS']._serialized_end=3660
-  _globals['_DISTINCTMETADATAFORWINDOWTYPEREAD']._serialized_start=3663
-  _globals['_DISTINCTMETADATAFORWINDOWTYPEREAD']._serialized_end=3850
-  _globals['_DISTINCTMETADATAFORWINDOWTYPE']._serialized_start=3852
-  _globals['_DISTINCTMETADATAFORWINDOWTYPE']._serialized_end=3929
-  _globals['_WINDOWSFORMETADATAREAD']._serialized_start=3932
-  _globals['_WINDOWSFORMETADATAREAD']._serialized_end=4237
-  _globals['_WINDOWSFORMETADATAREAD_METADATA']._serialized_start=4173
-  _globals['_WINDOWSFORMETADATAREAD_METADATA']._serialized_end=4237
-  _globals['_WINDOWSFORMETADATA']._serialized_start=4239
-  _globals['_WINDOWSFORMETADATA']._serialized_end=4284
-  _globals['_RESULTSFORALGORITHMANDMETADATAREAD']._serialized_start=4287
-  _globals['_RESULTSFORALGORITHMANDMETADATAREAD']._serialized_end=4618
-  _globals['_RESULTSFORALGORITHMANDMETADATAREAD_METADATA']._serialized_start=4173
-  _globals['_RESULTSFORALGORITHMANDMETADATAREAD_METADATA']._serialized_end=4237
-  _globals['_RESULTSFORALGORITHMANDMETADATA']._serialized_start=4621
-  _globals['_RESULTSFORALGORITHMANDMETADATA']._serialized_end=4909
-  _globals['_RESULTSFORALGORITHMANDMETADATA_RESULTSROW']._serialized_start=3261
-  _globals['_RESULTSFORALGORITHMANDMETADATA_RESULTSROW']._serialized_end=3453
-  _globals['_ANNOTATEWRITE']._serialized_start=4912
-  _globals['_ANNOTATEWRITE']._serialized_end=5313
-  _globals['_ANNOTATERESPONSE']._serialized_start=5315
-  _globals['_ANNOTATERESPONSE']._serialized_end=5333
-  _globals['_ORCACORE']._serialized_start=5527
-  _globals['_ORCACORE']._serialized_end=6351
-  _globals['_ORCAPROCESSOR']._serialized_start=6354
-  _globals['_ORCAPROCESSOR']._serialized_end=6484
+  _globals['_WINDOW']._serialized_end=554
+  _globals['_METADATAFIELD']._serialized_start=556
+  _globals['_METADATAFIELD']._serialized_end=622
+  _globals['_WINDOWTYPE']._serialized_start=625
+  _globals['_WINDOWTYPE']._serialized_end=753
+  _globals['_WINDOWEMITSTATUS']._serialized_start=756
+  _globals['_WINDOWEMITSTATUS']._serialized_end=983
+  _globals['_WINDOWEMITSTATUS_STATUSENUM']._serialized_start=881
+  _globals['_WINDOWEMITSTATUS_STATUSENUM']._serialized_end=983
+  _globals['_WINDOWSEMIT']._serialized_start=985
+  _globals['_WINDOWSEMIT']._serialized_end=1024
+  _globals['_WINDOWSEMITSTATUS']._serialized_start=1026
+  _globals['_WINDOWSEMITSTATUS']._serialized_end=1082
+  _globals['_WINDOWOPEN']._serialized_start=1085
+  _globals['_WINDOWOPEN']._serialized_end=1368
+  _globals['_WINDOWEXTENSION']._serialized_start=1371
+  _globals['_WINDOWEXTENSION']._serialized_end=1565
+  _globals['_WINDOWCLOSURE']._serialized_start=1568
+  _globals['_WINDOWCLOSURE']._serialized_end=1760
+  _globals['_WINDOWAMENDMENT']._serialized_start=1762
+  _globals['_WINDOWAMENDMENT']._serialized_end=1853
+  _globals['_ALGORITHMDEPENDENCY']._serialized_start=1856
+  _globals['_ALGORITHMDEPENDENCY']._serialized_end=1991
+  _globals['_ALGORITHM']._serialized_start=1994
+  _globals['_ALGORITHM']._serialized_end=2245
+  _globals['_FLOATARRAY']._serialized_start=2247
+  _globals['_FLOATARRAY']._serialized_end=2275
+  _globals['_RESULT']._serialized_start=2278
+  _globals['_RESULT']._serialized_end=2500
+  _globals['_PROCESSORREGISTRATION']._serialized_start=2503
+  _globals['_PROCESSORREGISTRATION']._serialized_end=2704
+  _globals['_PROCESSORDEREGISTRATION']._serialized_start=2706
+  _globals['_PROCESSORDEREGISTRATION']._serialized_end=2793
+  _globals['_ALGORITHMRETIREMENT']._serialized_start=2795
+  _globals['_ALGORITHMRETIREMENT']._serialized_end=2878
+  _globals['_ALGORITHMDEPENDENCYREMOVAL']._serialized_start=2881
+  _globals['_ALGORITHMDEPENDENCYREMOVAL']._serialized_end=3060
+  _globals['_RETRYPOLICY']._serialized_start=3063
+  _globals['_RETRYPOLICY']._serialized_end=3287
+  _globals['_PROCESSINGTASK']._serialized_start=3290
+  _globals['_PROCESSINGTASK']._serialized_end=3466
+  _globals['_TASKSUBSCRIPTION']._serialized_start=3468
+  _globals['_TASKSUBSCRIPTION']._serialized_end=3533
+  _globals['_TASKRESULT']._serialized_start=3535
+  _globals['_TASKRESULT']._serialized_end=3605
+  _globals['_EXECUTIONREQUEST']._serialized_start=3608
+  _globals['_EXECUTIONREQUEST']._serialized_end=3761
+  _globals['_EXECUTIONRESULT']._serialized_start=3763
+  _globals['_EXECUTIONRESULT']._serialized_end=3857
+  _globals['_ALGORITHMRESULT']._serialized_start=3859
+  _globals['_ALGORITHMRESULT']._serialized_end=3948
+  _globals['_STATUS']._serialized_start=3950
+  _globals['_STATUS']._serialized_end=3993
+  _globals['_HEALTHCHECKREQUEST']._serialized_start=3995
+  _globals['_HEALTHCHECKREQUEST']._serialized_end=4042
+  _globals['_HEALTHCHECKRESPONSE']._serialized_start=4045
+  _globals['_HEALTHCHECKRESPONSE']._serialized_end=4272
+  _globals['_HEALTHCHECKRESPONSE_STATUS']._serialized_start=4174
+  _globals['_HEALTHCHECKRESPONSE_STATUS']._serialized_end=4272
+  _globals['_PROCESSORMETRICS']._serialized_start=4274
+  _globals['_PROCESSORMETRICS']._serialized_end=4381
+  _globals['_WINDOWTYPEREAD']._serialized_start=4383
+  _globals['_WINDOWTYPEREAD']._serialized_end=4399
+  _globals['_WINDOWTYPES']._serialized_start=4401
+  _globals['_WINDOWTYPES']._serialized_end=4444
+  _globals['_ALGORITHMSREAD']._serialized_start=4446
+  _globals['_ALGORITHMSREAD']._serialized_end=4462
+  _globals['_ALGORITHMS']._serialized_start=4464
+  _globals['_ALGORITHMS']._serialized_end=4507
+  _globals['_PROCESSORSREAD']._serialized_start=4509
+  _globals['_PROCESSORSREAD']._serialized_end=4525
+  _globals['_PROCESSORS']._serialized_start=4528
+  _globals['_PROCESSORS']._serialized_end=5204
+  _globals['_PROCESSORS_PROCESSOR']._serialized_start=4585
+  _globals['_PROCESSORS_PROCESSOR']._serialized_end=4883
+  _globals['_PROCESSORS_INSTANCE']._serialized_start=4886
+  _globals['_PROCESSORS_INSTANCE']._serialized_end=5204
+  _globals['_RESULTSSTATSREAD']._serialized_start=5206
+  _globals['_RESULTSSTATSREAD']._serialized_end=5224
+  _globals['_RESULTSSTATS']._serialized_start=5226
+  _globals['_RESULTSSTATS']._serialized_end=5255
+  _globals['_ALGORITHMFIELDSREAD']._serialized_start=5258
+  _globals['_ALGORITHMFIELDSREAD']._serialized_end=5436
+  _globals['_ALGORITHMFIELDS']._serialized_start=5438
+  _globals['_ALGORITHMFIELDS']._serialized_end=5470
+  _globals['_RESULTSFORALGORITHMREAD']._serialized_start=5473
+  _globals['_RESULTSFORALGORITHMREAD']._serialized_end=5683
+  _globals['_RESULTSFORALGORITHM']._serialized_start=5686
+  _globals['_RESULTSFORALGORITHM']._serialized_end=6026
+  _globals['_RESULTSFORALGORITHM_RESULTSROW']._serialized_start=5760
+  _globals['_RESULTSFORALGORITHM_RESULTSROW']._serialized_end=6026
+  _globals['_WINDOWSREAD']._serialized_start=6029
+  _globals['_WINDOWSREAD']._serialized_end=6225
+  _globals['_WINDOWS']._serialized_start=6227
+  _globals['_WINDOWS']._serialized_end=6261
+  _globals['_DISTINCTMETADATAFORWINDOWTYPEREAD']._serialized_start=6264
+  _globals['_DISTINCTMETADATAFORWINDOWTYPEREAD']._serialized_end=6451
+  _globals['_DISTINCTMETADATAFORWINDOWTYPE']._serialized_start=6453
+  _globals['_DISTINCTMETADATAFORWINDOWTYPE']._serialized_end=6530
+  _globals['_WINDOWSFORMETADATAREAD']._serialized_start=6533
+  _globals['_WINDOWSFORMETADATAREAD']._serialized_end=6838
+  _globals['_WINDOWSFORMETADATAREAD_METADATA']._serialized_start=6774
+  _globals['_WINDOWSFORMETADATAREAD_METADATA']._serialized_end=6838
+  _globals['_WINDOWSFORMETADATA']._serialized_start=6840
+  _globals['_WINDOWSFORMETADATA']._serialized_end=6885
+  _globals['_RESULTSSUBSCRIPTION']._serialized_start=6888
+  _globals['_RESULTSSUBSCRIPTION']._serialized_end=7121
+  _globals['_RESULTSSUBSCRIPTION_METADATA']._serialized_start=6774
+  _globals['_RESULTSSUBSCRIPTION_METADATA']._serialized_end=6838
+  _globals['_RESULTUPDATE']._serialized_start=7123
+  _globals['_RESULTUPDATE']._serialized_end=7222
+  _globals['_RESULTSFORALGORITHMANDMETADATAREAD']._serialized_start=7225
+  _globals['_RESULTSFORALGORITHMANDMETADATAREAD']._serialized_end=7556
+  _globals['_RESULTSFORALGORITHMANDMETADATAREAD_METADATA']._serialized_start=6774
+  _globals['_RESULTSFORALGORITHMANDMETADATAREAD_METADATA']._serialized_end=6838
+  _globals['_RESULTSFORALGORITHMANDMETADATA']._serialized_start=7559
+  _globals['_RESULTSFORALGORITHMANDMETADATA']._serialized_end=7901
+  _globals['_RESULTSFORALGORITHMANDMETADATA_RESULTSROW']._serialized_start=7655
+  _globals['_RESULTSFORALGORITHMANDMETADATA_RESULTSROW']._serialized_end=7901
+  _globals['_ANNOTATEWRITE']._serialized_start=7904
+  _globals['_ANNOTATEWRITE']._serialized_end=8305
+  _globals['_ANNOTATERESPONSE']._serialized_start=8307
+  _globals['_ANNOTATERESPONSE']._serialized_end=8325
+  _globals['_EXECUTIONREAD']._serialized_start=8327
+  _globals['_EXECUTIONREAD']._serialized_end=8371
+  _globals['_EXECUTIONQUEUEREAD']._serialized_start=8373
+  _globals['_EXECUTIONQUEUEREAD']._serialized_end=8393
+  _globals['_EXECUTIONQUEUE']._serialized_start=8395
+  _globals['_EXECUTIONQUEUE']._serialized_end=8500
+  _globals['_EXECUTIONCANCEL']._serialized_start=8502
+  _globals['_EXECUTIONCANCEL']._serialized_end=8548
+  _globals['_EXECUTIONSREAD']._serialized_start=8551
+  _globals['_EXECUTIONSREAD']._serialized_end=8748
+  _globals['_EXECUTIONATTEMPT']._serialized_start=8751
+  _globals['_EXECUTIONATTEMPT']._serialized_end=8974
+  _globals['_ALGORITHMEXECUTION']._serialized_start=8977
+  _globals['_ALGORITHMEXECUTION']._serialized_end=9264
+  _globals['_EXECUTION']._serialized_start=9267
+  _globals['_EXECUTION']._serialized_end=9552
+  _globals['_EXECUTIONS']._serialized_start=9554
+  _globals['_EXECUTIONS']._serialized_end=9598
+  _globals['_WINDOWSREPROCESS']._serialized_start=9601
+  _globals['_WINDOWSREPROCESS']._serialized_end=9893
+  _globals['_REPROCESSREAD']._serialized_start=9895
+  _globals['_REPROCESSREAD']._serialized_end=9944
+  _globals['_REPROCESS']._serialized_start=9947
+  _globals['_REPROCESS']._serialized_end=10229
+  _globals['_FAILEDEXECUTIONSREAD']._serialized_start=10232
+  _globals['_FAILEDEXECUTIONSREAD']._serialized_end=10480
+  _globals['_FAILEDEXECUTIONSREQUEUE']._serialized_start=10483
+  _globals['_FAILEDEXECUTIONSREQUEUE']._serialized_end=10708
+  _globals['_FAILEDEXECUTION']._serialized_start=10711
+  _globals['_FAILEDEXECUTION']._serialized_end=11005
+  _globals['_FAILEDEXECUTIONS']._serialized_start=11007
+  _globals['_FAILEDEXECUTIONS']._serialized_end=11070
+  _globals['_REQUEUEDEXECUTIONS']._serialized_start=11072
+  _globals['_REQUEUEDEXECUTIONS']._serialized_end=11110
+  _globals['_ALERTRULE']._serialized_start=11113
+  _globals['_ALERTRULE']._serialized_end=11256
+  _globals['_ALERTRULESREAD']._serialized_start=11258
+  _globals['_ALERTRULESREAD']._serialized_end=11274
+  _globals['_ALERTRULES']._serialized_start=11276
+  _globals['_ALERTRULES']._serialized_end=11321
+  _globals['_ALERTRULEDELETION']._serialized_start=11323
+  _globals['_ALERTRULEDELETION']._serialized_end=11364
+  _globals['_ALERTSREAD']._serialized_start=11367
+  _globals['_ALERTSREAD']._serialized_end=11582
+  _globals['_ALERTS']._serialized_start=11585
+  _globals['_ALERTS']._serialized_end=12067
+  _globals['_ALERTS_ALERT']._serialized_start=11627
+  _globals['_ALERTS_ALERT']._serialized_end=12067
+  _globals['_ALERTACKNOWLEDGEMENT']._serialized_start=12069
+  _globals['_ALERTACKNOWLEDGEMENT']._serialized_end=12143
+  _globals['_WINDOWSCHEDULE']._serialized_start=12146
+  _globals['_WINDOWSCHEDULE']._serialized_end=12507
+  _globals['_WINDOWSCHEDULESREAD']._serialized_start=12509
+  _globals['_WINDOWSCHEDULESREAD']._serialized_end=12530
+  _globals['_WINDOWSCHEDULES']._serialized_start=12532
+  _globals['_WINDOWSCHEDULES']._serialized_end=12592
+  _globals['_WINDOWSCHEDULEDELETION']._serialized_start=12594
+  _globals['_WINDOWSCHEDULEDELETION']._serialized_end=12640
+  _globals['_ROLLUPRULE']._serialized_start=12643
+  _globals['_ROLLUPRULE']._serialized_end=12924
+  _globals['_ROLLUPRULESREAD']._serialized_start=12926
+  _globals['_ROLLUPRULESREAD']._serialized_end=12943
+  _globals['_ROLLUPRULES']._serialized_start=12945
+  _globals['_ROLLUPRULES']._serialized_end=12993
+  _globals['_ROLLUPRULEDELETION']._serialized_start=12995
+  _globals['_ROLLUPRULEDELETION']._serialized_end=13037
+  _globals['_CHILDWINDOWSREAD']._serialized_start=13039
+  _globals['_CHILDWINDOWSREAD']._serialized_end=13090
+  _globals['_ORCACORE']._serialized_start=13866
+  _globals['_ORCACORE']._serialized_end=16341
+  _globals['_ORCAPROCESSOR']._serialized_start=16344
+  _globals['_ORCAPROCESSOR']._serialized_end=16474
 # @@protoc_insertion_point(module_scope)
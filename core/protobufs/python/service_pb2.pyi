@@ -22,6 +22,32 @@ class ResultStatus(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
     RESULT_STATUS_HANDLED_FAILED: _ClassVar[ResultStatus]
     RESULT_STATUS_UNHANDLED_FAILED: _ClassVar[ResultStatus]
     RESULT_STATUS_SUCEEDED: _ClassVar[ResultStatus]
+
+class ConnectionState(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    CONNECTION_STATE_UNSPECIFIED: _ClassVar[ConnectionState]
+    CONNECTION_STATE_DISCONNECTED: _ClassVar[ConnectionState]
+    CONNECTION_STATE_IDLE: _ClassVar[ConnectionState]
+    CONNECTION_STATE_CONNECTING: _ClassVar[ConnectionState]
+    CONNECTION_STATE_READY: _ClassVar[ConnectionState]
+    CONNECTION_STATE_TRANSIENT_FAILURE: _ClassVar[ConnectionState]
+    CONNECTION_STATE_SHUTDOWN: _ClassVar[ConnectionState]
+
+class ExecutionStatus(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    EXECUTION_STATUS_UNSPECIFIED: _ClassVar[ExecutionStatus]
+    EXECUTION_STATUS_PENDING: _ClassVar[ExecutionStatus]
+    EXECUTION_STATUS_RUNNING: _ClassVar[ExecutionStatus]
+    EXECUTION_STATUS_SUCCEEDED: _ClassVar[ExecutionStatus]
+    EXECUTION_STATUS_FAILED: _ClassVar[ExecutionStatus]
+    EXECUTION_STATUS_SKIPPED: _ClassVar[ExecutionStatus]
+    EXECUTION_STATUS_CANCELLED: _ClassVar[ExecutionStatus]
+
+class AlertStatus(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
+    __slots__ = ()
+    ALERT_STATUS_UNSPECIFIED: _ClassVar[AlertStatus]
+    ALERT_STATUS_FIRING: _ClassVar[AlertStatus]
+    ALERT_STATUS_RESOLVED: _ClassVar[AlertStatus]
 NOT_SPECIFIED: ResultType
 STRUCT: ResultType
 VALUE: ResultType
@@ -30,22 +56,43 @@ NONE: ResultType
 RESULT_STATUS_HANDLED_FAILED: ResultStatus
 RESULT_STATUS_UNHANDLED_FAILED: ResultStatus
 RESULT_STATUS_SUCEEDED: ResultStatus
+CONNECTION_STATE_UNSPECIFIED: ConnectionState
+CONNECTION_STATE_DISCONNECTED: ConnectionState
+CONNECTION_STATE_IDLE: ConnectionState
+CONNECTION_STATE_CONNECTING: ConnectionState
+CONNECTION_STATE_READY: ConnectionState
+CONNECTION_STATE_TRANSIENT_FAILURE: ConnectionState
+CONNECTION_STATE_SHUTDOWN: ConnectionState
+EXECUTION_STATUS_UNSPECIFIED: ExecutionStatus
+EXECUTION_STATUS_PENDING: ExecutionStatus
+EXECUTION_STATUS_RUNNING: ExecutionStatus
+EXECUTION_STATUS_SUCCEEDED: ExecutionStatus
+EXECUTION_STATUS_FAILED: ExecutionStatus
+EXECUTION_STATUS_SKIPPED: ExecutionStatus
+EXECUTION_STATUS_CANCELLED: ExecutionStatus
+ALERT_STATUS_UNSPECIFIED: AlertStatus
+ALERT_STATUS_FIRING: AlertStatus
+ALERT_STATUS_RESOLVED: AlertStatus
 
 class Window(_message.Message):
-    __slots__ = ("time_from", "time_to", "window_type_name", "window_type_version", "origin", "metadata")
+    __slots__ = ("time_from", "time_to", "window_type_name", "window_type_version", "origin", "metadata", "target_algorithms", "idempotency_key")
     TIME_FROM_FIELD_NUMBER: _ClassVar[int]
     TIME_TO_FIELD_NUMBER: _ClassVar[int]
     WINDOW_TYPE_NAME_FIELD_NUMBER: _ClassVar[int]
     WINDOW_TYPE_VERSION_FIELD_NUMBER: _ClassVar[int]
     ORIGIN_FIELD_NUMBER: _ClassVar[int]
     METADATA_FIELD_NUMBER: _ClassVar[int]
+    TARGET_ALGORITHMS_FIELD_NUMBER: _ClassVar[int]
+    IDEMPOTENCY_KEY_FIELD_NUMBER: _ClassVar[int]
     time_from: _timestamp_pb2.Timestamp
     time_to: _timestamp_pb2.Timestamp
     window_type_name: str
     window_type_version: str
     origin: str
     metadata: _struct_pb2.Struct
-    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window_type_name: _Optional[str] = ..., window_type_version: _Optional[str] = ..., origin: _Optional[str] = ..., metadata: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ...) -> None: ...
+    target_algorithms: _containers.RepeatedCompositeFieldContainer[Algorithm]
+    idempotency_key: str
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window_type_name: _Optional[str] = ..., window_type_version: _Optional[str] = ..., origin: _Optional[str] = ..., metadata: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., target_algorithms: _Optional[_Iterable[_Union[Algorithm, _Mapping]]] = ..., idempotency_key: _Optional[str] = ...) -> None: ...
 
 class MetadataField(_message.Message):
     __slots__ = ("name", "description")
@@ -68,18 +115,88 @@ class WindowType(_message.Message):
     def __init__(self, name: _Optional[str] = ..., version: _Optional[str] = ..., description: _Optional[str] = ..., metadataFields: _Optional[_Iterable[_Union[MetadataField, _Mapping]]] = ...) -> None: ...
 
 class WindowEmitStatus(_message.Message):
-    __slots__ = ("status",)
+    __slots__ = ("status", "exec_id", "duplicate", "error")
     class StatusEnum(int, metaclass=_enum_type_wrapper.EnumTypeWrapper):
         __slots__ = ()
         TRIGGERING_FAILED: _ClassVar[WindowEmitStatus.StatusEnum]
         NO_TRIGGERED_ALGORITHMS: _ClassVar[WindowEmitStatus.StatusEnum]
         PROCESSING_TRIGGERED: _ClassVar[WindowEmitStatus.StatusEnum]
+        QUEUED: _ClassVar[WindowEmitStatus.StatusEnum]
     TRIGGERING_FAILED: WindowEmitStatus.StatusEnum
     NO_TRIGGERED_ALGORITHMS: WindowEmitStatus.StatusEnum
     PROCESSING_TRIGGERED: WindowEmitStatus.StatusEnum
+    QUEUED: WindowEmitStatus.StatusEnum
     STATUS_FIELD_NUMBER: _ClassVar[int]
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    DUPLICATE_FIELD_NUMBER: _ClassVar[int]
+    ERROR_FIELD_NUMBER: _ClassVar[int]
     status: WindowEmitStatus.StatusEnum
-    def __init__(self, status: _Optional[_Union[WindowEmitStatus.StatusEnum, str]] = ...) -> None: ...
+    exec_id: str
+    duplicate: bool
+    error: str
+    def __init__(self, status: _Optional[_Union[WindowEmitStatus.StatusEnum, str]] = ..., exec_id: _Optional[str] = ..., duplicate: bool = ..., error: _Optional[str] = ...) -> None: ...
+
+class WindowsEmit(_message.Message):
+    __slots__ = ("windows",)
+    WINDOWS_FIELD_NUMBER: _ClassVar[int]
+    windows: _containers.RepeatedCompositeFieldContainer[Window]
+    def __init__(self, windows: _Optional[_Iterable[_Union[Window, _Mapping]]] = ...) -> None: ...
+
+class WindowsEmitStatus(_message.Message):
+    __slots__ = ("statuses",)
+    STATUSES_FIELD_NUMBER: _ClassVar[int]
+    statuses: _containers.RepeatedCompositeFieldContainer[WindowEmitStatus]
+    def __init__(self, statuses: _Optional[_Iterable[_Union[WindowEmitStatus, _Mapping]]] = ...) -> None: ...
+
+class WindowOpen(_message.Message):
+    __slots__ = ("time_from", "window_type_name", "window_type_version", "origin", "metadata", "idempotency_key", "provisional")
+    TIME_FROM_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_TYPE_NAME_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_TYPE_VERSION_FIELD_NUMBER: _ClassVar[int]
+    ORIGIN_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    IDEMPOTENCY_KEY_FIELD_NUMBER: _ClassVar[int]
+    PROVISIONAL_FIELD_NUMBER: _ClassVar[int]
+    time_from: _timestamp_pb2.Timestamp
+    window_type_name: str
+    window_type_version: str
+    origin: str
+    metadata: _struct_pb2.Struct
+    idempotency_key: str
+    provisional: bool
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window_type_name: _Optional[str] = ..., window_type_version: _Optional[str] = ..., origin: _Optional[str] = ..., metadata: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., idempotency_key: _Optional[str] = ..., provisional: bool = ...) -> None: ...
+
+class WindowExtension(_message.Message):
+    __slots__ = ("window_type_name", "window_type_version", "idempotency_key", "time_to")
+    WINDOW_TYPE_NAME_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_TYPE_VERSION_FIELD_NUMBER: _ClassVar[int]
+    IDEMPOTENCY_KEY_FIELD_NUMBER: _ClassVar[int]
+    TIME_TO_FIELD_NUMBER: _ClassVar[int]
+    window_type_name: str
+    window_type_version: str
+    idempotency_key: str
+    time_to: _timestamp_pb2.Timestamp
+    def __init__(self, window_type_name: _Optional[str] = ..., window_type_version: _Optional[str] = ..., idempotency_key: _Optional[str] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class WindowClosure(_message.Message):
+    __slots__ = ("window_type_name", "window_type_version", "idempotency_key", "time_to")
+    WINDOW_TYPE_NAME_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_TYPE_VERSION_FIELD_NUMBER: _ClassVar[int]
+    IDEMPOTENCY_KEY_FIELD_NUMBER: _ClassVar[int]
+    TIME_TO_FIELD_NUMBER: _ClassVar[int]
+    window_type_name: str
+    window_type_version: str
+    idempotency_key: str
+    time_to: _timestamp_pb2.Timestamp
+    def __init__(self, window_type_name: _Optional[str] = ..., window_type_version: _Optional[str] = ..., idempotency_key: _Optional[str] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class WindowAmendment(_message.Message):
+    __slots__ = ("window", "amended_window")
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    AMENDED_WINDOW_FIELD_NUMBER: _ClassVar[int]
+    window: Window
+    amended_window: Window
+    def __init__(self, window: _Optional[_Union[Window, _Mapping]] = ..., amended_window: _Optional[_Union[Window, _Mapping]] = ...) -> None: ...
 
 class AlgorithmDependency(_message.Message):
     __slots__ = ("name", "version", "processor_name", "processor_runtime")
@@ -94,18 +211,22 @@ class AlgorithmDependency(_message.Message):
     def __init__(self, name: _Optional[str] = ..., version: _Optional[str] = ..., processor_name: _Optional[str] = ..., processor_runtime: _Optional[str] = ...) -> None: ...
 
 class Algorithm(_message.Message):
-    __slots__ = ("name", "version", "window_type", "dependencies", "result_type")
+    __slots__ = ("name", "version", "window_type", "dependencies", "result_type", "retry_policy", "timeout_ms")
     NAME_FIELD_NUMBER: _ClassVar[int]
     VERSION_FIELD_NUMBER: _ClassVar[int]
     WINDOW_TYPE_FIELD_NUMBER: _ClassVar[int]
     DEPENDENCIES_FIELD_NUMBER: _ClassVar[int]
     RESULT_TYPE_FIELD_NUMBER: _ClassVar[int]
+    RETRY_POLICY_FIELD_NUMBER: _ClassVar[int]
+    TIMEOUT_MS_FIELD_NUMBER: _ClassVar[int]
     name: str
     version: str
     window_type: WindowType
     dependencies: _containers.RepeatedCompositeFieldContainer[AlgorithmDependency]
     result_type: ResultType
-    def __init__(self, name: _Optional[str] = ..., version: _Optional[str] = ..., window_type: _Optional[_Union[WindowType, _Mapping]] = ..., dependencies: _Optional[_Iterable[_Union[AlgorithmDependency, _Mapping]]] = ..., result_type: _Optional[_Union[ResultType, str]] = ...) -> None: ...
+    retry_policy: RetryPolicy
+    timeout_ms: int
+    def __init__(self, name: _Optional[str] = ..., version: _Optional[str] = ..., window_type: _Optional[_Union[WindowType, _Mapping]] = ..., dependencies: _Optional[_Iterable[_Union[AlgorithmDependency, _Mapping]]] = ..., result_type: _Optional[_Union[ResultType, str]] = ..., retry_policy: _Optional[_Union[RetryPolicy, _Mapping]] = ..., timeout_ms: _Optional[int] = ...) -> None: ...
 
 class FloatArray(_message.Message):
     __slots__ = ("values",)
@@ -114,42 +235,116 @@ class FloatArray(_message.Message):
     def __init__(self, values: _Optional[_Iterable[float]] = ...) -> None: ...
 
 class Result(_message.Message):
-    __slots__ = ("status", "single_value", "float_values", "struct_value", "timestamp")
+    __slots__ = ("status", "single_value", "float_values", "struct_value", "timestamp", "error_message")
     STATUS_FIELD_NUMBER: _ClassVar[int]
     SINGLE_VALUE_FIELD_NUMBER: _ClassVar[int]
     FLOAT_VALUES_FIELD_NUMBER: _ClassVar[int]
     STRUCT_VALUE_FIELD_NUMBER: _ClassVar[int]
     TIMESTAMP_FIELD_NUMBER: _ClassVar[int]
+    ERROR_MESSAGE_FIELD_NUMBER: _ClassVar[int]
     status: ResultStatus
     single_value: float
     float_values: FloatArray
     struct_value: _struct_pb2.Struct
     timestamp: int
-    def __init__(self, status: _Optional[_Union[ResultStatus, str]] = ..., single_value: _Optional[float] = ..., float_values: _Optional[_Union[FloatArray, _Mapping]] = ..., struct_value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., timestamp: _Optional[int] = ...) -> None: ...
+    error_message: str
+    def __init__(self, status: _Optional[_Union[ResultStatus, str]] = ..., single_value: _Optional[float] = ..., float_values: _Optional[_Union[FloatArray, _Mapping]] = ..., struct_value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., timestamp: _Optional[int] = ..., error_message: _Optional[str] = ...) -> None: ...
 
 class ProcessorRegistration(_message.Message):
-    __slots__ = ("name", "runtime", "connection_str", "supported_algorithms")
+    __slots__ = ("name", "runtime", "connection_str", "supported_algorithms", "retry_policy", "instance_id")
     NAME_FIELD_NUMBER: _ClassVar[int]
     RUNTIME_FIELD_NUMBER: _ClassVar[int]
     CONNECTION_STR_FIELD_NUMBER: _ClassVar[int]
     SUPPORTED_ALGORITHMS_FIELD_NUMBER: _ClassVar[int]
+    RETRY_POLICY_FIELD_NUMBER: _ClassVar[int]
+    INSTANCE_ID_FIELD_NUMBER: _ClassVar[int]
     name: str
     runtime: str
     connection_str: str
     supported_algorithms: _containers.RepeatedCompositeFieldContainer[Algorithm]
-    def __init__(self, name: _Optional[str] = ..., runtime: _Optional[str] = ..., connection_str: _Optional[str] = ..., supported_algorithms: _Optional[_Iterable[_Union[Algorithm, _Mapping]]] = ...) -> None: ...
+    retry_policy: RetryPolicy
+    instance_id: str
+    def __init__(self, name: _Optional[str] = ..., runtime: _Optional[str] = ..., connection_str: _Optional[str] = ..., supported_algorithms: _Optional[_Iterable[_Union[Algorithm, _Mapping]]] = ..., retry_policy: _Optional[_Union[RetryPolicy, _Mapping]] = ..., instance_id: _Optional[str] = ...) -> None: ...
+
+class ProcessorDeregistration(_message.Message):
+    __slots__ = ("name", "runtime", "force")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    FORCE_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    runtime: str
+    force: bool
+    def __init__(self, name: _Optional[str] = ..., runtime: _Optional[str] = ..., force: bool = ...) -> None: ...
+
+class AlgorithmRetirement(_message.Message):
+    __slots__ = ("name", "version", "force")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    VERSION_FIELD_NUMBER: _ClassVar[int]
+    FORCE_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    version: str
+    force: bool
+    def __init__(self, name: _Optional[str] = ..., version: _Optional[str] = ..., force: bool = ...) -> None: ...
+
+class AlgorithmDependencyRemoval(_message.Message):
+    __slots__ = ("algorithm_name", "algorithm_version", "dependency_name", "dependency_version", "force")
+    ALGORITHM_NAME_FIELD_NUMBER: _ClassVar[int]
+    ALGORITHM_VERSION_FIELD_NUMBER: _ClassVar[int]
+    DEPENDENCY_NAME_FIELD_NUMBER: _ClassVar[int]
+    DEPENDENCY_VERSION_FIELD_NUMBER: _ClassVar[int]
+    FORCE_FIELD_NUMBER: _ClassVar[int]
+    algorithm_name: str
+    algorithm_version: str
+    dependency_name: str
+    dependency_version: str
+    force: bool
+    def __init__(self, algorithm_name: _Optional[str] = ..., algorithm_version: _Optional[str] = ..., dependency_name: _Optional[str] = ..., dependency_version: _Optional[str] = ..., force: bool = ...) -> None: ...
+
+class RetryPolicy(_message.Message):
+    __slots__ = ("max_attempts", "initial_backoff_ms", "max_backoff_ms", "backoff_multiplier", "jitter", "retryable_codes")
+    MAX_ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    INITIAL_BACKOFF_MS_FIELD_NUMBER: _ClassVar[int]
+    MAX_BACKOFF_MS_FIELD_NUMBER: _ClassVar[int]
+    BACKOFF_MULTIPLIER_FIELD_NUMBER: _ClassVar[int]
+    JITTER_FIELD_NUMBER: _ClassVar[int]
+    RETRYABLE_CODES_FIELD_NUMBER: _ClassVar[int]
+    max_attempts: int
+    initial_backoff_ms: int
+    max_backoff_ms: int
+    backoff_multiplier: float
+    jitter: float
+    retryable_codes: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, max_attempts: _Optional[int] = ..., initial_backoff_ms: _Optional[int] = ..., max_backoff_ms: _Optional[int] = ..., backoff_multiplier: _Optional[float] = ..., jitter: _Optional[float] = ..., retryable_codes: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class ProcessingTask(_message.Message):
-    __slots__ = ("task_id", "algorithm", "window", "dependency_results")
+    __slots__ = ("task_id", "algorithm", "window", "dependency_results", "exec_id")
     TASK_ID_FIELD_NUMBER: _ClassVar[int]
     ALGORITHM_FIELD_NUMBER: _ClassVar[int]
     WINDOW_FIELD_NUMBER: _ClassVar[int]
     DEPENDENCY_RESULTS_FIELD_NUMBER: _ClassVar[int]
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
     task_id: str
     algorithm: Algorithm
     window: Window
-    dependency_results: _containers.RepeatedCompositeFieldContainer[Result]
-    def __init__(self, task_id: _Optional[str] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ..., window: _Optional[_Union[Window, _Mapping]] = ..., dependency_results: _Optional[_Iterable[_Union[Result, _Mapping]]] = ...) -> None: ...
+    dependency_results: _containers.RepeatedCompositeFieldContainer[AlgorithmResult]
+    exec_id: str
+    def __init__(self, task_id: _Optional[str] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ..., window: _Optional[_Union[Window, _Mapping]] = ..., dependency_results: _Optional[_Iterable[_Union[AlgorithmResult, _Mapping]]] = ..., exec_id: _Optional[str] = ...) -> None: ...
+
+class TaskSubscription(_message.Message):
+    __slots__ = ("name", "runtime")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    runtime: str
+    def __init__(self, name: _Optional[str] = ..., runtime: _Optional[str] = ...) -> None: ...
+
+class TaskResult(_message.Message):
+    __slots__ = ("task_id", "result")
+    TASK_ID_FIELD_NUMBER: _ClassVar[int]
+    RESULT_FIELD_NUMBER: _ClassVar[int]
+    task_id: str
+    result: Result
+    def __init__(self, task_id: _Optional[str] = ..., result: _Optional[_Union[Result, _Mapping]] = ...) -> None: ...
 
 class ExecutionRequest(_message.Message):
     __slots__ = ("exec_id", "window", "algorithm_results", "algorithms")
@@ -252,12 +447,47 @@ class ProcessorsRead(_message.Message):
 class Processors(_message.Message):
     __slots__ = ("processor",)
     class Processor(_message.Message):
-        __slots__ = ("name", "runtime")
+        __slots__ = ("name", "runtime", "connection_state", "instances", "last_seen", "status", "status_message", "metrics", "available")
         NAME_FIELD_NUMBER: _ClassVar[int]
         RUNTIME_FIELD_NUMBER: _ClassVar[int]
+        CONNECTION_STATE_FIELD_NUMBER: _ClassVar[int]
+        INSTANCES_FIELD_NUMBER: _ClassVar[int]
+        LAST_SEEN_FIELD_NUMBER: _ClassVar[int]
+        STATUS_FIELD_NUMBER: _ClassVar[int]
+        STATUS_MESSAGE_FIELD_NUMBER: _ClassVar[int]
+        METRICS_FIELD_NUMBER: _ClassVar[int]
+        AVAILABLE_FIELD_NUMBER: _ClassVar[int]
         name: str
         runtime: str
-        def __init__(self, name: _Optional[str] = ..., runtime: _Optional[str] = ...) -> None: ...
+        connection_state: ConnectionState
+        instances: _containers.RepeatedCompositeFieldContainer[Processors.Instance]
+        last_seen: _timestamp_pb2.Timestamp
+        status: HealthCheckResponse.Status
+        status_message: str
+        metrics: ProcessorMetrics
+        available: bool
+        def __init__(self, name: _Optional[str] = ..., runtime: _Optional[str] = ..., connection_state: _Optional[_Union[ConnectionState, str]] = ..., instances: _Optional[_Iterable[_Union[Processors.Instance, _Mapping]]] = ..., last_seen: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., status: _Optional[_Union[HealthCheckResponse.Status, str]] = ..., status_message: _Optional[str] = ..., metrics: _Optional[_Union[ProcessorMetrics, _Mapping]] = ..., available: bool = ...) -> None: ...
+    class Instance(_message.Message):
+        __slots__ = ("connection_str", "instance_id", "connection_state", "registered", "last_seen", "status", "status_message", "metrics", "available")
+        CONNECTION_STR_FIELD_NUMBER: _ClassVar[int]
+        INSTANCE_ID_FIELD_NUMBER: _ClassVar[int]
+        CONNECTION_STATE_FIELD_NUMBER: _ClassVar[int]
+        REGISTERED_FIELD_NUMBER: _ClassVar[int]
+        LAST_SEEN_FIELD_NUMBER: _ClassVar[int]
+        STATUS_FIELD_NUMBER: _ClassVar[int]
+        STATUS_MESSAGE_FIELD_NUMBER: _ClassVar[int]
+        METRICS_FIELD_NUMBER: _ClassVar[int]
+        AVAILABLE_FIELD_NUMBER: _ClassVar[int]
+        connection_str: str
+        instance_id: str
+        connection_state: ConnectionState
+        registered: _timestamp_pb2.Timestamp
+        last_seen: _timestamp_pb2.Timestamp
+        status: HealthCheckResponse.Status
+        status_message: str
+        metrics: ProcessorMetrics
+        available: bool
+        def __init__(self, connection_str: _Optional[str] = ..., instance_id: _Optional[str] = ..., connection_state: _Optional[_Union[ConnectionState, str]] = ..., registered: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., last_seen: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., status: _Optional[_Union[HealthCheckResponse.Status, str]] = ..., status_message: _Optional[str] = ..., metrics: _Optional[_Union[ProcessorMetrics, _Mapping]] = ..., available: bool = ...) -> None: ...
     PROCESSOR_FIELD_NUMBER: _ClassVar[int]
     processor: _containers.RepeatedCompositeFieldContainer[Processors.Processor]
     def __init__(self, processor: _Optional[_Iterable[_Union[Processors.Processor, _Mapping]]] = ...) -> None: ...
@@ -289,41 +519,51 @@ class AlgorithmFields(_message.Message):
     def __init__(self, field: _Optional[_Iterable[str]] = ...) -> None: ...
 
 class ResultsForAlgorithmRead(_message.Message):
-    __slots__ = ("time_from", "time_to", "algorithm")
+    __slots__ = ("time_from", "time_to", "algorithm", "include_superseded")
     TIME_FROM_FIELD_NUMBER: _ClassVar[int]
     TIME_TO_FIELD_NUMBER: _ClassVar[int]
     ALGORITHM_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_SUPERSEDED_FIELD_NUMBER: _ClassVar[int]
     time_from: _timestamp_pb2.Timestamp
     time_to: _timestamp_pb2.Timestamp
     algorithm: Algorithm
-    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ...) -> None: ...
+    include_superseded: bool
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ..., include_superseded: bool = ...) -> None: ...
 
 class ResultsForAlgorithm(_message.Message):
     __slots__ = ("results",)
     class ResultsRow(_message.Message):
-        __slots__ = ("time", "single_value", "array_values", "struct_value")
+        __slots__ = ("time", "single_value", "array_values", "struct_value", "status", "error_message", "superseded")
         TIME_FIELD_NUMBER: _ClassVar[int]
         SINGLE_VALUE_FIELD_NUMBER: _ClassVar[int]
         ARRAY_VALUES_FIELD_NUMBER: _ClassVar[int]
         STRUCT_VALUE_FIELD_NUMBER: _ClassVar[int]
+        STATUS_FIELD_NUMBER: _ClassVar[int]
+        ERROR_MESSAGE_FIELD_NUMBER: _ClassVar[int]
+        SUPERSEDED_FIELD_NUMBER: _ClassVar[int]
         time: _timestamp_pb2.Timestamp
         single_value: float
         array_values: FloatArray
         struct_value: _struct_pb2.Struct
-        def __init__(self, time: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., single_value: _Optional[float] = ..., array_values: _Optional[_Union[FloatArray, _Mapping]] = ..., struct_value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ...) -> None: ...
+        status: ResultStatus
+        error_message: str
+        superseded: bool
+        def __init__(self, time: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., single_value: _Optional[float] = ..., array_values: _Optional[_Union[FloatArray, _Mapping]] = ..., struct_value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., status: _Optional[_Union[ResultStatus, str]] = ..., error_message: _Optional[str] = ..., superseded: bool = ...) -> None: ...
     RESULTS_FIELD_NUMBER: _ClassVar[int]
     results: _containers.RepeatedCompositeFieldContainer[ResultsForAlgorithm.ResultsRow]
     def __init__(self, results: _Optional[_Iterable[_Union[ResultsForAlgorithm.ResultsRow, _Mapping]]] = ...) -> None: ...
 
 class WindowsRead(_message.Message):
-    __slots__ = ("time_from", "time_to", "window")
+    __slots__ = ("time_from", "time_to", "window", "include_superseded")
     TIME_FROM_FIELD_NUMBER: _ClassVar[int]
     TIME_TO_FIELD_NUMBER: _ClassVar[int]
     WINDOW_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_SUPERSEDED_FIELD_NUMBER: _ClassVar[int]
     time_from: _timestamp_pb2.Timestamp
     time_to: _timestamp_pb2.Timestamp
     window: WindowType
-    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window: _Optional[_Union[WindowType, _Mapping]] = ...) -> None: ...
+    include_superseded: bool
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window: _Optional[_Union[WindowType, _Mapping]] = ..., include_superseded: bool = ...) -> None: ...
 
 class Windows(_message.Message):
     __slots__ = ("window",)
@@ -372,6 +612,35 @@ class WindowsForMetadata(_message.Message):
     window: _containers.RepeatedCompositeFieldContainer[Window]
     def __init__(self, window: _Optional[_Iterable[_Union[Window, _Mapping]]] = ...) -> None: ...
 
+class ResultsSubscription(_message.Message):
+    __slots__ = ("algorithms", "window_type", "metadata", "resume_after")
+    class Metadata(_message.Message):
+        __slots__ = ("field", "value")
+        FIELD_FIELD_NUMBER: _ClassVar[int]
+        VALUE_FIELD_NUMBER: _ClassVar[int]
+        field: str
+        value: _struct_pb2.Value
+        def __init__(self, field: _Optional[str] = ..., value: _Optional[_Union[_struct_pb2.Value, _Mapping]] = ...) -> None: ...
+    ALGORITHMS_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_TYPE_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    RESUME_AFTER_FIELD_NUMBER: _ClassVar[int]
+    algorithms: _containers.RepeatedCompositeFieldContainer[Algorithm]
+    window_type: WindowType
+    metadata: _containers.RepeatedCompositeFieldContainer[ResultsSubscription.Metadata]
+    resume_after: int
+    def __init__(self, algorithms: _Optional[_Iterable[_Union[Algorithm, _Mapping]]] = ..., window_type: _Optional[_Union[WindowType, _Mapping]] = ..., metadata: _Optional[_Iterable[_Union[ResultsSubscription.Metadata, _Mapping]]] = ..., resume_after: _Optional[int] = ...) -> None: ...
+
+class ResultUpdate(_message.Message):
+    __slots__ = ("cursor", "window", "algorithm_result")
+    CURSOR_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    ALGORITHM_RESULT_FIELD_NUMBER: _ClassVar[int]
+    cursor: int
+    window: Window
+    algorithm_result: AlgorithmResult
+    def __init__(self, cursor: _Optional[int] = ..., window: _Optional[_Union[Window, _Mapping]] = ..., algorithm_result: _Optional[_Union[AlgorithmResult, _Mapping]] = ...) -> None: ...
+
 class ResultsForAlgorithmAndMetadataRead(_message.Message):
     __slots__ = ("time_from", "time_to", "algorithm", "metadata")
     class Metadata(_message.Message):
@@ -394,16 +663,20 @@ class ResultsForAlgorithmAndMetadataRead(_message.Message):
 class ResultsForAlgorithmAndMetadata(_message.Message):
     __slots__ = ("results",)
     class ResultsRow(_message.Message):
-        __slots__ = ("time", "single_value", "array_values", "struct_value")
+        __slots__ = ("time", "single_value", "array_values", "struct_value", "status", "error_message")
         TIME_FIELD_NUMBER: _ClassVar[int]
         SINGLE_VALUE_FIELD_NUMBER: _ClassVar[int]
         ARRAY_VALUES_FIELD_NUMBER: _ClassVar[int]
         STRUCT_VALUE_FIELD_NUMBER: _ClassVar[int]
+        STATUS_FIELD_NUMBER: _ClassVar[int]
+        ERROR_MESSAGE_FIELD_NUMBER: _ClassVar[int]
         time: _timestamp_pb2.Timestamp
         single_value: float
         array_values: FloatArray
         struct_value: _struct_pb2.Struct
-        def __init__(self, time: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., single_value: _Optional[float] = ..., array_values: _Optional[_Union[FloatArray, _Mapping]] = ..., struct_value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ...) -> None: ...
+        status: ResultStatus
+        error_message: str
+        def __init__(self, time: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., single_value: _Optional[float] = ..., array_values: _Optional[_Union[FloatArray, _Mapping]] = ..., struct_value: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., status: _Optional[_Union[ResultStatus, str]] = ..., error_message: _Optional[str] = ...) -> None: ...
     RESULTS_FIELD_NUMBER: _ClassVar[int]
     results: _containers.RepeatedCompositeFieldContainer[ResultsForAlgorithmAndMetadata.ResultsRow]
     def __init__(self, results: _Optional[_Iterable[_Union[ResultsForAlgorithmAndMetadata.ResultsRow, _Mapping]]] = ...) -> None: ...
@@ -427,3 +700,388 @@ class AnnotateWrite(_message.Message):
 class AnnotateResponse(_message.Message):
     __slots__ = ()
     def __init__(self) -> None: ...
+
+class ExecutionRead(_message.Message):
+    __slots__ = ("exec_id",)
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    exec_id: str
+    def __init__(self, exec_id: _Optional[str] = ...) -> None: ...
+
+class ExecutionQueueRead(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class ExecutionQueue(_message.Message):
+    __slots__ = ("workers", "active", "queued", "queue_size", "saturation")
+    WORKERS_FIELD_NUMBER: _ClassVar[int]
+    ACTIVE_FIELD_NUMBER: _ClassVar[int]
+    QUEUED_FIELD_NUMBER: _ClassVar[int]
+    QUEUE_SIZE_FIELD_NUMBER: _ClassVar[int]
+    SATURATION_FIELD_NUMBER: _ClassVar[int]
+    workers: int
+    active: int
+    queued: int
+    queue_size: int
+    saturation: float
+    def __init__(self, workers: _Optional[int] = ..., active: _Optional[int] = ..., queued: _Optional[int] = ..., queue_size: _Optional[int] = ..., saturation: _Optional[float] = ...) -> None: ...
+
+class ExecutionCancel(_message.Message):
+    __slots__ = ("exec_id",)
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    exec_id: str
+    def __init__(self, exec_id: _Optional[str] = ...) -> None: ...
+
+class ExecutionsRead(_message.Message):
+    __slots__ = ("time_from", "time_to", "window", "status")
+    TIME_FROM_FIELD_NUMBER: _ClassVar[int]
+    TIME_TO_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    time_from: _timestamp_pb2.Timestamp
+    time_to: _timestamp_pb2.Timestamp
+    window: WindowType
+    status: ExecutionStatus
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window: _Optional[_Union[WindowType, _Mapping]] = ..., status: _Optional[_Union[ExecutionStatus, str]] = ...) -> None: ...
+
+class ExecutionAttempt(_message.Message):
+    __slots__ = ("attempt", "status", "error_code", "error_message", "backoff_ms", "started", "finished")
+    ATTEMPT_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    ERROR_CODE_FIELD_NUMBER: _ClassVar[int]
+    ERROR_MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    BACKOFF_MS_FIELD_NUMBER: _ClassVar[int]
+    STARTED_FIELD_NUMBER: _ClassVar[int]
+    FINISHED_FIELD_NUMBER: _ClassVar[int]
+    attempt: int
+    status: ExecutionStatus
+    error_code: str
+    error_message: str
+    backoff_ms: int
+    started: _timestamp_pb2.Timestamp
+    finished: _timestamp_pb2.Timestamp
+    def __init__(self, attempt: _Optional[int] = ..., status: _Optional[_Union[ExecutionStatus, str]] = ..., error_code: _Optional[str] = ..., error_message: _Optional[str] = ..., backoff_ms: _Optional[int] = ..., started: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., finished: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class AlgorithmExecution(_message.Message):
+    __slots__ = ("algorithm", "processor_name", "processor_runtime", "status", "started", "finished", "error_message", "attempts")
+    ALGORITHM_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_NAME_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    STARTED_FIELD_NUMBER: _ClassVar[int]
+    FINISHED_FIELD_NUMBER: _ClassVar[int]
+    ERROR_MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    algorithm: Algorithm
+    processor_name: str
+    processor_runtime: str
+    status: ExecutionStatus
+    started: _timestamp_pb2.Timestamp
+    finished: _timestamp_pb2.Timestamp
+    error_message: str
+    attempts: _containers.RepeatedCompositeFieldContainer[ExecutionAttempt]
+    def __init__(self, algorithm: _Optional[_Union[Algorithm, _Mapping]] = ..., processor_name: _Optional[str] = ..., processor_runtime: _Optional[str] = ..., status: _Optional[_Union[ExecutionStatus, str]] = ..., started: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., finished: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., error_message: _Optional[str] = ..., attempts: _Optional[_Iterable[_Union[ExecutionAttempt, _Mapping]]] = ...) -> None: ...
+
+class Execution(_message.Message):
+    __slots__ = ("exec_id", "window", "status", "created", "started", "finished", "algorithms", "provisional")
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    CREATED_FIELD_NUMBER: _ClassVar[int]
+    STARTED_FIELD_NUMBER: _ClassVar[int]
+    FINISHED_FIELD_NUMBER: _ClassVar[int]
+    ALGORITHMS_FIELD_NUMBER: _ClassVar[int]
+    PROVISIONAL_FIELD_NUMBER: _ClassVar[int]
+    exec_id: str
+    window: Window
+    status: ExecutionStatus
+    created: _timestamp_pb2.Timestamp
+    started: _timestamp_pb2.Timestamp
+    finished: _timestamp_pb2.Timestamp
+    algorithms: _containers.RepeatedCompositeFieldContainer[AlgorithmExecution]
+    provisional: bool
+    def __init__(self, exec_id: _Optional[str] = ..., window: _Optional[_Union[Window, _Mapping]] = ..., status: _Optional[_Union[ExecutionStatus, str]] = ..., created: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., started: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., finished: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., algorithms: _Optional[_Iterable[_Union[AlgorithmExecution, _Mapping]]] = ..., provisional: bool = ...) -> None: ...
+
+class Executions(_message.Message):
+    __slots__ = ("executions",)
+    EXECUTIONS_FIELD_NUMBER: _ClassVar[int]
+    executions: _containers.RepeatedCompositeFieldContainer[Execution]
+    def __init__(self, executions: _Optional[_Iterable[_Union[Execution, _Mapping]]] = ...) -> None: ...
+
+class WindowsReprocess(_message.Message):
+    __slots__ = ("time_from", "time_to", "window", "metadata", "algorithms", "windows_per_second")
+    TIME_FROM_FIELD_NUMBER: _ClassVar[int]
+    TIME_TO_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    ALGORITHMS_FIELD_NUMBER: _ClassVar[int]
+    WINDOWS_PER_SECOND_FIELD_NUMBER: _ClassVar[int]
+    time_from: _timestamp_pb2.Timestamp
+    time_to: _timestamp_pb2.Timestamp
+    window: WindowType
+    metadata: _struct_pb2.Struct
+    algorithms: _containers.RepeatedCompositeFieldContainer[Algorithm]
+    windows_per_second: float
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window: _Optional[_Union[WindowType, _Mapping]] = ..., metadata: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., algorithms: _Optional[_Iterable[_Union[Algorithm, _Mapping]]] = ..., windows_per_second: _Optional[float] = ...) -> None: ...
+
+class ReprocessRead(_message.Message):
+    __slots__ = ("reprocess_id",)
+    REPROCESS_ID_FIELD_NUMBER: _ClassVar[int]
+    reprocess_id: str
+    def __init__(self, reprocess_id: _Optional[str] = ...) -> None: ...
+
+class Reprocess(_message.Message):
+    __slots__ = ("reprocess_id", "status", "total_windows", "triggered_windows", "succeeded_windows", "failed_windows", "created", "finished", "error_message")
+    REPROCESS_ID_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    TOTAL_WINDOWS_FIELD_NUMBER: _ClassVar[int]
+    TRIGGERED_WINDOWS_FIELD_NUMBER: _ClassVar[int]
+    SUCCEEDED_WINDOWS_FIELD_NUMBER: _ClassVar[int]
+    FAILED_WINDOWS_FIELD_NUMBER: _ClassVar[int]
+    CREATED_FIELD_NUMBER: _ClassVar[int]
+    FINISHED_FIELD_NUMBER: _ClassVar[int]
+    ERROR_MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    reprocess_id: str
+    status: ExecutionStatus
+    total_windows: int
+    triggered_windows: int
+    succeeded_windows: int
+    failed_windows: int
+    created: _timestamp_pb2.Timestamp
+    finished: _timestamp_pb2.Timestamp
+    error_message: str
+    def __init__(self, reprocess_id: _Optional[str] = ..., status: _Optional[_Union[ExecutionStatus, str]] = ..., total_windows: _Optional[int] = ..., triggered_windows: _Optional[int] = ..., succeeded_windows: _Optional[int] = ..., failed_windows: _Optional[int] = ..., created: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., finished: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., error_message: _Optional[str] = ...) -> None: ...
+
+class FailedExecutionsRead(_message.Message):
+    __slots__ = ("time_from", "time_to", "processor_name", "processor_runtime", "algorithm", "include_requeued")
+    TIME_FROM_FIELD_NUMBER: _ClassVar[int]
+    TIME_TO_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_NAME_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    ALGORITHM_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_REQUEUED_FIELD_NUMBER: _ClassVar[int]
+    time_from: _timestamp_pb2.Timestamp
+    time_to: _timestamp_pb2.Timestamp
+    processor_name: str
+    processor_runtime: str
+    algorithm: Algorithm
+    include_requeued: bool
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., processor_name: _Optional[str] = ..., processor_runtime: _Optional[str] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ..., include_requeued: bool = ...) -> None: ...
+
+class FailedExecutionsRequeue(_message.Message):
+    __slots__ = ("time_from", "time_to", "processor_name", "processor_runtime", "algorithm")
+    TIME_FROM_FIELD_NUMBER: _ClassVar[int]
+    TIME_TO_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_NAME_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    ALGORITHM_FIELD_NUMBER: _ClassVar[int]
+    time_from: _timestamp_pb2.Timestamp
+    time_to: _timestamp_pb2.Timestamp
+    processor_name: str
+    processor_runtime: str
+    algorithm: Algorithm
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., processor_name: _Optional[str] = ..., processor_runtime: _Optional[str] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ...) -> None: ...
+
+class FailedExecution(_message.Message):
+    __slots__ = ("exec_id", "task_exec_id", "processor_name", "processor_runtime", "request", "error_code", "error_message", "attempts", "failed", "requeued")
+    EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    TASK_EXEC_ID_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_NAME_FIELD_NUMBER: _ClassVar[int]
+    PROCESSOR_RUNTIME_FIELD_NUMBER: _ClassVar[int]
+    REQUEST_FIELD_NUMBER: _ClassVar[int]
+    ERROR_CODE_FIELD_NUMBER: _ClassVar[int]
+    ERROR_MESSAGE_FIELD_NUMBER: _ClassVar[int]
+    ATTEMPTS_FIELD_NUMBER: _ClassVar[int]
+    FAILED_FIELD_NUMBER: _ClassVar[int]
+    REQUEUED_FIELD_NUMBER: _ClassVar[int]
+    exec_id: str
+    task_exec_id: str
+    processor_name: str
+    processor_runtime: str
+    request: ExecutionRequest
+    error_code: str
+    error_message: str
+    attempts: int
+    failed: _timestamp_pb2.Timestamp
+    requeued: _timestamp_pb2.Timestamp
+    def __init__(self, exec_id: _Optional[str] = ..., task_exec_id: _Optional[str] = ..., processor_name: _Optional[str] = ..., processor_runtime: _Optional[str] = ..., request: _Optional[_Union[ExecutionRequest, _Mapping]] = ..., error_code: _Optional[str] = ..., error_message: _Optional[str] = ..., attempts: _Optional[int] = ..., failed: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., requeued: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class FailedExecutions(_message.Message):
+    __slots__ = ("failed_executions",)
+    FAILED_EXECUTIONS_FIELD_NUMBER: _ClassVar[int]
+    failed_executions: _containers.RepeatedCompositeFieldContainer[FailedExecution]
+    def __init__(self, failed_executions: _Optional[_Iterable[_Union[FailedExecution, _Mapping]]] = ...) -> None: ...
+
+class RequeuedExecutions(_message.Message):
+    __slots__ = ("exec_ids",)
+    EXEC_IDS_FIELD_NUMBER: _ClassVar[int]
+    exec_ids: _containers.RepeatedScalarFieldContainer[str]
+    def __init__(self, exec_ids: _Optional[_Iterable[str]] = ...) -> None: ...
+
+class AlertRule(_message.Message):
+    __slots__ = ("name", "algorithm", "expression", "description", "metadata_key")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    ALGORITHM_FIELD_NUMBER: _ClassVar[int]
+    EXPRESSION_FIELD_NUMBER: _ClassVar[int]
+    DESCRIPTION_FIELD_NUMBER: _ClassVar[int]
+    METADATA_KEY_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    algorithm: Algorithm
+    expression: str
+    description: str
+    metadata_key: str
+    def __init__(self, name: _Optional[str] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ..., expression: _Optional[str] = ..., description: _Optional[str] = ..., metadata_key: _Optional[str] = ...) -> None: ...
+
+class AlertRulesRead(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class AlertRules(_message.Message):
+    __slots__ = ("alert_rules",)
+    ALERT_RULES_FIELD_NUMBER: _ClassVar[int]
+    alert_rules: _containers.RepeatedCompositeFieldContainer[AlertRule]
+    def __init__(self, alert_rules: _Optional[_Iterable[_Union[AlertRule, _Mapping]]] = ...) -> None: ...
+
+class AlertRuleDeletion(_message.Message):
+    __slots__ = ("name",)
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    def __init__(self, name: _Optional[str] = ...) -> None: ...
+
+class AlertsRead(_message.Message):
+    __slots__ = ("time_from", "time_to", "alert_rule_name", "status", "include_acknowledged")
+    TIME_FROM_FIELD_NUMBER: _ClassVar[int]
+    TIME_TO_FIELD_NUMBER: _ClassVar[int]
+    ALERT_RULE_NAME_FIELD_NUMBER: _ClassVar[int]
+    STATUS_FIELD_NUMBER: _ClassVar[int]
+    INCLUDE_ACKNOWLEDGED_FIELD_NUMBER: _ClassVar[int]
+    time_from: _timestamp_pb2.Timestamp
+    time_to: _timestamp_pb2.Timestamp
+    alert_rule_name: str
+    status: AlertStatus
+    include_acknowledged: bool
+    def __init__(self, time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., alert_rule_name: _Optional[str] = ..., status: _Optional[_Union[AlertStatus, str]] = ..., include_acknowledged: bool = ...) -> None: ...
+
+class Alerts(_message.Message):
+    __slots__ = ("alerts",)
+    class Alert(_message.Message):
+        __slots__ = ("alert_id", "alert_rule_name", "algorithm", "status", "origin", "metadata", "window_time_from", "window_time_to", "fired", "resolved", "acknowledged", "acknowledged_by")
+        ALERT_ID_FIELD_NUMBER: _ClassVar[int]
+        ALERT_RULE_NAME_FIELD_NUMBER: _ClassVar[int]
+        ALGORITHM_FIELD_NUMBER: _ClassVar[int]
+        STATUS_FIELD_NUMBER: _ClassVar[int]
+        ORIGIN_FIELD_NUMBER: _ClassVar[int]
+        METADATA_FIELD_NUMBER: _ClassVar[int]
+        WINDOW_TIME_FROM_FIELD_NUMBER: _ClassVar[int]
+        WINDOW_TIME_TO_FIELD_NUMBER: _ClassVar[int]
+        FIRED_FIELD_NUMBER: _ClassVar[int]
+        RESOLVED_FIELD_NUMBER: _ClassVar[int]
+        ACKNOWLEDGED_FIELD_NUMBER: _ClassVar[int]
+        ACKNOWLEDGED_BY_FIELD_NUMBER: _ClassVar[int]
+        alert_id: int
+        alert_rule_name: str
+        algorithm: Algorithm
+        status: AlertStatus
+        origin: str
+        metadata: _struct_pb2.Struct
+        window_time_from: _timestamp_pb2.Timestamp
+        window_time_to: _timestamp_pb2.Timestamp
+        fired: _timestamp_pb2.Timestamp
+        resolved: _timestamp_pb2.Timestamp
+        acknowledged: _timestamp_pb2.Timestamp
+        acknowledged_by: str
+        def __init__(self, alert_id: _Optional[int] = ..., alert_rule_name: _Optional[str] = ..., algorithm: _Optional[_Union[Algorithm, _Mapping]] = ..., status: _Optional[_Union[AlertStatus, str]] = ..., origin: _Optional[str] = ..., metadata: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., window_time_from: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., window_time_to: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., fired: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., resolved: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., acknowledged: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., acknowledged_by: _Optional[str] = ...) -> None: ...
+    ALERTS_FIELD_NUMBER: _ClassVar[int]
+    alerts: _containers.RepeatedCompositeFieldContainer[Alerts.Alert]
+    def __init__(self, alerts: _Optional[_Iterable[_Union[Alerts.Alert, _Mapping]]] = ...) -> None: ...
+
+class AlertAcknowledgement(_message.Message):
+    __slots__ = ("alert_id", "acknowledged_by")
+    ALERT_ID_FIELD_NUMBER: _ClassVar[int]
+    ACKNOWLEDGED_BY_FIELD_NUMBER: _ClassVar[int]
+    alert_id: int
+    acknowledged_by: str
+    def __init__(self, alert_id: _Optional[int] = ..., acknowledged_by: _Optional[str] = ...) -> None: ...
+
+class WindowSchedule(_message.Message):
+    __slots__ = ("name", "window_type_name", "window_type_version", "cron", "timezone", "origin", "metadata", "start", "emitted_until", "next_emission")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_TYPE_NAME_FIELD_NUMBER: _ClassVar[int]
+    WINDOW_TYPE_VERSION_FIELD_NUMBER: _ClassVar[int]
+    CRON_FIELD_NUMBER: _ClassVar[int]
+    TIMEZONE_FIELD_NUMBER: _ClassVar[int]
+    ORIGIN_FIELD_NUMBER: _ClassVar[int]
+    METADATA_FIELD_NUMBER: _ClassVar[int]
+    START_FIELD_NUMBER: _ClassVar[int]
+    EMITTED_UNTIL_FIELD_NUMBER: _ClassVar[int]
+    NEXT_EMISSION_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    window_type_name: str
+    window_type_version: str
+    cron: str
+    timezone: str
+    origin: str
+    metadata: _struct_pb2.Struct
+    start: _timestamp_pb2.Timestamp
+    emitted_until: _timestamp_pb2.Timestamp
+    next_emission: _timestamp_pb2.Timestamp
+    def __init__(self, name: _Optional[str] = ..., window_type_name: _Optional[str] = ..., window_type_version: _Optional[str] = ..., cron: _Optional[str] = ..., timezone: _Optional[str] = ..., origin: _Optional[str] = ..., metadata: _Optional[_Union[_struct_pb2.Struct, _Mapping]] = ..., start: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., emitted_until: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ..., next_emission: _Optional[_Union[_timestamp_pb2.Timestamp, _Mapping]] = ...) -> None: ...
+
+class WindowSchedulesRead(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class WindowSchedules(_message.Message):
+    __slots__ = ("window_schedules",)
+    WINDOW_SCHEDULES_FIELD_NUMBER: _ClassVar[int]
+    window_schedules: _containers.RepeatedCompositeFieldContainer[WindowSchedule]
+    def __init__(self, window_schedules: _Optional[_Iterable[_Union[WindowSchedule, _Mapping]]] = ...) -> None: ...
+
+class WindowScheduleDeletion(_message.Message):
+    __slots__ = ("name",)
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    def __init__(self, name: _Optional[str] = ...) -> None: ...
+
+class RollupRule(_message.Message):
+    __slots__ = ("name", "child_window_type_name", "child_window_type_version", "parent_window_type_name", "parent_window_type_version", "cron", "timezone", "metadata_keys", "origin")
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    CHILD_WINDOW_TYPE_NAME_FIELD_NUMBER: _ClassVar[int]
+    CHILD_WINDOW_TYPE_VERSION_FIELD_NUMBER: _ClassVar[int]
+    PARENT_WINDOW_TYPE_NAME_FIELD_NUMBER: _ClassVar[int]
+    PARENT_WINDOW_TYPE_VERSION_FIELD_NUMBER: _ClassVar[int]
+    CRON_FIELD_NUMBER: _ClassVar[int]
+    TIMEZONE_FIELD_NUMBER: _ClassVar[int]
+    METADATA_KEYS_FIELD_NUMBER: _ClassVar[int]
+    ORIGIN_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    child_window_type_name: str
+    child_window_type_version: str
+    parent_window_type_name: str
+    parent_window_type_version: str
+    cron: str
+    timezone: str
+    metadata_keys: _containers.RepeatedScalarFieldContainer[str]
+    origin: str
+    def __init__(self, name: _Optional[str] = ..., child_window_type_name: _Optional[str] = ..., child_window_type_version: _Optional[str] = ..., parent_window_type_name: _Optional[str] = ..., parent_window_type_version: _Optional[str] = ..., cron: _Optional[str] = ..., timezone: _Optional[str] = ..., metadata_keys: _Optional[_Iterable[str]] = ..., origin: _Optional[str] = ...) -> None: ...
+
+class RollupRulesRead(_message.Message):
+    __slots__ = ()
+    def __init__(self) -> None: ...
+
+class RollupRules(_message.Message):
+    __slots__ = ("rollup_rules",)
+    ROLLUP_RULES_FIELD_NUMBER: _ClassVar[int]
+    rollup_rules: _containers.RepeatedCompositeFieldContainer[RollupRule]
+    def __init__(self, rollup_rules: _Optional[_Iterable[_Union[RollupRule, _Mapping]]] = ...) -> None: ...
+
+class RollupRuleDeletion(_message.Message):
+    __slots__ = ("name",)
+    NAME_FIELD_NUMBER: _ClassVar[int]
+    name: str
+    def __init__(self, name: _Optional[str] = ...) -> None: ...
+
+class ChildWindowsRead(_message.Message):
+    __slots__ = ("window",)
+    WINDOW_FIELD_NUMBER: _ClassVar[int]
+    window: Window
+    def __init__(self, window: _Optional[_Union[Window, _Mapping]] = ...) -> None: ...
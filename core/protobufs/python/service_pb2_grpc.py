@@ -50,6 +50,61 @@ class OrcaCoreStub(object):
                 request_serializer=service__pb2.Window.SerializeToString,
                 response_deserializer=service__pb2.WindowEmitStatus.FromString,
                 _registered_method=True)
+        self.EmitWindows = channel.unary_unary(
+                '/OrcaCore/EmitWindows',
+                request_serializer=service__pb2.WindowsEmit.SerializeToString,
+                response_deserializer=service__pb2.WindowsEmitStatus.FromString,
+                _registered_method=True)
+        self.EmitWindowStream = channel.stream_unary(
+                '/OrcaCore/EmitWindowStream',
+                request_serializer=service__pb2.Window.SerializeToString,
+                response_deserializer=service__pb2.WindowsEmitStatus.FromString,
+                _registered_method=True)
+        self.OpenWindow = channel.unary_unary(
+                '/OrcaCore/OpenWindow',
+                request_serializer=service__pb2.WindowOpen.SerializeToString,
+                response_deserializer=service__pb2.WindowEmitStatus.FromString,
+                _registered_method=True)
+        self.ExtendWindow = channel.unary_unary(
+                '/OrcaCore/ExtendWindow',
+                request_serializer=service__pb2.WindowExtension.SerializeToString,
+                response_deserializer=service__pb2.WindowEmitStatus.FromString,
+                _registered_method=True)
+        self.CloseWindow = channel.unary_unary(
+                '/OrcaCore/CloseWindow',
+                request_serializer=service__pb2.WindowClosure.SerializeToString,
+                response_deserializer=service__pb2.WindowEmitStatus.FromString,
+                _registered_method=True)
+        self.AmendWindow = channel.unary_unary(
+                '/OrcaCore/AmendWindow',
+                request_serializer=service__pb2.WindowAmendment.SerializeToString,
+                response_deserializer=service__pb2.WindowEmitStatus.FromString,
+                _registered_method=True)
+        self.DeregisterProcessor = channel.unary_unary(
+                '/OrcaCore/DeregisterProcessor',
+                request_serializer=service__pb2.ProcessorDeregistration.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.RetireAlgorithm = channel.unary_unary(
+                '/OrcaCore/RetireAlgorithm',
+                request_serializer=service__pb2.AlgorithmRetirement.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.RemoveAlgorithmDependency = channel.unary_unary(
+                '/OrcaCore/RemoveAlgorithmDependency',
+                request_serializer=service__pb2.AlgorithmDependencyRemoval.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.SubscribeTasks = channel.unary_stream(
+                '/OrcaCore/SubscribeTasks',
+                request_serializer=service__pb2.TaskSubscription.SerializeToString,
+                response_deserializer=service__pb2.ProcessingTask.FromString,
+                _registered_method=True)
+        self.SubmitResult = channel.unary_unary(
+                '/OrcaCore/SubmitResult',
+                request_serializer=service__pb2.TaskResult.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
         self.ReadWindowTypes = channel.unary_unary(
                 '/OrcaCore/ReadWindowTypes',
                 request_serializer=service__pb2.WindowTypeRead.SerializeToString,
@@ -100,11 +155,116 @@ class OrcaCoreStub(object):
                 request_serializer=service__pb2.ResultsForAlgorithmAndMetadataRead.SerializeToString,
                 response_deserializer=service__pb2.ResultsForAlgorithmAndMetadata.FromString,
                 _registered_method=True)
+        self.SubscribeResults = channel.unary_stream(
+                '/OrcaCore/SubscribeResults',
+                request_serializer=service__pb2.ResultsSubscription.SerializeToString,
+                response_deserializer=service__pb2.ResultUpdate.FromString,
+                _registered_method=True)
         self.Annotate = channel.unary_unary(
                 '/OrcaCore/Annotate',
                 request_serializer=service__pb2.AnnotateWrite.SerializeToString,
                 response_deserializer=service__pb2.AnnotateResponse.FromString,
                 _registered_method=True)
+        self.CreateWindowSchedule = channel.unary_unary(
+                '/OrcaCore/CreateWindowSchedule',
+                request_serializer=service__pb2.WindowSchedule.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.ReadWindowSchedules = channel.unary_unary(
+                '/OrcaCore/ReadWindowSchedules',
+                request_serializer=service__pb2.WindowSchedulesRead.SerializeToString,
+                response_deserializer=service__pb2.WindowSchedules.FromString,
+                _registered_method=True)
+        self.DeleteWindowSchedule = channel.unary_unary(
+                '/OrcaCore/DeleteWindowSchedule',
+                request_serializer=service__pb2.WindowScheduleDeletion.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.CreateRollupRule = channel.unary_unary(
+                '/OrcaCore/CreateRollupRule',
+                request_serializer=service__pb2.RollupRule.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.ReadRollupRules = channel.unary_unary(
+                '/OrcaCore/ReadRollupRules',
+                request_serializer=service__pb2.RollupRulesRead.SerializeToString,
+                response_deserializer=service__pb2.RollupRules.FromString,
+                _registered_method=True)
+        self.DeleteRollupRule = channel.unary_unary(
+                '/OrcaCore/DeleteRollupRule',
+                request_serializer=service__pb2.RollupRuleDeletion.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.ReadChildWindows = channel.unary_unary(
+                '/OrcaCore/ReadChildWindows',
+                request_serializer=service__pb2.ChildWindowsRead.SerializeToString,
+                response_deserializer=service__pb2.Windows.FromString,
+                _registered_method=True)
+        self.CreateAlertRule = channel.unary_unary(
+                '/OrcaCore/CreateAlertRule',
+                request_serializer=service__pb2.AlertRule.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.ReadAlertRules = channel.unary_unary(
+                '/OrcaCore/ReadAlertRules',
+                request_serializer=service__pb2.AlertRulesRead.SerializeToString,
+                response_deserializer=service__pb2.AlertRules.FromString,
+                _registered_method=True)
+        self.DeleteAlertRule = channel.unary_unary(
+                '/OrcaCore/DeleteAlertRule',
+                request_serializer=service__pb2.AlertRuleDeletion.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.ReadAlerts = channel.unary_unary(
+                '/OrcaCore/ReadAlerts',
+                request_serializer=service__pb2.AlertsRead.SerializeToString,
+                response_deserializer=service__pb2.Alerts.FromString,
+                _registered_method=True)
+        self.AcknowledgeAlert = channel.unary_unary(
+                '/OrcaCore/AcknowledgeAlert',
+                request_serializer=service__pb2.AlertAcknowledgement.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.ReadExecution = channel.unary_unary(
+                '/OrcaCore/ReadExecution',
+                request_serializer=service__pb2.ExecutionRead.SerializeToString,
+                response_deserializer=service__pb2.Execution.FromString,
+                _registered_method=True)
+        self.ReadExecutions = channel.unary_unary(
+                '/OrcaCore/ReadExecutions',
+                request_serializer=service__pb2.ExecutionsRead.SerializeToString,
+                response_deserializer=service__pb2.Executions.FromString,
+                _registered_method=True)
+        self.CancelExecution = channel.unary_unary(
+                '/OrcaCore/CancelExecution',
+                request_serializer=service__pb2.ExecutionCancel.SerializeToString,
+                response_deserializer=service__pb2.Status.FromString,
+                _registered_method=True)
+        self.ReadExecutionQueue = channel.unary_unary(
+                '/OrcaCore/ReadExecutionQueue',
+                request_serializer=service__pb2.ExecutionQueueRead.SerializeToString,
+                response_deserializer=service__pb2.ExecutionQueue.FromString,
+                _registered_method=True)
+        self.ReprocessWindows = channel.unary_unary(
+                '/OrcaCore/ReprocessWindows',
+                request_serializer=service__pb2.WindowsReprocess.SerializeToString,
+                response_deserializer=service__pb2.Reprocess.FromString,
+                _registered_method=True)
+        self.ReadReprocess = channel.unary_unary(
+                '/OrcaCore/ReadReprocess',
+                request_serializer=service__pb2.ReprocessRead.SerializeToString,
+                response_deserializer=service__pb2.Reprocess.FromString,
+                _registered_method=True)
+        self.ReadFailedExecutions = channel.unary_unary(
+                '/OrcaCore/ReadFailedExecutions',
+                request_serializer=service__pb2.FailedExecutionsRead.SerializeToString,
+                response_deserializer=service__pb2.FailedExecutions.FromString,
+                _registered_method=True)
+        self.RequeueFailedExecutions = channel.unary_unary(
+                '/OrcaCore/RequeueFailedExecutions',
+                request_serializer=service__pb2.FailedExecutionsRequeue.SerializeToString,
+                response_deserializer=service__pb2.RequeuedExecutions.FromString,
+                _registered_method=True)
 
 
 class OrcaCoreServicer(object):
@@ -130,6 +290,90 @@ class OrcaCoreServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def EmitWindows(self, request, context):
+        """Submit a batch of windows for processing, returning the status of each
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def EmitWindowStream(self, request_iterator, context):
+        """Stream windows for processing, returning the status of each once the
+        stream is closed
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def OpenWindow(self, request, context):
+        """Open a window whose end is not yet known. Its algorithms are not
+        triggered until it is closed, unless it is provisional
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExtendWindow(self, request, context):
+        """Extend an open window up to a later time
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CloseWindow(self, request, context):
+        """Close an open window at its end, triggering its algorithms
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AmendWindow(self, request, context):
+        """Supersede an emitted window with a corrected one, triggering its
+        algorithms again. The superseded window and its results are hidden from
+        reads by default
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeregisterProcessor(self, request, context):
+        """Deregister a processor, retiring the algorithms it supports
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RetireAlgorithm(self, request, context):
+        """Retire an algorithm so that it is no longer executed. Results it has
+        already produced remain readable
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RemoveAlgorithmDependency(self, request, context):
+        """Remove the dependency of one algorithm on another
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SubscribeTasks(self, request, context):
+        """Subscribe a registered processor for the tasks it is to execute. This is
+        an alternative to orca-core dialling out to the processor, for
+        processors that cannot be reached, e.g. behind NAT or a firewall
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SubmitResult(self, request, context):
+        """Submit the result of a task received through SubscribeTasks
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def ReadWindowTypes(self, request, context):
         """------------------- Data operations ------------------- 
         """
@@ -191,100 +435,1282 @@ class OrcaCoreServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
-    def Annotate(self, request, context):
-        """------------------ Annotation operations ----------------- 
-        """
-        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
-        context.set_details('Method not implemented!')
-        raise NotImplementedError('Method not implemented!')
+    def SubscribeResults(self, request, context):
+        """Stream results as they are written, optionally filtered by algorithm,
+        window type and window metadata
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Annotate(self, request, context):
+        """------------------ Annotation operations ----------------- 
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateWindowSchedule(self, request, context):
+        """------------------- Schedule operations ------------------ 
+
+        Create a schedule that windows of a type are emitted on, or replace the
+        schedule of the same name
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadWindowSchedules(self, request, context):
+        """Read the window schedules
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteWindowSchedule(self, request, context):
+        """Delete a window schedule. Windows it has already emitted are kept
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateRollupRule(self, request, context):
+        """Create a rule that rolls windows of one type up into windows of
+        another, or replace the rule of the same name
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadRollupRules(self, request, context):
+        """Read the roll-up rules
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteRollupRule(self, request, context):
+        """Delete a roll-up rule. Windows it has already rolled up are kept
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadChildWindows(self, request, context):
+        """Read the child windows that a window was rolled up from
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CreateAlertRule(self, request, context):
+        """-------------------- Alert operations -------------------- 
+
+        Create an alert rule, or replace the rule of the same name
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadAlertRules(self, request, context):
+        """Read the alert rules
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def DeleteAlertRule(self, request, context):
+        """Delete an alert rule along with its alerts
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadAlerts(self, request, context):
+        """Read the alerts fired within a time range
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AcknowledgeAlert(self, request, context):
+        """Acknowledge an alert
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadExecution(self, request, context):
+        """------------------ Execution operations ------------------ 
+
+        Read the state of the execution triggered by an emitted window
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadExecutions(self, request, context):
+        """Read the state of the executions triggered by windows in a time range
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def CancelExecution(self, request, context):
+        """Cancel an in-flight execution, including the work sent to processors
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadExecutionQueue(self, request, context):
+        """Read the depth and saturation of the queue of executions
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReprocessWindows(self, request, context):
+        """Execute algorithms over windows that have already been emitted, e.g. to
+        backfill the results of a newly registered algorithm
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadReprocess(self, request, context):
+        """Read the progress of a reprocess
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ReadFailedExecutions(self, request, context):
+        """Read the processor tasks that failed after exhausting their retries
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def RequeueFailedExecutions(self, request, context):
+        """Re-execute the executions of failed processor tasks, along with the
+        algorithms that were skipped because of them
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+
+def add_OrcaCoreServicer_to_server(servicer, server):
+    rpc_method_handlers = {
+            'RegisterProcessor': grpc.unary_unary_rpc_method_handler(
+                    servicer.RegisterProcessor,
+                    request_deserializer=service__pb2.ProcessorRegistration.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'EmitWindow': grpc.unary_unary_rpc_method_handler(
+                    servicer.EmitWindow,
+                    request_deserializer=service__pb2.Window.FromString,
+                    response_serializer=service__pb2.WindowEmitStatus.SerializeToString,
+            ),
+            'EmitWindows': grpc.unary_unary_rpc_method_handler(
+                    servicer.EmitWindows,
+                    request_deserializer=service__pb2.WindowsEmit.FromString,
+                    response_serializer=service__pb2.WindowsEmitStatus.SerializeToString,
+            ),
+            'EmitWindowStream': grpc.stream_unary_rpc_method_handler(
+                    servicer.EmitWindowStream,
+                    request_deserializer=service__pb2.Window.FromString,
+                    response_serializer=service__pb2.WindowsEmitStatus.SerializeToString,
+            ),
+            'OpenWindow': grpc.unary_unary_rpc_method_handler(
+                    servicer.OpenWindow,
+                    request_deserializer=service__pb2.WindowOpen.FromString,
+                    response_serializer=service__pb2.WindowEmitStatus.SerializeToString,
+            ),
+            'ExtendWindow': grpc.unary_unary_rpc_method_handler(
+                    servicer.ExtendWindow,
+                    request_deserializer=service__pb2.WindowExtension.FromString,
+                    response_serializer=service__pb2.WindowEmitStatus.SerializeToString,
+            ),
+            'CloseWindow': grpc.unary_unary_rpc_method_handler(
+                    servicer.CloseWindow,
+                    request_deserializer=service__pb2.WindowClosure.FromString,
+                    response_serializer=service__pb2.WindowEmitStatus.SerializeToString,
+            ),
+            'AmendWindow': grpc.unary_unary_rpc_method_handler(
+                    servicer.AmendWindow,
+                    request_deserializer=service__pb2.WindowAmendment.FromString,
+                    response_serializer=service__pb2.WindowEmitStatus.SerializeToString,
+            ),
+            'DeregisterProcessor': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeregisterProcessor,
+                    request_deserializer=service__pb2.ProcessorDeregistration.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'RetireAlgorithm': grpc.unary_unary_rpc_method_handler(
+                    servicer.RetireAlgorithm,
+                    request_deserializer=service__pb2.AlgorithmRetirement.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'RemoveAlgorithmDependency': grpc.unary_unary_rpc_method_handler(
+                    servicer.RemoveAlgorithmDependency,
+                    request_deserializer=service__pb2.AlgorithmDependencyRemoval.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'SubscribeTasks': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeTasks,
+                    request_deserializer=service__pb2.TaskSubscription.FromString,
+                    response_serializer=service__pb2.ProcessingTask.SerializeToString,
+            ),
+            'SubmitResult': grpc.unary_unary_rpc_method_handler(
+                    servicer.SubmitResult,
+                    request_deserializer=service__pb2.TaskResult.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadWindowTypes': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadWindowTypes,
+                    request_deserializer=service__pb2.WindowTypeRead.FromString,
+                    response_serializer=service__pb2.WindowTypes.SerializeToString,
+            ),
+            'ReadAlgorithms': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadAlgorithms,
+                    request_deserializer=service__pb2.AlgorithmsRead.FromString,
+                    response_serializer=service__pb2.Algorithms.SerializeToString,
+            ),
+            'ReadProcessors': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadProcessors,
+                    request_deserializer=service__pb2.ProcessorsRead.FromString,
+                    response_serializer=service__pb2.Processors.SerializeToString,
+            ),
+            'ReadResultsStats': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadResultsStats,
+                    request_deserializer=service__pb2.ResultsStatsRead.FromString,
+                    response_serializer=service__pb2.ResultsStats.SerializeToString,
+            ),
+            'ReadResultFieldsForAlgorithm': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadResultFieldsForAlgorithm,
+                    request_deserializer=service__pb2.AlgorithmFieldsRead.FromString,
+                    response_serializer=service__pb2.AlgorithmFields.SerializeToString,
+            ),
+            'ReadResultsForAlgorithm': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadResultsForAlgorithm,
+                    request_deserializer=service__pb2.ResultsForAlgorithmRead.FromString,
+                    response_serializer=service__pb2.ResultsForAlgorithm.SerializeToString,
+            ),
+            'ReadWindows': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadWindows,
+                    request_deserializer=service__pb2.WindowsRead.FromString,
+                    response_serializer=service__pb2.Windows.SerializeToString,
+            ),
+            'ReadDistinctMetadataForWindowType': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadDistinctMetadataForWindowType,
+                    request_deserializer=service__pb2.DistinctMetadataForWindowTypeRead.FromString,
+                    response_serializer=service__pb2.DistinctMetadataForWindowType.SerializeToString,
+            ),
+            'ReadWindowsForMetadata': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadWindowsForMetadata,
+                    request_deserializer=service__pb2.WindowsForMetadataRead.FromString,
+                    response_serializer=service__pb2.WindowsForMetadata.SerializeToString,
+            ),
+            'ReadResultsForAlgorithmAndMetadata': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadResultsForAlgorithmAndMetadata,
+                    request_deserializer=service__pb2.ResultsForAlgorithmAndMetadataRead.FromString,
+                    response_serializer=service__pb2.ResultsForAlgorithmAndMetadata.SerializeToString,
+            ),
+            'SubscribeResults': grpc.unary_stream_rpc_method_handler(
+                    servicer.SubscribeResults,
+                    request_deserializer=service__pb2.ResultsSubscription.FromString,
+                    response_serializer=service__pb2.ResultUpdate.SerializeToString,
+            ),
+            'Annotate': grpc.unary_unary_rpc_method_handler(
+                    servicer.Annotate,
+                    request_deserializer=service__pb2.AnnotateWrite.FromString,
+                    response_serializer=service__pb2.AnnotateResponse.SerializeToString,
+            ),
+            'CreateWindowSchedule': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateWindowSchedule,
+                    request_deserializer=service__pb2.WindowSchedule.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadWindowSchedules': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadWindowSchedules,
+                    request_deserializer=service__pb2.WindowSchedulesRead.FromString,
+                    response_serializer=service__pb2.WindowSchedules.SerializeToString,
+            ),
+            'DeleteWindowSchedule': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteWindowSchedule,
+                    request_deserializer=service__pb2.WindowScheduleDeletion.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'CreateRollupRule': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateRollupRule,
+                    request_deserializer=service__pb2.RollupRule.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadRollupRules': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadRollupRules,
+                    request_deserializer=service__pb2.RollupRulesRead.FromString,
+                    response_serializer=service__pb2.RollupRules.SerializeToString,
+            ),
+            'DeleteRollupRule': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteRollupRule,
+                    request_deserializer=service__pb2.RollupRuleDeletion.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadChildWindows': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadChildWindows,
+                    request_deserializer=service__pb2.ChildWindowsRead.FromString,
+                    response_serializer=service__pb2.Windows.SerializeToString,
+            ),
+            'CreateAlertRule': grpc.unary_unary_rpc_method_handler(
+                    servicer.CreateAlertRule,
+                    request_deserializer=service__pb2.AlertRule.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadAlertRules': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadAlertRules,
+                    request_deserializer=service__pb2.AlertRulesRead.FromString,
+                    response_serializer=service__pb2.AlertRules.SerializeToString,
+            ),
+            'DeleteAlertRule': grpc.unary_unary_rpc_method_handler(
+                    servicer.DeleteAlertRule,
+                    request_deserializer=service__pb2.AlertRuleDeletion.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadAlerts': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadAlerts,
+                    request_deserializer=service__pb2.AlertsRead.FromString,
+                    response_serializer=service__pb2.Alerts.SerializeToString,
+            ),
+            'AcknowledgeAlert': grpc.unary_unary_rpc_method_handler(
+                    servicer.AcknowledgeAlert,
+                    request_deserializer=service__pb2.AlertAcknowledgement.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadExecution': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadExecution,
+                    request_deserializer=service__pb2.ExecutionRead.FromString,
+                    response_serializer=service__pb2.Execution.SerializeToString,
+            ),
+            'ReadExecutions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadExecutions,
+                    request_deserializer=service__pb2.ExecutionsRead.FromString,
+                    response_serializer=service__pb2.Executions.SerializeToString,
+            ),
+            'CancelExecution': grpc.unary_unary_rpc_method_handler(
+                    servicer.CancelExecution,
+                    request_deserializer=service__pb2.ExecutionCancel.FromString,
+                    response_serializer=service__pb2.Status.SerializeToString,
+            ),
+            'ReadExecutionQueue': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadExecutionQueue,
+                    request_deserializer=service__pb2.ExecutionQueueRead.FromString,
+                    response_serializer=service__pb2.ExecutionQueue.SerializeToString,
+            ),
+            'ReprocessWindows': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReprocessWindows,
+                    request_deserializer=service__pb2.WindowsReprocess.FromString,
+                    response_serializer=service__pb2.Reprocess.SerializeToString,
+            ),
+            'ReadReprocess': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadReprocess,
+                    request_deserializer=service__pb2.ReprocessRead.FromString,
+                    response_serializer=service__pb2.Reprocess.SerializeToString,
+            ),
+            'ReadFailedExecutions': grpc.unary_unary_rpc_method_handler(
+                    servicer.ReadFailedExecutions,
+                    request_deserializer=service__pb2.FailedExecutionsRead.FromString,
+                    response_serializer=service__pb2.FailedExecutions.SerializeToString,
+            ),
+            'RequeueFailedExecutions': grpc.unary_unary_rpc_method_handler(
+                    servicer.RequeueFailedExecutions,
+                    request_deserializer=service__pb2.FailedExecutionsRequeue.FromString,
+                    response_serializer=service__pb2.RequeuedExecutions.SerializeToString,
+            ),
+    }
+    generic_handler = grpc.method_handlers_generic_handler(
+            'OrcaCore', rpc_method_handlers)
+    server.add_generic_rpc_handlers((generic_handler,))
+    server.add_registered_method_handlers('OrcaCore', rpc_method_handlers)
+
+
+ # This class is part of an EXPERIMENTAL API.
+class OrcaCore(object):
+    """OrcaCore is the central orchestration service that:
+    - Manages the lifecycle of processing windows
+    - Coordinates algorithm execution across distributed processors
+    - Tracks DAG dependencies and execution state
+    - Routes results between dependent algorithms
+    ------------------- Core operations ------------------- 
+    """
+
+    @staticmethod
+    def RegisterProcessor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/RegisterProcessor',
+            service__pb2.ProcessorRegistration.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def EmitWindow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/EmitWindow',
+            service__pb2.Window.SerializeToString,
+            service__pb2.WindowEmitStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def EmitWindows(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/EmitWindows',
+            service__pb2.WindowsEmit.SerializeToString,
+            service__pb2.WindowsEmitStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def EmitWindowStream(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_unary(
+            request_iterator,
+            target,
+            '/OrcaCore/EmitWindowStream',
+            service__pb2.Window.SerializeToString,
+            service__pb2.WindowsEmitStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def OpenWindow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/OpenWindow',
+            service__pb2.WindowOpen.SerializeToString,
+            service__pb2.WindowEmitStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExtendWindow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ExtendWindow',
+            service__pb2.WindowExtension.SerializeToString,
+            service__pb2.WindowEmitStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CloseWindow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/CloseWindow',
+            service__pb2.WindowClosure.SerializeToString,
+            service__pb2.WindowEmitStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def AmendWindow(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/AmendWindow',
+            service__pb2.WindowAmendment.SerializeToString,
+            service__pb2.WindowEmitStatus.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeregisterProcessor(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/DeregisterProcessor',
+            service__pb2.ProcessorDeregistration.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RetireAlgorithm(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/RetireAlgorithm',
+            service__pb2.AlgorithmRetirement.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def RemoveAlgorithmDependency(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/RemoveAlgorithmDependency',
+            service__pb2.AlgorithmDependencyRemoval.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SubscribeTasks(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/OrcaCore/SubscribeTasks',
+            service__pb2.TaskSubscription.SerializeToString,
+            service__pb2.ProcessingTask.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SubmitResult(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/SubmitResult',
+            service__pb2.TaskResult.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadWindowTypes(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadWindowTypes',
+            service__pb2.WindowTypeRead.SerializeToString,
+            service__pb2.WindowTypes.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadAlgorithms(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadAlgorithms',
+            service__pb2.AlgorithmsRead.SerializeToString,
+            service__pb2.Algorithms.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadProcessors(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadProcessors',
+            service__pb2.ProcessorsRead.SerializeToString,
+            service__pb2.Processors.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadResultsStats(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadResultsStats',
+            service__pb2.ResultsStatsRead.SerializeToString,
+            service__pb2.ResultsStats.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadResultFieldsForAlgorithm(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadResultFieldsForAlgorithm',
+            service__pb2.AlgorithmFieldsRead.SerializeToString,
+            service__pb2.AlgorithmFields.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadResultsForAlgorithm(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadResultsForAlgorithm',
+            service__pb2.ResultsForAlgorithmRead.SerializeToString,
+            service__pb2.ResultsForAlgorithm.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadWindows(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadWindows',
+            service__pb2.WindowsRead.SerializeToString,
+            service__pb2.Windows.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadDistinctMetadataForWindowType(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadDistinctMetadataForWindowType',
+            service__pb2.DistinctMetadataForWindowTypeRead.SerializeToString,
+            service__pb2.DistinctMetadataForWindowType.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadWindowsForMetadata(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadWindowsForMetadata',
+            service__pb2.WindowsForMetadataRead.SerializeToString,
+            service__pb2.WindowsForMetadata.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadResultsForAlgorithmAndMetadata(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadResultsForAlgorithmAndMetadata',
+            service__pb2.ResultsForAlgorithmAndMetadataRead.SerializeToString,
+            service__pb2.ResultsForAlgorithmAndMetadata.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SubscribeResults(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_stream(
+            request,
+            target,
+            '/OrcaCore/SubscribeResults',
+            service__pb2.ResultsSubscription.SerializeToString,
+            service__pb2.ResultUpdate.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Annotate(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/Annotate',
+            service__pb2.AnnotateWrite.SerializeToString,
+            service__pb2.AnnotateResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def CreateWindowSchedule(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/CreateWindowSchedule',
+            service__pb2.WindowSchedule.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ReadWindowSchedules(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadWindowSchedules',
+            service__pb2.WindowSchedulesRead.SerializeToString,
+            service__pb2.WindowSchedules.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def DeleteWindowSchedule(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/DeleteWindowSchedule',
+            service__pb2.WindowScheduleDeletion.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
 
+    @staticmethod
+    def CreateRollupRule(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/CreateRollupRule',
+            service__pb2.RollupRule.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
 
-def add_OrcaCoreServicer_to_server(servicer, server):
-    rpc_method_handlers = {
-            'RegisterProcessor': grpc.unary_unary_rpc_method_handler(
-                    servicer.RegisterProcessor,
-                    request_deserializer=service__pb2.ProcessorRegistration.FromString,
-                    response_serializer=service__pb2.Status.SerializeToString,
-            ),
-            'EmitWindow': grpc.unary_unary_rpc_method_handler(
-                    servicer.EmitWindow,
-                    request_deserializer=service__pb2.Window.FromString,
-                    response_serializer=service__pb2.WindowEmitStatus.SerializeToString,
-            ),
-            'ReadWindowTypes': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadWindowTypes,
-                    request_deserializer=service__pb2.WindowTypeRead.FromString,
-                    response_serializer=service__pb2.WindowTypes.SerializeToString,
-            ),
-            'ReadAlgorithms': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadAlgorithms,
-                    request_deserializer=service__pb2.AlgorithmsRead.FromString,
-                    response_serializer=service__pb2.Algorithms.SerializeToString,
-            ),
-            'ReadProcessors': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadProcessors,
-                    request_deserializer=service__pb2.ProcessorsRead.FromString,
-                    response_serializer=service__pb2.Processors.SerializeToString,
-            ),
-            'ReadResultsStats': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadResultsStats,
-                    request_deserializer=service__pb2.ResultsStatsRead.FromString,
-                    response_serializer=service__pb2.ResultsStats.SerializeToString,
-            ),
-            'ReadResultFieldsForAlgorithm': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadResultFieldsForAlgorithm,
-                    request_deserializer=service__pb2.AlgorithmFieldsRead.FromString,
-                    response_serializer=service__pb2.AlgorithmFields.SerializeToString,
-            ),
-            'ReadResultsForAlgorithm': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadResultsForAlgorithm,
-                    request_deserializer=service__pb2.ResultsForAlgorithmRead.FromString,
-                    response_serializer=service__pb2.ResultsForAlgorithm.SerializeToString,
-            ),
-            'ReadWindows': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadWindows,
-                    request_deserializer=service__pb2.WindowsRead.FromString,
-                    response_serializer=service__pb2.Windows.SerializeToString,
-            ),
-            'ReadDistinctMetadataForWindowType': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadDistinctMetadataForWindowType,
-                    request_deserializer=service__pb2.DistinctMetadataForWindowTypeRead.FromString,
-                    response_serializer=service__pb2.DistinctMetadataForWindowType.SerializeToString,
-            ),
-            'ReadWindowsForMetadata': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadWindowsForMetadata,
-                    request_deserializer=service__pb2.WindowsForMetadataRead.FromString,
-                    response_serializer=service__pb2.WindowsForMetadata.SerializeToString,
-            ),
-            'ReadResultsForAlgorithmAndMetadata': grpc.unary_unary_rpc_method_handler(
-                    servicer.ReadResultsForAlgorithmAndMetadata,
-                    request_deserializer=service__pb2.ResultsForAlgorithmAndMetadataRead.FromString,
-                    response_serializer=service__pb2.ResultsForAlgorithmAndMetadata.SerializeToString,
-            ),
-            'Annotate': grpc.unary_unary_rpc_method_handler(
-                    servicer.Annotate,
-                    request_deserializer=service__pb2.AnnotateWrite.FromString,
-                    response_serializer=service__pb2.AnnotateResponse.SerializeToString,
-            ),
-    }
-    generic_handler = grpc.method_handlers_generic_handler(
-            'OrcaCore', rpc_method_handlers)
-    server.add_generic_rpc_handlers((generic_handler,))
-    server.add_registered_method_handlers('OrcaCore', rpc_method_handlers)
+    @staticmethod
+    def ReadRollupRules(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadRollupRules',
+            service__pb2.RollupRulesRead.SerializeToString,
+            service__pb2.RollupRules.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
 
+    @staticmethod
+    def DeleteRollupRule(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/DeleteRollupRule',
+            service__pb2.RollupRuleDeletion.SerializeToString,
+            service__pb2.Status.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
 
- # This class is part of an EXPERIMENTAL API.
-class OrcaCore(object):
-    """OrcaCore is the central orchestration service that:
-    - Manages the lifecycle of processing windows
-    - Coordinates algorithm execution across distributed processors
-    - Tracks DAG dependencies and execution state
-    - Routes results between dependent algorithms
-    ------------------- Core operations ------------------- 
-    """
+    @staticmethod
+    def ReadChildWindows(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/OrcaCore/ReadChildWindows',
+            service__pb2.ChildWindowsRead.SerializeToString,
+            service__pb2.Windows.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
 
     @staticmethod
-    def RegisterProcessor(request,
+    def CreateAlertRule(request,
             target,
             options=(),
             channel_credentials=None,
@@ -297,8 +1723,8 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/RegisterProcessor',
-            service__pb2.ProcessorRegistration.SerializeToString,
+            '/OrcaCore/CreateAlertRule',
+            service__pb2.AlertRule.SerializeToString,
             service__pb2.Status.FromString,
             options,
             channel_credentials,
@@ -311,7 +1737,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def EmitWindow(request,
+    def ReadAlertRules(request,
             target,
             options=(),
             channel_credentials=None,
@@ -324,9 +1750,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/EmitWindow',
-            service__pb2.Window.SerializeToString,
-            service__pb2.WindowEmitStatus.FromString,
+            '/OrcaCore/ReadAlertRules',
+            service__pb2.AlertRulesRead.SerializeToString,
+            service__pb2.AlertRules.FromString,
             options,
             channel_credentials,
             insecure,
@@ -338,7 +1764,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadWindowTypes(request,
+    def DeleteAlertRule(request,
             target,
             options=(),
             channel_credentials=None,
@@ -351,9 +1777,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadWindowTypes',
-            service__pb2.WindowTypeRead.SerializeToString,
-            service__pb2.WindowTypes.FromString,
+            '/OrcaCore/DeleteAlertRule',
+            service__pb2.AlertRuleDeletion.SerializeToString,
+            service__pb2.Status.FromString,
             options,
             channel_credentials,
             insecure,
@@ -365,7 +1791,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadAlgorithms(request,
+    def ReadAlerts(request,
             target,
             options=(),
             channel_credentials=None,
@@ -378,9 +1804,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadAlgorithms',
-            service__pb2.AlgorithmsRead.SerializeToString,
-            service__pb2.Algorithms.FromString,
+            '/OrcaCore/ReadAlerts',
+            service__pb2.AlertsRead.SerializeToString,
+            service__pb2.Alerts.FromString,
             options,
             channel_credentials,
             insecure,
@@ -392,7 +1818,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadProcessors(request,
+    def AcknowledgeAlert(request,
             target,
             options=(),
             channel_credentials=None,
@@ -405,9 +1831,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadProcessors',
-            service__pb2.ProcessorsRead.SerializeToString,
-            service__pb2.Processors.FromString,
+            '/OrcaCore/AcknowledgeAlert',
+            service__pb2.AlertAcknowledgement.SerializeToString,
+            service__pb2.Status.FromString,
             options,
             channel_credentials,
             insecure,
@@ -419,7 +1845,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadResultsStats(request,
+    def ReadExecution(request,
             target,
             options=(),
             channel_credentials=None,
@@ -432,9 +1858,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadResultsStats',
-            service__pb2.ResultsStatsRead.SerializeToString,
-            service__pb2.ResultsStats.FromString,
+            '/OrcaCore/ReadExecution',
+            service__pb2.ExecutionRead.SerializeToString,
+            service__pb2.Execution.FromString,
             options,
             channel_credentials,
             insecure,
@@ -446,7 +1872,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadResultFieldsForAlgorithm(request,
+    def ReadExecutions(request,
             target,
             options=(),
             channel_credentials=None,
@@ -459,9 +1885,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadResultFieldsForAlgorithm',
-            service__pb2.AlgorithmFieldsRead.SerializeToString,
-            service__pb2.AlgorithmFields.FromString,
+            '/OrcaCore/ReadExecutions',
+            service__pb2.ExecutionsRead.SerializeToString,
+            service__pb2.Executions.FromString,
             options,
             channel_credentials,
             insecure,
@@ -473,7 +1899,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadResultsForAlgorithm(request,
+    def CancelExecution(request,
             target,
             options=(),
             channel_credentials=None,
@@ -486,9 +1912,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadResultsForAlgorithm',
-            service__pb2.ResultsForAlgorithmRead.SerializeToString,
-            service__pb2.ResultsForAlgorithm.FromString,
+            '/OrcaCore/CancelExecution',
+            service__pb2.ExecutionCancel.SerializeToString,
+            service__pb2.Status.FromString,
             options,
             channel_credentials,
             insecure,
@@ -500,7 +1926,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadWindows(request,
+    def ReadExecutionQueue(request,
             target,
             options=(),
             channel_credentials=None,
@@ -513,9 +1939,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadWindows',
-            service__pb2.WindowsRead.SerializeToString,
-            service__pb2.Windows.FromString,
+            '/OrcaCore/ReadExecutionQueue',
+            service__pb2.ExecutionQueueRead.SerializeToString,
+            service__pb2.ExecutionQueue.FromString,
             options,
             channel_credentials,
             insecure,
@@ -527,7 +1953,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadDistinctMetadataForWindowType(request,
+    def ReprocessWindows(request,
             target,
             options=(),
             channel_credentials=None,
@@ -540,9 +1966,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadDistinctMetadataForWindowType',
-            service__pb2.DistinctMetadataForWindowTypeRead.SerializeToString,
-            service__pb2.DistinctMetadataForWindowType.FromString,
+            '/OrcaCore/ReprocessWindows',
+            service__pb2.WindowsReprocess.SerializeToString,
+            service__pb2.Reprocess.FromString,
             options,
             channel_credentials,
             insecure,
@@ -554,7 +1980,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadWindowsForMetadata(request,
+    def ReadReprocess(request,
             target,
             options=(),
             channel_credentials=None,
@@ -567,9 +1993,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadWindowsForMetadata',
-            service__pb2.WindowsForMetadataRead.SerializeToString,
-            service__pb2.WindowsForMetadata.FromString,
+            '/OrcaCore/ReadReprocess',
+            service__pb2.ReprocessRead.SerializeToString,
+            service__pb2.Reprocess.FromString,
             options,
             channel_credentials,
             insecure,
@@ -581,7 +2007,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def ReadResultsForAlgorithmAndMetadata(request,
+    def ReadFailedExecutions(request,
             target,
             options=(),
             channel_credentials=None,
@@ -594,9 +2020,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/ReadResultsForAlgorithmAndMetadata',
-            service__pb2.ResultsForAlgorithmAndMetadataRead.SerializeToString,
-            service__pb2.ResultsForAlgorithmAndMetadata.FromString,
+            '/OrcaCore/ReadFailedExecutions',
+            service__pb2.FailedExecutionsRead.SerializeToString,
+            service__pb2.FailedExecutions.FromString,
             options,
             channel_credentials,
             insecure,
@@ -608,7 +2034,7 @@ class OrcaCore(object):
             _registered_method=True)
 
     @staticmethod
-    def Annotate(request,
+    def RequeueFailedExecutions(request,
             target,
             options=(),
             channel_credentials=None,
@@ -621,9 +2047,9 @@ class OrcaCore(object):
         return grpc.experimental.unary_unary(
             request,
             target,
-            '/OrcaCore/Annotate',
-            service__pb2.AnnotateWrite.SerializeToString,
-            service__pb2.AnnotateResponse.FromString,
+            '/OrcaCore/RequeueFailedExecutions',
+            service__pb2.FailedExecutionsRequeue.SerializeToString,
+            service__pb2.RequeuedExecutions.FromString,
             options,
             channel_credentials,
             insecure,